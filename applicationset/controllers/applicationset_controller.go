@@ -699,10 +699,10 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 				}
 			}
 
-			// Preserve post-delete finalizers:
+			// Preserve pre-delete and post-delete finalizers:
 			//   https://github.com/argoproj/argo-cd/issues/17181
 			for _, finalizer := range found.ObjectMeta.Finalizers {
-				if strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
+				if strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) || strings.HasPrefix(finalizer, argov1alpha1.PreDeleteFinalizerName) {
 					if generatedApp.Finalizers == nil {
 						generatedApp.Finalizers = []string{}
 					}
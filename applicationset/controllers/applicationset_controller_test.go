@@ -1250,7 +1250,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 			},
 		},
 		{
-			name: "Ensure that argocd post-delete finalizers are preserved from an existing app",
+			name: "Ensure that argocd pre-delete and post-delete finalizers are preserved from an existing app",
 			appSet: v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
@@ -1277,6 +1277,8 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						Finalizers: []string{
 							v1alpha1.PostDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName + "/mystage",
+							v1alpha1.PreDeleteFinalizerName,
+							v1alpha1.PreDeleteFinalizerName + "/cleanup",
 						},
 					},
 					Spec: v1alpha1.ApplicationSpec{
@@ -1307,6 +1309,8 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						Finalizers: []string{
 							v1alpha1.PostDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName + "/mystage",
+							v1alpha1.PreDeleteFinalizerName,
+							v1alpha1.PreDeleteFinalizerName + "/cleanup",
 						},
 					},
 					Spec: v1alpha1.ApplicationSpec{
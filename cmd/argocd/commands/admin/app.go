@@ -56,6 +56,9 @@ argocd admin app generate-spec APPNAME
 
 # Reconcile all applications and store reconciliation summary in the specified file
 argocd admin app get-reconcile-results APPNAME
+
+# Export the health and sync status timeline of an application
+argocd admin app export-timeline APPNAME
 `,
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
@@ -65,6 +68,7 @@ argocd admin app get-reconcile-results APPNAME
 	command.AddCommand(NewGenAppSpecCommand())
 	command.AddCommand(NewReconcileCommand(clientOpts))
 	command.AddCommand(NewDiffReconcileResults())
+	command.AddCommand(NewExportTimelineCommand(clientOpts))
 	return command
 }
 
@@ -433,7 +437,7 @@ func reconcileApplications(
 		sources = append(sources, app.Spec.GetSource())
 		revisions = append(revisions, app.Spec.GetSource().TargetRevision)
 
-		res, err := appStateManager.CompareAppState(&app, proj, revisions, sources, false, false, nil, false, false)
+		res, err := appStateManager.CompareAppState(context.Background(), &app, proj, revisions, sources, false, false, nil, false, false)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,148 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	argoerrors "github.com/argoproj/argo-cd/v2/util/errors"
+)
+
+// statusTimelineEntry is a status transition of an application along with the time the status lasted
+type statusTimelineEntry struct {
+	v1alpha1.StatusTransition `json:",inline"`
+	// Duration is the time the status lasted until the next transition of the same type, or until now
+	Duration string `json:"duration"`
+	// Ongoing is true if the status did not transition since
+	Ongoing bool `json:"ongoing,omitempty"`
+}
+
+// getStatusTimelineEntries returns the given status transitions along with the time each status lasted
+func getStatusTimelineEntries(timeline []v1alpha1.StatusTransition, now time.Time) []statusTimelineEntry {
+	entries := make([]statusTimelineEntry, len(timeline))
+	next := map[v1alpha1.StatusTransitionType]time.Time{}
+	for i := len(timeline) - 1; i >= 0; i-- {
+		transition := timeline[i]
+		end, ok := next[transition.Type]
+		if !ok {
+			end = now
+		}
+		entries[i] = statusTimelineEntry{
+			StatusTransition: transition,
+			Duration:         end.Sub(transition.TransitionedAt.Time).Truncate(time.Second).String(),
+			Ongoing:          !ok,
+		}
+		next[transition.Type] = transition.TransitionedAt.Time
+	}
+	return entries
+}
+
+// NewExportTimelineCommand returns a new instance of the `argocd admin app export-timeline` command
+func NewExportTimelineCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		clientConfig        clientcmd.ClientConfig
+		cacheSrc            func() (*appstatecache.Cache, error)
+		portForwardRedis    bool
+		redisCompressionStr string
+		appNamespace        string
+		output              string
+	)
+	command := &cobra.Command{
+		Use:   "export-timeline APPNAME",
+		Short: "Export the transitions of the health and sync status of an application, along with the time each status lasted",
+		Example: `
+# Export the status timeline of an application as JSON
+argocd admin app export-timeline APPNAME
+
+# Print the periods an application spent in each health and sync status
+argocd admin app export-timeline APPNAME -o wide
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			clientCfg, err := clientConfig.ClientConfig()
+			argoerrors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			argoerrors.CheckError(err)
+			kubeClient := kubernetes.NewForConfigOrDie(clientCfg)
+			appClient := appclientset.NewForConfigOrDie(clientCfg)
+
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			if appNs == "" {
+				appNs = namespace
+			}
+			app, err := appClient.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, v1.GetOptions{})
+			argoerrors.CheckError(err)
+
+			cache, err := newAppStateCache(kubeClient, namespace, portForwardRedis, cacheSrc, clientOpts.RedisName, clientOpts.RedisHaProxyName, redisCompressionStr)
+			argoerrors.CheckError(err)
+			var timeline []v1alpha1.StatusTransition
+			if err := cache.GetAppStatusTimeline(app.InstanceName(namespace), &timeline); err != nil && !errors.Is(err, appstatecache.ErrCacheMiss) {
+				argoerrors.CheckError(err)
+			}
+			entries := getStatusTimelineEntries(timeline, time.Now())
+
+			switch output {
+			case "wide":
+				printStatusTimeline(entries)
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(entries)
+				argoerrors.CheckError(err)
+				fmt.Print(string(yamlBytes))
+			case "json":
+				jsonBytes, err := json.MarshalIndent(entries, "", "  ")
+				argoerrors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			default:
+				argoerrors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application, defaults to the namespace of the kubectl context")
+	command.Flags().StringVarP(&output, "output", "o", "json", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+
+	cacheSrc = appstatecache.AddCacheFlagsToCmd(command)
+
+	// parse all added flags so far to get the redis-compression flag that was added by AddCacheFlagsToCmd() above
+	// we can ignore unchecked error here as the command will be parsed again and checked when command.Execute() is run later
+	// nolint:errcheck
+	command.ParseFlags(os.Args[1:])
+	redisCompressionStr, _ = command.Flags().GetString(cacheutil.CLIFlagRedisCompress)
+	return command
+}
+
+func printStatusTimeline(entries []statusTimelineEntry) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "TRANSITIONED AT\tTYPE\tFROM\tTO\tDURATION\tREVISION\tREASON\n")
+	for _, entry := range entries {
+		duration := entry.Duration
+		if entry.Ongoing {
+			duration = fmt.Sprintf("%s (ongoing)", duration)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", entry.TransitionedAt.UTC().Format(time.RFC3339), entry.Type, entry.From, entry.To, duration, entry.Revision, strings.ReplaceAll(entry.Reason, "\n", " "))
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestGetStatusTimelineEntries(t *testing.T) {
+	now := time.Now()
+	timeline := []v1alpha1.StatusTransition{
+		{Type: v1alpha1.StatusTransitionHealth, From: "Healthy", To: "Degraded", TransitionedAt: v1.NewTime(now.Add(-time.Hour))},
+		{Type: v1alpha1.StatusTransitionSync, From: "Synced", To: "OutOfSync", TransitionedAt: v1.NewTime(now.Add(-50 * time.Minute))},
+		{Type: v1alpha1.StatusTransitionHealth, From: "Degraded", To: "Healthy", TransitionedAt: v1.NewTime(now.Add(-15 * time.Minute))},
+	}
+
+	entries := getStatusTimelineEntries(timeline, now)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, "Degraded", entries[0].To)
+	assert.Equal(t, "45m0s", entries[0].Duration)
+	assert.False(t, entries[0].Ongoing)
+	assert.Equal(t, "50m0s", entries[1].Duration)
+	assert.True(t, entries[1].Ongoing)
+	assert.Equal(t, "15m0s", entries[2].Duration)
+	assert.True(t, entries[2].Ongoing)
+
+	assert.Empty(t, getStatusTimelineEntries(nil, now))
+}
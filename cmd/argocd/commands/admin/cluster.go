@@ -78,6 +78,37 @@ type ClusterWithInfo struct {
 	Namespaces []string
 }
 
+// newAppStateCache returns the application state cache, port-forwarding the redis of the given namespace if requested
+func newAppStateCache(kubeClient kubernetes.Interface, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), redisName string, redisHaProxyName string, redisCompressionStr string) (*appstatecache.Cache, error) {
+	if !portForwardRedis {
+		return cacheSrc()
+	}
+	overrides := clientcmd.ConfigOverrides{}
+	redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
+	redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
+	port, err := kubeutil.PortForward(6379, namespace, &overrides,
+		redisHaProxyPodLabelSelector, redisPodLabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
+
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.Background(), defaulRedisInitialPasswordSecretName, v1.GetOptions{})
+	if err == nil {
+		if _, ok := secret.Data[defaultResisInitialPasswordKey]; ok {
+			redisOptions.Password = string(secret.Data[defaultResisInitialPasswordKey])
+		}
+	}
+
+	client := redis.NewClient(redisOptions)
+	compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
+	if err != nil {
+		return nil, err
+	}
+	return appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour), nil
+}
+
 func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClient *versioned.Clientset, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, error) {
 	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
 
@@ -90,43 +121,19 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 	if err != nil {
 		return nil, err
 	}
+	cache, err := newAppStateCache(kubeClient, namespace, portForwardRedis, cacheSrc, redisName, redisHaProxyName, redisCompressionStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cluster info is required by the resource-weighted sharding algorithm
+	for i := range clustersList.Items {
+		_ = cache.GetClusterInfo(clustersList.Items[i].Server, &clustersList.Items[i].Info)
+	}
 	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
 	clusterShardingCache.Init(clustersList, appItems)
 	clusterShards := clusterShardingCache.GetDistribution()
 
-	var cache *appstatecache.Cache
-	if portForwardRedis {
-		overrides := clientcmd.ConfigOverrides{}
-		redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
-		redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
-		port, err := kubeutil.PortForward(6379, namespace, &overrides,
-			redisHaProxyPodLabelSelector, redisPodLabelSelector)
-		if err != nil {
-			return nil, err
-		}
-
-		redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
-
-		secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.Background(), defaulRedisInitialPasswordSecretName, v1.GetOptions{})
-		if err == nil {
-			if _, ok := secret.Data[defaultResisInitialPasswordKey]; ok {
-				redisOptions.Password = string(secret.Data[defaultResisInitialPasswordKey])
-			}
-		}
-
-		client := redis.NewClient(redisOptions)
-		compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
-		if err != nil {
-			return nil, err
-		}
-		cache = appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour)
-	} else {
-		cache, err = cacheSrc()
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	apps := appItems.Items
 	for i, app := range apps {
 		err := argo.ValidateDestination(ctx, &app.Spec.Destination, argoDB)
@@ -167,7 +174,6 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 			for ns := range nsSet {
 				namespaces = append(namespaces, ns)
 			}
-			_ = cache.GetClusterInfo(cluster.Server, &cluster.Info)
 			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces}
 			return nil
 		})
@@ -230,7 +236,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, resource-weighted] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
@@ -246,18 +252,31 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 func printStatsSummary(clusters []ClusterWithInfo) {
 	totalResourcesCount := int64(0)
 	resourcesCountByShard := map[int]int64{}
+	totalWeight := int64(0)
+	weightByShard := map[int]int64{}
+	clustersCountByShard := map[int]int{}
+	appsCountByShard := map[int]int64{}
 	for _, c := range clusters {
 		totalResourcesCount += c.Info.CacheInfo.ResourcesCount
 		resourcesCountByShard[c.Shard] += c.Info.CacheInfo.ResourcesCount
+		weight := sharding.GetClusterWeight(&c.Cluster, c.Info.ApplicationsCount)
+		totalWeight += weight
+		weightByShard[c.Shard] += weight
+		clustersCountByShard[c.Shard]++
+		appsCountByShard[c.Shard] += c.Info.ApplicationsCount
 	}
 
 	avgResourcesByShard := totalResourcesCount / int64(len(resourcesCountByShard))
+	avgWeightByShard := totalWeight / int64(len(weightByShard))
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, "SHARD\tRESOURCES COUNT\n")
+	_, _ = fmt.Fprintf(w, "SHARD\tCLUSTERS\tAPPS\tRESOURCES COUNT\tWEIGHT\n")
 	for shard := 0; shard < len(resourcesCountByShard); shard++ {
 		cnt := resourcesCountByShard[shard]
 		percent := (float64(cnt) / float64(avgResourcesByShard)) * 100.0
-		_, _ = fmt.Fprintf(w, "%d\t%s\n", shard, fmt.Sprintf("%d (%.0f%%)", cnt, percent))
+		weight := weightByShard[shard]
+		weightPercent := (float64(weight) / float64(avgWeightByShard)) * 100.0
+		_, _ = fmt.Fprintf(w, "%d\t%d\t%d\t%s\t%s\n", shard, clustersCountByShard[shard], appsCountByShard[shard],
+			fmt.Sprintf("%d (%.0f%%)", cnt, percent), fmt.Sprintf("%d (%.0f%%)", weight, weightPercent))
 	}
 	_ = w.Flush()
 }
@@ -521,7 +540,7 @@ argocd admin cluster stats target-cluster`,
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, resource-weighted] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
@@ -0,0 +1,116 @@
+package admin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/config"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/manifestpolicy"
+)
+
+// NewProjectCheckManifestPoliciesCommand checks local manifests against the manifest policies of a project
+func NewProjectCheckManifestPoliciesCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "check-manifest-policies PROJECT_PATH MANIFESTS_PATH...",
+		Short: "Checks local manifests against the manifest policies of the specified project file",
+		Long:  "Checks local manifests against the manifest policies of the specified project file. Exits with code 1 if any manifest violates a policy with Deny severity.",
+		Example: `# Checks the manifests of a directory against the manifest policies of a project
+argocd admin proj check-manifest-policies /path/to/project.yaml /path/to/manifests/
+
+# Checks rendered manifests against the manifest policies of a project
+kustomize build . > /tmp/manifests.yaml && argocd admin proj check-manifest-policies project.yaml /tmp/manifests.yaml`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) < 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			violations, err := checkManifestPolicies(args[0], args[1:])
+			errors.CheckError(err)
+			if len(violations) == 0 {
+				fmt.Println("No manifest policy violations found")
+				return
+			}
+
+			denied := false
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "SEVERITY\tPOLICY\tGROUP\tKIND\tNAMESPACE\tNAME\tMESSAGE\n")
+			for _, violation := range violations {
+				if violation.Severity == v1alpha1.ManifestPolicySeverityDeny {
+					denied = true
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", violation.Severity, violation.Policy, violation.Resource.Group, violation.Resource.Kind, violation.Resource.Namespace, violation.Resource.Name, violation.Message)
+			}
+			_ = w.Flush()
+			if denied {
+				os.Exit(1)
+			}
+		},
+	}
+	return command
+}
+
+// checkManifestPolicies evaluates the manifest policies of the project file against the manifests of the given files or directories
+func checkManifestPolicies(projPath string, manifestPaths []string) ([]manifestpolicy.Violation, error) {
+	var proj v1alpha1.AppProject
+	if err := config.UnmarshalLocalFile(projPath, &proj); err != nil {
+		return nil, fmt.Errorf("error reading project file %s: %w", projPath, err)
+	}
+	if err := proj.ValidateProject(); err != nil {
+		return nil, fmt.Errorf("invalid project %s: %w", proj.Name, err)
+	}
+	if err := manifestpolicy.Validate(proj.Spec.ManifestPolicies); err != nil {
+		return nil, err
+	}
+	var objs []*unstructured.Unstructured
+	for _, manifestPath := range manifestPaths {
+		pathObjs, err := readLocalManifests(manifestPath)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, pathObjs...)
+	}
+	return manifestpolicy.Evaluate(proj.Spec.ManifestPolicies, objs), nil
+}
+
+// readLocalManifests reads the manifests of a YAML or JSON file, or of all YAML and JSON files of a directory
+func readLocalManifests(manifestPath string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	err := filepath.Walk(manifestPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if path != manifestPath {
+			switch strings.ToLower(filepath.Ext(path)) {
+			case ".yaml", ".yml", ".json":
+			default:
+				return nil
+			}
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		fileObjs, err := kube.SplitYAML(data)
+		if err != nil {
+			return fmt.Errorf("error parsing manifests of %s: %w", path, err)
+		}
+		objs = append(objs, fileObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
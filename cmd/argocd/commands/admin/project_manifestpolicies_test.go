@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestCheckManifestPolicies(t *testing.T) {
+	violations, err := checkManifestPolicies("testdata/manifestpolicies/project.yaml", []string{"testdata/manifestpolicies/manifests"})
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+
+	assert.Equal(t, "no-latest-images", violations[0].Policy)
+	assert.Equal(t, v1alpha1.ManifestPolicySeverityDeny, violations[0].Severity)
+	assert.Equal(t, "Deployment", violations[0].Resource.Kind)
+
+	assert.Equal(t, "team-label", violations[1].Policy)
+	assert.Equal(t, v1alpha1.ManifestPolicySeverityWarn, violations[1].Severity)
+	assert.Equal(t, "Service", violations[1].Resource.Kind)
+}
+
+func TestCheckManifestPolicies_InvalidProject(t *testing.T) {
+	_, err := checkManifestPolicies("testdata/manifestpolicies/missing.yaml", []string{"testdata/manifestpolicies/manifests"})
+	require.Error(t, err)
+}
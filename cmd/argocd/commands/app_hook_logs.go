@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// NewApplicationHookLogsCommand returns a new instance of the `argocd app hook-logs` command
+func NewApplicationHookLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var resourceName string
+	var kind string
+	var group string
+	var project string
+	command := &cobra.Command{
+		Use:   "hook-logs APPNAME",
+		Short: "Print the logs captured from the pods of the failed hooks of the last sync of an application",
+		Example: `  # Print the logs of all the failed hooks of the last sync
+  argocd app hook-logs my-app
+
+  # Print the logs of a failed PreSync migration job
+  argocd app hook-logs my-app --kind Job --resource-name migrate`,
+	}
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of the hook")
+	command.Flags().StringVar(&kind, "kind", "", "Kind of the hook")
+	command.Flags().StringVar(&group, "group", "", "Group of the hook")
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Run = func(c *cobra.Command, args []string) {
+		ctx := c.Context()
+
+		if len(args) != 1 {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		}
+		appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+		defer argoio.Close(conn)
+		query := &applicationpkg.ResourcesQuery{
+			ApplicationName: &appName,
+			AppNamespace:    &appNs,
+			Project:         &project,
+		}
+		if resourceName != "" {
+			query.Name = &resourceName
+		}
+		if kind != "" {
+			query.Kind = &kind
+		}
+		if c.Flags().Changed("group") {
+			query.Group = &group
+		}
+		res, err := appIf.ListHookLogs(ctx, query)
+		errors.CheckError(err)
+		if len(res.Items) == 0 {
+			fmt.Println("No logs were captured from failed hooks")
+			return
+		}
+		for _, hookLogs := range res.Items {
+			for _, container := range hookLogs.Containers {
+				fmt.Printf("==> %s hook %s/%s, pod %s, container %s <==\n", hookLogs.HookType, hookLogs.Kind, hookLogs.Name, hookLogs.PodName, container.Container)
+				if container.Truncated {
+					fmt.Println("(truncated)")
+				}
+				fmt.Println(container.Logs)
+			}
+		}
+	}
+	return command
+}
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// NewApplicationMaintenanceCommand returns a new instance of the `argocd app maintenance` command
+func NewApplicationMaintenanceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Manage the maintenance mode of an application, which suspends automated sync and self-heal",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationEnableMaintenanceCommand(clientOpts))
+	command.AddCommand(NewApplicationDisableMaintenanceCommand(clientOpts))
+	return command
+}
+
+// NewApplicationEnableMaintenanceCommand returns a new instance of the `argocd app maintenance enable` command
+func NewApplicationEnableMaintenanceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var reason string
+	var duration string
+	var project string
+	command := &cobra.Command{
+		Use:   "enable APPNAME",
+		Short: "Put an application in maintenance mode",
+		Example: `  # Suspend automated sync of an application for the next 24 hours
+  argocd app maintenance enable my-app --reason "database migration"
+
+  # Suspend automated sync of an application for the next 2 hours
+  argocd app maintenance enable my-app --reason "database migration" --duration 2h`,
+	}
+	command.Flags().StringVar(&reason, "reason", "", "Reason for putting the application in maintenance mode")
+	command.Flags().StringVar(&duration, "duration", "", "Duration of the maintenance mode, after which automated sync resumes (default 24h)")
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Run = func(c *cobra.Command, args []string) {
+		ctx := c.Context()
+
+		if len(args) != 1 {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		}
+		appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+		defer argoio.Close(conn)
+		app, err := appIf.EnableMaintenance(ctx, &applicationpkg.ApplicationMaintenanceRequest{
+			Name:         &appName,
+			AppNamespace: &appNs,
+			Reason:       &reason,
+			Duration:     &duration,
+			Project:      &project,
+		})
+		errors.CheckError(err)
+		fmt.Printf("Application '%s' is in maintenance mode until %s\n", appName, app.Status.Maintenance.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	return command
+}
+
+// NewApplicationDisableMaintenanceCommand returns a new instance of the `argocd app maintenance disable` command
+func NewApplicationDisableMaintenanceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var project string
+	command := &cobra.Command{
+		Use:   "disable APPNAME",
+		Short: "End the maintenance mode of an application",
+	}
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Run = func(c *cobra.Command, args []string) {
+		ctx := c.Context()
+
+		if len(args) != 1 {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		}
+		appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+		defer argoio.Close(conn)
+		_, err := appIf.DisableMaintenance(ctx, &applicationpkg.ApplicationMaintenanceRequest{
+			Name:         &appName,
+			AppNamespace: &appNs,
+			Project:      &project,
+		})
+		errors.CheckError(err)
+		fmt.Printf("Application '%s' is no longer in maintenance mode\n", appName)
+	}
+	return command
+}
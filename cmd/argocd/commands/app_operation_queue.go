@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// NewApplicationOperationQueueCommand returns a new instance of the `argocd app queue` command
+func NewApplicationOperationQueueCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "queue",
+		Short: "Manage the operations queued while an operation of an application is in progress",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationListQueuedOperationsCommand(clientOpts))
+	command.AddCommand(NewApplicationCancelQueuedOperationCommand(clientOpts))
+	return command
+}
+
+// NewApplicationListQueuedOperationsCommand returns a new instance of the `argocd app queue list` command
+func NewApplicationListQueuedOperationsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var project string
+	command := &cobra.Command{
+		Use:   "list APPNAME",
+		Short: "List the queued operations of an application, in the order they will run",
+	}
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Run = func(c *cobra.Command, args []string) {
+		ctx := c.Context()
+
+		if len(args) != 1 {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		}
+		appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+		defer argoio.Close(conn)
+		query := &applicationpkg.ApplicationQuery{
+			Name:         &appName,
+			AppNamespace: &appNs,
+		}
+		if project != "" {
+			query.Project = []string{project}
+		}
+		res, err := appIf.GetOperationQueue(ctx, query)
+		errors.CheckError(err)
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintf(w, "ID\tQUEUED AT\tINITIATED BY\tREVISION\n")
+		for _, queued := range res.Items {
+			initiatedBy := queued.Operation.InitiatedBy.Username
+			if queued.Operation.InitiatedBy.Automated {
+				initiatedBy = "automated"
+			}
+			revision := ""
+			if queued.Operation.Sync != nil {
+				revision = queued.Operation.Sync.Revision
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", queued.ID, queued.QueuedAt.UTC().Format(time.RFC3339), initiatedBy, revision)
+		}
+		_ = w.Flush()
+	}
+	return command
+}
+
+// NewApplicationCancelQueuedOperationCommand returns a new instance of the `argocd app queue cancel` command
+func NewApplicationCancelQueuedOperationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var project string
+	command := &cobra.Command{
+		Use:   "cancel APPNAME ID",
+		Short: "Remove an operation from the queue of an application",
+	}
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Run = func(c *cobra.Command, args []string) {
+		ctx := c.Context()
+
+		if len(args) != 2 {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		}
+		appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+		id := args[1]
+
+		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+		defer argoio.Close(conn)
+		_, err := appIf.CancelQueuedOperation(ctx, &applicationpkg.ApplicationQueuedOperationRequest{
+			Name:         &appName,
+			Id:           &id,
+			AppNamespace: &appNs,
+			Project:      &project,
+		})
+		errors.CheckError(err)
+		fmt.Printf("Queued operation '%s' of application '%s' cancelled\n", id, appName)
+	}
+	return command
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
 	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 	projectpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/cli"
@@ -77,6 +78,7 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectWindowsCommand(clientOpts))
 	command.AddCommand(NewProjectAddOrphanedIgnoreCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveOrphanedIgnoreCommand(clientOpts))
+	command.AddCommand(NewProjectOrphanedResourcesCommand(clientOpts))
 	command.AddCommand(NewProjectAddSourceNamespace(clientOpts))
 	command.AddCommand(NewProjectRemoveSourceNamespace(clientOpts))
 	return command
@@ -462,6 +464,70 @@ func NewProjectRemoveOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOption
 	return command
 }
 
+// NewProjectOrphanedResourcesCommand returns a new instance of an `argocd proj orphaned-resources` command
+func NewProjectOrphanedResourcesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "orphaned-resources PROJECT",
+		Short: "Preview the orphaned resources affected by the orphaned resources actions of a project",
+		Example: templates.Examples(`
+		# List the orphaned resources of the applications of the project with name PROJECT which would be deleted or adopted
+		argocd proj orphaned-resources PROJECT
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			projConn, projIf := clientset.NewProjectClientOrDie()
+			defer argoio.Close(projConn)
+			appConn, appIf := clientset.NewApplicationClientOrDie()
+			defer argoio.Close(appConn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+			apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{Projects: []string{projName}})
+			errors.CheckError(err)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "APP\tACTION\tGROUP\tKIND\tNAMESPACE\tNAME\tSCHEDULED\tDRY-RUN\n")
+			for _, app := range apps.Items {
+				appName := app.Name
+				appNs := app.Namespace
+				tree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{
+					ApplicationName: &appName,
+					AppNamespace:    &appNs,
+					Project:         &projName,
+				})
+				errors.CheckError(err)
+				scheduled := map[string]v1alpha1.OrphanedResourceStatus{}
+				for _, res := range app.Status.OrphanedResources {
+					scheduled[fmt.Sprintf("%s/%s/%s/%s", res.Group, res.Kind, res.Namespace, res.Name)] = res
+				}
+				for _, node := range tree.OrphanedNodes {
+					if len(node.ParentRefs) > 0 {
+						continue
+					}
+					action := proj.Spec.OrphanedResources.GetAction(node.Group, node.Kind, node.Name)
+					if action == nil {
+						continue
+					}
+					scheduledAt := "-"
+					if res, ok := scheduled[fmt.Sprintf("%s/%s/%s/%s", node.Group, node.Kind, node.Namespace, node.Name)]; ok && res.Action == action.Action {
+						scheduledAt = res.ScheduledAt.UTC().Format(time.RFC3339)
+					}
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%v\n", app.QualifiedName(), action.Action, node.Group, node.Kind, node.Namespace, node.Name, scheduledAt, action.DryRun)
+				}
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
+
 // NewProjectAddSourceCommand returns a new instance of an `argocd proj add-src` command
 func NewProjectAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
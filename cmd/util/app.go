@@ -80,6 +80,9 @@ type AppOptions struct {
 	retryBackoffDuration            time.Duration
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
+	retryBackoffJitter              int64
+	retryPolicy                     string
+	syncTimeout                     time.Duration
 	ref                             string
 }
 
@@ -136,6 +139,9 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().DurationVar(&opts.retryBackoffDuration, "sync-retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Sync retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
+	command.Flags().Int64Var(&opts.retryBackoffJitter, "sync-retry-backoff-jitter", 0, "Max percentage (0-100) by which the sync retry backoff duration is randomly reduced")
+	command.Flags().StringVar(&opts.retryPolicy, "sync-retry-policy", string(argoappv1.RetryPolicyAlways), "Which failed syncs are retried (one of: Always, Transient)")
+	command.Flags().DurationVar(&opts.syncTimeout, "sync-timeout", 0, "Max duration of each sync attempt before it is terminated and marked as Failed (e.g. 30m, 1h). Set to 0 to remove the timeout")
 	command.Flags().StringVar(&opts.ref, "ref", "", "Ref is reference to another source within sources field")
 }
 
@@ -221,6 +227,16 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 						Factor:      ptr.To(appOpts.retryBackoffFactor),
 					},
 				}
+				if appOpts.retryBackoffJitter > 0 {
+					spec.SyncPolicy.Retry.Backoff.Jitter = ptr.To(appOpts.retryBackoffJitter)
+				}
+				switch argoappv1.RetryPolicy(appOpts.retryPolicy) {
+				case argoappv1.RetryPolicyAlways:
+				case argoappv1.RetryPolicyTransient:
+					spec.SyncPolicy.Retry.Policy = argoappv1.RetryPolicyTransient
+				default:
+					log.Fatalf("Invalid sync-retry-policy [%s]", appOpts.retryPolicy)
+				}
 			} else if appOpts.retryLimit == 0 {
 				if spec.SyncPolicy.IsZero() {
 					spec.SyncPolicy = nil
@@ -230,6 +246,23 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			} else {
 				log.Fatalf("Invalid sync-retry-limit [%d]", appOpts.retryLimit)
 			}
+		case "sync-timeout":
+			switch {
+			case appOpts.syncTimeout > 0:
+				if spec.SyncPolicy == nil {
+					spec.SyncPolicy = &argoappv1.SyncPolicy{}
+				}
+				spec.SyncPolicy.Timeout = appOpts.syncTimeout.String()
+			case appOpts.syncTimeout == 0:
+				if spec.SyncPolicy != nil {
+					spec.SyncPolicy.Timeout = ""
+				}
+				if spec.SyncPolicy.IsZero() {
+					spec.SyncPolicy = nil
+				}
+			default:
+				log.Fatalf("Invalid sync-timeout [%s]", appOpts.syncTimeout)
+			}
 		}
 	})
 	if flags.Changed("auto-prune") {
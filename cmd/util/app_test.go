@@ -228,6 +228,23 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-retry-limit", "0"))
 		assert.Nil(t, f.spec.SyncPolicy.Retry)
 	})
+	t.Run("RetryPolicy", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("sync-retry-policy", "Transient"))
+		require.NoError(t, f.SetFlag("sync-retry-backoff-jitter", "20"))
+		require.NoError(t, f.SetFlag("sync-retry-limit", "5"))
+		assert.Equal(t, v1alpha1.RetryPolicyTransient, f.spec.SyncPolicy.Retry.Policy)
+		assert.Equal(t, int64(20), *f.spec.SyncPolicy.Retry.Backoff.Jitter)
+
+		require.NoError(t, f.SetFlag("sync-retry-limit", "0"))
+		assert.Nil(t, f.spec.SyncPolicy.Retry)
+	})
+	t.Run("SyncTimeout", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("sync-timeout", "30m"))
+		assert.Equal(t, "30m0s", f.spec.SyncPolicy.Timeout)
+
+		require.NoError(t, f.SetFlag("sync-timeout", "0"))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
 	t.Run("Kustomize", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-deployment=2"))
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-statefulset=4"))
@@ -133,7 +133,22 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// ResourceWeightedShardingAlgorithm uses an algorithm that distributes clusters across all shards based on the
+	// amount of work they cause: the number of cached resources and APIs of a cluster, and the number of applications
+	// targeting it. Each cluster is assigned to the shard its ID hashes to, unless the load of that shard would exceed
+	// the average load by more than a threshold, in which case it is assigned to the least loaded shard. Clusters are
+	// only reweighted once their weight changes by more than the threshold.
+	ResourceWeightedShardingAlgorithm = "resource-weighted"
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
+
+	// ApplicationShardingKeyApplication distributes the applications of an application-sharded cluster across all
+	// shards based on their qualified name
+	ApplicationShardingKeyApplication = "application"
+	// ApplicationShardingKeyNamespace distributes the applications of an application-sharded cluster across all
+	// shards based on their destination namespace, so that applications deploying to the same namespace are
+	// processed by the same shard
+	ApplicationShardingKeyNamespace = "namespace"
 )
 
 // Dex related constants
@@ -204,6 +219,23 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+
+	// AnnotationKeyHookTimeout is the maximum amount of time a hook may run before the sync operation is terminated.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h").
+	AnnotationKeyHookTimeout = "argocd.argoproj.io/hook-timeout"
+
+	// AnnotationKeySkipPreDeleteHooks forces the deletion of an Application without running or waiting for its PreDelete hooks
+	// when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
+	AnnotationKeySkipPreDeleteHooks = "argocd.argoproj.io/skip-pre-delete-hooks"
+
+	// AnnotationKeyDeletionWave is the wave in which a resource is deleted during the cascaded deletion of its Application.
+	// Resources are deleted from the highest wave to the lowest, and the wave defaults to the sync wave of the resource.
+	AnnotationKeyDeletionWave = "argocd.argoproj.io/deletion-wave"
+
+	// AnnotationKeyDeletionWaveTimeout is the maximum amount of time the cascaded deletion of an Application waits for the
+	// resources of a deletion wave to be gone before reporting the finalizers blocking them. Default unit is seconds, but
+	// could also be a duration (e.g. "2m", "1h").
+	AnnotationKeyDeletionWaveTimeout = "argocd.argoproj.io/deletion-wave-timeout"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -258,6 +290,14 @@ const (
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvControllerShardingRebalanceThreshold is the percentage by which the load of a shard may exceed the average load
+	// before the resource-weighted sharding algorithm assigns clusters to other shards
+	EnvControllerShardingRebalanceThreshold = "ARGOCD_CONTROLLER_SHARDING_REBALANCE_THRESHOLD"
+	// EnvControllerApplicationShardingClusters is a comma separated list of servers of the clusters whose applications,
+	// instead of the clusters themselves, are distributed across the controller shards
+	EnvControllerApplicationShardingClusters = "ARGOCD_CONTROLLER_APPLICATION_SHARDING_CLUSTERS"
+	// EnvControllerApplicationShardingKey is the key used to distribute the applications of application-sharded clusters: application or namespace
+	EnvControllerApplicationShardingKey = "ARGOCD_CONTROLLER_APPLICATION_SHARDING_KEY"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
@@ -24,6 +24,9 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/semaphore"
 	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -39,6 +42,7 @@ import (
 	"k8s.io/client-go/informers"
 	informerv1 "k8s.io/client-go/informers/apps/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
@@ -453,6 +457,9 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 // setAppManagedResources will build a list of ResourceDiff based on the provided comparisonResult
 // and persist app resources related data in the cache. Will return the persisted ApplicationTree.
 func (ctrl *ApplicationController) setAppManagedResources(a *appv1.Application, comparisonResult *comparisonResult) (*appv1.ApplicationTree, error) {
+	if a.Spec.HasMultipleDestinations() {
+		return ctrl.setAppDestinationsManagedResources(a, comparisonResult)
+	}
 	managedResources, err := ctrl.hideSecretData(a, comparisonResult)
 	if err != nil {
 		return nil, fmt.Errorf("error getting managed resources: %w", err)
@@ -948,10 +955,48 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 			message := fmt.Sprintf("Unable to delete application resources: %v", err.Error())
 			ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonStatusRefreshed, Type: v1.EventTypeWarning}, message, context.TODO())
 		}
+	} else if len(app.Status.OperationQueue) > 0 {
+		ctrl.startQueuedOperation(app)
 	}
 	return
 }
 
+// startQueuedOperation starts the first operation of the queue of the application, once no operation is in progress
+func (ctrl *ApplicationController) startQueuedOperation(app *appv1.Application) {
+	logCtx := getAppLog(app)
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	for {
+		// the informer might have stale data, while the api server updates the queue
+		freshApp, err := appIf.Get(context.Background(), app.Name, metav1.GetOptions{})
+		if err != nil {
+			logCtx.Errorf("Failed to retrieve latest application state: %v", err)
+			return
+		}
+		if freshApp.Operation != nil || freshApp.DeletionTimestamp != nil || len(freshApp.Status.OperationQueue) == 0 {
+			return
+		}
+		queued := freshApp.Status.OperationQueue[0]
+		freshApp.Status.OperationQueue = freshApp.Status.OperationQueue[1:]
+		freshApp.Operation = queued.Operation.DeepCopy()
+		freshApp.Status.OperationState = nil
+		updated, err := appIf.Update(context.Background(), freshApp, metav1.UpdateOptions{})
+		if err == nil {
+			ctrl.writeBackToInformer(updated)
+			message := fmt.Sprintf("Started queued operation %s initiated by %s", queued.ID, queued.Operation.InitiatedBy.Username)
+			if queued.Operation.InitiatedBy.Automated {
+				message = fmt.Sprintf("Started queued operation %s initiated automatically", queued.ID)
+			}
+			ctrl.logAppEvent(updated, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeNormal}, message, context.TODO())
+			return
+		}
+		if !apierr.IsConflict(err) {
+			logCtx.Errorf("Failed to start queued operation %s: %v", queued.ID, err)
+			return
+		}
+		logCtx.Warnf("Failed to start queued operation %s due to update conflict. Retrying again...", queued.ID)
+	}
+}
+
 func (ctrl *ApplicationController) processAppComparisonTypeQueueItem() (processNext bool) {
 	key, shutdown := ctrl.appComparisonTypeRefreshQueue.Get()
 	processNext = true
@@ -1106,10 +1151,16 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		return err
 	}
 
+	if app.Spec.HasMultipleDestinations() {
+		return ctrl.finalizeApplicationDestinationsDeletion(app, proj, projectClusters)
+	}
+
 	isValid, cluster := ctrl.isValidDestination(app)
 	if !isValid {
 		app.UnSetCascadedDeletion()
 		app.UnSetPostDeleteFinalizer()
+		app.UnSetPreDeleteFinalizer()
+		app.UnSetPreDeleteFinalizer("cleanup")
 		if err := ctrl.updateFinalizers(app); err != nil {
 			return err
 		}
@@ -1117,59 +1168,63 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		return nil
 	}
 	config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, app, cluster.RESTConfig())
+	// the resources and the hooks are deleted, and the hooks are created, on behalf of the service account
+	if err := ctrl.impersonateDestinationServiceAccount(app, proj, config); err != nil {
+		return err
+	}
 
-	if app.CascadedDeletion() {
-		logCtx.Infof("Deleting resources")
-		// ApplicationDestination points to a valid cluster, so we may clean up the live objects
-		objs := make([]*unstructured.Unstructured, 0)
+	if (app.HasPreDeleteFinalizer() || app.HasPreDeleteFinalizer("cleanup")) && skipPreDeleteHooks(app) {
+		logCtx.Warnf("Skipping PreDelete hooks as requested by the %s annotation", common.AnnotationKeySkipPreDeleteHooks)
+		app.UnSetPreDeleteFinalizer()
+		app.UnSetPreDeleteFinalizer("cleanup")
+		return ctrl.updateFinalizers(app)
+	}
+
+	if app.HasPreDeleteFinalizer() {
 		objsMap, err := ctrl.getPermittedAppLiveObjects(app, proj, projectClusters)
 		if err != nil {
 			return err
 		}
 
-		for k := range objsMap {
-			// Wait for objects pending deletion to complete before proceeding with next sync wave
-			if objsMap[k].GetDeletionTimestamp() != nil {
-				logCtx.Infof("%d objects remaining for deletion", len(objsMap))
-				return nil
-			}
-
-			if ctrl.shouldBeDeleted(app, objsMap[k]) {
-				objs = append(objs, objsMap[k])
-			}
+		done, failedMessage, err := ctrl.executePreDeleteHooks(app, proj, objsMap, config, logCtx)
+		if err != nil {
+			return err
 		}
-
-		filteredObjs := FilterObjectsForDeletion(objs)
-
-		propagationPolicy := metav1.DeletePropagationForeground
-		if app.GetPropagationPolicy() == appv1.BackgroundPropagationPolicyFinalizer {
-			propagationPolicy = metav1.DeletePropagationBackground
+		if failedMessage != "" {
+			ctrl.blockAppDeletion(app, failedMessage)
+			return nil
+		}
+		if !done {
+			return nil
 		}
-		logCtx.Infof("Deleting application's resources with %s propagation policy", propagationPolicy)
+		app.UnSetPreDeleteFinalizer()
+		return ctrl.updateFinalizers(app)
+	}
 
-		err = kube.RunAllAsync(len(filteredObjs), func(i int) error {
-			obj := filteredObjs[i]
-			return ctrl.kubectl.DeleteResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
-		})
+	if app.HasPreDeleteFinalizer("cleanup") {
+		objsMap, err := ctrl.getPermittedAppLiveObjects(app, proj, projectClusters)
 		if err != nil {
 			return err
 		}
 
-		objsMap, err = ctrl.getPermittedAppLiveObjects(app, proj, projectClusters)
+		done, err := ctrl.cleanupPreDeleteHooks(objsMap, config, logCtx)
 		if err != nil {
 			return err
 		}
-
-		for k, obj := range objsMap {
-			if !ctrl.shouldBeDeleted(app, obj) {
-				delete(objsMap, k)
-			}
-		}
-		if len(objsMap) > 0 {
-			logCtx.Infof("%d objects remaining for deletion", len(objsMap))
+		if !done {
 			return nil
 		}
-		logCtx.Infof("Successfully deleted %d resources", len(objs))
+		app.UnSetPreDeleteFinalizer("cleanup")
+		return ctrl.updateFinalizers(app)
+	}
+
+	if app.CascadedDeletion() {
+		logCtx.Infof("Deleting resources")
+		// ApplicationDestination points to a valid cluster, so we may clean up the live objects
+		done, err := ctrl.deleteAppResources(app, "", proj, projectClusters, config, logCtx)
+		if err != nil || !done {
+			return err
+		}
 		app.UnSetCascadedDeletion()
 		return ctrl.updateFinalizers(app)
 	}
@@ -1222,6 +1277,102 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	return nil
 }
 
+// blockAppDeletion reports that the deletion of the application is blocked by its failed PreDelete hooks. The event is
+// only emitted when the failure changes, not on each reconciliation of the blocked deletion.
+func (ctrl *ApplicationController) blockAppDeletion(app *appv1.Application, failedMessage string) {
+	for _, c := range app.Status.Conditions {
+		if c.Type == appv1.ApplicationConditionPreDeleteError && c.Message == failedMessage {
+			return
+		}
+	}
+	ctrl.setAppCondition(app, appv1.ApplicationCondition{Type: appv1.ApplicationConditionPreDeleteError, Message: failedMessage})
+	message := fmt.Sprintf("Application deletion is blocked: %s", failedMessage)
+	ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonStatusRefreshed, Type: v1.EventTypeWarning}, message, context.TODO())
+}
+
+// impersonateDestinationServiceAccount makes config modify the resources of the application on behalf of the service
+// account of its destination, if impersonation is enabled
+func (ctrl *ApplicationController) impersonateDestinationServiceAccount(app *appv1.Application, proj *appv1.AppProject, config *rest.Config) error {
+	impersonationEnabled, err := ctrl.settingsMgr.IsImpersonationEnabled()
+	if err != nil {
+		return fmt.Errorf("error getting impersonation settings: %w", err)
+	}
+	if !impersonationEnabled {
+		return nil
+	}
+	serviceAccount, err := proj.GetDestinationServiceAccount(app.Spec.Destination, app.Namespace)
+	if err != nil {
+		return fmt.Errorf("error getting the service account to impersonate: %w", err)
+	}
+	config.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+	return nil
+}
+
+// deleteAppResources deletes the live resources of the application on the given cluster, one deletion wave after the
+// other. The destination is only set for applications with multiple destinations. Returns whether all the resources
+// are gone.
+func (ctrl *ApplicationController) deleteAppResources(app *appv1.Application, destination string, proj *appv1.AppProject, projectClusters func(project string) ([]*appv1.Cluster, error), config *rest.Config, logCtx *log.Entry) (bool, error) {
+	objs := make([]*unstructured.Unstructured, 0)
+	objsMap, err := ctrl.getPermittedAppLiveObjects(app, proj, projectClusters)
+	if err != nil {
+		return false, err
+	}
+
+	var pending []*unstructured.Unstructured
+	for k := range objsMap {
+		if objsMap[k].GetDeletionTimestamp() != nil {
+			pending = append(pending, objsMap[k])
+			continue
+		}
+
+		if ctrl.shouldBeDeleted(app, objsMap[k]) {
+			objs = append(objs, objsMap[k])
+		}
+	}
+	// Wait for objects pending deletion to complete before proceeding with next deletion wave
+	if len(pending) > 0 {
+		logCtx.Infof("%d objects remaining for deletion", len(objsMap))
+		return false, ctrl.waitForDeletionWave(app, destination, pending, len(objs), time.Now())
+	}
+
+	warnInvalidDeletionWaves(objs, logCtx)
+	filteredObjs := FilterObjectsForDeletion(objs)
+	if len(filteredObjs) > 0 {
+		ctrl.setDeletionProgress(app, destination, fmt.Sprintf("Deleting %d resources of deletion wave %d, %d resources remaining in later waves", len(filteredObjs), deletionWave(filteredObjs[0]), len(objs)-len(filteredObjs)))
+	}
+
+	propagationPolicy := metav1.DeletePropagationForeground
+	if app.GetPropagationPolicy() == appv1.BackgroundPropagationPolicyFinalizer {
+		propagationPolicy = metav1.DeletePropagationBackground
+	}
+	logCtx.Infof("Deleting application's resources with %s propagation policy", propagationPolicy)
+
+	err = kube.RunAllAsync(len(filteredObjs), func(i int) error {
+		obj := filteredObjs[i]
+		return ctrl.kubectl.DeleteResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	})
+	if err != nil {
+		return false, err
+	}
+
+	objsMap, err = ctrl.getPermittedAppLiveObjects(app, proj, projectClusters)
+	if err != nil {
+		return false, err
+	}
+
+	for k, obj := range objsMap {
+		if !ctrl.shouldBeDeleted(app, obj) {
+			delete(objsMap, k)
+		}
+	}
+	if len(objsMap) > 0 {
+		logCtx.Infof("%d objects remaining for deletion", len(objsMap))
+		return false, nil
+	}
+	logCtx.Infof("Successfully deleted %d resources", len(objs))
+	return true, nil
+}
+
 func (ctrl *ApplicationController) updateFinalizers(app *appv1.Application) error {
 	_, err := ctrl.getAppProj(app)
 	if err != nil {
@@ -1250,18 +1401,23 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 
 	app.Status.SetConditions([]appv1.ApplicationCondition{condition}, map[appv1.ApplicationConditionType]bool{condition.Type: true})
 
-	var patch []byte
+	if err := ctrl.patchAppConditions(app); err != nil {
+		logCtx.Errorf("Unable to set application condition: %v", err)
+	}
+}
+
+// patchAppConditions persists the conditions of the application
+func (ctrl *ApplicationController) patchAppConditions(app *appv1.Application) error {
 	patch, err := json.Marshal(map[string]interface{}{
 		"status": map[string]interface{}{
 			"conditions": app.Status.Conditions,
 		},
 	})
-	if err == nil {
-		_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
-	}
 	if err != nil {
-		logCtx.Errorf("Unable to set application condition: %v", err)
+		return err
 	}
+	_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
 }
 
 func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
@@ -1286,7 +1442,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		terminating = state.Phase == synccommon.OperationTerminating
 		// Failed  operation with retry strategy might have be in-progress and has completion time
 		if state.FinishedAt != nil && !terminating {
-			retryAt, err := app.Status.OperationState.Operation.Retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount)
+			retryAt, err := app.Status.OperationState.Operation.Retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount, string(app.UID))
 			if err != nil {
 				state.Phase = synccommon.OperationFailed
 				state.Message = err.Error()
@@ -1315,7 +1471,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		logCtx.Infof("Initialized new operation: %v", *app.Operation)
 	}
 
-	if err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, ctrl.db); err != nil {
+	if app.Spec.HasMultipleDestinations() {
+		// the destinations are validated when the operation is performed on them
+		ctrl.appStateManager.SyncAppState(app, state)
+	} else if err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, ctrl.db); err != nil {
 		state.Phase = synccommon.OperationFailed
 		state.Message = err.Error()
 	} else {
@@ -1349,10 +1508,19 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			}
 		}
 	} else if state.Phase == synccommon.OperationFailed || state.Phase == synccommon.OperationError {
-		if !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
-			now := metav1.Now()
+		retriesLeft := state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0
+		transient := isTransientSyncFailure(state)
+		if !terminating && state.Operation.Retry.Limit != 0 {
+			recordRetryAttempt(state, transient, metav1.Now())
+		}
+		if !terminating && retriesLeft && !state.Operation.Retry.ShouldRetry(transient) {
+			state.Message = fmt.Sprintf("%s (not retried: failure is not transient)", state.Message)
+		} else if !terminating && retriesLeft {
+			// the finish time is persisted with a precision of a second, and the retry is performed once the retry
+			// count is incremented, so the reported retry time is computed the same way as the retry time
+			now := metav1.NewTime(time.Now().Truncate(time.Second))
 			state.FinishedAt = &now
-			if retryAt, err := state.Operation.Retry.NextRetryAt(now.Time, state.RetryCount); err != nil {
+			if retryAt, err := state.Operation.Retry.NextRetryAt(now.Time, state.RetryCount+1, string(app.UID)); err != nil {
 				state.Phase = synccommon.OperationFailed
 				state.Message = fmt.Sprintf("%s (failed to retry: %v)", state.Message, err)
 			} else {
@@ -1366,6 +1534,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 
 	ctrl.setOperationState(app, state)
+	if state.Phase == synccommon.OperationRunning && app.Spec.HasMultipleDestinations() {
+		// the health gates of the destination rollout are evaluated against the latest status of the destinations
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &destinationRolloutRefreshInterval)
+	}
 	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.Sync.DryRun) {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
@@ -1519,6 +1691,12 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		"dest-namespace":   origApp.Spec.Destination.Namespace,
 	})
 
+	ctx, span := tracer.Start(context.Background(), "reconcile", trace.WithAttributes(appSpanAttributes(origApp)...), trace.WithAttributes(
+		attribute.Int("comparison_level", int(comparisonLevel)),
+		attribute.String("refresh_type", string(refreshType)),
+	))
+	defer span.End()
+
 	startTime := time.Now()
 	defer func() {
 		reconcileDuration := time.Since(startTime)
@@ -1530,6 +1708,11 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		}).Info("Reconciliation completed")
 	}()
 
+	if comparisonLevel == ComparisonWithNothing && app.Spec.HasMultipleDestinations() {
+		// the resource tree of an application with multiple destinations is only built by comparing its destinations
+		comparisonLevel = CompareWithRecent
+	}
+
 	if comparisonLevel == ComparisonWithNothing {
 		managedResources := make([]*appv1.ResourceDiff, 0)
 		if err := ctrl.cache.GetAppManagedResources(app.InstanceName(ctrl.namespace), &managedResources); err != nil {
@@ -1599,12 +1782,20 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 
 	logCtx.Infof("Comparing app state (comparisonLevel: %v, revisions: %v)", comparisonLevel, revisions)
 
-	compareResult, err := ctrl.appStateManager.CompareAppState(app, project, revisions, sources,
-		refreshType == appv1.RefreshTypeHard,
-		comparisonLevel == CompareWithLatestForceResolve, localManifests, hasMultipleSources, false)
+	var compareResult *comparisonResult
+	if app.Spec.HasMultipleDestinations() {
+		compareResult, err = ctrl.compareAppDestinations(ctx, app, project, revisions, sources,
+			refreshType == appv1.RefreshTypeHard,
+			comparisonLevel == CompareWithLatestForceResolve, localManifests, hasMultipleSources)
+	} else {
+		compareResult, err = ctrl.appStateManager.CompareAppState(ctx, app, project, revisions, sources,
+			refreshType == appv1.RefreshTypeHard,
+			comparisonLevel == CompareWithLatestForceResolve, localManifests, hasMultipleSources, false)
+	}
 
 	if goerrors.Is(err, CompareStateRepoError) {
 		logCtx.Warnf("Ignoring temporary failed attempt to compare app state against repo: %v", err)
+		span.SetStatus(codes.Error, err.Error())
 		return // short circuit if git error is encountered
 	}
 
@@ -1614,16 +1805,37 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 
 	ctrl.normalizeApplication(origApp, app)
 
+	_, treeSpan := tracer.Start(ctx, "setAppManagedResources")
 	tree, err := ctrl.setAppManagedResources(app, compareResult)
+	endSpan(treeSpan, err)
 	if err != nil {
 		logCtx.Errorf("Failed to cache app resources: %v", err)
 	} else {
 		app.Status.Summary = tree.GetSummary(app)
+		if !app.Spec.HasMultipleDestinations() {
+			ctrl.processOrphanedResources(app, project, tree, now)
+		}
 	}
+	ctrl.recordDrift(app, compareResult, now)
+	ctrl.updateMaintenanceStatus(app, now.Time)
 
 	if project.Spec.SyncWindows.Matches(app).CanSync(false) {
-		syncErrCond, opMS := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
-		setOpMs = opMS
+		rollbackCond, rolledBack := ctrl.autoRollback(app, compareResult.syncStatus, compareResult.healthStatus)
+		if rollbackCond != nil {
+			app.Status.SetConditions(
+				[]appv1.ApplicationCondition{*rollbackCond},
+				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionRollbackWarning: true},
+			)
+		} else {
+			app.Status.SetConditions(
+				[]appv1.ApplicationCondition{},
+				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionRollbackWarning: true},
+			)
+		}
+		var syncErrCond *appv1.ApplicationCondition
+		if !rolledBack {
+			syncErrCond, setOpMs = ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
+		}
 		if syncErrCond != nil {
 			app.Status.SetConditions(
 				[]appv1.ApplicationCondition{*syncErrCond},
@@ -1648,9 +1860,16 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	sort.Slice(app.Status.Resources, func(i, j int) bool {
 		return resourceStatusKey(app.Status.Resources[i]) < resourceStatusKey(app.Status.Resources[j])
 	})
+	if app.Spec.HasMultipleDestinations() {
+		// the resources of an application with multiple destinations are reported by destination
+		app.Status.Resources = nil
+	}
+	app.Status.Destinations = compareResult.destinationStatuses
 	app.Status.SourceType = compareResult.appSourceType
 	app.Status.SourceTypes = compareResult.appSourceTypes
 	app.Status.ControllerNamespace = ctrl.namespace
+	markRevisionHealthy(app, compareResult.syncStatus, compareResult.healthStatus, now)
+	ctrl.recordStatusTransitions(origApp, &app.Status, now)
 	patchMs = ctrl.persistAppStatus(origApp, &app.Status)
 	if (compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer() || compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer("cleanup")) &&
 		app.GetDeletionTimestamp() == nil {
@@ -1666,6 +1885,20 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 			logCtx.Errorf("Failed to update finalizers: %v", err)
 		}
 	}
+	if (compareResult.hasPreDeleteHooks != app.HasPreDeleteFinalizer() || compareResult.hasPreDeleteHooks != app.HasPreDeleteFinalizer("cleanup")) &&
+		app.GetDeletionTimestamp() == nil {
+		if compareResult.hasPreDeleteHooks {
+			app.SetPreDeleteFinalizer()
+			app.SetPreDeleteFinalizer("cleanup")
+		} else {
+			app.UnSetPreDeleteFinalizer()
+			app.UnSetPreDeleteFinalizer("cleanup")
+		}
+
+		if err := ctrl.updateFinalizers(app); err != nil {
+			logCtx.Errorf("Failed to update finalizers: %v", err)
+		}
+	}
 	return
 }
 
@@ -1720,6 +1953,8 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 			}
 		} else if !app.Spec.Destination.Equals(app.Status.Sync.ComparedTo.Destination) {
 			reason = "spec.destination differs"
+		} else if !app.Spec.Destinations.Equals(app.Status.Sync.ComparedTo.Destinations) {
+			reason = "spec.destinations differs"
 		} else if app.HasChangedManagedNamespaceMetadata() {
 			reason = "spec.syncPolicy.managedNamespaceMetadata differs"
 		} else if !app.Spec.IgnoreDifferences.Equals(app.Status.Sync.ComparedTo.IgnoreDifferences) {
@@ -1840,6 +2075,25 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 }
 
 // autoSync will initiate a sync operation for an application configured with automated sync
+// updateMaintenanceStatus ends the maintenance mode of the application once it expired, and reflects an active
+// maintenance mode in the application conditions
+func (ctrl *ApplicationController) updateMaintenanceStatus(app *appv1.Application, now time.Time) {
+	if maintenance := app.Status.Maintenance; maintenance != nil && !maintenance.IsActive(now) {
+		app.Status.Maintenance = nil
+		message := fmt.Sprintf("Maintenance mode started by %s expired", maintenance.StartedBy)
+		ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonMaintenanceExpired, Type: v1.EventTypeNormal}, message, context.TODO())
+	}
+	var conditions []appv1.ApplicationCondition
+	if maintenance := app.Status.Maintenance; maintenance != nil {
+		message := fmt.Sprintf("Automated sync is suspended by the maintenance mode started by %s until %s", maintenance.StartedBy, maintenance.ExpiresAt.UTC().Format(time.RFC3339))
+		if maintenance.Reason != "" {
+			message = fmt.Sprintf("%s: %s", message, maintenance.Reason)
+		}
+		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionMaintenanceWarning, Message: message})
+	}
+	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionMaintenanceWarning: true})
+}
+
 func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, revisionUpdated bool) (*appv1.ApplicationCondition, time.Duration) {
 	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
 		return nil, 0
@@ -1854,6 +2108,14 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		logCtx.Infof("Skipping auto-sync: deletion in progress")
 		return nil, 0
 	}
+	if len(app.Status.OperationQueue) > 0 {
+		logCtx.Infof("Skipping auto-sync: queued operations are pending")
+		return nil, 0
+	}
+	if maintenance := app.Status.Maintenance; maintenance.IsActive(time.Now()) {
+		logCtx.Infof("Skipping auto-sync: application is in maintenance mode until %s", maintenance.ExpiresAt.UTC().Format(time.RFC3339))
+		return nil, 0
+	}
 
 	// Only perform auto-sync if we detect OutOfSync status. This is to prevent us from attempting
 	// a sync when application is already in a Synced or Unknown state
@@ -1862,6 +2124,12 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil, 0
 	}
 
+	if rollback := app.Status.Rollback; app.Spec.SyncPolicy.Automated.Rollback != nil && rollback != nil &&
+		rollback.Matches(syncStatus.Revision, syncStatus.Revisions, app.Spec.HasMultipleSources()) {
+		logCtx.Infof("Skipping auto-sync: application was automatically rolled back from %s", syncStatus.Revision)
+		return nil, 0
+	}
+
 	if !app.Spec.SyncPolicy.Automated.Prune {
 		requirePruneOnly := true
 		for _, r := range resources {
@@ -1954,6 +2222,8 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	} else {
 		ctrl.writeBackToInformer(updatedApp)
 	}
+	// the revision the application was rolled back from is superseded
+	app.Status.Rollback = nil
 
 	var target string
 	if updatedApp.Spec.HasMultipleSources() {
@@ -2065,11 +2335,12 @@ func (ctrl *ApplicationController) canProcessApp(obj interface{}) bool {
 		}
 	}
 
-	cluster, err := ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	// an application with multiple destinations is processed by the shard of its first destination
+	cluster, err := ctrl.db.GetCluster(context.Background(), sharding.ShardingDestination(app).Server)
 	if err != nil {
 		return ctrl.clusterSharding.IsManagedCluster(nil)
 	}
-	return ctrl.clusterSharding.IsManagedCluster(cluster)
+	return ctrl.clusterSharding.IsManagedApp(app, cluster)
 }
 
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
@@ -2241,7 +2512,7 @@ func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1
 }
 
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
-	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace)
+	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace, ctrl.clusterSharding.UpdateClusterInfo)
 	go updater.Run(ctx)
 }
 
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -64,6 +65,8 @@ type fakeData struct {
 	metricsCacheExpiration         time.Duration
 	applicationNamespaces          []string
 	updateRevisionForPathsResponse *apiclient.UpdateRevisionForPathsResponse
+	revisionMetadata               *v1alpha1.RevisionMetadata
+	additionalObjs                 []runtime.Object
 }
 
 type MockKubectl struct {
@@ -71,6 +74,17 @@ type MockKubectl struct {
 
 	DeletedResources []kube.ResourceKey
 	CreatedResources []*unstructured.Unstructured
+	PatchedResources map[kube.ResourceKey][]byte
+	// ImpersonatedUsers are the users impersonated by the configs of the deletions
+	ImpersonatedUsers []string
+}
+
+func (m *MockKubectl) PatchResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte, subresources ...string) (*unstructured.Unstructured, error) {
+	if m.PatchedResources == nil {
+		m.PatchedResources = map[kube.ResourceKey][]byte{}
+	}
+	m.PatchedResources[kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name)] = patchBytes
+	return m.Kubectl.PatchResource(ctx, config, gvk, name, namespace, patchType, patchBytes, subresources...)
 }
 
 func (m *MockKubectl) CreateResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, obj *unstructured.Unstructured, createOptions metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
@@ -80,6 +94,7 @@ func (m *MockKubectl) CreateResource(ctx context.Context, config *rest.Config, g
 
 func (m *MockKubectl) DeleteResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions metav1.DeleteOptions) error {
 	m.DeletedResources = append(m.DeletedResources, kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name))
+	m.ImpersonatedUsers = append(m.ImpersonatedUsers, config.Impersonate.UserName)
 	return m.Kubectl.DeleteResource(ctx, config, gvk, name, namespace, deleteOptions)
 }
 
@@ -110,6 +125,11 @@ func newFakeController(data *fakeData, repoErr error) *ApplicationController {
 	}
 
 	mockRepoClient.On("UpdateRevisionForPaths", mock.Anything, mock.Anything).Return(data.updateRevisionForPathsResponse, nil)
+	if data.revisionMetadata != nil {
+		mockRepoClient.On("GetRevisionMetadata", mock.Anything, mock.Anything).Return(data.revisionMetadata, nil)
+	} else {
+		mockRepoClient.On("GetRevisionMetadata", mock.Anything, mock.Anything).Return(nil, errors.New("revision metadata not found"))
+	}
 
 	mockRepoClientset := mockrepoclient.Clientset{RepoServerServiceClient: &mockRepoClient}
 
@@ -133,7 +153,7 @@ func newFakeController(data *fakeData, repoErr error) *ApplicationController {
 		},
 		Data: data.configMapData,
 	}
-	kubeClient := fake.NewSimpleClientset(&clust, &cm, &secret)
+	kubeClient := fake.NewSimpleClientset(append([]runtime.Object{&clust, &cm, &secret}, data.additionalObjs...)...)
 	settingsMgr := settings.NewSettingsManager(context.Background(), kubeClient, test.FakeArgoCDNamespace)
 	kubectl := &MockKubectl{Kubectl: &kubetest.MockKubectlCmd{}}
 	ctrl, err := NewApplicationController(
@@ -411,6 +431,45 @@ var fakePostDeleteHook = `
 }
 `
 
+var fakePreDeleteHook = `
+{
+  "apiVersion": "batch/v1",
+  "kind": "Job",
+  "metadata": {
+    "name": "pre-delete-hook",
+    "namespace": "default",
+    "labels": {
+      "app.kubernetes.io/instance": "my-app"
+    },
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreDelete",
+      "argocd.argoproj.io/hook-delete-policy": "HookSucceeded"
+    }
+  },
+  "spec": {
+    "template": {
+      "metadata": {
+        "name": "pre-delete-hook"
+      },
+      "spec": {
+        "containers": [
+          {
+            "name": "pre-delete-hook",
+            "image": "busybox",
+            "command": [
+              "/bin/sh",
+              "-c",
+              "sleep 5 && echo hello from the pre-delete-hook job"
+            ]
+          }
+        ],
+        "restartPolicy": "Never"
+      }
+    }
+  }
+}
+`
+
 var fakeServiceAccount = `
 {
   "apiVersion": "v1",
@@ -518,6 +577,15 @@ func newFakePostDeleteHook() map[string]interface{} {
 	return hook
 }
 
+func newFakePreDeleteHook() map[string]interface{} {
+	var hook map[string]interface{}
+	err := yaml.Unmarshal([]byte(fakePreDeleteHook), &hook)
+	if err != nil {
+		panic(err)
+	}
+	return hook
+}
+
 func newFakeRoleBinding() map[string]interface{} {
 	var roleBinding map[string]interface{}
 	err := yaml.Unmarshal([]byte(fakeRoleBinding), &roleBinding)
@@ -561,6 +629,111 @@ func TestAutoSync(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncMaintenance(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+
+	t.Run("Active", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.Maintenance = &v1alpha1.MaintenanceStatus{StartedBy: "admin", ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour))}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.Maintenance = &v1alpha1.MaintenanceStatus{StartedBy: "admin", ExpiresAt: metav1.NewTime(time.Now().Add(-time.Minute))}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+}
+
+func TestAutoSyncQueuedOperations(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationQueue = []v1alpha1.QueuedOperation{{ID: "abc", Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "aaa"}}}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestStartQueuedOperation(t *testing.T) {
+	queue := []v1alpha1.QueuedOperation{
+		{ID: "abc", Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "aaa"}, InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"}}},
+		{ID: "def", Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "bbb"}, InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"}}},
+	}
+
+	t.Run("NoOperationInProgress", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.OperationQueue = queue
+		app.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		ctrl.startQueuedOperation(app)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, "aaa", app.Operation.Sync.Revision)
+		assert.Nil(t, app.Status.OperationState)
+		require.Len(t, app.Status.OperationQueue, 1)
+		assert.Equal(t, "def", app.Status.OperationQueue[0].ID)
+	})
+
+	t.Run("OperationInProgress", func(t *testing.T) {
+		app := newFakeApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "ccc"}}
+		app.Status.OperationQueue = queue
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		ctrl.startQueuedOperation(app)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "ccc", app.Operation.Sync.Revision)
+		assert.Len(t, app.Status.OperationQueue, 2)
+	})
+}
+
+func TestUpdateMaintenanceStatus(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Active", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.Maintenance = &v1alpha1.MaintenanceStatus{Reason: "database migration", StartedBy: "admin", ExpiresAt: metav1.NewTime(now.Add(time.Hour))}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		ctrl.updateMaintenanceStatus(app, now)
+		require.NotNil(t, app.Status.Maintenance)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionMaintenanceWarning, app.Status.Conditions[0].Type)
+		assert.Contains(t, app.Status.Conditions[0].Message, "started by admin")
+		assert.Contains(t, app.Status.Conditions[0].Message, "database migration")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.Maintenance = &v1alpha1.MaintenanceStatus{StartedBy: "admin", ExpiresAt: metav1.NewTime(now.Add(-time.Minute))}
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionMaintenanceWarning, Message: "suspended"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		ctrl.updateMaintenanceStatus(app, now)
+		assert.Nil(t, app.Status.Maintenance)
+		assert.Empty(t, app.Status.Conditions)
+	})
+}
+
 func TestAutoSyncNotAllowEmpty(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.SyncPolicy.Automated.Prune = true
@@ -817,6 +990,46 @@ func TestFinalizeAppDeletion(t *testing.T) {
 		assert.True(t, patched)
 	})
 
+	t.Run("CascadingDeleteImpersonation", func(t *testing.T) {
+		impersonatedProj := defaultProj.DeepCopy()
+		impersonatedProj.Spec.DestinationServiceAccounts = []v1alpha1.ApplicationDestinationServiceAccount{{Server: "*", Namespace: test.FakeArgoCDNamespace, DefaultServiceAccount: "deployer"}}
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		cm := newFakeCM()
+		cmObj := kube.MustToUnstructured(&cm)
+		newController := func(proj *v1alpha1.AppProject) *ApplicationController {
+			ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}, managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(cmObj): cmObj,
+			}, configMapData: map[string]string{"application.sync.impersonation.enabled": "true"}}, nil)
+			fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+			defaultReactor := fakeAppCs.ReactionChain[0]
+			fakeAppCs.ReactionChain = nil
+			fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+				return defaultReactor.React(action)
+			})
+			fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+				return true, &v1alpha1.Application{}, nil
+			})
+			return ctrl
+		}
+
+		ctrl := newController(impersonatedProj)
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"system:serviceaccount:" + test.FakeArgoCDNamespace + ":deployer"}, ctrl.kubectl.(*MockKubectl).ImpersonatedUsers)
+
+		// the resources are not deleted with the permissions of the controller if no service account matches
+		ctrl = newController(&defaultProj)
+		err = ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.ErrorContains(t, err, "error getting the service account to impersonate")
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
 	// Ensure any stray resources irregularly labeled with instance label of app are not deleted upon deleting,
 	// when app project restriction is in place
 	t.Run("ProjectRestrictionEnforced", func(t *testing.T) {
@@ -1091,6 +1304,173 @@ func TestFinalizeAppDeletion(t *testing.T) {
 		// finalizer is not removed
 		assert.False(t, patched)
 	})
+
+	newPreDeleteController := func(app *v1alpha1.Application, liveObjs ...*unstructured.Unstructured) (*ApplicationController, *[]string) {
+		managedLiveObjs := map[kube.ResourceKey]*unstructured.Unstructured{}
+		for _, obj := range liveObjs {
+			managedLiveObjs[kube.GetResourceKey(obj)] = obj
+		}
+		ctrl := newFakeController(&fakeData{
+			manifestResponses: []*apiclient.ManifestResponse{{
+				Manifests: []*apiclient.Manifest{
+					{
+						CompiledManifest: fakePreDeleteHook,
+					},
+				},
+			}},
+			apps:            []runtime.Object{app, &defaultProj},
+			managedLiveObjs: managedLiveObjs,
+		}, nil)
+
+		var patches []string
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		defaultReactor := fakeAppCs.ReactionChain[0]
+		fakeAppCs.ReactionChain = nil
+		fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			return defaultReactor.React(action)
+		})
+		fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patches = append(patches, string(action.(kubetesting.PatchAction).GetPatch()))
+			return true, &v1alpha1.Application{}, nil
+		})
+		return ctrl, &patches
+	}
+
+	newFinishedPreDeleteHook := func(conditionType string) *unstructured.Unstructured {
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		conditions := []interface{}{
+			map[string]interface{}{
+				"type":    conditionType,
+				"status":  "True",
+				"message": "hook " + conditionType,
+			},
+		}
+		require.NoError(t, unstructured.SetNestedField(liveHook.Object, conditions, "status", "conditions"))
+		return liveHook
+	}
+
+	t.Run("PreDelete_HookIsCreated", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.SetPreDeleteFinalizer("cleanup")
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		ctrl, patches := newPreDeleteController(app)
+
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// finalizer is not deleted
+		assert.Empty(t, *patches)
+		// pre-delete hook is created before any resource is deleted
+		require.Len(t, ctrl.kubectl.(*MockKubectl).CreatedResources, 1)
+		require.Equal(t, "pre-delete-hook", ctrl.kubectl.(*MockKubectl).CreatedResources[0].GetName())
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("PreDelete_HookIsExecuted", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.SetPreDeleteFinalizer("cleanup")
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		ctrl, patches := newPreDeleteController(app, newFinishedPreDeleteHook("Complete"))
+
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// finalizer is removed
+		require.Len(t, *patches, 1)
+		assert.NotContains(t, (*patches)[0], `"`+v1alpha1.PreDeleteFinalizerName+`"`)
+		assert.Contains(t, (*patches)[0], v1alpha1.PreDeleteFinalizerName+"/cleanup")
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("PreDelete_HookFailed", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.SetPreDeleteFinalizer("cleanup")
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		ctrl, patches := newPreDeleteController(app, newFinishedPreDeleteHook("Failed"))
+
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// the failure is surfaced as a condition and the deletion is blocked
+		require.Len(t, *patches, 1)
+		assert.Contains(t, (*patches)[0], v1alpha1.ApplicationConditionPreDeleteError)
+		assert.Contains(t, (*patches)[0], "PreDelete hooks failed: Job/pre-delete-hook: hook Failed")
+		assert.NotContains(t, (*patches)[0], "finalizers")
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+		events, err := ctrl.kubeClientset.CoreV1().Events(app.Namespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, events.Items, 1)
+	})
+
+	t.Run("PreDelete_HookFailedAlreadyReported", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.SetPreDeleteFinalizer("cleanup")
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{
+			Type:    v1alpha1.ApplicationConditionPreDeleteError,
+			Message: "PreDelete hooks failed: Job/pre-delete-hook: hook Failed",
+		}}
+		ctrl, patches := newPreDeleteController(app, newFinishedPreDeleteHook("Failed"))
+
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// the failure is only reported once while the deletion stays blocked
+		assert.Empty(t, *patches)
+		events, err := ctrl.kubeClientset.CoreV1().Events(app.Namespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, events.Items)
+	})
+
+	t.Run("PreDelete_HookIsDeleted", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer("cleanup")
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		ctrl, patches := newPreDeleteController(app, newFinishedPreDeleteHook("Complete"))
+
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// pre-delete hook is deleted
+		require.Len(t, ctrl.kubectl.(*MockKubectl).DeletedResources, 1)
+		assert.Equal(t, "pre-delete-hook", ctrl.kubectl.(*MockKubectl).DeletedResources[0].Name)
+		// finalizer is not removed
+		assert.Empty(t, *patches)
+	})
+
+	t.Run("PreDelete_HooksAreSkipped", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.SetPreDeleteFinalizer("cleanup")
+		app.Annotations = map[string]string{common.AnnotationKeySkipPreDeleteHooks: "true"}
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		ctrl, patches := newPreDeleteController(app, newFinishedPreDeleteHook("Failed"))
+
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// pre-delete finalizers are removed without running the hooks
+		require.Len(t, *patches, 1)
+		assert.NotContains(t, (*patches)[0], v1alpha1.PreDeleteFinalizerName)
+		assert.Contains(t, (*patches)[0], v1alpha1.ResourcesFinalizerName)
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).CreatedResources)
+	})
 }
 
 // TestNormalizeApplication verifies we normalize an application during reconciliation
@@ -1253,6 +1633,97 @@ func TestGetResourceTree_HasOrphanedResources(t *testing.T) {
 	assert.Equal(t, []v1alpha1.ResourceNode{orphanedDeploy1, orphanedDeploy2}, tree.OrphanedNodes)
 }
 
+func TestProcessOrphanedResources(t *testing.T) {
+	now := metav1.Now()
+	orphanedNode := func(kind string, name string) v1alpha1.ResourceNode {
+		return v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: kind, Namespace: test.FakeDestNamespace, Name: name}}
+	}
+	tree := &v1alpha1.ApplicationTree{OrphanedNodes: []v1alpha1.ResourceNode{
+		orphanedNode("Deployment", "old-web"),
+		orphanedNode("StatefulSet", "db"),
+		orphanedNode("Deployment", "other"),
+		// children are deleted or adopted along with their orphaned parents
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: test.FakeDestNamespace, Name: "old-web-abc"}, ParentRefs: []v1alpha1.ResourceRef{orphanedNode("Deployment", "old-web").ResourceRef}},
+	}}
+	newProj := func(actions ...v1alpha1.OrphanedResourceAction) *v1alpha1.AppProject {
+		proj := defaultProj.DeepCopy()
+		proj.Spec.OrphanedResources = &v1alpha1.OrphanedResourcesMonitorSettings{Actions: actions}
+		return proj
+	}
+
+	t.Run("PendingDeletion", func(t *testing.T) {
+		app := newFakeApp()
+		proj := newProj(v1alpha1.OrphanedResourceAction{Action: v1alpha1.OrphanedResourceActionDelete, Group: "apps", Kind: "Deployment", Name: "old-*", GracePeriod: "1h"})
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+		ctrl.processOrphanedResources(app, proj, tree, now)
+		require.Len(t, app.Status.OrphanedResources, 1)
+		res := app.Status.OrphanedResources[0]
+		assert.Equal(t, "old-web", res.Name)
+		assert.Equal(t, v1alpha1.OrphanedResourceActionDelete, res.Action)
+		assert.Equal(t, now.Add(time.Hour), res.ScheduledAt.Time)
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("GracePeriodElapsed", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.OrphanedResources = []v1alpha1.OrphanedResourceStatus{{
+			Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "old-web",
+			Action: v1alpha1.OrphanedResourceActionDelete, OrphanedSince: metav1.NewTime(now.Add(-2 * time.Hour)),
+		}}
+		proj := newProj(v1alpha1.OrphanedResourceAction{Action: v1alpha1.OrphanedResourceActionDelete, Group: "apps", Kind: "Deployment", Name: "old-*", GracePeriod: "1h"})
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+		ctrl.processOrphanedResources(app, proj, tree, now)
+		assert.Empty(t, app.Status.OrphanedResources)
+		assert.Equal(t, []kube.ResourceKey{kube.NewResourceKey("apps", "Deployment", test.FakeDestNamespace, "old-web")}, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.OrphanedResources = []v1alpha1.OrphanedResourceStatus{{
+			Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "old-web",
+			Action: v1alpha1.OrphanedResourceActionDelete, OrphanedSince: metav1.NewTime(now.Add(-2 * time.Hour)),
+		}}
+		proj := newProj(v1alpha1.OrphanedResourceAction{Action: v1alpha1.OrphanedResourceActionDelete, Group: "apps", Kind: "Deployment", Name: "old-*", GracePeriod: "1h", DryRun: true})
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+		ctrl.processOrphanedResources(app, proj, tree, now)
+		require.Len(t, app.Status.OrphanedResources, 1)
+		assert.True(t, app.Status.OrphanedResources[0].DryRun)
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("Adopt", func(t *testing.T) {
+		app := newFakeApp()
+		proj := newProj(v1alpha1.OrphanedResourceAction{Action: v1alpha1.OrphanedResourceActionAdopt, Group: "apps", Kind: "StatefulSet"})
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+		ctrl.processOrphanedResources(app, proj, tree, now)
+		assert.Empty(t, app.Status.OrphanedResources)
+		patched := ctrl.kubectl.(*MockKubectl).PatchedResources
+		require.Len(t, patched, 1)
+		assert.JSONEq(t, `{"metadata":{"labels":{"app.kubernetes.io/instance":"my-app"}}}`, string(patched[kube.NewResourceKey("apps", "StatefulSet", test.FakeDestNamespace, "db")]))
+	})
+
+	t.Run("AdoptWithAutomatedPrune", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{Prune: true}}
+		proj := newProj(v1alpha1.OrphanedResourceAction{Action: v1alpha1.OrphanedResourceActionAdopt, Group: "apps", Kind: "StatefulSet"})
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+		ctrl.processOrphanedResources(app, proj, tree, now)
+		require.Len(t, app.Status.OrphanedResources, 1)
+		assert.Equal(t, "db", app.Status.OrphanedResources[0].Name)
+		assert.Contains(t, app.Status.OrphanedResources[0].Message, "the resource would be pruned by the automated sync of the application")
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).PatchedResources)
+	})
+
+	t.Run("NoActions", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.OrphanedResources = []v1alpha1.OrphanedResourceStatus{{Kind: "Deployment", Name: "old-web"}}
+		proj := newProj()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+		ctrl.processOrphanedResources(app, proj, tree, now)
+		assert.Nil(t, app.Status.OrphanedResources)
+	})
+}
+
 func TestSetOperationStateOnDeletedApp(t *testing.T) {
 	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}}, nil)
 	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
@@ -1793,6 +2264,33 @@ func TestProcessRequestedAppOperation_FailedHasRetries(t *testing.T) {
 	assert.Equal(t, float64(1), retryCount)
 }
 
+func TestProcessRequestedAppOperation_FailedNotTransient(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "invalid-project"
+	app.Operation = &v1alpha1.Operation{
+		Sync:  &v1alpha1.SyncOperation{},
+		Retry: v1alpha1.RetryStrategy{Limit: 1, Policy: v1alpha1.RetryPolicyTransient},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]interface{}{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationError), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Contains(t, message, "not retried: failure is not transient")
+	attempts, _, _ := unstructured.NestedSlice(receivedPatch, "status", "operationState", "retryAttempts")
+	assert.Len(t, attempts, 1)
+}
+
 func TestProcessRequestedAppOperation_RunningPreviouslyFailed(t *testing.T) {
 	app := newFakeApp()
 	app.Operation = &v1alpha1.Operation{
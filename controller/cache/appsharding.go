@@ -0,0 +1,159 @@
+package cache
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/controller/sharding"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// shardNamespaces holds the namespaces cached by the shard for an application-sharded cluster: the namespaces of the
+// applications the shard processes. Namespaces are added as the applications of the shard are compared, and are only
+// removed when the controller restarts.
+type shardNamespaces struct {
+	lock sync.Mutex
+	// allowed holds the namespaces of the cluster, and is empty if the cluster is not namespace-scoped
+	allowed    map[string]bool
+	namespaces map[string]bool
+	// clusterResources is true if the cluster-scoped resources of the cluster are cached
+	clusterResources bool
+}
+
+func newShardNamespaces(cluster *appv1.Cluster, namespaces []string) *shardNamespaces {
+	n := &shardNamespaces{allowed: map[string]bool{}, namespaces: map[string]bool{}, clusterResources: cluster.ClusterResources || len(cluster.Namespaces) == 0}
+	for _, namespace := range cluster.Namespaces {
+		n.allowed[namespace] = true
+	}
+	n.add(namespaces)
+	return n
+}
+
+// add adds the given namespaces, and returns true if any of them was not cached yet
+func (n *shardNamespaces) add(namespaces []string) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	added := false
+	for _, namespace := range namespaces {
+		if namespace == "" || n.namespaces[namespace] || (len(n.allowed) > 0 && !n.allowed[namespace]) {
+			continue
+		}
+		n.namespaces[namespace] = true
+		added = true
+	}
+	return added
+}
+
+// list returns the sorted cached namespaces
+func (n *shardNamespaces) list() []string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	namespaces := make([]string, 0, len(n.namespaces))
+	for namespace := range n.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// getShardNamespaces returns the namespaces of the applications deployed to the given application-sharded cluster
+// which are processed by this shard
+func (c *liveStateCache) getShardNamespaces(cluster *appv1.Cluster) []string {
+	if c.appInformer == nil {
+		return nil
+	}
+	var namespaces []string
+	for _, obj := range c.appInformer.GetStore().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		var appNamespaces []string
+		for i, destination := range app.Spec.GetDestinations() {
+			if err := argo.ValidateDestination(context.Background(), &destination, c.db); err != nil || destination.Server != cluster.Server {
+				continue
+			}
+			appNamespaces = append(appNamespaces, destination.Namespace)
+			resources := app.Status.Resources
+			if app.Spec.HasMultipleDestinations() {
+				resources = nil
+				if i < len(app.Status.Destinations) {
+					resources = app.Status.Destinations[i].Resources
+				}
+			}
+			for _, res := range resources {
+				appNamespaces = append(appNamespaces, res.Namespace)
+			}
+		}
+		if len(appNamespaces) > 0 && c.isManagedApp(app, cluster) {
+			namespaces = append(namespaces, appNamespaces...)
+		}
+	}
+	return namespaces
+}
+
+// isManagedApp returns whether the given application is processed by this shard. An application with multiple
+// destinations is processed by the shard of its first destination, which may not be the given cluster.
+func (c *liveStateCache) isManagedApp(app *appv1.Application, cluster *appv1.Cluster) bool {
+	destination := sharding.ShardingDestination(app)
+	if err := argo.ValidateDestination(context.Background(), &destination, c.db); err != nil {
+		return false
+	}
+	if destination.Server == cluster.Server {
+		return c.clusterSharding.IsManagedApp(app, cluster)
+	}
+	destinationCluster, err := c.db.GetCluster(context.Background(), destination.Server)
+	if err != nil {
+		return c.clusterSharding.IsManagedCluster(nil)
+	}
+	return c.clusterSharding.IsManagedApp(app, destinationCluster)
+}
+
+// addShardNamespaces adds the namespaces of the destinations of the given application deployed to application-sharded
+// clusters to the cache of these clusters, before the cluster caches are synced. The namespaces of the target resources
+// are added to the cache of the cluster of the destination they are compared with. The cluster caches are invalidated
+// if any namespace was not cached yet.
+func (c *liveStateCache) addShardNamespaces(a *appv1.Application, targetObjs []*unstructured.Unstructured) {
+	for _, destination := range a.Spec.GetDestinations() {
+		if destination.Server == "" || !c.clusterSharding.IsApplicationShardedCluster(destination.Server) {
+			continue
+		}
+		namespaces := []string{destination.Namespace}
+		if destination.Server == a.Spec.Destination.Server {
+			for _, obj := range targetObjs {
+				namespaces = append(namespaces, obj.GetNamespace())
+			}
+		}
+		c.addClusterShardNamespaces(destination.Server, namespaces)
+	}
+}
+
+// addClusterShardNamespaces adds the given namespaces to the cache of the given application-sharded cluster
+func (c *liveStateCache) addClusterShardNamespaces(server string, namespaces []string) {
+	clusterCache, err := c.getCluster(server)
+	if err != nil {
+		return
+	}
+	c.lock.RLock()
+	shardNamespaces, ok := c.shardNamespaces[server]
+	snapshotter := c.snapshotters[server]
+	c.lock.RUnlock()
+	if !ok {
+		return
+	}
+	if !shardNamespaces.add(namespaces) {
+		return
+	}
+	list := shardNamespaces.list()
+	log.Infof("Caching namespaces %v of application-sharded cluster %s", list, server)
+	if snapshotter != nil {
+		snapshotter.setNamespaces(list)
+	}
+	clusterCache.Invalidate(clustercache.SetNamespaces(list), clustercache.SetClusterResources(shardNamespaces.clusterResources))
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/controller/sharding"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+)
+
+func TestShardNamespaces(t *testing.T) {
+	t.Run("ClusterScoped", func(t *testing.T) {
+		namespaces := newShardNamespaces(&appv1.Cluster{Server: "https://cluster"}, []string{"b", "a", ""})
+		assert.Equal(t, []string{"a", "b"}, namespaces.list())
+		assert.True(t, namespaces.clusterResources)
+		assert.False(t, namespaces.add([]string{"a"}))
+		assert.True(t, namespaces.add([]string{"a", "c"}))
+		assert.Equal(t, []string{"a", "b", "c"}, namespaces.list())
+	})
+	t.Run("NamespaceScoped", func(t *testing.T) {
+		namespaces := newShardNamespaces(&appv1.Cluster{Server: "https://cluster", Namespaces: []string{"a", "b"}}, []string{"a", "c"})
+		assert.Equal(t, []string{"a"}, namespaces.list())
+		assert.False(t, namespaces.clusterResources)
+		// namespaces which are not namespaces of the cluster are never cached
+		assert.False(t, namespaces.add([]string{"c"}))
+		assert.True(t, namespaces.add([]string{"b"}))
+	})
+}
+
+func TestGetShardNamespaces(t *testing.T) {
+	sharding.ApplicationShardedClusters = []string{"https://cluster"}
+	defer func() { sharding.ApplicationShardedClusters = []string{} }()
+	db := &dbmocks.ArgoDB{}
+	shards := []sharding.ClusterShardingCache{
+		sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm),
+		sharding.NewClusterSharding(db, 1, 2, common.DefaultShardingAlgorithm),
+	}
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &appv1.Application{}, 0, cache.Indexers{})
+	for i := 0; i < 10; i++ {
+		app := &appv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("app-%d", i), Namespace: "argocd"},
+			Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: "https://cluster", Namespace: fmt.Sprintf("ns-%d", i)}},
+			Status:     appv1.ApplicationStatus{Resources: []appv1.ResourceStatus{{Kind: "ConfigMap", Namespace: fmt.Sprintf("extra-%d", i)}}},
+		}
+		assert.NoError(t, informer.GetStore().Add(app))
+	}
+	assert.NoError(t, informer.GetStore().Add(&appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "argocd"},
+		Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: "https://other", Namespace: "other"}},
+	}))
+
+	// each shard caches the destination and resource namespaces of the applications it processes only
+	cluster := &appv1.Cluster{Server: "https://cluster"}
+	var all []string
+	for _, shard := range shards {
+		c := &liveStateCache{appInformer: informer, clusterSharding: shard, db: db}
+		namespaces := c.getShardNamespaces(cluster)
+		assert.NotEmpty(t, namespaces)
+		assert.Less(t, len(namespaces), 20)
+		all = append(all, namespaces...)
+	}
+	assert.Len(t, all, 20)
+	assert.NotContains(t, all, "other")
+}
+
+func TestGetShardNamespaces_MultipleDestinations(t *testing.T) {
+	sharding.ApplicationShardedClusters = []string{"https://cluster"}
+	defer func() { sharding.ApplicationShardedClusters = []string{} }()
+	db := &dbmocks.ArgoDB{}
+	db.On("GetCluster", mock.Anything, "https://other").Return(&appv1.Cluster{Server: "https://other"}, nil)
+	shards := []sharding.ClusterShardingCache{
+		sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm),
+		sharding.NewClusterSharding(db, 1, 2, common.DefaultShardingAlgorithm),
+	}
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &appv1.Application{}, 0, cache.Indexers{})
+	// the application is processed by the shard of its first destination, shard 0, although its name hashes to shard 1
+	assert.NoError(t, informer.GetStore().Add(&appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "multi", Namespace: "argocd"},
+		Spec: appv1.ApplicationSpec{Destinations: appv1.ApplicationDestinations{
+			{Server: "https://other", Namespace: "ns-a"},
+			{Server: "https://cluster", Namespace: "ns-b"},
+		}},
+		Status: appv1.ApplicationStatus{Destinations: []appv1.ApplicationDestinationStatus{
+			{},
+			{Resources: []appv1.ResourceStatus{{Kind: "ConfigMap", Namespace: "extra"}}},
+		}},
+	}))
+
+	cluster := &appv1.Cluster{Server: "https://cluster"}
+	c := &liveStateCache{appInformer: informer, clusterSharding: shards[0], db: db}
+	assert.ElementsMatch(t, []string{"ns-b", "extra"}, c.getShardNamespaces(cluster))
+	c = &liveStateCache{appInformer: informer, clusterSharding: shards[1], db: db}
+	assert.Empty(t, c.getShardNamespaces(cluster))
+}
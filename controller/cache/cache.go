@@ -25,7 +25,10 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
@@ -69,6 +72,19 @@ const (
 
 	// EnvClusterCacheRetryUseBackoff is the env variable to control whether to use a backoff strategy with the retry during cluster cache sync
 	EnvClusterCacheRetryUseBackoff = "ARGOCD_CLUSTER_CACHE_RETRY_USE_BACKOFF"
+
+	// EnvClusterCacheSnapshotDir is the env variable that holds the directory in which cluster cache snapshots are persisted.
+	// Snapshots are disabled if not set.
+	EnvClusterCacheSnapshotDir = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_DIR"
+
+	// EnvClusterCacheSnapshotInterval is the env variable that holds the interval at which cluster cache snapshots are persisted
+	EnvClusterCacheSnapshotInterval = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_INTERVAL"
+
+	// EnvClusterCacheSnapshotMaxAge is the env variable that holds the maximum age of a cluster cache snapshot to warm the cluster cache from
+	EnvClusterCacheSnapshotMaxAge = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_MAX_AGE"
+
+	// EnvClusterCacheMetadataOnlyUnmanagedKinds is the env variable to control whether kinds no application manages are watched with the metadata of the resources only
+	EnvClusterCacheMetadataOnlyUnmanagedKinds = "ARGOCD_CLUSTER_CACHE_METADATA_ONLY_UNMANAGED_KINDS"
 )
 
 // GitOps engine cluster cache tuning options
@@ -100,6 +116,19 @@ var (
 
 	// clusterCacheRetryUseBackoff specifies whether to use a backoff strategy on cluster cache sync, if retry is enabled
 	clusterCacheRetryUseBackoff bool = false
+
+	// clusterCacheSnapshotDir is the directory in which cluster cache snapshots are persisted, if set
+	clusterCacheSnapshotDir = ""
+
+	// clusterCacheSnapshotInterval controls how often cluster cache snapshots are persisted
+	clusterCacheSnapshotInterval = 1 * time.Minute
+
+	// clusterCacheSnapshotMaxAge is the maximum age of a snapshot to warm a cluster cache from. Older snapshots are
+	// unlikely to be resumable since the API server only keeps the recent history of resource versions.
+	clusterCacheSnapshotMaxAge = 10 * time.Minute
+
+	// clusterCacheMetadataOnlyUnmanagedKinds specifies whether kinds no application manages are watched with the metadata of the resources only
+	clusterCacheMetadataOnlyUnmanagedKinds bool = false
 )
 
 func init() {
@@ -111,6 +140,10 @@ func init() {
 	clusterCacheListSemaphoreSize = env.ParseInt64FromEnv(EnvClusterCacheListSemaphore, clusterCacheListSemaphoreSize, 0, math.MaxInt64)
 	clusterCacheAttemptLimit = int32(env.ParseNumFromEnv(EnvClusterCacheAttemptLimit, int(clusterCacheAttemptLimit), 1, math.MaxInt32))
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
+	clusterCacheSnapshotDir = env.StringFromEnv(EnvClusterCacheSnapshotDir, clusterCacheSnapshotDir)
+	clusterCacheSnapshotInterval = env.ParseDurationFromEnv(EnvClusterCacheSnapshotInterval, clusterCacheSnapshotInterval, time.Second, math.MaxInt64)
+	clusterCacheSnapshotMaxAge = env.ParseDurationFromEnv(EnvClusterCacheSnapshotMaxAge, clusterCacheSnapshotMaxAge, 0, math.MaxInt64)
+	clusterCacheMetadataOnlyUnmanagedKinds = env.ParseBoolFromEnv(EnvClusterCacheMetadataOnlyUnmanagedKinds, false)
 }
 
 type LiveStateCache interface {
@@ -163,6 +196,10 @@ type ResourceInfo struct {
 	NodeInfo *NodeInfo
 
 	manifestHash string
+	// metadataOnly is true if the resource was received from a metadata-only watch
+	metadataOnly bool
+	// objectSize is the approximate size of the resource, computed if unmanaged kinds are watched with metadata only
+	objectSize int64
 }
 
 func NewLiveStateCache(
@@ -210,9 +247,15 @@ type liveStateCache struct {
 	resourceTracking     argo.ResourceTracking
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
 
-	clusters      map[string]clustercache.ClusterCache
-	cacheSettings cacheSettings
-	lock          sync.RWMutex
+	clusters     map[string]clustercache.ClusterCache
+	snapshotters map[string]*clusterSnapshotter
+	// metadataOnlyWatches holds the kinds managed by applications of each cluster, if unmanaged kinds are watched with
+	// metadata only
+	metadataOnlyWatches map[string]*metadataOnlyWatches
+	// shardNamespaces holds the namespaces cached by this shard for each application-sharded cluster
+	shardNamespaces map[string]*shardNamespaces
+	cacheSettings   cacheSettings
+	lock            sync.RWMutex
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -494,6 +537,38 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		clusterCacheConfig.WarningHandler = rest.NoWarnings{}
 	}
 
+	var watches *metadataOnlyWatches
+	if clusterCacheMetadataOnlyUnmanagedKinds {
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(rest.CopyConfig(clusterCacheConfig))
+		if err != nil {
+			return nil, fmt.Errorf("error creating discovery client: %w", err)
+		}
+		mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+		watches = newMetadataOnlyWatches(cluster.Server, mapper, c.getManagedKinds(cluster), func(gvk schema.GroupVersionKind) bool {
+			c.lock.RLock()
+			healthOverride := c.cacheSettings.clusterSettings.ResourceHealthOverride
+			c.lock.RUnlock()
+			return requiresFullObjects(gvk, healthOverride)
+		})
+		clusterCacheConfig.Wrap(watches.wrapTransport(clusterCacheConfig.Host))
+	}
+
+	namespaces, clusterResources := cluster.Namespaces, cluster.ClusterResources
+	var appShardNamespaces *shardNamespaces
+	if c.clusterSharding.IsApplicationShardedCluster(cluster.Server) {
+		// the shard only caches the namespaces of the applications it processes
+		appShardNamespaces = newShardNamespaces(cluster, c.getShardNamespaces(cluster))
+		if list := appShardNamespaces.list(); len(list) > 0 {
+			namespaces, clusterResources = list, appShardNamespaces.clusterResources
+		}
+	}
+
+	var snapshotter *clusterSnapshotter
+	if clusterCacheSnapshotDir != "" {
+		snapshotter = newClusterSnapshotter(clusterCacheSnapshotDir, cluster.Server, namespaces, clusterCacheSnapshotMaxAge, time.Now())
+		clusterCacheConfig.Wrap(snapshotter.wrapTransport(clusterCacheConfig.Host))
+	}
+
 	clusterCacheOpts := []clustercache.UpdateSettingsFunc{
 		clustercache.SetListSemaphore(semaphore.NewWeighted(clusterCacheListSemaphoreSize)),
 		clustercache.SetListPageSize(clusterCacheListPageSize),
@@ -502,11 +577,26 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		clustercache.SetClusterSyncRetryTimeout(clusterSyncRetryTimeoutDuration),
 		clustercache.SetResyncTimeout(clusterCacheResyncDuration),
 		clustercache.SetSettings(cacheSettings.clusterSettings),
-		clustercache.SetNamespaces(cluster.Namespaces),
-		clustercache.SetClusterResources(cluster.ClusterResources),
+		clustercache.SetNamespaces(namespaces),
+		clustercache.SetClusterResources(clusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (interface{}, bool) {
+			_, metadataOnly := un.Object[metadataOnlyObjectKey]
+			delete(un.Object, metadataOnlyObjectKey)
+			if snapshotter != nil {
+				// resources served from a snapshot without their manifest are not managed by any application
+				if res, ok := restoreSnapshotResourceInfo(un); ok {
+					if watches != nil {
+						res.objectSize = estimateObjectSize(un.Object)
+					}
+					return res, false
+				}
+			}
 			res := &ResourceInfo{}
 			populateNodeInfo(un, res, resourceCustomLabels)
+			if watches != nil {
+				res.metadataOnly = metadataOnly
+				res.objectSize = estimateObjectSize(un.Object)
+			}
 			c.lock.RLock()
 			cacheSettings := c.cacheSettings
 			c.lock.RUnlock()
@@ -530,8 +620,9 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 			}
 
 			// edge case. we do not label CRDs, so they miss the tracking label we inject. But we still
-			// want the full resource to be available in our cache (to diff), so we store all CRDs
-			return res, res.AppName != "" || gvk.Kind == kube.CustomResourceDefinitionKind
+			// want the full resource to be available in our cache (to diff), so we store all CRDs.
+			// Metadata-only resources are not stored, so that they are loaded from the cluster if they are diffed.
+			return res, !metadataOnly && (res.AppName != "" || gvk.Kind == kube.CustomResourceDefinitionKind)
 		}),
 		clustercache.SetLogr(logutils.NewLogrusLogger(log.WithField("server", cluster.Server))),
 		clustercache.SetRetryOptions(clusterCacheAttemptLimit, clusterCacheRetryUseBackoff, isRetryableError),
@@ -549,6 +640,10 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 			ref = oldRes.Ref
 		}
 
+		if newRes != nil && snapshotter != nil {
+			snapshotter.updated(newRes.Ref, newRes.ResourceVersion)
+		}
+
 		if newRes == nil {
 			log.WithFields(log.Fields{
 				"server":      cluster.Server,
@@ -601,6 +696,24 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 	})
 
 	c.clusters[server] = clusterCache
+	if snapshotter != nil {
+		if c.snapshotters == nil {
+			c.snapshotters = make(map[string]*clusterSnapshotter)
+		}
+		c.snapshotters[server] = snapshotter
+	}
+	if watches != nil {
+		if c.metadataOnlyWatches == nil {
+			c.metadataOnlyWatches = make(map[string]*metadataOnlyWatches)
+		}
+		c.metadataOnlyWatches[server] = watches
+	}
+	if appShardNamespaces != nil {
+		if c.shardNamespaces == nil {
+			c.shardNamespaces = make(map[string]*shardNamespaces)
+		}
+		c.shardNamespaces[server] = appShardNamespaces
+	}
 
 	return clusterCache, nil
 }
@@ -677,6 +790,10 @@ func (c *liveStateCache) GetNamespaceTopLevelResources(server string, namespace
 }
 
 func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	if clusterCacheMetadataOnlyUnmanagedKinds {
+		c.manageKinds(a.Spec.Destination.Server, targetObjs)
+	}
+	c.addShardNamespaces(a, targetObjs)
 	clusterInfo, err := c.getSyncedCluster(a.Spec.Destination.Server)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster info for %q: %w", a.Spec.Destination.Server, err)
@@ -694,18 +811,74 @@ func (c *liveStateCache) GetVersionsInfo(serverURL string) (string, []kube.APIRe
 	return clusterInfo.GetServerVersion(), clusterInfo.GetAPIResources(), nil
 }
 
+// manageKinds upgrades the kinds of the given target resources to full watches, before the cluster cache is synced
+func (c *liveStateCache) manageKinds(server string, targetObjs []*unstructured.Unstructured) {
+	if _, err := c.getCluster(server); err != nil {
+		return
+	}
+	c.lock.RLock()
+	watches, ok := c.metadataOnlyWatches[server]
+	c.lock.RUnlock()
+	if !ok {
+		return
+	}
+	kinds := make([]schema.GroupKind, 0, len(targetObjs))
+	for _, obj := range targetObjs {
+		kinds = append(kinds, obj.GroupVersionKind().GroupKind())
+	}
+	watches.manage(kinds)
+}
+
+// getManagedKinds returns the kinds of the resources managed by the applications deployed to the given cluster
+func (c *liveStateCache) getManagedKinds(cluster *appv1.Cluster) []schema.GroupKind {
+	if c.appInformer == nil {
+		return nil
+	}
+	var kinds []schema.GroupKind
+	for _, obj := range c.appInformer.GetStore().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		for i, destination := range app.Spec.GetDestinations() {
+			if err := argo.ValidateDestination(context.Background(), &destination, c.db); err != nil || destination.Server != cluster.Server {
+				continue
+			}
+			resources := app.Status.Resources
+			if app.Spec.HasMultipleDestinations() {
+				resources = nil
+				if i < len(app.Status.Destinations) {
+					resources = app.Status.Destinations[i].Resources
+				}
+			}
+			for _, res := range resources {
+				kinds = append(kinds, schema.GroupKind{Group: res.Group, Kind: res.Kind})
+			}
+		}
+	}
+	return kinds
+}
+
 func (c *liveStateCache) isClusterHasApps(apps []interface{}, cluster *appv1.Cluster) bool {
 	for _, obj := range apps {
 		app, ok := obj.(*appv1.Application)
 		if !ok {
 			continue
 		}
-		err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, c.db)
-		if err != nil {
+		if !app.Spec.HasMultipleDestinations() {
+			err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, c.db)
+			if err != nil {
+				continue
+			}
+			if app.Spec.Destination.Server == cluster.Server {
+				return true
+			}
 			continue
 		}
-		if app.Spec.Destination.Server == cluster.Server {
-			return true
+		for _, destination := range app.Spec.Destinations {
+			if err := argo.ValidateDestination(context.Background(), &destination, c.db); err == nil && destination.Server == cluster.Server {
+				return true
+			}
 		}
 	}
 	return false
@@ -761,11 +934,62 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 		return c.db.WatchClusters(ctx, c.handleAddEvent, c.handleModEvent, c.handleDeleteEvent)
 	})
 
+	if clusterCacheSnapshotDir != "" {
+		go c.watchSnapshots(ctx)
+	}
+
 	<-ctx.Done()
+	if clusterCacheSnapshotDir != "" {
+		c.saveSnapshots()
+	}
 	c.invalidate(c.cacheSettings)
 	return nil
 }
 
+// watchSnapshots periodically persists the snapshots of the cluster caches until the context is done
+func (c *liveStateCache) watchSnapshots(ctx context.Context) {
+	ticker := time.NewTicker(clusterCacheSnapshotInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.saveSnapshots()
+		}
+	}
+}
+
+// saveSnapshots persists the snapshots of the synced cluster caches
+func (c *liveStateCache) saveSnapshots() {
+	c.lock.RLock()
+	clusters := make(map[string]clustercache.ClusterCache)
+	snapshotters := make(map[string]*clusterSnapshotter)
+	for server, snapshotter := range c.snapshotters {
+		clusters[server] = c.clusters[server]
+		snapshotters[server] = snapshotter
+	}
+	c.lock.RUnlock()
+
+	now := time.Now()
+	for server, snapshotter := range snapshotters {
+		if err := snapshotter.save(clusters[server], now); err != nil {
+			log.Warnf("Failed to save cluster cache snapshot of %s: %v", server, err)
+		}
+	}
+}
+
+// removeSnapshotter stops persisting the snapshot of the given cluster and removes the persisted one
+func (c *liveStateCache) removeSnapshotter(server string) {
+	c.lock.Lock()
+	snapshotter, ok := c.snapshotters[server]
+	delete(c.snapshotters, server)
+	c.lock.Unlock()
+	if ok {
+		snapshotter.remove()
+	}
+}
+
 func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
 	return c.clusterSharding.IsManagedCluster(cluster)
 }
@@ -800,11 +1024,19 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 	cluster, ok := c.clusters[newCluster.Server]
 	c.lock.Unlock()
 	if ok {
-		if !c.canHandleCluster(newCluster) {
+		c.lock.RLock()
+		_, appSharded := c.shardNamespaces[newCluster.Server]
+		c.lock.RUnlock()
+		// the namespaces cached for an application-sharded cluster are computed when its cluster cache is created
+		namespacesChanged := !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) || oldCluster.ClusterResources != newCluster.ClusterResources
+		if !c.canHandleCluster(newCluster) || (appSharded && namespacesChanged) {
 			cluster.Invalidate()
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
+			delete(c.metadataOnlyWatches, newCluster.Server)
+			delete(c.shardNamespaces, newCluster.Server)
 			c.lock.Unlock()
+			c.removeSnapshotter(newCluster.Server)
 			return
 		}
 
@@ -814,6 +1046,12 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 		}
 		if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
 			updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
+			c.lock.RLock()
+			snapshotter, ok := c.snapshotters[newCluster.Server]
+			c.lock.RUnlock()
+			if ok {
+				snapshotter.setNamespaces(newCluster.Namespaces)
+			}
 		}
 		if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
 			updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
@@ -844,8 +1082,11 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 		cluster.Invalidate()
 		c.lock.Lock()
 		delete(c.clusters, clusterServer)
+		delete(c.metadataOnlyWatches, clusterServer)
+		delete(c.shardNamespaces, clusterServer)
 		c.lock.Unlock()
 	}
+	c.removeSnapshotter(clusterServer)
 }
 
 func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
@@ -865,6 +1106,44 @@ func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
 	return res
 }
 
+// GetClustersResourceStats returns the number and the approximate size of the resources of each kind in the cluster
+// caches, if unmanaged kinds are watched with metadata only
+func (c *liveStateCache) GetClustersResourceStats() []metrics.ClusterResourceStats {
+	if !clusterCacheMetadataOnlyUnmanagedKinds {
+		return nil
+	}
+	clusters := make(map[string]clustercache.ClusterCache)
+	c.lock.RLock()
+	for k := range c.clusters {
+		clusters[k] = c.clusters[k]
+	}
+	c.lock.RUnlock()
+
+	type statsKey struct {
+		gvk          schema.GroupVersionKind
+		metadataOnly bool
+	}
+	res := make([]metrics.ClusterResourceStats, 0)
+	for server, clusterCache := range clusters {
+		stats := make(map[statsKey]*metrics.ClusterResourceStats)
+		for _, r := range clusterCache.FindResources("") {
+			info := resInfo(r)
+			key := statsKey{gvk: schema.FromAPIVersionAndKind(r.Ref.APIVersion, r.Ref.Kind), metadataOnly: info.metadataOnly}
+			kindStats, ok := stats[key]
+			if !ok {
+				kindStats = &metrics.ClusterResourceStats{Server: server, GroupVersionKind: key.gvk, MetadataOnly: key.metadataOnly}
+				stats[key] = kindStats
+			}
+			kindStats.Objects++
+			kindStats.Bytes += info.objectSize
+		}
+		for _, kindStats := range stats {
+			res = append(res, *kindStats)
+		}
+	}
+	return res
+}
+
 func (c *liveStateCache) GetClusterCache(server string) (clustercache.ClusterCache, error) {
 	return c.getSyncedCluster(server)
 }
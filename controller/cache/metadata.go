@@ -0,0 +1,354 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v2/util/lua"
+)
+
+const (
+	// metadataOnlyObjectKey marks the objects received from metadata-only watches until they are populated
+	metadataOnlyObjectKey = "argocd.argoproj.io/metadata-only"
+
+	partialObjectMetadataListAccept = "application/json;as=PartialObjectMetadataList;g=meta.k8s.io;v=v1,application/json"
+	partialObjectMetadataAccept     = "application/json;as=PartialObjectMetadata;g=meta.k8s.io;v=v1,application/json"
+)
+
+// requiresFullObjects returns true if the controller needs the full objects of the given kind, even if no application
+// manages it: to populate the resource tree info or to assess the health of the resources.
+func requiresFullObjects(gvk schema.GroupVersionKind, healthOverride health.HealthOverride) bool {
+	switch gvk.GroupKind() {
+	case schema.GroupKind{Group: "apiextensions.k8s.io", Kind: kube.CustomResourceDefinitionKind},
+		schema.GroupKind{Kind: kube.PodKind},
+		schema.GroupKind{Kind: kube.ServiceKind},
+		schema.GroupKind{Kind: "Node"},
+		schema.GroupKind{Group: "extensions", Kind: kube.IngressKind},
+		schema.GroupKind{Group: "networking.k8s.io", Kind: kube.IngressKind},
+		schema.GroupKind{Group: "networking.istio.io", Kind: "VirtualService"}:
+		return true
+	}
+	if health.GetHealthCheckFunc(gvk) != nil {
+		return true
+	}
+	if overrides, ok := healthOverride.(lua.ResourceHealthOverrides); ok {
+		hasHealthCheck, err := overrides.HasHealthCheck(gvk)
+		return hasHealthCheck || err != nil
+	}
+	return false
+}
+
+// metadataOnlyWatches serves the list and watch requests of a cluster cache for the kinds no application manages
+// with the metadata of the resources only, which is enough to build the ownership trees. The objects received are
+// restored to their kind and marked with metadataOnlyObjectKey. A kind is upgraded to full watches once an application
+// manages it: the metadata-only watches of the kind are expired, so that the cluster cache relists it.
+type metadataOnlyWatches struct {
+	server              string
+	mapper              meta.RESTMapper
+	requiresFullObjects func(gvk schema.GroupVersionKind) bool
+
+	lock    sync.Mutex
+	managed map[schema.GroupKind]bool
+	// partial holds the API paths which were listed or watched with the metadata of the resources only
+	partial map[string]bool
+	streams map[*metadataWatchStream]bool
+}
+
+func newMetadataOnlyWatches(server string, mapper meta.RESTMapper, managed []schema.GroupKind, requiresFullObjects func(gvk schema.GroupVersionKind) bool) *metadataOnlyWatches {
+	w := &metadataOnlyWatches{
+		server:              server,
+		mapper:              mapper,
+		requiresFullObjects: requiresFullObjects,
+		managed:             map[schema.GroupKind]bool{},
+		partial:             map[string]bool{},
+		streams:             map[*metadataWatchStream]bool{},
+	}
+	for _, gk := range managed {
+		w.managed[gk] = true
+	}
+	return w
+}
+
+// manage upgrades the given kinds to full watches
+func (w *metadataOnlyWatches) manage(kinds []schema.GroupKind) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for _, gk := range kinds {
+		if w.managed[gk] {
+			continue
+		}
+		w.managed[gk] = true
+		for stream := range w.streams {
+			if stream.gvk.GroupKind() == gk {
+				log.Infof("Upgrading watch of %s on %s to full objects", gk, w.server)
+				stream.expire()
+			}
+		}
+	}
+}
+
+// prepareRequest returns whether the request of the given API path should only return the metadata of the resources,
+// and whether a watch should be expired since the path was listed with the metadata of the resources only but the
+// kind was upgraded since
+func (w *metadataOnlyWatches) prepareRequest(path string, gvk schema.GroupVersionKind, isWatch bool, firstPage bool) (bool, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if !w.managed[gvk.GroupKind()] && !w.requiresFullObjects(gvk) {
+		w.partial[path] = true
+		return true, false
+	}
+	if isWatch {
+		return false, w.partial[path]
+	}
+	if firstPage {
+		delete(w.partial, path)
+	}
+	return false, false
+}
+
+func (w *metadataOnlyWatches) addStream(stream *metadataWatchStream) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.streams[stream] = true
+	if w.managed[stream.gvk.GroupKind()] {
+		stream.expire()
+	}
+}
+
+func (w *metadataOnlyWatches) removeStream(stream *metadataWatchStream) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	delete(w.streams, stream)
+}
+
+// wrapTransport returns a transport wrapper which requests the metadata of the resources only for unmanaged kinds
+func (w *metadataOnlyWatches) wrapTransport(host string) func(rt http.RoundTripper) http.RoundTripper {
+	pathPrefix := ""
+	if u, err := url.Parse(host); err == nil {
+		pathPrefix = strings.TrimSuffix(u.Path, "/")
+	}
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &metadataOnlyTransport{watches: w, pathPrefix: pathPrefix, next: rt}
+	}
+}
+
+type metadataOnlyTransport struct {
+	watches    *metadataOnlyWatches
+	pathPrefix string
+	next       http.RoundTripper
+}
+
+func (t *metadataOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.next.RoundTrip(req)
+	}
+	path := strings.TrimPrefix(req.URL.Path, t.pathPrefix)
+	gvr, ok := parseCollectionPath(path)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+	gvk, err := t.watches.mapper.KindFor(gvr)
+	if err != nil {
+		return t.next.RoundTrip(req)
+	}
+	query := req.URL.Query()
+	isWatch := query.Get("watch") == "true" || query.Get("watch") == "1"
+	metadataOnly, expired := t.watches.prepareRequest(path, gvk, isWatch, query.Get("continue") == "")
+	if expired {
+		log.Infof("Expiring watch of %s on %s to relist full objects", gvk.GroupKind(), t.watches.server)
+		return expiredWatchResponse(req, gvk)
+	}
+	if !metadataOnly {
+		return t.next.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	if isWatch {
+		req.Header.Set("Accept", partialObjectMetadataAccept)
+	} else {
+		req.Header.Set("Accept", partialObjectMetadataListAccept)
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	if isWatch {
+		stream := newMetadataWatchStream(t.watches, resp.Body, gvk)
+		t.watches.addStream(stream)
+		resp.Body = stream
+		return resp, nil
+	}
+	return restorePartialObjectList(resp, gvk)
+}
+
+// setPartialObjectKind restores the kind of an object received with the metadata of the resource only
+func setPartialObjectKind(obj map[string]interface{}, gvk schema.GroupVersionKind) {
+	obj["apiVersion"] = gvk.GroupVersion().String()
+	obj["kind"] = gvk.Kind
+	obj[metadataOnlyObjectKey] = true
+}
+
+// restorePartialObjectList restores the kind of a list of resources received with their metadata only
+func restorePartialObjectList(resp *http.Response, gvk schema.GroupVersionKind) (*http.Response, error) {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading list of %s: %w", gvk.GroupKind(), err)
+	}
+	var list map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&list); err == nil && list["kind"] == "PartialObjectMetadataList" {
+		list["apiVersion"] = gvk.GroupVersion().String()
+		list["kind"] = gvk.Kind + "List"
+		items, _ := list["items"].([]interface{})
+		for _, item := range items {
+			if obj, ok := item.(map[string]interface{}); ok {
+				setPartialObjectKind(obj, gvk)
+			}
+		}
+		if body, err = json.Marshal(list); err != nil {
+			return nil, fmt.Errorf("error encoding list of %s: %w", gvk.GroupKind(), err)
+		}
+		resp.Header = resp.Header.Clone()
+		resp.Header.Set("Content-Type", "application/json")
+		resp.Header.Del("Content-Length")
+	}
+	// the API server returns full objects if it cannot serve the metadata of the resources only
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+func expiredWatchEvent(gvk schema.GroupVersionKind) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "ERROR",
+		"object": map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Status",
+			"metadata":   map[string]interface{}{},
+			"status":     "Failure",
+			"reason":     "Expired",
+			"code":       http.StatusGone,
+			"message":    fmt.Sprintf("metadata-only watch of %s was upgraded to full objects", gvk.GroupKind()),
+		},
+	}
+}
+
+// expiredWatchResponse returns a watch response which expires the resource version of the request, so that the
+// cluster cache relists the resources
+func expiredWatchResponse(req *http.Request, gvk schema.GroupVersionKind) (*http.Response, error) {
+	body, err := json.Marshal(expiredWatchEvent(gvk))
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// metadataWatchStream restores the kind of the objects of a metadata-only watch. Once expired, the stream ends with an
+// event expiring the resource version of the watch.
+type metadataWatchStream struct {
+	watches  *metadataOnlyWatches
+	gvk      schema.GroupVersionKind
+	upstream io.ReadCloser
+	reader   *io.PipeReader
+	expired  atomic.Bool
+}
+
+func newMetadataWatchStream(watches *metadataOnlyWatches, upstream io.ReadCloser, gvk schema.GroupVersionKind) *metadataWatchStream {
+	reader, writer := io.Pipe()
+	stream := &metadataWatchStream{watches: watches, gvk: gvk, upstream: upstream, reader: reader}
+	go stream.run(writer)
+	return stream
+}
+
+func (s *metadataWatchStream) run(writer *io.PipeWriter) {
+	decoder := json.NewDecoder(s.upstream)
+	decoder.UseNumber()
+	encoder := json.NewEncoder(writer)
+	for {
+		var event struct {
+			Type   string                 `json:"type"`
+			Object map[string]interface{} `json:"object"`
+		}
+		if err := decoder.Decode(&event); err != nil {
+			if s.expired.Load() {
+				_ = encoder.Encode(expiredWatchEvent(s.gvk))
+				_ = writer.Close()
+			} else if err == io.EOF {
+				_ = writer.Close()
+			} else {
+				_ = writer.CloseWithError(err)
+			}
+			return
+		}
+		if event.Object["kind"] == "PartialObjectMetadata" {
+			setPartialObjectKind(event.Object, s.gvk)
+		}
+		if err := encoder.Encode(event); err != nil {
+			_ = s.upstream.Close()
+			return
+		}
+	}
+}
+
+// expire ends the stream with an event expiring the resource version of the watch
+func (s *metadataWatchStream) expire() {
+	s.expired.Store(true)
+	_ = s.upstream.Close()
+}
+
+func (s *metadataWatchStream) Read(p []byte) (int, error) {
+	return s.reader.Read(p)
+}
+
+func (s *metadataWatchStream) Close() error {
+	s.watches.removeStream(s)
+	_ = s.reader.Close()
+	return s.upstream.Close()
+}
+
+// estimateObjectSize returns the approximate number of bytes held by the given value of an unstructured object
+func estimateObjectSize(value interface{}) int64 {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		size := int64(0)
+		for key, item := range v {
+			size += int64(len(key)) + estimateObjectSize(item)
+		}
+		return size
+	case []interface{}:
+		size := int64(0)
+		for _, item := range v {
+			size += estimateObjectSize(item)
+		}
+		return size
+	case string:
+		return int64(len(v))
+	default:
+		return 8
+	}
+}
@@ -0,0 +1,253 @@
+package cache
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v2/util/lua"
+)
+
+var configMapsGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+const (
+	fullConfigMapList     = `{"apiVersion":"v1","kind":"ConfigMapList","metadata":{"resourceVersion":"10"},"items":[{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm","namespace":"default","resourceVersion":"10"},"data":{"key":"value"}}]}`
+	partialConfigMapList  = `{"apiVersion":"meta.k8s.io/v1","kind":"PartialObjectMetadataList","metadata":{"resourceVersion":"10"},"items":[{"apiVersion":"meta.k8s.io/v1","kind":"PartialObjectMetadata","metadata":{"name":"cm","namespace":"default","resourceVersion":"10","generation":1}}]}`
+	fullPodList           = `{"apiVersion":"v1","kind":"PodList","metadata":{"resourceVersion":"10"},"items":[{"apiVersion":"v1","kind":"Pod","metadata":{"name":"pod","namespace":"default","resourceVersion":"10"},"spec":{"nodeName":"node"}}]}`
+	partialConfigMapEvent = `{"type":"ADDED","object":{"apiVersion":"meta.k8s.io/v1","kind":"PartialObjectMetadata","metadata":{"name":"cm2","namespace":"default","resourceVersion":"11"}}}`
+)
+
+// fakeAPIServer serves lists and watches of config maps and pods, with metadata only if requested
+type fakeAPIServer struct {
+	lock sync.Mutex
+	// partialSupported is false if the server ignores requests for metadata only
+	partialSupported bool
+	accepts          []string
+	watches          []*io.PipeWriter
+}
+
+func (s *fakeAPIServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	accept := req.Header.Get("Accept")
+	s.accepts = append(s.accepts, accept)
+	partial := s.partialSupported && strings.Contains(accept, "as=PartialObjectMetadata")
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Request:    req,
+	}
+	switch {
+	case req.URL.Query().Get("watch") == "true":
+		reader, writer := io.Pipe()
+		s.watches = append(s.watches, writer)
+		resp.Body = reader
+	case strings.HasSuffix(req.URL.Path, "/pods"):
+		resp.Body = io.NopCloser(strings.NewReader(fullPodList))
+	case partial:
+		resp.Body = io.NopCloser(strings.NewReader(partialConfigMapList))
+	default:
+		resp.Body = io.NopCloser(strings.NewReader(fullConfigMapList))
+	}
+	return resp, nil
+}
+
+func (s *fakeAPIServer) lastAccept() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.accepts[len(s.accepts)-1]
+}
+
+func (s *fakeAPIServer) lastWatch() *io.PipeWriter {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.watches[len(s.watches)-1]
+}
+
+func newTestMetadataOnlyWatches(managed ...schema.GroupKind) *metadataOnlyWatches {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: kube.PodKind}, meta.RESTScopeNamespace)
+	return newMetadataOnlyWatches("https://cluster", mapper, managed, func(gvk schema.GroupVersionKind) bool {
+		return requiresFullObjects(gvk, lua.ResourceHealthOverrides{})
+	})
+}
+
+func newMetadataOnlyClient(t *testing.T, watches *metadataOnlyWatches, server *fakeAPIServer) dynamic.Interface {
+	t.Helper()
+	host := "https://cluster.example.com/proxy"
+	client, err := dynamic.NewForConfig(&rest.Config{
+		Host:          host,
+		Transport:     server,
+		WrapTransport: watches.wrapTransport(host),
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func receiveEvent(t *testing.T, w watch.Interface) (watch.Event, bool) {
+	t.Helper()
+	select {
+	case event, ok := <-w.ResultChan():
+		return event, ok
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watch event")
+		return watch.Event{}, false
+	}
+}
+
+func TestMetadataOnlyWatches_List(t *testing.T) {
+	t.Run("UnmanagedKind", func(t *testing.T) {
+		server := &fakeAPIServer{partialSupported: true}
+		client := newMetadataOnlyClient(t, newTestMetadataOnlyWatches(), server)
+		list, err := client.Resource(configMapsGVR).Namespace("default").List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, partialObjectMetadataListAccept, server.lastAccept())
+		assert.Equal(t, "10", list.GetResourceVersion())
+		require.Len(t, list.Items, 1)
+		item := list.Items[0]
+		assert.Equal(t, schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, item.GroupVersionKind())
+		assert.Equal(t, "cm", item.GetName())
+		assert.Equal(t, int64(1), item.GetGeneration())
+		assert.Contains(t, item.Object, metadataOnlyObjectKey)
+		assert.NotContains(t, item.Object, "data")
+	})
+
+	t.Run("ManagedKind", func(t *testing.T) {
+		server := &fakeAPIServer{partialSupported: true}
+		client := newMetadataOnlyClient(t, newTestMetadataOnlyWatches(schema.GroupKind{Kind: "ConfigMap"}), server)
+		list, err := client.Resource(configMapsGVR).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, server.lastAccept(), "PartialObjectMetadata")
+		require.Len(t, list.Items, 1)
+		assert.NotContains(t, list.Items[0].Object, metadataOnlyObjectKey)
+		assert.Contains(t, list.Items[0].Object, "data")
+	})
+
+	t.Run("KindRequiringFullObjects", func(t *testing.T) {
+		server := &fakeAPIServer{partialSupported: true}
+		client := newMetadataOnlyClient(t, newTestMetadataOnlyWatches(), server)
+		list, err := client.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, server.lastAccept(), "PartialObjectMetadata")
+		require.Len(t, list.Items, 1)
+		assert.Contains(t, list.Items[0].Object, "spec")
+	})
+
+	t.Run("PartialObjectsNotSupported", func(t *testing.T) {
+		server := &fakeAPIServer{partialSupported: false}
+		client := newMetadataOnlyClient(t, newTestMetadataOnlyWatches(), server)
+		list, err := client.Resource(configMapsGVR).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.NotContains(t, list.Items[0].Object, metadataOnlyObjectKey)
+		assert.Contains(t, list.Items[0].Object, "data")
+	})
+}
+
+func TestMetadataOnlyWatches_Watch(t *testing.T) {
+	server := &fakeAPIServer{partialSupported: true}
+	watches := newTestMetadataOnlyWatches()
+	client := newMetadataOnlyClient(t, watches, server)
+	configMaps := client.Resource(configMapsGVR)
+
+	w, err := configMaps.Watch(context.Background(), metav1.ListOptions{ResourceVersion: "10"})
+	require.NoError(t, err)
+	defer w.Stop()
+	assert.Equal(t, partialObjectMetadataAccept, server.lastAccept())
+
+	go func() {
+		_, _ = server.lastWatch().Write([]byte(partialConfigMapEvent))
+	}()
+	event, ok := receiveEvent(t, w)
+	require.True(t, ok)
+	assert.Equal(t, watch.Added, event.Type)
+	obj, ok := event.Object.(*unstructured.Unstructured)
+	require.True(t, ok)
+	assert.Equal(t, "ConfigMap", obj.GetKind())
+	assert.Equal(t, "cm2", obj.GetName())
+	assert.Contains(t, obj.Object, metadataOnlyObjectKey)
+
+	// an application starts managing config maps: the watch is expired so that config maps are relisted
+	watches.manage([]schema.GroupKind{{Kind: "ConfigMap"}})
+	event, ok = receiveEvent(t, w)
+	require.True(t, ok)
+	assert.Equal(t, watch.Error, event.Type)
+	assert.True(t, apierrors.IsResourceExpired(apierrors.FromObject(event.Object)))
+	_, ok = receiveEvent(t, w)
+	assert.False(t, ok)
+
+	// resuming the watch from the resource version of the metadata-only list expires it as well
+	resumed, err := configMaps.Watch(context.Background(), metav1.ListOptions{ResourceVersion: "11"})
+	require.NoError(t, err)
+	defer resumed.Stop()
+	event, ok = receiveEvent(t, resumed)
+	require.True(t, ok)
+	assert.Equal(t, watch.Error, event.Type)
+	assert.True(t, apierrors.IsResourceExpired(apierrors.FromObject(event.Object)))
+
+	// once relisted, config maps are watched with full objects
+	_, err = configMaps.List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, server.lastAccept(), "PartialObjectMetadata")
+	full, err := configMaps.Watch(context.Background(), metav1.ListOptions{ResourceVersion: "10"})
+	require.NoError(t, err)
+	defer full.Stop()
+	assert.NotContains(t, server.lastAccept(), "PartialObjectMetadata")
+}
+
+func TestParseCollectionPath(t *testing.T) {
+	for path, expected := range map[string]*schema.GroupVersionResource{
+		"/api/v1/pods":                            {Version: "v1", Resource: "pods"},
+		"/api/v1/namespaces":                      {Version: "v1", Resource: "namespaces"},
+		"/api/v1/namespaces/default/pods":         {Version: "v1", Resource: "pods"},
+		"/apis/apps/v1/deployments":               {Group: "apps", Version: "v1", Resource: "deployments"},
+		"/apis/apps/v1/namespaces/ns/deployments": {Group: "apps", Version: "v1", Resource: "deployments"},
+		"/api/v1/namespaces/default":              nil,
+		"/api/v1/namespaces/default/pods/pod":     nil,
+		"/apis/apps/v1":                           nil,
+		"/version":                                nil,
+	} {
+		gvr, ok := parseCollectionPath(path)
+		if expected == nil {
+			assert.False(t, ok, path)
+		} else {
+			assert.True(t, ok, path)
+			assert.Equal(t, *expected, gvr, path)
+		}
+	}
+}
+
+func TestRequiresFullObjects(t *testing.T) {
+	overrides := lua.ResourceHealthOverrides{"example.com/Custom": {HealthLua: "return {}"}}
+	assert.True(t, requiresFullObjects(schema.GroupVersionKind{Version: "v1", Kind: kube.PodKind}, overrides))
+	assert.True(t, requiresFullObjects(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: kube.ReplicaSetKind}, overrides))
+	assert.True(t, requiresFullObjects(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: kube.CustomResourceDefinitionKind}, overrides))
+	assert.True(t, requiresFullObjects(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Custom"}, overrides))
+	assert.False(t, requiresFullObjects(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, overrides))
+	assert.False(t, requiresFullObjects(schema.GroupVersionKind{Group: "coordination.k8s.io", Version: "v1", Kind: "Lease"}, overrides))
+}
+
+func TestEstimateObjectSize(t *testing.T) {
+	size := estimateObjectSize(map[string]interface{}{
+		"key":   "value",
+		"items": []interface{}{"a", int64(1)},
+	})
+	assert.Equal(t, int64(len("key")+len("value")+len("items")+1+8), size)
+}
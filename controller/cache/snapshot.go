@@ -0,0 +1,455 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// snapshotResourceInfoKey holds the resource info of the objects served from a snapshot without their manifest
+	snapshotResourceInfoKey = "argocd.argoproj.io/snapshot-resource-info"
+)
+
+// clusterSnapshot is the persisted state of a cluster cache. It holds the resources of each list request of the
+// cluster cache, along with the resource version to resume watching from.
+type clusterSnapshot struct {
+	Server    string    `json:"server"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Lists holds the resources of each list request, keyed by the API path of the request
+	Lists map[string]*snapshotList `json:"lists"`
+}
+
+type snapshotList struct {
+	APIVersion      string            `json:"apiVersion"`
+	Kind            string            `json:"kind"`
+	ResourceVersion string            `json:"resourceVersion"`
+	Items           []json.RawMessage `json:"items"`
+}
+
+// clusterSnapshotter persists the resources of a cluster cache to disk, and serves the list requests of the cluster
+// cache from the snapshot persisted before the controller restarted. Each list is served at most once, so that the
+// cluster cache relists from the API server if the watch cannot be resumed from the resource version of the snapshot.
+//
+// A snapshot only holds what the cluster cache keeps: the manifests of the resources managed by applications and of
+// the CRDs, and the metadata and resource info of the other resources. Secrets are never persisted.
+type clusterSnapshotter struct {
+	server string
+	path   string
+
+	lock       sync.Mutex
+	namespaces []string
+	pending    map[string]*snapshotList
+	// listed holds the API paths which were listed and are not watched yet
+	listed map[string]bool
+	// watched holds the resource version of the last list of each API path, once the cluster cache watches it
+	watched map[string]watchedList
+	// processed holds the resource version of the last resource updated by the cluster cache, by group kind and namespace
+	processed map[processedKey]processedVersion
+	// seq orders the watches and the resource updates recorded by the snapshotter
+	seq uint64
+}
+
+type watchedList struct {
+	resourceVersion string
+	seq             uint64
+}
+
+type processedKey struct {
+	groupKind schema.GroupKind
+	namespace string
+}
+
+type processedVersion struct {
+	resourceVersion string
+	seq             uint64
+}
+
+// newClusterSnapshotter returns a snapshotter of the given cluster which loads the snapshot persisted in the given
+// directory, unless it is older than the given max age
+func newClusterSnapshotter(dir string, server string, namespaces []string, maxAge time.Duration, now time.Time) *clusterSnapshotter {
+	hash := sha256.Sum256([]byte(server))
+	s := &clusterSnapshotter{
+		server:     server,
+		path:       filepath.Join(dir, hex.EncodeToString(hash[:])+".json.gz"),
+		namespaces: namespaces,
+		listed:     map[string]bool{},
+		watched:    map[string]watchedList{},
+		processed:  map[processedKey]processedVersion{},
+	}
+	snapshot, err := s.load()
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		log.Warnf("Failed to load cluster cache snapshot of %s: %v", server, err)
+	case snapshot.Server != server:
+		log.Warnf("Ignoring cluster cache snapshot %s of cluster %s", s.path, snapshot.Server)
+	case now.Sub(snapshot.CreatedAt) > maxAge:
+		log.Infof("Ignoring cluster cache snapshot of %s created %s ago", server, now.Sub(snapshot.CreatedAt).Truncate(time.Second))
+	default:
+		log.Infof("Loaded cluster cache snapshot of %s with %d lists", server, len(snapshot.Lists))
+		s.pending = snapshot.Lists
+	}
+	return s
+}
+
+func (s *clusterSnapshotter) load() (*clusterSnapshot, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	reader, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing snapshot: %w", err)
+	}
+	var snapshot clusterSnapshot
+	if err := json.NewDecoder(reader).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("error decoding snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+func (s *clusterSnapshotter) setNamespaces(namespaces []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.namespaces = namespaces
+}
+
+// listing records that the cluster cache lists the given API path: the resources of the path are left out of the
+// snapshots until the cluster cache watches them from the resource version of the list
+func (s *clusterSnapshotter) listing(path string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.watched, path)
+	s.listed[path] = true
+}
+
+// watching records the resource version the cluster cache watches the given API path from. The cluster cache starts
+// watching once the resources of the list are added to it, so the first watch after a list is from the resource version
+// of the list. Later watches resume from the resource version of the last event received, which the cluster cache may
+// not have processed yet, so the resource versions of the resources it updated since are recorded instead.
+func (s *clusterSnapshotter) watching(path string, resourceVersion string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.listed[path] && resourceVersion != "" {
+		delete(s.listed, path)
+		s.seq++
+		s.watched[path] = watchedList{resourceVersion: resourceVersion, seq: s.seq}
+	}
+}
+
+// updated records the resource version of a resource the cluster cache was updated with. The watches deliver the events
+// of a list in order, so the snapshot can resume watching from the resource version of the last resource updated since
+// the list, and does not need to go back to the resource version of the list, which etcd may have compacted since.
+func (s *clusterSnapshotter) updated(ref v1.ObjectReference, resourceVersion string) {
+	if resourceVersion == "" {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.seq++
+	key := processedKey{groupKind: schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind).GroupKind(), namespace: ref.Namespace}
+	s.processed[key] = processedVersion{resourceVersion: resourceVersion, seq: s.seq}
+}
+
+// resourceVersion returns the resource version to resume watching the given API path from: the resource version of
+// the last resource of the path updated since the path is watched, or else the resource version of the list
+func (s *clusterSnapshotter) resourceVersion(path string, groupKind schema.GroupKind, namespace string) (string, bool) {
+	watched, ok := s.watched[path]
+	if !ok {
+		return "", false
+	}
+	resourceVersion, seq := watched.resourceVersion, watched.seq
+	for key, processed := range s.processed {
+		if key.groupKind != groupKind || (namespace != "" && key.namespace != namespace) {
+			continue
+		}
+		if processed.seq > seq {
+			resourceVersion, seq = processed.resourceVersion, processed.seq
+		}
+	}
+	return resourceVersion, true
+}
+
+// takeList returns the list of the snapshot matching the given API path, if it was not served yet
+func (s *clusterSnapshotter) takeList(path string) *snapshotList {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	list, ok := s.pending[path]
+	if ok {
+		delete(s.pending, path)
+	}
+	return list
+}
+
+// wrapTransport returns a transport wrapper which serves the first list request of each API path from the snapshot
+func (s *clusterSnapshotter) wrapTransport(host string) func(rt http.RoundTripper) http.RoundTripper {
+	pathPrefix := ""
+	if u, err := url.Parse(host); err == nil {
+		pathPrefix = strings.TrimSuffix(u.Path, "/")
+	}
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &snapshotTransport{snapshotter: s, pathPrefix: pathPrefix, next: rt}
+	}
+}
+
+type snapshotTransport struct {
+	snapshotter *clusterSnapshotter
+	pathPrefix  string
+	next        http.RoundTripper
+}
+
+func (t *snapshotTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.next.RoundTrip(req)
+	}
+	path := strings.TrimPrefix(req.URL.Path, t.pathPrefix)
+	if _, ok := parseCollectionPath(path); !ok {
+		return t.next.RoundTrip(req)
+	}
+	query := req.URL.Query()
+	for _, param := range []string{"labelSelector", "fieldSelector"} {
+		if query.Get(param) != "" {
+			return t.next.RoundTrip(req)
+		}
+	}
+	if query.Get("watch") == "true" || query.Get("watch") == "1" {
+		t.snapshotter.watching(path, query.Get("resourceVersion"))
+		return t.next.RoundTrip(req)
+	}
+	if query.Get("continue") != "" {
+		return t.next.RoundTrip(req)
+	}
+	t.snapshotter.listing(path)
+	list := t.snapshotter.takeList(path)
+	if list == nil || query.Get("resourceVersion") != "" {
+		return t.next.RoundTrip(req)
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"apiVersion": list.APIVersion,
+		"kind":       list.Kind,
+		"metadata":   map[string]string{"resourceVersion": list.ResourceVersion},
+		"items":      list.Items,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding snapshot list: %w", err)
+	}
+	log.Debugf("Serving list %s of cluster %s from snapshot at resource version %s", req.URL.Path, t.snapshotter.server, list.ResourceVersion)
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// listPath returns the API path of the request listing the resources of the given API in the given namespace
+func listPath(gvr schema.GroupVersionResource, namespace string) string {
+	path := "/apis/" + gvr.Group + "/" + gvr.Version
+	if gvr.Group == "" {
+		path = "/api/" + gvr.Version
+	}
+	if namespace != "" {
+		path += "/namespaces/" + namespace
+	}
+	return path + "/" + gvr.Resource
+}
+
+// parseCollectionPath returns the API resource listed by the given API path, and false if the path does not list
+// resources
+func parseCollectionPath(path string) (schema.GroupVersionResource, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var gvr schema.GroupVersionResource
+	switch {
+	case len(segments) >= 3 && segments[0] == "api":
+		gvr.Version = segments[1]
+		segments = segments[2:]
+	case len(segments) >= 4 && segments[0] == "apis":
+		gvr.Group = segments[1]
+		gvr.Version = segments[2]
+		segments = segments[3:]
+	default:
+		return gvr, false
+	}
+	switch {
+	case len(segments) == 1:
+		gvr.Resource = segments[0]
+	case len(segments) == 3 && segments[0] == "namespaces":
+		gvr.Resource = segments[2]
+	default:
+		return gvr, false
+	}
+	return gvr, true
+}
+
+// snapshotItem returns the object persisted for the given resource of the cluster cache: its manifest if the cluster
+// cache keeps it, otherwise its metadata and resource info
+func snapshotItem(res *clustercache.Resource) (json.RawMessage, error) {
+	if res.Resource != nil {
+		return res.Resource.MarshalJSON()
+	}
+	meta := metav1.ObjectMeta{
+		Name:            res.Ref.Name,
+		Namespace:       res.Ref.Namespace,
+		UID:             res.Ref.UID,
+		ResourceVersion: res.ResourceVersion,
+		OwnerReferences: res.OwnerRefs,
+	}
+	if res.CreationTimestamp != nil {
+		meta.CreationTimestamp = *res.CreationTimestamp
+	}
+	obj := map[string]interface{}{
+		"apiVersion": res.Ref.APIVersion,
+		"kind":       res.Ref.Kind,
+		"metadata":   meta,
+	}
+	if info, ok := res.Info.(*ResourceInfo); ok {
+		obj[snapshotResourceInfoKey] = info
+	}
+	return json.Marshal(obj)
+}
+
+// restoreSnapshotResourceInfo returns the resource info persisted with an object served from a snapshot without its
+// manifest, and false if the object was not served from a snapshot or was served with its manifest
+func restoreSnapshotResourceInfo(un *unstructured.Unstructured) (*ResourceInfo, bool) {
+	value, ok := un.Object[snapshotResourceInfoKey]
+	if !ok {
+		return nil, false
+	}
+	delete(un.Object, snapshotResourceInfoKey)
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+	var info ResourceInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		log.Warnf("Failed to restore resource info of %s from cluster cache snapshot: %v", un.GetName(), err)
+		return nil, false
+	}
+	return &info, true
+}
+
+// build returns a snapshot of the resources of the given cluster cache. The lists which are not watched since their
+// last list are left out, since the cluster cache may not hold the resources of the list yet.
+func (s *clusterSnapshotter) build(cache clustercache.ClusterCache, now time.Time) *clusterSnapshot {
+	info := cache.GetClusterInfo()
+	apis := map[schema.GroupVersionKind]kube.APIResourceInfo{}
+	for _, api := range info.APIResources {
+		apis[api.GroupKind.WithVersion(api.GroupVersionResource.Version)] = api
+	}
+
+	// the resource versions are read before the resources, so that the snapshot never resumes watching past a
+	// resource update it does not hold
+	s.lock.Lock()
+	namespaces := s.namespaces
+	resourceVersions := map[string]string{}
+	for _, api := range info.APIResources {
+		for _, namespace := range append([]string{""}, namespaces...) {
+			path := listPath(api.GroupVersionResource, namespace)
+			if resourceVersion, ok := s.resourceVersion(path, api.GroupKind, namespace); ok {
+				resourceVersions[path] = resourceVersion
+			}
+		}
+	}
+	s.lock.Unlock()
+
+	resources := cache.FindResources("")
+
+	snapshot := &clusterSnapshot{Server: s.server, CreatedAt: now, Lists: map[string]*snapshotList{}}
+	for key, res := range resources {
+		if key.Group == "" && key.Kind == kube.SecretKind {
+			continue
+		}
+		gvk := schema.FromAPIVersionAndKind(res.Ref.APIVersion, res.Ref.Kind)
+		api, ok := apis[gvk]
+		if !ok {
+			continue
+		}
+		namespace := ""
+		if api.Meta.Namespaced && len(namespaces) > 0 {
+			namespace = key.Namespace
+		}
+		path := listPath(api.GroupVersionResource, namespace)
+		resourceVersion, ok := resourceVersions[path]
+		if !ok {
+			continue
+		}
+		item, err := snapshotItem(res)
+		if err != nil {
+			log.Warnf("Failed to add resource %s to cluster cache snapshot: %v", key, err)
+			continue
+		}
+		list, ok := snapshot.Lists[path]
+		if !ok {
+			list = &snapshotList{APIVersion: api.GroupVersionResource.GroupVersion().String(), Kind: api.GroupKind.Kind + "List", ResourceVersion: resourceVersion}
+			snapshot.Lists[path] = list
+		}
+		list.Items = append(list.Items, item)
+	}
+	return snapshot
+}
+
+// save persists a snapshot of the given cluster cache, unless the cluster cache is not synced
+func (s *clusterSnapshotter) save(cache clustercache.ClusterCache, now time.Time) error {
+	info := cache.GetClusterInfo()
+	if info.LastCacheSyncTime == nil || info.SyncError != nil {
+		return nil
+	}
+	snapshot := s.build(cache, now)
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("error creating snapshot file: %w", err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	writer := gzip.NewWriter(tmp)
+	if err := json.NewEncoder(writer).Encode(snapshot); err != nil {
+		return fmt.Errorf("error encoding snapshot: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("error compressing snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing snapshot file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("error replacing snapshot file: %w", err)
+	}
+	return nil
+}
+
+// remove deletes the persisted snapshot of the cluster
+func (s *clusterSnapshotter) remove() {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Warnf("Failed to remove cluster cache snapshot of %s: %v", s.server, err)
+	}
+}
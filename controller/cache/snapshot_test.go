@@ -0,0 +1,276 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var (
+	podsGVR        = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	deploymentsGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	snapshotAPIs   = []kube.APIResourceInfo{{
+		GroupKind:            schema.GroupKind{Kind: "Pod"},
+		GroupVersionResource: podsGVR,
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}, {
+		GroupKind:            schema.GroupKind{Group: "apps", Kind: "Deployment"},
+		GroupVersionResource: deploymentsGVR,
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}, {
+		GroupKind:            schema.GroupKind{Kind: kube.SecretKind},
+		GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}}
+)
+
+func newSnapshotResource(apiVersion string, kind string, namespace string, name string, resourceVersion string) *unstructured.Unstructured {
+	un := &unstructured.Unstructured{}
+	un.SetAPIVersion(apiVersion)
+	un.SetKind(kind)
+	un.SetNamespace(namespace)
+	un.SetName(name)
+	un.SetResourceVersion(resourceVersion)
+	return un
+}
+
+// newSnapshotClusterCache returns a synced cluster cache holding the given resources. The manifests of the resources
+// managed by an application are kept.
+func newSnapshotClusterCache(resources ...*unstructured.Unstructured) *mocks.ClusterCache {
+	syncTime := time.Now()
+	cached := map[kube.ResourceKey]*cache.Resource{}
+	for _, un := range resources {
+		info := &ResourceInfo{AppName: un.GetLabels()[common.LabelKeyAppInstance]}
+		res := &cache.Resource{ResourceVersion: un.GetResourceVersion(), Ref: kube.GetObjectRef(un), Info: info}
+		if info.AppName != "" {
+			res.Resource = un
+		}
+		cached[kube.GetResourceKey(un)] = res
+	}
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("GetClusterInfo").Return(cache.ClusterInfo{LastCacheSyncTime: &syncTime, APIResources: snapshotAPIs})
+	clusterCache.On("FindResources", "").Return(cached)
+	return clusterCache
+}
+
+// watchSnapshotPaths records that the cluster cache listed the given API paths and watches them from the given
+// resource version
+func watchSnapshotPaths(snapshotter *clusterSnapshotter, resourceVersion string, paths ...string) {
+	for _, path := range paths {
+		snapshotter.listing(path)
+		snapshotter.watching(path, resourceVersion)
+	}
+}
+
+func newSnapshotClient(t *testing.T, snapshotter *clusterSnapshotter, apiServerLists *int) dynamic.Interface {
+	t.Helper()
+	host := "https://cluster.example.com/proxy"
+	client, err := dynamic.NewForConfig(&rest.Config{
+		Host: host,
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			*apiServerLists++
+			body := `{"apiVersion":"v1","kind":"PodList","metadata":{"resourceVersion":"100"},"items":[]}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+				Request:    req,
+			}, nil
+		}),
+		WrapTransport: snapshotter.wrapTransport(host),
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestClusterSnapshotter_SaveAndServe(t *testing.T) {
+	dir := t.TempDir()
+	pod1 := newSnapshotResource("v1", kube.PodKind, "default", "pod-1", "10")
+	pod1.SetLabels(map[string]string{common.LabelKeyAppInstance: "guestbook"})
+	pod2 := newSnapshotResource("v1", kube.PodKind, "other", "pod-2", "15")
+	deploy := newSnapshotResource("apps/v1", kube.DeploymentKind, "default", "deploy", "12")
+
+	snapshotter := newClusterSnapshotter(dir, "https://cluster", nil, time.Hour, time.Now())
+	watchSnapshotPaths(snapshotter, "15", "/api/v1/pods")
+	watchSnapshotPaths(snapshotter, "12", "/apis/apps/v1/deployments")
+	require.NoError(t, snapshotter.save(newSnapshotClusterCache(pod1, pod2, deploy), time.Now()))
+
+	restored := newClusterSnapshotter(dir, "https://cluster", nil, time.Hour, time.Now())
+	apiServerLists := 0
+	client := newSnapshotClient(t, restored, &apiServerLists)
+
+	pods, err := client.Resource(podsGVR).List(context.Background(), metav1.ListOptions{Limit: 500})
+	require.NoError(t, err)
+	assert.Equal(t, 0, apiServerLists)
+	assert.Equal(t, "15", pods.GetResourceVersion())
+	var names []string
+	for _, item := range pods.Items {
+		names = append(names, item.GetName())
+		// the manifests of the resources managed by an application are persisted, and the resource info of the others
+		info, ok := restoreSnapshotResourceInfo(&item)
+		if item.GetName() == "pod-1" {
+			assert.False(t, ok)
+			assert.Equal(t, "guestbook", item.GetLabels()[common.LabelKeyAppInstance])
+		} else {
+			require.True(t, ok)
+			assert.Equal(t, "", info.AppName)
+			assert.Equal(t, "15", item.GetResourceVersion())
+		}
+	}
+	assert.ElementsMatch(t, []string{"pod-1", "pod-2"}, names)
+
+	deployments, err := client.Resource(deploymentsGVR).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, apiServerLists)
+	assert.Equal(t, "12", deployments.GetResourceVersion())
+	require.Len(t, deployments.Items, 1)
+	assert.Equal(t, "deploy", deployments.Items[0].GetName())
+
+	// each list is only served once, so that a relist goes to the API server
+	pods, err = client.Resource(podsGVR).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, apiServerLists)
+	assert.Equal(t, "100", pods.GetResourceVersion())
+
+	// watches always go to the API server
+	_, _ = client.Resource(deploymentsGVR).Watch(context.Background(), metav1.ListOptions{ResourceVersion: "12"})
+	assert.Equal(t, 2, apiServerLists)
+}
+
+func TestClusterSnapshotter_Namespaces(t *testing.T) {
+	dir := t.TempDir()
+	pod := newSnapshotResource("v1", kube.PodKind, "default", "pod-1", "10")
+	snapshotter := newClusterSnapshotter(dir, "https://cluster", []string{"default"}, time.Hour, time.Now())
+	watchSnapshotPaths(snapshotter, "10", "/api/v1/namespaces/default/pods")
+	require.NoError(t, snapshotter.save(newSnapshotClusterCache(pod), time.Now()))
+
+	restored := newClusterSnapshotter(dir, "https://cluster", []string{"default"}, time.Hour, time.Now())
+	assert.Contains(t, restored.pending, "/api/v1/namespaces/default/pods")
+	apiServerLists := 0
+	client := newSnapshotClient(t, restored, &apiServerLists)
+	pods, err := client.Resource(podsGVR).Namespace("default").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, apiServerLists)
+	assert.Len(t, pods.Items, 1)
+}
+
+func TestClusterSnapshotter_Build(t *testing.T) {
+	t.Run("NotWatched", func(t *testing.T) {
+		pod := newSnapshotResource("v1", kube.PodKind, "default", "pod-1", "10")
+		deploy := newSnapshotResource("apps/v1", kube.DeploymentKind, "default", "deploy", "12")
+		snapshotter := newClusterSnapshotter(t.TempDir(), "https://cluster", nil, time.Hour, time.Now())
+		watchSnapshotPaths(snapshotter, "12", "/apis/apps/v1/deployments")
+		// the pods are listed but not watched yet, so the cluster cache may not hold them yet
+		snapshotter.listing("/api/v1/pods")
+
+		snapshot := snapshotter.build(newSnapshotClusterCache(pod, deploy), time.Now())
+		assert.NotContains(t, snapshot.Lists, "/api/v1/pods")
+		assert.Contains(t, snapshot.Lists, "/apis/apps/v1/deployments")
+	})
+
+	t.Run("Relisted", func(t *testing.T) {
+		pod := newSnapshotResource("v1", kube.PodKind, "default", "pod-1", "10")
+		snapshotter := newClusterSnapshotter(t.TempDir(), "https://cluster", nil, time.Hour, time.Now())
+		watchSnapshotPaths(snapshotter, "10", "/api/v1/pods")
+		// watches resumed after the first one do not move the resource version of the list
+		snapshotter.watching("/api/v1/pods", "20")
+		assert.Equal(t, "10", snapshotter.build(newSnapshotClusterCache(pod), time.Now()).Lists["/api/v1/pods"].ResourceVersion)
+
+		snapshotter.listing("/api/v1/pods")
+		assert.NotContains(t, snapshotter.build(newSnapshotClusterCache(pod), time.Now()).Lists, "/api/v1/pods")
+		snapshotter.watching("/api/v1/pods", "30")
+		assert.Equal(t, "30", snapshotter.build(newSnapshotClusterCache(pod), time.Now()).Lists["/api/v1/pods"].ResourceVersion)
+	})
+
+	t.Run("Updated", func(t *testing.T) {
+		pod := newSnapshotResource("v1", kube.PodKind, "default", "pod-1", "10")
+		snapshotter := newClusterSnapshotter(t.TempDir(), "https://cluster", nil, time.Hour, time.Now())
+		// the resources updated while the pods are relisted are older than the list
+		snapshotter.listing("/api/v1/pods")
+		snapshotter.updated(kube.GetObjectRef(pod), "5")
+		snapshotter.watching("/api/v1/pods", "10")
+		assert.Equal(t, "10", snapshotter.build(newSnapshotClusterCache(pod), time.Now()).Lists["/api/v1/pods"].ResourceVersion)
+
+		// the snapshot resumes watching from the last resource the cluster cache was updated with
+		snapshotter.updated(kube.GetObjectRef(newSnapshotResource("v1", kube.PodKind, "other", "pod-2", "30")), "30")
+		snapshotter.updated(kube.GetObjectRef(pod), "25")
+		snapshotter.updated(kube.GetObjectRef(newSnapshotResource("apps/v1", kube.DeploymentKind, "default", "deploy", "40")), "40")
+		assert.Equal(t, "25", snapshotter.build(newSnapshotClusterCache(pod), time.Now()).Lists["/api/v1/pods"].ResourceVersion)
+	})
+
+	t.Run("UpdatedNamespaces", func(t *testing.T) {
+		pod1 := newSnapshotResource("v1", kube.PodKind, "default", "pod-1", "10")
+		pod2 := newSnapshotResource("v1", kube.PodKind, "other", "pod-2", "10")
+		snapshotter := newClusterSnapshotter(t.TempDir(), "https://cluster", []string{"default", "other"}, time.Hour, time.Now())
+		watchSnapshotPaths(snapshotter, "10", "/api/v1/namespaces/default/pods", "/api/v1/namespaces/other/pods")
+		snapshotter.updated(kube.GetObjectRef(pod2), "20")
+
+		lists := snapshotter.build(newSnapshotClusterCache(pod1, pod2), time.Now()).Lists
+		assert.Equal(t, "10", lists["/api/v1/namespaces/default/pods"].ResourceVersion)
+		assert.Equal(t, "20", lists["/api/v1/namespaces/other/pods"].ResourceVersion)
+	})
+
+	t.Run("Secrets", func(t *testing.T) {
+		secret := newSnapshotResource("v1", kube.SecretKind, "default", "secret", "10")
+		secret.SetLabels(map[string]string{common.LabelKeyAppInstance: "guestbook"})
+		snapshotter := newClusterSnapshotter(t.TempDir(), "https://cluster", nil, time.Hour, time.Now())
+		watchSnapshotPaths(snapshotter, "10", "/api/v1/secrets")
+
+		assert.Empty(t, snapshotter.build(newSnapshotClusterCache(secret), time.Now()).Lists)
+	})
+}
+
+func TestClusterSnapshotter_Load(t *testing.T) {
+	dir := t.TempDir()
+	pod := newSnapshotResource("v1", kube.PodKind, "default", "pod-1", "10")
+	snapshotter := newClusterSnapshotter(dir, "https://cluster", nil, time.Hour, time.Now())
+	watchSnapshotPaths(snapshotter, "10", "/api/v1/pods")
+	createdAt := time.Now().Add(-20 * time.Minute)
+	require.NoError(t, snapshotter.save(newSnapshotClusterCache(pod), createdAt))
+
+	t.Run("Recent", func(t *testing.T) {
+		assert.Len(t, newClusterSnapshotter(dir, "https://cluster", nil, time.Hour, time.Now()).pending, 1)
+	})
+	t.Run("TooOld", func(t *testing.T) {
+		assert.Empty(t, newClusterSnapshotter(dir, "https://cluster", nil, 10*time.Minute, time.Now()).pending)
+	})
+	t.Run("OtherCluster", func(t *testing.T) {
+		assert.Empty(t, newClusterSnapshotter(dir, "https://other", nil, time.Hour, time.Now()).pending)
+	})
+	t.Run("NotSynced", func(t *testing.T) {
+		clusterCache := &mocks.ClusterCache{}
+		clusterCache.On("GetClusterInfo").Return(cache.ClusterInfo{SyncError: errors.New("sync failed")})
+		other := newClusterSnapshotter(t.TempDir(), "https://cluster", nil, time.Hour, time.Now())
+		require.NoError(t, other.save(clusterCache, time.Now()))
+		_, err := other.load()
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+	t.Run("Removed", func(t *testing.T) {
+		snapshotter.remove()
+		assert.Empty(t, newClusterSnapshotter(dir, "https://cluster", nil, time.Hour, time.Now()).pending)
+	})
+}
@@ -40,6 +40,8 @@ type clusterInfoUpdater struct {
 	projGetter    func(app *appv1.Application) (*appv1.AppProject, error)
 	namespace     string
 	lastUpdated   time.Time
+	// infoHandler is notified of the latest info of every cluster, including clusters managed by other shards
+	infoHandler func(server string, info *appv1.ClusterInfo)
 }
 
 func NewClusterInfoUpdater(
@@ -50,8 +52,9 @@ func NewClusterInfoUpdater(
 	clusterFilter func(cluster *appv1.Cluster) bool,
 	projGetter func(app *appv1.Application) (*appv1.AppProject, error),
 	namespace string,
+	infoHandler func(server string, info *appv1.ClusterInfo),
 ) *clusterInfoUpdater {
-	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
+	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}, infoHandler}
 }
 
 func (c *clusterInfoUpdater) Run(ctx context.Context) {
@@ -111,6 +114,17 @@ func (c *clusterInfoUpdater) updateClusters() {
 		return nil
 	})
 	log.Debugf("Successfully saved info of %d clusters", len(clustersFiltered))
+
+	if c.infoHandler != nil {
+		for _, cluster := range clusters.Items {
+			var info appv1.ClusterInfo
+			if err := c.cache.GetClusterInfo(cluster.Server, &info); err != nil {
+				log.Debugf("Failed to get info of cluster %s: %v", cluster.Server, err)
+				continue
+			}
+			c.infoHandler(cluster.Server, &info)
+		}
+	}
 }
 
 func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv1.Cluster, info *cache.ClusterInfo) error {
@@ -132,6 +146,15 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 				continue
 			}
 		}
+		if a.Spec.HasMultipleDestinations() {
+			for _, destination := range a.Spec.Destinations {
+				if err := argo.ValidateDestination(ctx, &destination, c.db); err == nil && destination.Server == cluster.Server {
+					appCount += 1
+					break
+				}
+			}
+			continue
+		}
 		if err := argo.ValidateDestination(ctx, &a.Spec.Destination, c.db); err != nil {
 			continue
 		}
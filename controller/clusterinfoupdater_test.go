@@ -88,7 +88,7 @@ func TestClusterSecretUpdater(t *testing.T) {
 		}
 
 		lister := applisters.NewApplicationLister(appInformer.GetIndexer()).Applications(fakeNamespace)
-		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, fakeNamespace)
+		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, fakeNamespace, nil)
 
 		err = updater.updateClusterInfo(context.Background(), *cluster, info)
 		require.NoError(t, err, "Invoking updateClusterInfo failed.")
@@ -0,0 +1,478 @@
+package controller
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/controller/metrics"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// destinationRolloutRefreshInterval is the interval at which an application with multiple destinations is refreshed
+// while a sync operation is in progress, to evaluate the health gates of the destination rollout
+var destinationRolloutRefreshInterval = 10 * time.Second
+
+// destinationComparisonResult is the result of the comparison of one of the destinations of an application with
+// multiple destinations
+type destinationComparisonResult struct {
+	app    *appv1.Application
+	result *comparisonResult
+}
+
+// destinationApp returns a copy of the given application with multiple destinations which is deployed to the given
+// destination only. The state of the destination is compared and synced by comparing and syncing the copy.
+func destinationApp(app *appv1.Application, dest appv1.ApplicationDestination) *appv1.Application {
+	destApp := app.DeepCopy()
+	destApp.Spec.Destination = dest
+	destApp.Spec.Destinations = nil
+	destApp.Status.Destinations = nil
+	return destApp
+}
+
+// destinationString returns the cluster name, or server if not set, and the namespace of the given destination
+func destinationString(dest appv1.ApplicationDestination) string {
+	cluster := dest.Name
+	if cluster == "" {
+		cluster = dest.Server
+	}
+	return fmt.Sprintf("%s/%s", cluster, dest.Namespace)
+}
+
+// compareAppDestinations compares the state of each destination of an application with multiple destinations and
+// aggregates the results: the application is OutOfSync if any destination is, and its health is the worst health of
+// its destinations. The comparison conditions of the destinations are set on the application, prefixed with their
+// destination.
+func (ctrl *ApplicationController) compareAppDestinations(ctx context.Context, app *appv1.Application, project *appv1.AppProject, revisions []string, sources []appv1.ApplicationSource, noCache bool, noRevisionCache bool, localManifests []string, hasMultipleSources bool) (*comparisonResult, error) {
+	compRes := &comparisonResult{
+		syncStatus:   &appv1.SyncStatus{Status: appv1.SyncStatusCodeSynced, ComparedTo: app.BuildComparedToStatus()},
+		healthStatus: &appv1.HealthStatus{Status: health.HealthStatusHealthy},
+		timings:      map[string]time.Duration{},
+	}
+	conditions := make([]appv1.ApplicationCondition, 0)
+	for i, dest := range app.Spec.Destinations {
+		destApp := destinationApp(app, dest)
+		// the comparison is never served from the diff cache, since the status of the copy was compared to all the
+		// destinations and the cache is shared by the destinations
+		res, err := ctrl.appStateManager.CompareAppState(ctx, destApp, project, revisions, sources, noCache, noRevisionCache, localManifests, hasMultipleSources, false)
+		if goerrors.Is(err, CompareStateRepoError) {
+			return nil, err
+		}
+		sort.Slice(res.resources, func(i, j int) bool {
+			return resourceStatusKey(res.resources[i]) < resourceStatusKey(res.resources[j])
+		})
+		compRes.destinationStatuses = append(compRes.destinationStatuses, appv1.ApplicationDestinationStatus{
+			Destination: dest,
+			Status:      res.syncStatus.Status,
+			Health:      *res.healthStatus,
+			Resources:   res.resources,
+		})
+		compRes.destinations = append(compRes.destinations, destinationComparisonResult{app: destApp, result: res})
+
+		if i == 0 {
+			compRes.syncStatus.Revision = res.syncStatus.Revision
+			compRes.syncStatus.Revisions = res.syncStatus.Revisions
+			compRes.appSourceType = res.appSourceType
+			compRes.appSourceTypes = res.appSourceTypes
+		}
+		switch res.syncStatus.Status {
+		case appv1.SyncStatusCodeOutOfSync:
+			compRes.syncStatus.Status = appv1.SyncStatusCodeOutOfSync
+		case appv1.SyncStatusCodeUnknown:
+			if compRes.syncStatus.Status == appv1.SyncStatusCodeSynced {
+				compRes.syncStatus.Status = appv1.SyncStatusCodeUnknown
+			}
+		}
+		if health.IsWorse(compRes.healthStatus.Status, res.healthStatus.Status) {
+			compRes.healthStatus = &appv1.HealthStatus{Status: res.healthStatus.Status}
+			if res.healthStatus.Message != "" {
+				compRes.healthStatus.Message = fmt.Sprintf("%s: %s", destinationString(dest), res.healthStatus.Message)
+			}
+		}
+		compRes.resources = append(compRes.resources, res.resources...)
+		compRes.managedResources = append(compRes.managedResources, res.managedResources...)
+		compRes.revisionUpdated = compRes.revisionUpdated || res.revisionUpdated
+		compRes.hasPreDeleteHooks = compRes.hasPreDeleteHooks || res.hasPreDeleteHooks
+		compRes.hasPostDeleteHooks = compRes.hasPostDeleteHooks || res.hasPostDeleteHooks
+		for k, v := range res.timings {
+			compRes.timings[k] += v
+		}
+		for _, condition := range destApp.Status.GetConditions(comparisonConditionTypes) {
+			condition.Message = fmt.Sprintf("%s: %s", destinationString(dest), condition.Message)
+			conditions = append(conditions, condition)
+		}
+	}
+	app.Status.SetConditions(conditions, comparisonConditionTypes)
+	return compRes, nil
+}
+
+// setAppDestinationsManagedResources caches the managed resources and the resource tree of an application with
+// multiple destinations, which are the ones of all its destinations
+func (ctrl *ApplicationController) setAppDestinationsManagedResources(a *appv1.Application, comparisonResult *comparisonResult) (*appv1.ApplicationTree, error) {
+	tree := &appv1.ApplicationTree{}
+	managedResources := make([]*appv1.ResourceDiff, 0)
+	for _, dest := range comparisonResult.destinations {
+		destManagedResources, err := ctrl.hideSecretData(dest.app, dest.result)
+		if err != nil {
+			return nil, fmt.Errorf("error getting managed resources of destination %s: %w", destinationString(dest.app.Spec.Destination), err)
+		}
+		destTree, err := ctrl.getResourceTree(dest.app, destManagedResources)
+		if err != nil {
+			return nil, fmt.Errorf("error getting resource tree of destination %s: %w", destinationString(dest.app.Spec.Destination), err)
+		}
+		managedResources = append(managedResources, destManagedResources...)
+		tree.Nodes = append(tree.Nodes, destTree.Nodes...)
+		tree.OrphanedNodes = append(tree.OrphanedNodes, destTree.OrphanedNodes...)
+		tree.Hosts = append(tree.Hosts, destTree.Hosts...)
+	}
+	tree.Normalize()
+	err := ctrl.cache.SetAppResourcesTree(a.InstanceName(ctrl.namespace), tree)
+	if err != nil {
+		return nil, fmt.Errorf("error setting app resource tree: %w", err)
+	}
+	err = ctrl.cache.SetAppManagedResources(a.InstanceName(ctrl.namespace), managedResources)
+	if err != nil {
+		return nil, fmt.Errorf("error setting app managed resources: %w", err)
+	}
+	return tree, nil
+}
+
+// deletionDestination is a destination of an application with multiple destinations which is being deleted
+type deletionDestination struct {
+	app    *appv1.Application
+	name   string
+	config *rest.Config
+	logCtx *log.Entry
+}
+
+// getDeletionDestinations returns the destinations of an application with multiple destinations being deleted whose
+// cluster is defined, along with the config to modify their resources
+func (ctrl *ApplicationController) getDeletionDestinations(app *appv1.Application, proj *appv1.AppProject) ([]deletionDestination, error) {
+	logCtx := getAppLog(app)
+	var destinations []deletionDestination
+	for _, dest := range app.Spec.Destinations {
+		destApp := destinationApp(app, dest)
+		isValid, cluster := ctrl.isValidDestination(destApp)
+		if !isValid {
+			logCtx.Infof("Resource entries removed from undefined cluster of destination %s", destinationString(dest))
+			continue
+		}
+		config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, destApp, cluster.RESTConfig())
+		if err := ctrl.impersonateDestinationServiceAccount(destApp, proj, config); err != nil {
+			return nil, err
+		}
+		destinations = append(destinations, deletionDestination{
+			app:    destApp,
+			name:   destinationString(dest),
+			config: config,
+			logCtx: logCtx.WithField("destination", destinationString(dest)),
+		})
+	}
+	return destinations, nil
+}
+
+// runDeletionHooks runs the given step of the deletion hooks on the live objects of each destination, and returns true
+// once the step is done on all the destinations
+func (ctrl *ApplicationController) runDeletionHooks(destinations []deletionDestination, proj *appv1.AppProject, projectClusters func(project string) ([]*appv1.Cluster, error), step func(dest deletionDestination, liveObjs map[kube.ResourceKey]*unstructured.Unstructured) (bool, error)) (bool, error) {
+	allDone := true
+	for _, dest := range destinations {
+		objsMap, err := ctrl.getPermittedAppLiveObjects(dest.app, proj, projectClusters)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", dest.name, err)
+		}
+		done, err := step(dest, objsMap)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", dest.name, err)
+		}
+		allDone = allDone && done
+	}
+	return allDone, nil
+}
+
+// finalizeApplicationDestinationsDeletion deletes an application with multiple destinations like an application with a
+// single destination: the PreDelete hooks are run on all the destinations before the resources are deleted, one
+// destination after the other, and the PostDelete hooks are run on all the destinations once the resources are deleted.
+func (ctrl *ApplicationController) finalizeApplicationDestinationsDeletion(app *appv1.Application, proj *appv1.AppProject, projectClusters func(project string) ([]*appv1.Cluster, error)) error {
+	destinations, err := ctrl.getDeletionDestinations(app, proj)
+	if err != nil {
+		return err
+	}
+
+	if (app.HasPreDeleteFinalizer() || app.HasPreDeleteFinalizer("cleanup")) && skipPreDeleteHooks(app) {
+		getAppLog(app).Warnf("Skipping PreDelete hooks as requested by the %s annotation", common.AnnotationKeySkipPreDeleteHooks)
+		app.UnSetPreDeleteFinalizer()
+		app.UnSetPreDeleteFinalizer("cleanup")
+		return ctrl.updateFinalizers(app)
+	}
+
+	if app.HasPreDeleteFinalizer() {
+		var failedMessages []string
+		done, err := ctrl.runDeletionHooks(destinations, proj, projectClusters, func(dest deletionDestination, liveObjs map[kube.ResourceKey]*unstructured.Unstructured) (bool, error) {
+			done, failedMessage, err := ctrl.executePreDeleteHooks(dest.app, proj, liveObjs, dest.config, dest.logCtx)
+			if failedMessage != "" {
+				failedMessages = append(failedMessages, fmt.Sprintf("%s: %s", dest.name, failedMessage))
+			}
+			return done, err
+		})
+		if err != nil {
+			return err
+		}
+		if len(failedMessages) > 0 {
+			ctrl.blockAppDeletion(app, strings.Join(failedMessages, "; "))
+			return nil
+		}
+		if !done {
+			return nil
+		}
+		app.UnSetPreDeleteFinalizer()
+		return ctrl.updateFinalizers(app)
+	}
+
+	if app.HasPreDeleteFinalizer("cleanup") {
+		done, err := ctrl.runDeletionHooks(destinations, proj, projectClusters, func(dest deletionDestination, liveObjs map[kube.ResourceKey]*unstructured.Unstructured) (bool, error) {
+			return ctrl.cleanupPreDeleteHooks(liveObjs, dest.config, dest.logCtx)
+		})
+		if err != nil || !done {
+			return err
+		}
+		app.UnSetPreDeleteFinalizer("cleanup")
+		return ctrl.updateFinalizers(app)
+	}
+
+	if app.CascadedDeletion() {
+		for _, dest := range destinations {
+			done, err := ctrl.deleteAppResources(dest.app, dest.name, proj, projectClusters, dest.config, dest.logCtx)
+			if err != nil {
+				return fmt.Errorf("%s: %w", dest.name, err)
+			}
+			if !done {
+				return nil
+			}
+		}
+		app.UnSetCascadedDeletion()
+		return ctrl.updateFinalizers(app)
+	}
+
+	if app.HasPostDeleteFinalizer() {
+		done, err := ctrl.runDeletionHooks(destinations, proj, projectClusters, func(dest deletionDestination, liveObjs map[kube.ResourceKey]*unstructured.Unstructured) (bool, error) {
+			return ctrl.executePostDeleteHooks(dest.app, proj, liveObjs, dest.config, dest.logCtx)
+		})
+		if err != nil || !done {
+			return err
+		}
+		app.UnSetPostDeleteFinalizer()
+		return ctrl.updateFinalizers(app)
+	}
+
+	if app.HasPostDeleteFinalizer("cleanup") {
+		done, err := ctrl.runDeletionHooks(destinations, proj, projectClusters, func(dest deletionDestination, liveObjs map[kube.ResourceKey]*unstructured.Unstructured) (bool, error) {
+			return ctrl.cleanupPostDeleteHooks(liveObjs, dest.config, dest.logCtx)
+		})
+		if err != nil || !done {
+			return err
+		}
+		app.UnSetPostDeleteFinalizer("cleanup")
+		return ctrl.updateFinalizers(app)
+	}
+
+	if err := ctrl.cache.SetAppManagedResources(app.Name, nil); err != nil {
+		return err
+	}
+	if err := ctrl.cache.SetAppResourcesTree(app.Name, nil); err != nil {
+		return err
+	}
+	ctrl.projectRefreshQueue.Add(fmt.Sprintf("%s/%s", ctrl.namespace, app.Spec.GetProject()))
+	return nil
+}
+
+// syncAppDestinations performs the sync operation of the given state on each destination of an application with
+// multiple destinations, in the batches of its destination rollout. The destinations of a batch are synced in
+// parallel, and a batch is only synced once the sync of the previous batch succeeded and all its destinations were
+// observed Healthy. The state of the operation on each destination is kept in the destinations of the operation state.
+func (m *appStateManager) syncAppDestinations(app *appv1.Application, state *appv1.OperationState) {
+	destinations := app.Spec.Destinations
+	if len(state.Destinations) != len(destinations) {
+		state.Destinations = make([]appv1.DestinationOperationState, len(destinations))
+	}
+	for i := range destinations {
+		state.Destinations[i].Destination = destinations[i]
+	}
+
+	if state.Phase == synccommon.OperationTerminating {
+		m.terminateAppDestinations(app, state)
+		return
+	}
+
+	batches := app.Spec.DestinationRollout.Batches(len(destinations))
+	for n, batch := range batches {
+		var wg sync.WaitGroup
+		for _, i := range batch {
+			destState := &state.Destinations[i]
+			if destState.Phase == synccommon.OperationFailed || destState.Phase == synccommon.OperationError {
+				// the operation is retried
+				*destState = appv1.DestinationOperationState{Destination: destinations[i]}
+			}
+			if destState.Phase.Completed() {
+				continue
+			}
+			wg.Add(1)
+			go func(dest appv1.ApplicationDestination, destState *appv1.DestinationOperationState) {
+				defer wg.Done()
+				m.syncAppDestination(app, dest, state, destState, synccommon.OperationRunning)
+			}(destinations[i], destState)
+		}
+		wg.Wait()
+		setDestinationsSyncResult(state)
+
+		for _, i := range batch {
+			destState := state.Destinations[i]
+			if !destState.Phase.Completed() {
+				state.Message = fmt.Sprintf("Syncing batch %d of %d of the destinations", n+1, len(batches))
+				return
+			}
+		}
+		for _, i := range batch {
+			destState := state.Destinations[i]
+			if !destState.Phase.Successful() {
+				state.Phase = destState.Phase
+				state.Message = fmt.Sprintf("Sync of destination %s failed: %s", destinationString(destState.Destination), destState.Message)
+				return
+			}
+		}
+		if n < len(batches)-1 {
+			if passed, message, failed := destinationsHealthGate(app, state, batch); !passed {
+				if failed {
+					state.Phase = synccommon.OperationFailed
+				}
+				state.Message = message
+				return
+			}
+		}
+	}
+
+	state.Phase = synccommon.OperationSucceeded
+	state.Message = fmt.Sprintf("successfully synced %d destinations", len(destinations))
+
+	syncOp := state.Operation.Sync
+	if syncOp != nil && !syncOp.DryRun && len(syncOp.Resources) == 0 && state.SyncResult != nil {
+		res := state.SyncResult
+		err := m.persistRevisionHistory(app, res.Revision, res.Source, res.Revisions, res.Sources, len(res.Sources) > 0, state.StartedAt, state.Operation.InitiatedBy)
+		if err != nil {
+			state.Phase = synccommon.OperationError
+			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
+		}
+	}
+}
+
+// terminateAppDestinations terminates the sync operation on the destinations it is running on, and cancels it on
+// the destinations it did not start on yet
+func (m *appStateManager) terminateAppDestinations(app *appv1.Application, state *appv1.OperationState) {
+	now := metav1.Now()
+	var wg sync.WaitGroup
+	for i := range state.Destinations {
+		destState := &state.Destinations[i]
+		if destState.Phase.Completed() {
+			continue
+		}
+		if destState.Phase == "" {
+			destState.Phase = synccommon.OperationFailed
+			destState.Message = "Operation terminated"
+			destState.FinishedAt = &now
+			continue
+		}
+		wg.Add(1)
+		go func(dest appv1.ApplicationDestination, destState *appv1.DestinationOperationState) {
+			defer wg.Done()
+			m.syncAppDestination(app, dest, state, destState, synccommon.OperationTerminating)
+		}(app.Spec.Destinations[i], destState)
+	}
+	wg.Wait()
+	setDestinationsSyncResult(state)
+
+	for _, destState := range state.Destinations {
+		if !destState.Phase.Completed() {
+			return
+		}
+	}
+	state.Phase = synccommon.OperationFailed
+	state.Message = "Operation terminated"
+}
+
+// syncAppDestination performs one iteration of the sync operation of the given state on a destination of an
+// application with multiple destinations, and updates the state of the operation on the destination
+func (m *appStateManager) syncAppDestination(app *appv1.Application, dest appv1.ApplicationDestination, state *appv1.OperationState, destState *appv1.DestinationOperationState, phase synccommon.OperationPhase) {
+	now := metav1.Now()
+	if destState.StartedAt == nil {
+		destState.StartedAt = &now
+	}
+	opState := &appv1.OperationState{
+		Operation:  *state.Operation.DeepCopy(),
+		Phase:      phase,
+		Message:    destState.Message,
+		SyncResult: destState.SyncResult,
+		StartedAt:  *destState.StartedAt,
+	}
+	destApp := destinationApp(app, dest)
+	if err := argo.ValidateDestination(context.Background(), &destApp.Spec.Destination, m.db); err != nil {
+		opState.Phase = synccommon.OperationFailed
+		opState.Message = err.Error()
+	} else {
+		// the revision history is recorded once the operation completed on all the destinations
+		m.syncAppState(destApp, opState, false)
+	}
+	log.WithFields(log.Fields{"application": app.Name, "destination": destinationString(dest)}).Infof("Sync operation on destination (phase: %s)", opState.Phase)
+
+	destState.Phase = opState.Phase
+	destState.Message = opState.Message
+	destState.SyncResult = opState.SyncResult
+	if destState.Phase.Completed() {
+		finishedAt := metav1.Now()
+		destState.FinishedAt = &finishedAt
+	}
+}
+
+// setDestinationsSyncResult sets the revision and the source the operation syncs the destinations to in the sync result
+// of the operation, which automated sync relies on to decide whether the application should be synced
+func setDestinationsSyncResult(state *appv1.OperationState) {
+	for _, destState := range state.Destinations {
+		if res := destState.SyncResult; res != nil {
+			state.SyncResult = &appv1.SyncOperationResult{Revision: res.Revision, Revisions: res.Revisions, Source: res.Source, Sources: res.Sources}
+			return
+		}
+	}
+}
+
+// destinationsHealthGate returns whether all the given destinations were observed Healthy since the sync operation
+// completed on them. Otherwise, it returns the reason the rollout does not proceed and whether it is aborted, which is
+// the case once a destination is Degraded.
+func destinationsHealthGate(app *appv1.Application, state *appv1.OperationState, batch []int) (bool, string, bool) {
+	for _, i := range batch {
+		destState := state.Destinations[i]
+		dest := destinationString(destState.Destination)
+		if app.Status.ReconciledAt == nil || destState.FinishedAt == nil || app.Status.ReconciledAt.Before(destState.FinishedAt) || i >= len(app.Status.Destinations) {
+			return false, fmt.Sprintf("Waiting for the health of destination %s to be assessed", dest), false
+		}
+		switch status := app.Status.Destinations[i].Health; status.Status {
+		case health.HealthStatusHealthy:
+		case health.HealthStatusDegraded:
+			return false, fmt.Sprintf("Destination %s is Degraded: %s", dest, status.Message), true
+		default:
+			return false, fmt.Sprintf("Waiting for destination %s to become Healthy (%s)", dest, status.Status), false
+		}
+	}
+	return true, "", false
+}
@@ -0,0 +1,305 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+const fakeOtherClusterServer = "https://other:6443"
+
+// newFakeOtherCluster returns the secret of the second cluster of the applications with multiple destinations
+func newFakeOtherCluster() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other-cluster",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster},
+		},
+		Data: map[string][]byte{
+			"name":   []byte("other"),
+			"server": []byte(fakeOtherClusterServer),
+			"config": []byte(`{"bearerToken":"fake","tlsClientConfig":{"insecure":true}}`),
+		},
+	}
+}
+
+// newFakeMultiDestinationApp returns an application deployed to two clusters, one after the other
+func newFakeMultiDestinationApp() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.Destinations = v1alpha1.ApplicationDestinations{
+		{Server: app.Spec.Destination.Server, Namespace: "ns-1"},
+		{Server: fakeOtherClusterServer, Namespace: "ns-2"},
+	}
+	app.Spec.Destination = v1alpha1.ApplicationDestination{}
+	app.Spec.DestinationRollout = &v1alpha1.DestinationRollout{Strategy: v1alpha1.DestinationRolloutStrategySequential}
+	return app
+}
+
+// newFakeMultiDestinationController returns a controller which records the last patch of the application in receivedPatch
+func newFakeMultiDestinationController(t *testing.T, app *v1alpha1.Application, receivedPatch map[string]interface{}) *ApplicationController {
+	t.Helper()
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{
+			{Manifests: []*apiclient.Manifest{}, Revision: "abc123"},
+			{Manifests: []*apiclient.Manifest{}, Revision: "abc123"},
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		additionalObjs:  []runtime.Object{newFakeOtherCluster()},
+	}, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			for k := range receivedPatch {
+				delete(receivedPatch, k)
+			}
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+	return ctrl
+}
+
+func TestProcessRequestedAppOperation_MultipleDestinations(t *testing.T) {
+	t.Run("WaitsForHealthOfPreviousDestination", func(t *testing.T) {
+		app := newFakeMultiDestinationApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		receivedPatch := map[string]interface{}{}
+		ctrl := newFakeMultiDestinationController(t, app, receivedPatch)
+
+		ctrl.processRequestedAppOperation(app)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationRunning), phase)
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Equal(t, "Waiting for the health of destination https://localhost:6443/ns-1 to be assessed", message)
+		destinations, _, _ := unstructured.NestedSlice(receivedPatch, "status", "operationState", "destinations")
+		require.Len(t, destinations, 2)
+		assert.Equal(t, string(synccommon.OperationSucceeded), destinations[0].(map[string]interface{})["phase"])
+		assert.NotContains(t, destinations[1].(map[string]interface{}), "phase")
+	})
+
+	t.Run("ProceedsOnceHealthy", func(t *testing.T) {
+		app := newFakeMultiDestinationApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		finishedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+		reconciledAt := metav1.Now()
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Operation: *app.Operation,
+			Phase:     synccommon.OperationRunning,
+			StartedAt: finishedAt,
+			Destinations: []v1alpha1.DestinationOperationState{
+				{Destination: app.Spec.Destinations[0], Phase: synccommon.OperationSucceeded, StartedAt: &finishedAt, FinishedAt: &finishedAt, SyncResult: &v1alpha1.SyncOperationResult{Revision: "abc123"}},
+				{Destination: app.Spec.Destinations[1]},
+			},
+		}
+		app.Status.ReconciledAt = &reconciledAt
+		app.Status.Destinations = []v1alpha1.ApplicationDestinationStatus{
+			{Destination: app.Spec.Destinations[0], Status: v1alpha1.SyncStatusCodeSynced, Health: v1alpha1.HealthStatus{Status: health.HealthStatusHealthy}},
+			{Destination: app.Spec.Destinations[1], Status: v1alpha1.SyncStatusCodeOutOfSync, Health: v1alpha1.HealthStatus{Status: health.HealthStatusMissing}},
+		}
+		receivedPatch := map[string]interface{}{}
+		ctrl := newFakeMultiDestinationController(t, app, receivedPatch)
+
+		ctrl.processRequestedAppOperation(app)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Equal(t, "successfully synced 2 destinations", message)
+		destinations, _, _ := unstructured.NestedSlice(receivedPatch, "status", "operationState", "destinations")
+		require.Len(t, destinations, 2)
+		assert.Equal(t, string(synccommon.OperationSucceeded), destinations[1].(map[string]interface{})["phase"])
+	})
+}
+
+func TestProcessAppRefreshQueueItem_MultipleDestinations(t *testing.T) {
+	app := newFakeMultiDestinationApp()
+	app.Status = v1alpha1.ApplicationStatus{}
+	receivedPatch := map[string]interface{}{}
+	ctrl := newFakeMultiDestinationController(t, app, receivedPatch)
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), nil)
+	ctrl.appRefreshQueue.AddRateLimited(key)
+
+	ctrl.processAppRefreshQueueItem()
+
+	destinations, _, _ := unstructured.NestedSlice(receivedPatch, "status", "destinations")
+	require.Len(t, destinations, 2)
+	for i, namespace := range []string{"ns-1", "ns-2"} {
+		destination := destinations[i].(map[string]interface{})
+		assert.Equal(t, namespace, destination["destination"].(map[string]interface{})["namespace"])
+		assert.Equal(t, string(v1alpha1.SyncStatusCodeSynced), destination["status"])
+	}
+	comparedTo, _, _ := unstructured.NestedSlice(receivedPatch, "status", "sync", "comparedTo", "destinations")
+	assert.Len(t, comparedTo, 2)
+	status, _, _ := unstructured.NestedString(receivedPatch, "status", "sync", "status")
+	assert.Equal(t, string(v1alpha1.SyncStatusCodeSynced), status)
+}
+
+func TestProcessAppRefreshQueueItem_MultipleDestinationsOnSameCluster(t *testing.T) {
+	// the resources of an application are tracked by application name only, so two namespaces of the same cluster
+	// would compare and prune the resources of each other
+	app := newFakeMultiDestinationApp()
+	app.Spec.Destinations[1].Server = app.Spec.Destinations[0].Server
+	app.Status = v1alpha1.ApplicationStatus{}
+	receivedPatch := map[string]interface{}{}
+	ctrl := newFakeMultiDestinationController(t, app, receivedPatch)
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), nil)
+	ctrl.appRefreshQueue.AddRateLimited(key)
+
+	ctrl.processAppRefreshQueueItem()
+
+	destinations, _, _ := unstructured.NestedSlice(receivedPatch, "status", "destinations")
+	assert.Empty(t, destinations)
+	conditions, _, _ := unstructured.NestedSlice(receivedPatch, "status", "conditions")
+	require.Len(t, conditions, 1)
+	condition := conditions[0].(map[string]interface{})
+	assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, condition["type"])
+	assert.Equal(t, "application destinations with namespaces 'ns-1' and 'ns-2' are deployed to the same cluster 'https://localhost:6443'", condition["message"])
+}
+
+func TestDestinationsHealthGate(t *testing.T) {
+	finishedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	newApp := func(reconciledAt time.Time, healthStatus health.HealthStatusCode) (*v1alpha1.Application, *v1alpha1.OperationState) {
+		app := newFakeMultiDestinationApp()
+		reconciled := metav1.NewTime(reconciledAt)
+		app.Status.ReconciledAt = &reconciled
+		app.Status.Destinations = []v1alpha1.ApplicationDestinationStatus{
+			{Destination: app.Spec.Destinations[0], Health: v1alpha1.HealthStatus{Status: healthStatus, Message: "replicas unavailable"}},
+			{Destination: app.Spec.Destinations[1]},
+		}
+		state := &v1alpha1.OperationState{Destinations: []v1alpha1.DestinationOperationState{
+			{Destination: app.Spec.Destinations[0], Phase: synccommon.OperationSucceeded, FinishedAt: &finishedAt},
+			{Destination: app.Spec.Destinations[1]},
+		}}
+		return app, state
+	}
+
+	t.Run("Healthy", func(t *testing.T) {
+		app, state := newApp(time.Now(), health.HealthStatusHealthy)
+		passed, message, failed := destinationsHealthGate(app, state, []int{0})
+		assert.True(t, passed)
+		assert.Empty(t, message)
+		assert.False(t, failed)
+	})
+	t.Run("NotReconciledSinceSync", func(t *testing.T) {
+		app, state := newApp(finishedAt.Add(-time.Second), health.HealthStatusHealthy)
+		passed, message, failed := destinationsHealthGate(app, state, []int{0})
+		assert.False(t, passed)
+		assert.Equal(t, "Waiting for the health of destination https://localhost:6443/ns-1 to be assessed", message)
+		assert.False(t, failed)
+	})
+	t.Run("Progressing", func(t *testing.T) {
+		app, state := newApp(time.Now(), health.HealthStatusProgressing)
+		passed, message, failed := destinationsHealthGate(app, state, []int{0})
+		assert.False(t, passed)
+		assert.Equal(t, "Waiting for destination https://localhost:6443/ns-1 to become Healthy (Progressing)", message)
+		assert.False(t, failed)
+	})
+	t.Run("Degraded", func(t *testing.T) {
+		app, state := newApp(time.Now(), health.HealthStatusDegraded)
+		passed, message, failed := destinationsHealthGate(app, state, []int{0})
+		assert.False(t, passed)
+		assert.Equal(t, "Destination https://localhost:6443/ns-1 is Degraded: replicas unavailable", message)
+		assert.True(t, failed)
+	})
+}
+
+func TestFinalizeApplicationDestinationsDeletion(t *testing.T) {
+	newPreDeleteApp := func() *v1alpha1.Application {
+		app := newFakeMultiDestinationApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.SetPreDeleteFinalizer("cleanup")
+		return app
+	}
+	newPreDeleteController := func(app *v1alpha1.Application, liveObjs ...*unstructured.Unstructured) (*ApplicationController, *[]string) {
+		managedLiveObjs := map[kube.ResourceKey]*unstructured.Unstructured{}
+		for _, obj := range liveObjs {
+			managedLiveObjs[kube.GetResourceKey(obj)] = obj
+		}
+		hookResponse := &apiclient.ManifestResponse{Manifests: []*apiclient.Manifest{{CompiledManifest: fakePreDeleteHook}}}
+		ctrl := newFakeController(&fakeData{
+			apps:              []runtime.Object{app, &defaultProj},
+			manifestResponses: []*apiclient.ManifestResponse{hookResponse, hookResponse},
+			managedLiveObjs:   managedLiveObjs,
+			additionalObjs:    []runtime.Object{newFakeOtherCluster()},
+		}, nil)
+
+		var patches []string
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patches = append(patches, string(action.(kubetesting.PatchAction).GetPatch()))
+			return true, &v1alpha1.Application{}, nil
+		})
+		return ctrl, &patches
+	}
+	noProjectClusters := func(project string) ([]*v1alpha1.Cluster, error) {
+		return []*v1alpha1.Cluster{}, nil
+	}
+
+	t.Run("PreDelete_HooksAreCreatedOnEachDestination", func(t *testing.T) {
+		app := newPreDeleteApp()
+		ctrl, patches := newPreDeleteController(app)
+
+		require.NoError(t, ctrl.finalizeApplicationDeletion(app, noProjectClusters))
+		// the hooks are created on both destinations before any resource is deleted, and the finalizers are kept
+		assert.Len(t, ctrl.kubectl.(*MockKubectl).CreatedResources, 2)
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+		assert.Empty(t, *patches)
+	})
+
+	t.Run("PreDelete_HookFailed", func(t *testing.T) {
+		app := newPreDeleteApp()
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		require.NoError(t, unstructured.SetNestedSlice(liveHook.Object, []interface{}{
+			map[string]interface{}{"type": "Failed", "status": "True", "message": "hook Failed"},
+		}, "status", "conditions"))
+		ctrl, patches := newPreDeleteController(app, liveHook)
+
+		require.NoError(t, ctrl.finalizeApplicationDeletion(app, noProjectClusters))
+		// the failures are reported by destination and the deletion is blocked
+		require.Len(t, *patches, 1)
+		assert.Contains(t, (*patches)[0], v1alpha1.ApplicationConditionPreDeleteError)
+		assert.Contains(t, (*patches)[0], "https://localhost:6443/ns-1: PreDelete hooks failed: Job/pre-delete-hook: hook Failed")
+		assert.Contains(t, (*patches)[0], "https://other:6443/ns-2: PreDelete hooks failed: Job/pre-delete-hook: hook Failed")
+		assert.NotContains(t, (*patches)[0], "finalizers")
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("PreDelete_HooksSucceeded", func(t *testing.T) {
+		app := newPreDeleteApp()
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		require.NoError(t, unstructured.SetNestedSlice(liveHook.Object, []interface{}{
+			map[string]interface{}{"type": "Complete", "status": "True", "message": "hook Complete"},
+		}, "status", "conditions"))
+		ctrl, patches := newPreDeleteController(app, liveHook)
+
+		require.NoError(t, ctrl.finalizeApplicationDeletion(app, noProjectClusters))
+		// the PreDelete finalizer is only removed once the hooks completed on all the destinations
+		require.Len(t, *patches, 1)
+		assert.NotContains(t, (*patches)[0], `"`+v1alpha1.PreDeleteFinalizerName+`"`)
+		assert.Contains(t, (*patches)[0], v1alpha1.PreDeleteFinalizerName+"/cleanup")
+	})
+}
@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+)
+
+const (
+	// driftHistoryLimit is the maximum number of drift records kept per application
+	driftHistoryLimit = 20
+	// driftFieldsLimit is the maximum number of drifted field paths kept per drift record
+	driftFieldsLimit = 20
+)
+
+// argoCDManagers are the field managers of the changes applied by Argo CD itself. Argo CD uses its own field manager
+// for both client-side and server-side applies, so kubectl-client-side-apply is always a manual kubectl apply.
+var argoCDManagers = map[string]bool{
+	common.ArgoCDSSAManager:      true,
+	common.ApplicationController: true,
+}
+
+// driftFields returns the sorted paths of the fields which differ between the predicted and the normalized live state
+// of a resource, as JSON serialized in its diff result.
+func driftFields(predictedLive, normalizedLive []byte) []string {
+	var predicted, live map[string]interface{}
+	if err := json.Unmarshal(predictedLive, &predicted); err != nil {
+		return nil
+	}
+	if err := json.Unmarshal(normalizedLive, &live); err != nil {
+		return nil
+	}
+	// metadata maintained by the API server is not part of the target state
+	for _, obj := range []map[string]interface{}{predicted, live} {
+		if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+			for _, field := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp"} {
+				delete(metadata, field)
+			}
+		}
+		delete(obj, "status")
+	}
+	var fields []string
+	collectDriftFields("", predicted, live, &fields)
+	sort.Strings(fields)
+	if len(fields) > driftFieldsLimit {
+		fields = fields[:driftFieldsLimit]
+	}
+	return fields
+}
+
+func collectDriftFields(path string, predicted, live map[string]interface{}, fields *[]string) {
+	keys := map[string]bool{}
+	for key := range predicted {
+		keys[key] = true
+	}
+	for key := range live {
+		keys[key] = true
+	}
+	for key := range keys {
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+		predictedValue, predictedOK := predicted[key].(map[string]interface{})
+		liveValue, liveOK := live[key].(map[string]interface{})
+		if predictedOK && liveOK {
+			collectDriftFields(fieldPath, predictedValue, liveValue, fields)
+		} else if !reflect.DeepEqual(predicted[key], live[key]) {
+			*fields = append(*fields, fieldPath)
+		}
+	}
+}
+
+// lastFieldManager returns the field manager which last updated the given live object, ignoring the updates applied
+// by Argo CD.
+func lastFieldManager(live *unstructured.Unstructured) string {
+	var manager string
+	var lastUpdate *metav1.Time
+	for _, entry := range live.GetManagedFields() {
+		if argoCDManagers[entry.Manager] || entry.Time == nil {
+			continue
+		}
+		if lastUpdate == nil || lastUpdate.Before(entry.Time) {
+			manager = entry.Manager
+			lastUpdate = entry.Time
+		}
+	}
+	return manager
+}
+
+// recordDrift records the resources of an application which drifted from their target state since the previous
+// reconciliation, and resolves the drift records of the resources which are in sync again. A resource drifts if it
+// becomes OutOfSync while the application is still synced to the same revision and no operation is in progress.
+// The drift history is only loaded and updated from the cache when one of these transitions happens.
+func (ctrl *ApplicationController) recordDrift(app *appv1.Application, compareResult *comparisonResult, now metav1.Time) {
+	previous := make(map[string]appv1.ResourceStatus, len(app.Status.Resources))
+	for _, res := range app.Status.Resources {
+		previous[resourceStatusKey(res)] = res
+	}
+	current := make(map[string]appv1.ResourceStatus, len(compareResult.resources))
+	for _, res := range compareResult.resources {
+		current[resourceStatusKey(res)] = res
+	}
+
+	sameRevision := app.Status.Sync.Revision == compareResult.syncStatus.Revision &&
+		reflect.DeepEqual(app.Status.Sync.Revisions, compareResult.syncStatus.Revisions)
+	canDrift := sameRevision && app.Operation == nil &&
+		(app.Status.OperationState == nil || app.Status.OperationState.Phase.Completed())
+
+	var drifted []appv1.DriftRecord
+	if canDrift {
+		for _, res := range compareResult.managedResources {
+			key := resourceStatusKey(appv1.ResourceStatus{Group: res.Group, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name})
+			status, ok := current[key]
+			if !ok || res.Hook || status.Status != appv1.SyncStatusCodeOutOfSync {
+				continue
+			}
+			if prev, ok := previous[key]; !ok || prev.Status != appv1.SyncStatusCodeSynced {
+				continue
+			}
+			record := appv1.DriftRecord{
+				Group:      res.Group,
+				Kind:       res.Kind,
+				Namespace:  res.Namespace,
+				Name:       res.Name,
+				Revision:   compareResult.syncStatus.Revision,
+				DetectedAt: now,
+			}
+			if res.Live == nil || (status.Health != nil && status.Health.Status == health.HealthStatusMissing) {
+				record.Deleted = true
+			} else {
+				record.Fields = driftFields(res.Diff.PredictedLive, res.Diff.NormalizedLive)
+				record.Manager = lastFieldManager(res.Live)
+			}
+			drifted = append(drifted, record)
+		}
+	}
+
+	resolved := map[string]bool{}
+	for key, prev := range previous {
+		if prev.Status != appv1.SyncStatusCodeOutOfSync {
+			continue
+		}
+		if res, ok := current[key]; !ok || res.Status == appv1.SyncStatusCodeSynced {
+			resolved[key] = true
+		}
+	}
+
+	if len(drifted) == 0 && len(resolved) == 0 {
+		return
+	}
+
+	logCtx := getAppLog(app)
+	appName := app.InstanceName(ctrl.namespace)
+	var history []appv1.DriftRecord
+	if err := ctrl.cache.GetAppDriftHistory(appName, &history); err != nil && !errors.Is(err, appstatecache.ErrCacheMiss) {
+		logCtx.Warnf("Failed to get drift history: %v", err)
+		return
+	}
+
+	changed := false
+	open := map[string]bool{}
+	for i := range history {
+		record := &history[i]
+		if record.IsResolved() {
+			continue
+		}
+		key := resourceStatusKey(appv1.ResourceStatus{Group: record.Group, Kind: record.Kind, Namespace: record.Namespace, Name: record.Name})
+		if resolved[key] {
+			record.ResolvedAt = now.DeepCopy()
+			changed = true
+		} else {
+			open[key] = true
+		}
+	}
+	for _, record := range drifted {
+		key := resourceStatusKey(appv1.ResourceStatus{Group: record.Group, Kind: record.Kind, Namespace: record.Namespace, Name: record.Name})
+		if open[key] {
+			continue
+		}
+		logCtx.Infof("Resource %s drifted from its target state (fields: %s, manager: %s)", key, strings.Join(record.Fields, ", "), record.Manager)
+		history = append(history, record)
+		ctrl.metricsServer.IncDrift(app, record.Group, record.Kind)
+		changed = true
+	}
+	if !changed {
+		return
+	}
+
+	if len(history) > driftHistoryLimit {
+		history = history[len(history)-driftHistoryLimit:]
+	}
+	if err := ctrl.cache.SetAppDriftHistory(appName, history); err != nil {
+		logCtx.Warnf("Failed to set drift history: %v", err)
+	}
+}
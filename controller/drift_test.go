@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	syncedDeployment  = `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default","resourceVersion":"1"},"spec":{"replicas":1,"template":{"spec":{"containers":[{"image":"guestbook:v1","name":"guestbook"}]}}}}`
+	driftedDeployment = `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default","resourceVersion":"2"},"spec":{"replicas":3,"template":{"spec":{"containers":[{"image":"guestbook:v2","name":"guestbook"}]}}}}`
+)
+
+func newDriftComparisonResult(status v1alpha1.SyncStatusCode) *comparisonResult {
+	live := &unstructured.Unstructured{}
+	_ = live.UnmarshalJSON([]byte(driftedDeployment))
+	updatedAt := metav1.NewTime(time.Now())
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "argocd-controller", Operation: metav1.ManagedFieldsOperationApply, Time: &updatedAt},
+		{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: &updatedAt},
+	})
+	return &comparisonResult{
+		syncStatus: &v1alpha1.SyncStatus{Status: status, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+		resources:  []v1alpha1.ResourceStatus{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: status}},
+		managedResources: []managedResource{{
+			Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook",
+			Live: live,
+			Diff: diff.DiffResult{Modified: true, NormalizedLive: []byte(driftedDeployment), PredictedLive: []byte(syncedDeployment)},
+		}},
+	}
+}
+
+func TestRecordDrift(t *testing.T) {
+	app := newFakeApp()
+	app.Status.Sync.Revision = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	app.Status.Resources = []v1alpha1.ResourceStatus{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: v1alpha1.SyncStatusCodeSynced}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+	// the resource drifts
+	detectedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	result := newDriftComparisonResult(v1alpha1.SyncStatusCodeOutOfSync)
+	ctrl.recordDrift(app, result, detectedAt)
+
+	var history []v1alpha1.DriftRecord
+	require.NoError(t, ctrl.cache.GetAppDriftHistory(app.InstanceName(ctrl.namespace), &history))
+	require.Len(t, history, 1)
+	assert.Equal(t, "guestbook", history[0].Name)
+	assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", history[0].Revision)
+	assert.Equal(t, []string{"spec.replicas", "spec.template.spec.containers"}, history[0].Fields)
+	assert.Equal(t, "kubectl-edit", history[0].Manager)
+	assert.False(t, history[0].IsResolved())
+
+	// the resource is still drifted
+	app.Status.Resources = result.resources
+	ctrl.recordDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeOutOfSync), metav1.Now())
+	require.NoError(t, ctrl.cache.GetAppDriftHistory(app.InstanceName(ctrl.namespace), &history))
+	require.Len(t, history, 1)
+
+	// the resource is synced again
+	resolvedAt := metav1.Now()
+	result = newDriftComparisonResult(v1alpha1.SyncStatusCodeSynced)
+	ctrl.recordDrift(app, result, resolvedAt)
+	require.NoError(t, ctrl.cache.GetAppDriftHistory(app.InstanceName(ctrl.namespace), &history))
+	require.Len(t, history, 1)
+	require.True(t, history[0].IsResolved())
+	assert.Equal(t, resolvedAt.Unix(), history[0].ResolvedAt.Unix())
+}
+
+func TestLastFieldManager(t *testing.T) {
+	live := &unstructured.Unstructured{}
+	synced := metav1.NewTime(time.Now().Add(-time.Minute))
+	applied := metav1.NewTime(time.Now())
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "kubectl-client-side-apply", Operation: metav1.ManagedFieldsOperationUpdate, Time: &applied},
+		{Manager: "argocd-controller", Operation: metav1.ManagedFieldsOperationUpdate, Time: &synced},
+	})
+	assert.Equal(t, "kubectl-client-side-apply", lastFieldManager(live))
+
+	// the updates applied by Argo CD are not attributed
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: &synced},
+		{Manager: "argocd-controller", Operation: metav1.ManagedFieldsOperationApply, Time: &applied},
+	})
+	assert.Equal(t, "kubectl-edit", lastFieldManager(live))
+}
+
+func TestRecordDriftIgnoresRevisionChange(t *testing.T) {
+	app := newFakeApp()
+	app.Status.Sync.Revision = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	app.Status.Resources = []v1alpha1.ResourceStatus{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: v1alpha1.SyncStatusCodeSynced}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+	ctrl.recordDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeOutOfSync), metav1.Now())
+
+	var history []v1alpha1.DriftRecord
+	assert.Error(t, ctrl.cache.GetAppDriftHistory(app.InstanceName(ctrl.namespace), &history))
+}
+
+func TestRecordDriftHistoryLimit(t *testing.T) {
+	app := newFakeApp()
+	app.Status.Sync.Revision = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+	for i := 0; i < driftHistoryLimit+5; i++ {
+		app.Status.Resources = []v1alpha1.ResourceStatus{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: v1alpha1.SyncStatusCodeSynced}}
+		ctrl.recordDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeOutOfSync), metav1.Now())
+		app.Status.Resources[0].Status = v1alpha1.SyncStatusCodeOutOfSync
+		ctrl.recordDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeSynced), metav1.Now())
+	}
+
+	var history []v1alpha1.DriftRecord
+	require.NoError(t, ctrl.cache.GetAppDriftHistory(app.InstanceName(ctrl.namespace), &history))
+	assert.Len(t, history, driftHistoryLimit)
+	for _, record := range history {
+		assert.True(t, record.IsResolved())
+	}
+}
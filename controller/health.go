@@ -2,12 +2,15 @@ package controller
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	hookutil "github.com/argoproj/gitops-engine/pkg/sync/hook"
 	"github.com/argoproj/gitops-engine/pkg/sync/ignore"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
@@ -15,11 +18,54 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/lua"
 )
 
+// progressDeadlineExceeded prefixes the message of the resources reported Degraded as they remained Progressing for
+// longer than their progressing timeout
+const progressDeadlineExceeded = "progress deadline exceeded"
+
+// trackHealthTransition returns the health status of a resource along with the time of its last transition, given its
+// previously reported health. A resource Progressing for longer than the given timeout is reported Degraded, until its
+// health changes.
+func trackHealthTransition(previous *appv1.HealthStatus, current appv1.HealthStatus, timeout time.Duration, now metav1.Time) appv1.HealthStatus {
+	current.LastTransitionTime = &now
+	if previous == nil || previous.LastTransitionTime == nil {
+		return current
+	}
+	if current.Status == health.HealthStatusProgressing && timeout > 0 {
+		deadlineExceeded := previous.Status == health.HealthStatusDegraded && strings.HasPrefix(previous.Message, progressDeadlineExceeded)
+		if deadlineExceeded || (previous.Status == health.HealthStatusProgressing && now.Sub(previous.LastTransitionTime.Time) >= timeout) {
+			message := fmt.Sprintf("%s: resource has been progressing for more than %s", progressDeadlineExceeded, timeout)
+			if current.Message != "" {
+				message = fmt.Sprintf("%s: %s", message, current.Message)
+			}
+			current = appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: message, LastTransitionTime: &now}
+		}
+	}
+	if previous.Status == current.Status {
+		current.LastTransitionTime = previous.LastTransitionTime
+	}
+	return current
+}
+
 // setApplicationHealth updates the health statuses of all resources performed in the comparison
 func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool) (*appv1.HealthStatus, error) {
 	var savedErr error
 	var errCount uint
 	appHealth := appv1.HealthStatus{Status: health.HealthStatusHealthy}
+
+	// the transitions of the health of the resources are tracked from their previously persisted health
+	previousHealth := map[kubeutil.ResourceKey]*appv1.HealthStatus{}
+	for _, res := range app.Status.Resources {
+		if res.Health != nil {
+			previousHealth[kubeutil.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Health
+		}
+	}
+	appProgressingTimeout, err := app.Spec.GetProgressingTimeout()
+	if err != nil {
+		errCount++
+		savedErr = fmt.Errorf("invalid progressing timeout: %w", err)
+	}
+	now := metav1.Now()
+
 	for i, res := range resources {
 		if res.Target != nil && hookutil.Skip(res.Target) {
 			continue
@@ -54,8 +100,19 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 		}
 
 		if persistResourceHealth {
-			resHealth := appv1.HealthStatus{Status: healthStatus.Status, Message: healthStatus.Message}
+			progressingTimeout := appProgressingTimeout
+			if progressingTimeout == 0 {
+				progressingTimeout, err = healthOverrides.GetProgressingTimeout(gvk)
+				if err != nil && savedErr == nil {
+					errCount++
+					savedErr = err
+					log.WithField("application", app.QualifiedName()).Warn(savedErr)
+				}
+			}
+			key := kubeutil.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+			resHealth := trackHealthTransition(previousHealth[key], appv1.HealthStatus{Status: healthStatus.Status, Message: healthStatus.Message}, progressingTimeout, now)
 			statuses[i].Health = &resHealth
+			healthStatus = &health.HealthStatus{Status: resHealth.Status, Message: resHealth.Message}
 		} else {
 			statuses[i].Health = nil
 		}
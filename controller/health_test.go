@@ -3,6 +3,7 @@ package controller
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
@@ -183,3 +184,95 @@ return hs`,
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 	})
 }
+
+func TestTrackHealthTransition(t *testing.T) {
+	now := metav1.Now()
+	since := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(-d)}
+	}
+	progressing := appv1.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for rollout to finish"}
+
+	t.Run("FirstObservation", func(t *testing.T) {
+		res := trackHealthTransition(nil, progressing, time.Minute, now)
+		assert.Equal(t, health.HealthStatusProgressing, res.Status)
+		assert.Equal(t, &now, res.LastTransitionTime)
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		res := trackHealthTransition(&appv1.HealthStatus{Status: health.HealthStatusProgressing, LastTransitionTime: since(time.Minute)}, progressing, 0, now)
+		assert.Equal(t, health.HealthStatusProgressing, res.Status)
+		assert.Equal(t, since(time.Minute), res.LastTransitionTime)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		res := trackHealthTransition(&appv1.HealthStatus{Status: health.HealthStatusProgressing, LastTransitionTime: since(time.Minute)}, appv1.HealthStatus{Status: health.HealthStatusHealthy}, time.Minute, now)
+		assert.Equal(t, health.HealthStatusHealthy, res.Status)
+		assert.Equal(t, &now, res.LastTransitionTime)
+	})
+
+	t.Run("WithinDeadline", func(t *testing.T) {
+		res := trackHealthTransition(&appv1.HealthStatus{Status: health.HealthStatusProgressing, LastTransitionTime: since(time.Minute)}, progressing, 10*time.Minute, now)
+		assert.Equal(t, health.HealthStatusProgressing, res.Status)
+	})
+
+	t.Run("DeadlineExceeded", func(t *testing.T) {
+		res := trackHealthTransition(&appv1.HealthStatus{Status: health.HealthStatusProgressing, LastTransitionTime: since(11 * time.Minute)}, progressing, 10*time.Minute, now)
+		assert.Equal(t, health.HealthStatusDegraded, res.Status)
+		assert.Equal(t, "progress deadline exceeded: resource has been progressing for more than 10m0s: Waiting for rollout to finish", res.Message)
+		assert.Equal(t, &now, res.LastTransitionTime)
+
+		// the resource remains Degraded while it is Progressing
+		later := metav1.NewTime(now.Add(time.Minute))
+		res = trackHealthTransition(&res, progressing, 10*time.Minute, later)
+		assert.Equal(t, health.HealthStatusDegraded, res.Status)
+		assert.Equal(t, &now, res.LastTransitionTime)
+
+		res = trackHealthTransition(&res, appv1.HealthStatus{Status: health.HealthStatusHealthy}, 10*time.Minute, later)
+		assert.Equal(t, health.HealthStatusHealthy, res.Status)
+		assert.Equal(t, &later, res.LastTransitionTime)
+	})
+}
+
+func TestSetApplicationHealth_ProgressingTimeout(t *testing.T) {
+	overrides := lua.ResourceHealthOverrides{
+		lua.GetConfigMapKey(appv1.ApplicationSchemaGroupVersionKind): appv1.ResourceOverride{
+			HealthLua:          `return {status = "Progressing"}`,
+			ProgressingTimeout: "10m",
+		},
+	}
+	resources := []managedResource{{
+		Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind, Name: "foo", Live: newAppLiveObj(health.HealthStatusProgressing),
+	}}
+	previous := func(d time.Duration) *appv1.Application {
+		return &appv1.Application{Status: appv1.ApplicationStatus{Resources: []appv1.ResourceStatus{{
+			Group: application.Group, Kind: application.ApplicationKind, Name: "foo",
+			Health: &appv1.HealthStatus{Status: health.HealthStatusProgressing, LastTransitionTime: &metav1.Time{Time: time.Now().Add(-d)}},
+		}}}}
+	}
+
+	t.Run("OverrideTimeout", func(t *testing.T) {
+		resourceStatuses := initStatuses(resources)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, previous(15*time.Minute), true)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Contains(t, resourceStatuses[0].Health.Message, "progress deadline exceeded")
+	})
+
+	t.Run("AppTimeout", func(t *testing.T) {
+		app := previous(15 * time.Minute)
+		app.Spec.ProgressingTimeout = "30m"
+		resourceStatuses := initStatuses(resources)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+		assert.NotNil(t, resourceStatuses[0].Health.LastTransitionTime)
+	})
+
+	t.Run("InvalidAppTimeout", func(t *testing.T) {
+		app := previous(15 * time.Minute)
+		app.Spec.ProgressingTimeout = "soon"
+		resourceStatuses := initStatuses(resources)
+		_, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		require.Error(t, err)
+	})
+}
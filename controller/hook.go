@@ -2,16 +2,23 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/sync/hook"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/rest"
 
+	cdcommon "github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/util/lua"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -23,17 +30,34 @@ var (
 		"argocd.argoproj.io/hook": postDeleteHook,
 		"helm.sh/hook":            "post-delete",
 	}
+	preDeleteHook  = "PreDelete"
+	preDeleteHooks = map[string]string{
+		"argocd.argoproj.io/hook": preDeleteHook,
+		"helm.sh/hook":            "pre-delete",
+	}
+	deleteHooks = map[string]map[string]string{
+		postDeleteHook: postDeleteHooks,
+		preDeleteHook:  preDeleteHooks,
+	}
 )
 
 func isHook(obj *unstructured.Unstructured) bool {
-	return hook.IsHook(obj) || isPostDeleteHook(obj)
+	return hook.IsHook(obj) || isPostDeleteHook(obj) || isPreDeleteHook(obj)
 }
 
 func isPostDeleteHook(obj *unstructured.Unstructured) bool {
+	return isDeleteHook(obj, postDeleteHook)
+}
+
+func isPreDeleteHook(obj *unstructured.Unstructured) bool {
+	return isDeleteHook(obj, preDeleteHook)
+}
+
+func isDeleteHook(obj *unstructured.Unstructured, hookType string) bool {
 	if obj == nil || obj.GetAnnotations() == nil {
 		return false
 	}
-	for k, v := range postDeleteHooks {
+	for k, v := range deleteHooks[hookType] {
 		if val, ok := obj.GetAnnotations()[k]; ok && val == v {
 			return true
 		}
@@ -41,23 +65,42 @@ func isPostDeleteHook(obj *unstructured.Unstructured) bool {
 	return false
 }
 
+// skipPreDeleteHooks returns true if the application is annotated to be deleted without running its PreDelete hooks
+func skipPreDeleteHooks(app *v1alpha1.Application) bool {
+	skip, err := strconv.ParseBool(app.GetAnnotations()[cdcommon.AnnotationKeySkipPreDeleteHooks])
+	return err == nil && skip
+}
+
 func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+	done, _, err := ctrl.executeDeleteHooks(postDeleteHook, app, proj, liveObjs, config, logCtx)
+	return done, err
+}
+
+// executePreDeleteHooks creates the PreDelete hooks of an application and waits for them to complete. Unlike
+// PostDelete hooks, a failed PreDelete hook is reported with a non-empty message so that the deletion can be blocked.
+func (ctrl *ApplicationController) executePreDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, string, error) {
+	return ctrl.executeDeleteHooks(preDeleteHook, app, proj, liveObjs, config, logCtx)
+}
+
+// executeDeleteHooks creates the missing delete hooks of the given type and returns true once none of them is
+// progressing anymore, along with a message describing the failed hooks if any.
+func (ctrl *ApplicationController) executeDeleteHooks(hookType string, app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, string, error) {
 	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 	var revisions []string
 	for _, src := range app.Spec.GetSources() {
 		revisions = append(revisions, src.TargetRevision)
 	}
 
-	targets, _, _, err := ctrl.appStateManager.GetRepoObjs(app, app.Spec.GetSources(), appLabelKey, revisions, false, false, false, proj, false)
+	targets, _, _, err := ctrl.appStateManager.GetRepoObjs(context.Background(), app, app.Spec.GetSources(), appLabelKey, revisions, false, false, false, proj, false)
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 	runningHooks := map[kube.ResourceKey]*unstructured.Unstructured{}
 	for key, obj := range liveObjs {
-		if isPostDeleteHook(obj) {
+		if isDeleteHook(obj, hookType) {
 			runningHooks[key] = obj
 		}
 	}
@@ -67,7 +110,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 		if obj.GetNamespace() == "" {
 			obj.SetNamespace(app.Spec.Destination.Namespace)
 		}
-		if !isPostDeleteHook(obj) {
+		if !isDeleteHook(obj, hookType) {
 			continue
 		}
 		if runningHook := runningHooks[kube.GetResourceKey(obj)]; runningHook == nil {
@@ -78,25 +121,26 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 	for _, obj := range expectedHook {
 		_, err = ctrl.kubectl.CreateResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), obj, v1.CreateOptions{})
 		if err != nil {
-			return false, err
+			return false, "", err
 		}
 		createdCnt++
 	}
 	if createdCnt > 0 {
-		logCtx.Infof("Created %d post-delete hooks", createdCnt)
-		return false, nil
+		logCtx.Infof("Created %d %s hooks", createdCnt, deleteHooks[hookType]["helm.sh/hook"])
+		return false, "", nil
 	}
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 	healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
 
 	progressingHooksCnt := 0
+	var failedHooks []string
 	for _, obj := range runningHooks {
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
 		if err != nil {
-			return false, err
+			return false, "", err
 		}
 		if hookHealth == nil {
 			logCtx.WithFields(log.Fields{
@@ -110,19 +154,40 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 				Status: health.HealthStatusHealthy,
 			}
 		}
-		if hookHealth.Status == health.HealthStatusProgressing {
+		switch hookHealth.Status {
+		case health.HealthStatusProgressing:
 			progressingHooksCnt++
+		case health.HealthStatusDegraded:
+			failedHook := fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+			if hookHealth.Message != "" {
+				failedHook = fmt.Sprintf("%s: %s", failedHook, hookHealth.Message)
+			}
+			failedHooks = append(failedHooks, failedHook)
 		}
 	}
 	if progressingHooksCnt > 0 {
-		logCtx.Infof("Waiting for %d post-delete hooks to complete", progressingHooksCnt)
-		return false, nil
+		logCtx.Infof("Waiting for %d %s hooks to complete", progressingHooksCnt, deleteHooks[hookType]["helm.sh/hook"])
+		return false, "", nil
+	}
+	if len(failedHooks) > 0 {
+		sort.Strings(failedHooks)
+		return true, fmt.Sprintf("%s hooks failed: %s", hookType, strings.Join(failedHooks, "; ")), nil
 	}
 
-	return true, nil
+	return true, "", nil
 }
 
 func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+	return ctrl.cleanupDeleteHooks(postDeleteHook, liveObjs, config, logCtx)
+}
+
+func (ctrl *ApplicationController) cleanupPreDeleteHooks(liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+	return ctrl.cleanupDeleteHooks(preDeleteHook, liveObjs, config, logCtx)
+}
+
+// cleanupDeleteHooks deletes the delete hooks of the given type according to their delete policies and returns true
+// once all of them are gone.
+func (ctrl *ApplicationController) cleanupDeleteHooks(hookType string, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		return false, err
@@ -133,7 +198,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 	aggregatedHealth := health.HealthStatusHealthy
 	var hooks []*unstructured.Unstructured
 	for _, obj := range liveObjs {
-		if !isPostDeleteHook(obj) {
+		if !isDeleteHook(obj, hookType) {
 			continue
 		}
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
@@ -158,7 +223,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 				if obj.GetDeletionTimestamp() != nil {
 					continue
 				}
-				logCtx.Infof("Deleting post-delete hook %s/%s", obj.GetNamespace(), obj.GetName())
+				logCtx.Infof("Deleting %s hook %s/%s", deleteHooks[hookType]["helm.sh/hook"], obj.GetNamespace(), obj.GetName())
 				err = ctrl.kubectl.DeleteResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), v1.DeleteOptions{})
 				if err != nil {
 					return false, err
@@ -167,8 +232,82 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 		}
 	}
 	if pendingDeletionCount > 0 {
-		logCtx.Infof("Waiting for %d post-delete hooks to be deleted", pendingDeletionCount)
+		logCtx.Infof("Waiting for %d %s hooks to be deleted", pendingDeletionCount, deleteHooks[hookType]["helm.sh/hook"])
 		return false, nil
 	}
 	return true, nil
 }
+
+// getHookTimeout returns the timeout of a hook set by the hook-timeout annotation, or zero if the hook never times out
+func getHookTimeout(obj *unstructured.Unstructured) (time.Duration, error) {
+	return parseTimeout(obj.GetAnnotations()[cdcommon.AnnotationKeyHookTimeout])
+}
+
+// parseTimeout parses a timeout set by an annotation in seconds or as a duration, or returns zero if it is not set
+func parseTimeout(timeout string) (time.Duration, error) {
+	if timeout == "" {
+		return 0, nil
+	}
+	return v1alpha1.ParseDuration(timeout)
+}
+
+// getHungHookMessage returns a message describing the first running hook of a sync which exceeded the timeout set by
+// its hook-timeout annotation, or an empty string if no running hook timed out. The hooks are looked up in the given
+// live objects of the application, so that no additional request is sent to the cluster on each sync iteration.
+func getHungHookMessage(syncRes *v1alpha1.SyncOperationResult, liveObjs []*unstructured.Unstructured, now time.Time, logCtx *log.Entry) string {
+	liveObjByKey := map[kube.ResourceKey]*unstructured.Unstructured{}
+	for _, obj := range liveObjs {
+		if obj != nil {
+			liveObjByKey[kube.GetResourceKey(obj)] = obj
+		}
+	}
+	for _, res := range syncRes.Resources {
+		if res.HookType == "" || res.HookPhase != common.OperationRunning {
+			continue
+		}
+		obj, ok := liveObjByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)]
+		if !ok {
+			continue
+		}
+		timeout, err := getHookTimeout(obj)
+		if err != nil {
+			logCtx.Warnf("Ignoring invalid timeout of %s hook %s/%s: %v", res.HookType, res.Kind, res.Name, err)
+			continue
+		}
+		if timeout > 0 && now.Sub(obj.GetCreationTimestamp().Time) > timeout {
+			return fmt.Sprintf("%s hook %s/%s timed out after %s", res.HookType, res.Kind, res.Name, timeout)
+		}
+	}
+	return ""
+}
+
+// deleteFailedHooks deletes the completed hooks of a terminated sync which have the HookFailed delete policy. Running
+// hooks are deleted when the sync is terminated, but unlike a failed sync a terminated sync does not clean up the
+// completed hooks according to their delete policy.
+func (m *appStateManager) deleteFailedHooks(config *rest.Config, syncRes *v1alpha1.SyncOperationResult, deleteOptions v1.DeleteOptions, logCtx *log.Entry) {
+	for _, res := range syncRes.Resources {
+		if res.HookType == "" || !res.HookPhase.Completed() {
+			continue
+		}
+		obj, err := m.kubectl.GetResource(context.Background(), config, res.GroupVersionKind(), res.Name, res.Namespace)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				logCtx.Warnf("Failed to get %s hook %s/%s: %v", res.HookType, res.Kind, res.Name, err)
+			}
+			continue
+		}
+		if obj.GetDeletionTimestamp() != nil {
+			continue
+		}
+		for _, policy := range hook.DeletePolicies(obj) {
+			if policy != common.HookDeletePolicyHookFailed {
+				continue
+			}
+			logCtx.Infof("Deleting %s hook %s/%s", res.HookType, res.Kind, res.Name)
+			err = m.kubectl.DeleteResource(context.Background(), config, res.GroupVersionKind(), res.Name, res.Namespace, deleteOptions)
+			if err != nil && !apierrors.IsNotFound(err) {
+				logCtx.Warnf("Failed to delete %s hook %s/%s: %v", res.HookType, res.Kind, res.Name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+)
+
+const (
+	// hookLogsTailLines is the number of lines captured from the end of the logs of each container of a failed hook
+	hookLogsTailLines = 100
+	// hookLogsLimitBytes caps the size of the logs captured from each container of a failed hook
+	hookLogsLimitBytes = 16 * 1024
+)
+
+// logRedactions replace the credentials commonly printed by tools in their logs
+var logRedactions = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`(?i)((?:password|passwd|secret|token|api[_-]?key|access[_-]?key|private[_-]?key)["']?\s*[:=]\s*["']?)[^\s"',]+`), "${1}******"},
+	{regexp.MustCompile(`(?i)(authorization:\s*(?:bearer|basic)\s+)\S+`), "${1}******"},
+	{regexp.MustCompile(`(://[^/\s:@]+:)[^/\s@]+@`), "${1}******@"},
+}
+
+// redactLogs masks the credentials found in the given logs
+func redactLogs(logs string) string {
+	for _, redaction := range logRedactions {
+		logs = redaction.pattern.ReplaceAllString(logs, redaction.replacement)
+	}
+	return logs
+}
+
+// isPodHook returns whether the logs of the pods of the given hook can be captured
+func isPodHook(res *v1alpha1.ResourceResult) bool {
+	return (res.Group == "" && res.Kind == kube.PodKind) || (res.Group == "batch" && res.Kind == kube.JobKind)
+}
+
+// captureFailedHookLogs stores the tail of the container logs of the pods of the running hooks of a sync which
+// failed, before the engine deletes them according to their delete policy
+func (m *appStateManager) captureFailedHookLogs(app *v1alpha1.Application, state *v1alpha1.OperationState, config *rest.Config, logCtx *log.Entry) {
+	var kubeClient kubernetes.Interface
+	var captured []v1alpha1.HookLogs
+	for _, res := range state.SyncResult.Resources {
+		if res.HookType == "" || res.HookPhase != common.OperationRunning || !isPodHook(res) {
+			continue
+		}
+		obj, err := m.kubectl.GetResource(context.Background(), config, res.GroupVersionKind(), res.Name, res.Namespace)
+		if err != nil {
+			continue
+		}
+		healthStatus, err := health.GetResourceHealth(obj, nil)
+		if err != nil || healthStatus == nil || healthStatus.Status != health.HealthStatusDegraded {
+			continue
+		}
+		if kubeClient == nil {
+			kubeClient, err = kubernetes.NewForConfig(config)
+			if err != nil {
+				logCtx.Warnf("Failed to capture the logs of failed hooks: %v", err)
+				return
+			}
+		}
+		hookLogs, err := getHookLogs(context.Background(), kubeClient, obj)
+		if err != nil {
+			logCtx.Warnf("Failed to capture the logs of %s hook %s/%s: %v", res.HookType, res.Kind, res.Name, err)
+			continue
+		}
+		for i := range hookLogs {
+			hookLogs[i].HookType = res.HookType
+			hookLogs[i].SyncPhase = res.SyncPhase
+			hookLogs[i].OperationStartedAt = state.StartedAt
+		}
+		captured = append(captured, hookLogs...)
+	}
+	if len(captured) == 0 {
+		return
+	}
+
+	appName := app.InstanceName(m.namespace)
+	var cached []v1alpha1.HookLogs
+	if err := m.cache.GetAppHookLogs(appName, &cached); err != nil && !errors.Is(err, appstatecache.ErrCacheMiss) {
+		logCtx.Warnf("Failed to get hook logs: %v", err)
+		return
+	}
+	capturedPods := map[string]bool{}
+	for _, hookLogs := range captured {
+		capturedPods[hookLogs.Namespace+"/"+hookLogs.PodName] = true
+	}
+	// only the logs of the last operation are kept
+	var logs []v1alpha1.HookLogs
+	for _, hookLogs := range cached {
+		if hookLogs.OperationStartedAt.Equal(&state.StartedAt) && !capturedPods[hookLogs.Namespace+"/"+hookLogs.PodName] {
+			logs = append(logs, hookLogs)
+		}
+	}
+	logs = append(logs, captured...)
+	if err := m.cache.SetAppHookLogs(appName, logs); err != nil {
+		logCtx.Warnf("Failed to set hook logs: %v", err)
+	}
+}
+
+// getHookLogs returns the tail of the container logs of the pods of a hook, which is a Pod or a Job
+func getHookLogs(ctx context.Context, kubeClient kubernetes.Interface, obj *unstructured.Unstructured) ([]v1alpha1.HookLogs, error) {
+	var pods []corev1.Pod
+	switch obj.GetKind() {
+	case kube.PodKind:
+		var pod corev1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pod); err != nil {
+			return nil, fmt.Errorf("error converting pod: %w", err)
+		}
+		pods = append(pods, pod)
+	case kube.JobKind:
+		var job batchv1.Job
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &job); err != nil {
+			return nil, fmt.Errorf("error converting job: %w", err)
+		}
+		selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing job selector: %w", err)
+		}
+		list, err := kubeClient.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return nil, fmt.Errorf("error listing job pods: %w", err)
+		}
+		pods = list.Items
+	}
+
+	gvk := obj.GroupVersionKind()
+	var logs []v1alpha1.HookLogs
+	for _, pod := range pods {
+		hookLogs := v1alpha1.HookLogs{
+			Group:      gvk.Group,
+			Kind:       gvk.Kind,
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+			PodName:    pod.Name,
+			CapturedAt: metav1.Now(),
+		}
+		for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			data, err := kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container:  container.Name,
+				TailLines:  ptr.To(int64(hookLogsTailLines)),
+				LimitBytes: ptr.To(int64(hookLogsLimitBytes)),
+			}).DoRaw(ctx)
+			if err != nil {
+				// the container may not have started
+				continue
+			}
+			hookLogs.Containers = append(hookLogs.Containers, v1alpha1.ContainerLogs{
+				Container: container.Name,
+				Logs:      redactLogs(string(data)),
+				Truncated: len(data) >= hookLogsLimitBytes,
+			})
+		}
+		logs = append(logs, hookLogs)
+	}
+	return logs, nil
+}
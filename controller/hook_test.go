@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newFakeHook(name string, annotations map[string]string, createdAt time.Time) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("batch/v1")
+	obj.SetKind("Job")
+	obj.SetName(name)
+	obj.SetNamespace("default")
+	obj.SetAnnotations(annotations)
+	obj.SetCreationTimestamp(metav1.NewTime(createdAt))
+	return obj
+}
+
+func newHookController(hooks ...*unstructured.Unstructured) (*ApplicationController, *MockKubectl) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{newFakeApp()}}, nil)
+	kubectl := ctrl.kubectl.(*MockKubectl)
+	kubectl.Kubectl.(*kubetest.MockKubectlCmd).WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+		for _, hook := range hooks {
+			if hook.GetName() == name && hook.GetNamespace() == namespace {
+				return hook, nil
+			}
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: "jobs"}, name)
+	})
+	return ctrl, kubectl
+}
+
+func TestGetHookTimeout(t *testing.T) {
+	timeout, err := getHookTimeout(newFakeHook("hook", nil, time.Now()))
+	require.NoError(t, err)
+	assert.Zero(t, timeout)
+
+	timeout, err = getHookTimeout(newFakeHook("hook", map[string]string{"argocd.argoproj.io/hook-timeout": "90"}, time.Now()))
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	timeout, err = getHookTimeout(newFakeHook("hook", map[string]string{"argocd.argoproj.io/hook-timeout": "5m"}, time.Now()))
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, timeout)
+
+	_, err = getHookTimeout(newFakeHook("hook", map[string]string{"argocd.argoproj.io/hook-timeout": "later"}, time.Now()))
+	assert.Error(t, err)
+}
+
+func TestGetHungHookMessage(t *testing.T) {
+	now := time.Now()
+	liveObjs := []*unstructured.Unstructured{
+		newFakeHook("hung", map[string]string{"argocd.argoproj.io/hook-timeout": "5m"}, now.Add(-10*time.Minute)),
+		newFakeHook("running", map[string]string{"argocd.argoproj.io/hook-timeout": "300"}, now.Add(-time.Minute)),
+		newFakeHook("unlimited", nil, now.Add(-time.Hour)),
+		nil,
+	}
+	logCtx := log.WithField("application", "test")
+
+	hookResult := func(name string, phase common.OperationPhase) *v1alpha1.ResourceResult {
+		return &v1alpha1.ResourceResult{Group: "batch", Version: "v1", Kind: "Job", Namespace: "default", Name: name, HookType: common.HookTypePreSync, HookPhase: phase}
+	}
+
+	syncRes := &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{
+		hookResult("running", common.OperationRunning),
+		hookResult("unlimited", common.OperationRunning),
+		hookResult("missing", common.OperationRunning),
+	}}
+	assert.Empty(t, getHungHookMessage(syncRes, liveObjs, now, logCtx))
+
+	syncRes.Resources = append(syncRes.Resources, hookResult("hung", common.OperationRunning))
+	assert.Equal(t, "PreSync hook Job/hung timed out after 5m0s", getHungHookMessage(syncRes, liveObjs, now, logCtx))
+
+	syncRes.Resources[3].HookPhase = common.OperationFailed
+	assert.Empty(t, getHungHookMessage(syncRes, liveObjs, now, logCtx))
+}
+
+func TestDeleteFailedHooks(t *testing.T) {
+	ctrl, kubectl := newHookController(
+		newFakeHook("hook-failed", map[string]string{"argocd.argoproj.io/hook-delete-policy": "HookFailed"}, time.Now()),
+		newFakeHook("hook-succeeded", map[string]string{"argocd.argoproj.io/hook-delete-policy": "HookSucceeded"}, time.Now()),
+		newFakeHook("before-hook-creation", nil, time.Now()),
+	)
+	manager := ctrl.appStateManager.(*appStateManager)
+
+	syncRes := &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{
+		{Group: "batch", Version: "v1", Kind: "Job", Namespace: "default", Name: "hook-failed", HookType: common.HookTypePreSync, HookPhase: common.OperationFailed},
+		{Group: "batch", Version: "v1", Kind: "Job", Namespace: "default", Name: "hook-succeeded", HookType: common.HookTypePreSync, HookPhase: common.OperationSucceeded},
+		{Group: "batch", Version: "v1", Kind: "Job", Namespace: "default", Name: "before-hook-creation", HookType: common.HookTypePreSync, HookPhase: common.OperationFailed},
+		{Group: "batch", Version: "v1", Kind: "Job", Namespace: "default", Name: "missing", HookType: common.HookTypePreSync, HookPhase: common.OperationFailed},
+	}}
+	manager.deleteFailedHooks(&rest.Config{}, syncRes, metav1.DeleteOptions{}, log.WithField("application", "test"))
+
+	assert.Equal(t, []kube.ResourceKey{kube.NewResourceKey("batch", "Job", "default", "hook-failed")}, kubectl.DeletedResources)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/cache"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 const (
@@ -47,16 +48,45 @@ var (
 		append(descClusterDefaultLabels, "k8s_version"),
 		nil,
 	)
+	descClusterCacheObjects = prometheus.NewDesc(
+		"argocd_cluster_cache_objects",
+		"Number of k8s resource objects of a kind in the cache.",
+		append(descClusterDefaultLabels, "group", "version", "kind", "watch"),
+		nil,
+	)
+	descClusterCacheObjectBytes = prometheus.NewDesc(
+		"argocd_cluster_cache_object_bytes",
+		"Approximate size in bytes of the k8s resource objects of a kind in the cache.",
+		append(descClusterDefaultLabels, "group", "version", "kind", "watch"),
+		nil,
+	)
 )
 
 type HasClustersInfo interface {
 	GetClustersInfo() []cache.ClusterInfo
 }
 
+// ClusterResourceStats holds the number and the approximate size of the objects of a kind in a cluster cache
+type ClusterResourceStats struct {
+	Server           string
+	GroupVersionKind schema.GroupVersionKind
+	// MetadataOnly is true if the objects were received from a metadata-only watch
+	MetadataOnly bool
+	Objects      int64
+	Bytes        int64
+}
+
+// HasClustersResourceStats is implemented by clusters info sources which report the objects of each kind in the
+// cluster caches
+type HasClustersResourceStats interface {
+	GetClustersResourceStats() []ClusterResourceStats
+}
+
 type clusterCollector struct {
-	infoSource HasClustersInfo
-	info       []cache.ClusterInfo
-	lock       sync.Mutex
+	infoSource    HasClustersInfo
+	info          []cache.ClusterInfo
+	resourceStats []ClusterResourceStats
+	lock          sync.Mutex
 }
 
 func (c *clusterCollector) Run(ctx context.Context) {
@@ -69,9 +99,14 @@ func (c *clusterCollector) Run(ctx context.Context) {
 			break
 		case <-tick:
 			info := c.infoSource.GetClustersInfo()
+			var resourceStats []ClusterResourceStats
+			if statsSource, ok := c.infoSource.(HasClustersResourceStats); ok {
+				resourceStats = statsSource.GetClustersResourceStats()
+			}
 
 			c.lock.Lock()
 			c.info = info
+			c.resourceStats = resourceStats
 			c.lock.Unlock()
 		}
 	}
@@ -84,6 +119,8 @@ func (c *clusterCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descClusterAPIs
 	ch <- descClusterCacheAgeSeconds
 	ch <- descClusterConnectionStatus
+	ch <- descClusterCacheObjects
+	ch <- descClusterCacheObjectBytes
 }
 
 func (c *clusterCollector) Collect(ch chan<- prometheus.Metric) {
@@ -100,4 +137,13 @@ func (c *clusterCollector) Collect(ch chan<- prometheus.Metric) {
 		ch <- prometheus.MustNewConstMetric(descClusterCacheAgeSeconds, prometheus.GaugeValue, float64(cacheAgeSeconds), defaultValues...)
 		ch <- prometheus.MustNewConstMetric(descClusterConnectionStatus, prometheus.GaugeValue, boolFloat64(c.SyncError == nil), append(defaultValues, c.K8SVersion)...)
 	}
+	for _, stats := range c.resourceStats {
+		watch := "full"
+		if stats.MetadataOnly {
+			watch = "metadata"
+		}
+		labels := []string{stats.Server, stats.GroupVersionKind.Group, stats.GroupVersionKind.Version, stats.GroupVersionKind.Kind, watch}
+		ch <- prometheus.MustNewConstMetric(descClusterCacheObjects, prometheus.GaugeValue, float64(stats.Objects), labels...)
+		ch <- prometheus.MustNewConstMetric(descClusterCacheObjectBytes, prometheus.GaugeValue, float64(stats.Bytes), labels...)
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	gitopsCache "github.com/argoproj/gitops-engine/pkg/cache"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestMetricClusterConnectivity(t *testing.T) {
@@ -102,3 +103,33 @@ argocd_cluster_connection_status{k8s_version="1.21",server="server3"} 1
 		})
 	}
 }
+
+func TestMetricClusterCacheObjects(t *testing.T) {
+	cfg := TestMetricServerConfig{
+		FakeAppYAMLs: []string{fakeApp},
+		ExpectedResponse: `
+# HELP argocd_cluster_cache_object_bytes Approximate size in bytes of the k8s resource objects of a kind in the cache.
+# TYPE argocd_cluster_cache_object_bytes gauge
+argocd_cluster_cache_object_bytes{group="",kind="ConfigMap",server="server1",version="v1",watch="metadata"} 2048
+argocd_cluster_cache_object_bytes{group="apps",kind="Deployment",server="server1",version="v1",watch="full"} 4096
+# HELP argocd_cluster_cache_objects Number of k8s resource objects of a kind in the cache.
+# TYPE argocd_cluster_cache_objects gauge
+argocd_cluster_cache_objects{group="",kind="ConfigMap",server="server1",version="v1",watch="metadata"} 10
+argocd_cluster_cache_objects{group="apps",kind="Deployment",server="server1",version="v1",watch="full"} 2
+`,
+		ClustersInfo: []gitopsCache.ClusterInfo{{Server: "server1", K8SVersion: "1.21"}},
+		ResourceStats: []ClusterResourceStats{{
+			Server:           "server1",
+			GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+			MetadataOnly:     true,
+			Objects:          10,
+			Bytes:            2048,
+		}, {
+			Server:           "server1",
+			GroupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			Objects:          2,
+			Bytes:            4096,
+		}},
+	}
+	runTest(t, cfg)
+}
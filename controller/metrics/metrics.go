@@ -20,6 +20,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	applister "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/healthz"
 	"github.com/argoproj/argo-cd/v2/util/profile"
@@ -30,6 +31,7 @@ import (
 type MetricsServer struct {
 	*http.Server
 	syncCounter             *prometheus.CounterVec
+	driftCounter            *prometheus.CounterVec
 	kubectlExecCounter      *prometheus.CounterVec
 	kubectlExecPendingGauge *prometheus.GaugeVec
 	k8sRequestCounter       *prometheus.CounterVec
@@ -84,6 +86,37 @@ var (
 		nil,
 	)
 
+	descAppDORADeployments = prometheus.NewDesc(
+		"argocd_app_dora_deployments",
+		"Number of syncs which deployed a new revision of the application within the rolling window.",
+		append(descAppDefaultLabels, "window"),
+		nil,
+	)
+	descAppDORAFailedDeployments = prometheus.NewDesc(
+		"argocd_app_dora_failed_deployments",
+		"Number of deployments of the application within the rolling window after which the application did not become healthy.",
+		append(descAppDefaultLabels, "window"),
+		nil,
+	)
+	descAppDORAChangeFailureRate = prometheus.NewDesc(
+		"argocd_app_dora_change_failure_rate",
+		"Ratio of failed deployments of the application within the rolling window.",
+		append(descAppDefaultLabels, "window"),
+		nil,
+	)
+	descAppDORALeadTime = prometheus.NewDesc(
+		"argocd_app_dora_lead_time_seconds",
+		"Mean time between the commit of the revisions deployed within the rolling window and their deployment.",
+		append(descAppDefaultLabels, "window"),
+		nil,
+	)
+	descAppDORATimeToRestore = prometheus.NewDesc(
+		"argocd_app_dora_time_to_restore_seconds",
+		"Mean time between a failed deployment of the application within the rolling window and the application becoming healthy again.",
+		append(descAppDefaultLabels, "window"),
+		nil,
+	)
+
 	syncCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_app_sync_total",
@@ -92,6 +125,14 @@ var (
 		append(descAppDefaultLabels, "dest_server", "phase"),
 	)
 
+	driftCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_app_drift_total",
+			Help: "Number of times a synced application resource drifted from its target state.",
+		},
+		append(descAppDefaultLabels, "group", "kind"),
+	)
+
 	k8sRequestCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_app_k8s_request_total",
@@ -173,6 +214,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	healthz.ServeHealthCheck(mux, healthCheck)
 
 	registry.MustRegister(syncCounter)
+	registry.MustRegister(driftCounter)
 	registry.MustRegister(k8sRequestCounter)
 	registry.MustRegister(kubectlExecCounter)
 	registry.MustRegister(kubectlExecPendingGauge)
@@ -188,6 +230,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 			Handler: mux,
 		},
 		syncCounter:             syncCounter,
+		driftCounter:            driftCounter,
 		k8sRequestCounter:       k8sRequestCounter,
 		kubectlExecCounter:      kubectlExecCounter,
 		kubectlExecPendingGauge: kubectlExecPendingGauge,
@@ -231,6 +274,11 @@ func (m *MetricsServer) IncSync(app *argoappv1.Application, state *argoappv1.Ope
 	m.syncCounter.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject(), app.Spec.Destination.Server, string(state.Phase)).Inc()
 }
 
+// IncDrift increments the drift counter for a resource of an application
+func (m *MetricsServer) IncDrift(app *argoappv1.Application, group, kind string) {
+	m.driftCounter.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject(), group, kind).Inc()
+}
+
 func (m *MetricsServer) IncKubectlExec(command string) {
 	m.kubectlExecCounter.WithLabelValues(m.hostname, command).Inc()
 }
@@ -290,6 +338,7 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 	_, err := m.cron.AddFunc(fmt.Sprintf("@every %s", cacheExpiration), func() {
 		log.Infof("Reset Prometheus metrics based on existing expiration '%v'", cacheExpiration)
 		m.syncCounter.Reset()
+		m.driftCounter.Reset()
 		m.kubectlExecCounter.Reset()
 		m.kubectlExecPendingGauge.Reset()
 		m.k8sRequestCounter.Reset()
@@ -336,6 +385,11 @@ func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descAppInfo
 	ch <- descAppSyncStatusCode
 	ch <- descAppHealthStatus
+	ch <- descAppDORADeployments
+	ch <- descAppDORAFailedDeployments
+	ch <- descAppDORAChangeFailureRate
+	ch <- descAppDORALeadTime
+	ch <- descAppDORATimeToRestore
 }
 
 // Collect implements the prometheus.Collector interface
@@ -388,6 +442,24 @@ func (c *appCollector) collectApps(ch chan<- prometheus.Metric, app *argoappv1.A
 
 	addGauge(descAppInfo, 1, strconv.FormatBool(autoSyncEnabled), git.NormalizeGitURL(app.Spec.GetSource().RepoURL), app.Spec.Destination.Server, app.Spec.Destination.Namespace, string(syncStatus), string(healthStatus), operation)
 
+	if len(app.Status.History) > 0 {
+		now := time.Now()
+		for _, window := range argo.DORAWindows {
+			stats := argo.NewDORAStats(window)
+			stats.AddApplication(app, now)
+			windowLabel := argo.FormatDORAWindow(window)
+			addGauge(descAppDORADeployments, float64(stats.Deployments), windowLabel)
+			addGauge(descAppDORAFailedDeployments, float64(stats.FailedDeployments), windowLabel)
+			addGauge(descAppDORAChangeFailureRate, stats.ChangeFailureRate(), windowLabel)
+			if leadTime, ok := stats.LeadTime(); ok {
+				addGauge(descAppDORALeadTime, leadTime.Seconds(), windowLabel)
+			}
+			if restoreTime, ok := stats.TimeToRestore(); ok {
+				addGauge(descAppDORATimeToRestore, restoreTime.Seconds(), windowLabel)
+			}
+		}
+	}
+
 	if len(c.appLabels) > 0 {
 		labelValues := []string{}
 		for _, desiredLabel := range c.appLabels {
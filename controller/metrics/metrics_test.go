@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
@@ -183,18 +184,24 @@ func testApp(t *testing.T, fakeAppYAMLs []string, expectedResponse string) {
 }
 
 type fakeClusterInfo struct {
-	clustersInfo []gitopsCache.ClusterInfo
+	clustersInfo  []gitopsCache.ClusterInfo
+	resourceStats []ClusterResourceStats
 }
 
 func (f *fakeClusterInfo) GetClustersInfo() []gitopsCache.ClusterInfo {
 	return f.clustersInfo
 }
 
+func (f *fakeClusterInfo) GetClustersResourceStats() []ClusterResourceStats {
+	return f.resourceStats
+}
+
 type TestMetricServerConfig struct {
 	FakeAppYAMLs     []string
 	ExpectedResponse string
 	AppLabels        []string
 	ClustersInfo     []gitopsCache.ClusterInfo
+	ResourceStats    []ClusterResourceStats
 }
 
 func testMetricServer(t *testing.T, fakeAppYAMLs []string, expectedResponse string, appLabels []string) {
@@ -216,10 +223,11 @@ func runTest(t *testing.T, cfg TestMetricServerConfig) {
 	require.NoError(t, err)
 
 	if len(cfg.ClustersInfo) > 0 {
-		ci := &fakeClusterInfo{clustersInfo: cfg.ClustersInfo}
+		ci := &fakeClusterInfo{clustersInfo: cfg.ClustersInfo, resourceStats: cfg.ResourceStats}
 		collector := &clusterCollector{
-			infoSource: ci,
-			info:       ci.GetClustersInfo(),
+			infoSource:    ci,
+			info:          ci.GetClustersInfo(),
+			resourceStats: ci.GetClustersResourceStats(),
 		}
 		metricsServ.registry.MustRegister(collector)
 	}
@@ -332,6 +340,65 @@ argocd_app_sync_status{name="my-app",namespace="argocd",project="important-proje
 	testApp(t, []string{fakeApp}, expectedResponse)
 }
 
+func TestDORAMetrics(t *testing.T) {
+	now := time.Now().UTC()
+	formatTime := func(ago time.Duration) string {
+		return now.Add(-ago).Format(time.RFC3339)
+	}
+	day := 24 * time.Hour
+	appWithHistory := fmt.Sprintf(`
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: my-app
+  namespace: argocd
+spec:
+  destination:
+    namespace: dummy-namespace
+    server: https://localhost:6443
+  project: important-project
+  source:
+    path: some/path
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+status:
+  health:
+    status: Healthy
+  history:
+  - id: 0
+    revision: a
+    deployedAt: %s
+    committedAt: %s
+  - id: 1
+    revision: b
+    deployedAt: %s
+    committedAt: %s
+    healthyAt: %s
+`, formatTime(10*day), formatTime(10*day+time.Hour), formatTime(2*day), formatTime(2*day+3*time.Hour), formatTime(2*day-time.Hour))
+
+	expectedResponse := `
+# HELP argocd_app_dora_change_failure_rate Ratio of failed deployments of the application within the rolling window.
+# TYPE argocd_app_dora_change_failure_rate gauge
+argocd_app_dora_change_failure_rate{name="my-app",namespace="argocd",project="important-project",window="30d"} 0.5
+argocd_app_dora_change_failure_rate{name="my-app",namespace="argocd",project="important-project",window="7d"} 0
+# HELP argocd_app_dora_deployments Number of syncs which deployed a new revision of the application within the rolling window.
+# TYPE argocd_app_dora_deployments gauge
+argocd_app_dora_deployments{name="my-app",namespace="argocd",project="important-project",window="30d"} 2
+argocd_app_dora_deployments{name="my-app",namespace="argocd",project="important-project",window="7d"} 1
+# HELP argocd_app_dora_failed_deployments Number of deployments of the application within the rolling window after which the application did not become healthy.
+# TYPE argocd_app_dora_failed_deployments gauge
+argocd_app_dora_failed_deployments{name="my-app",namespace="argocd",project="important-project",window="30d"} 1
+argocd_app_dora_failed_deployments{name="my-app",namespace="argocd",project="important-project",window="7d"} 0
+# HELP argocd_app_dora_lead_time_seconds Mean time between the commit of the revisions deployed within the rolling window and their deployment.
+# TYPE argocd_app_dora_lead_time_seconds gauge
+argocd_app_dora_lead_time_seconds{name="my-app",namespace="argocd",project="important-project",window="30d"} 7200
+argocd_app_dora_lead_time_seconds{name="my-app",namespace="argocd",project="important-project",window="7d"} 10800
+# HELP argocd_app_dora_time_to_restore_seconds Mean time between a failed deployment of the application within the rolling window and the application becoming healthy again.
+# TYPE argocd_app_dora_time_to_restore_seconds gauge
+argocd_app_dora_time_to_restore_seconds{name="my-app",namespace="argocd",project="important-project",window="30d"} 694800
+`
+	testApp(t, []string{appWithHistory}, expectedResponse)
+}
+
 func TestMetricsSyncCounter(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
@@ -363,6 +430,32 @@ argocd_app_sync_total{dest_server="https://localhost:6443",name="my-app",namespa
 	assertMetricsPrinted(t, appSyncTotal, body)
 }
 
+func TestMetricsDriftCounter(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{})
+	require.NoError(t, err)
+
+	appDriftTotal := `
+# HELP argocd_app_drift_total Number of times a synced application resource drifted from its target state.
+# TYPE argocd_app_drift_total counter
+argocd_app_drift_total{group="",kind="ConfigMap",name="my-app",namespace="argocd",project="important-project"} 1
+argocd_app_drift_total{group="apps",kind="Deployment",name="my-app",namespace="argocd",project="important-project"} 2
+`
+
+	fakeApp := newFakeApp(fakeApp)
+	metricsServ.IncDrift(fakeApp, "apps", "Deployment")
+	metricsServ.IncDrift(fakeApp, "apps", "Deployment")
+	metricsServ.IncDrift(fakeApp, "", "ConfigMap")
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assertMetricsPrinted(t, appDriftTotal, rr.Body.String())
+}
+
 // assertMetricsPrinted asserts every line in the expected lines appears in the body
 func assertMetricsPrinted(t *testing.T, expectedLines, body string) {
 	t.Helper()
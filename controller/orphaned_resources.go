@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v2/controller/metrics"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// orphanedResourceActionVerbs describe the actions applied to orphaned resources in events
+var orphanedResourceActionVerbs = map[appv1.OrphanedResourceActionType]string{
+	appv1.OrphanedResourceActionDelete: "deleted",
+	appv1.OrphanedResourceActionAdopt:  "adopted",
+}
+
+// processOrphanedResources applies the actions configured in the project to the top-level orphaned resources of the
+// application once they remained orphaned for the grace period of the action, and reports the pending actions in the
+// application status. The children of the orphaned resources are deleted or adopted along with them.
+func (ctrl *ApplicationController) processOrphanedResources(app *appv1.Application, proj *appv1.AppProject, tree *appv1.ApplicationTree, now metav1.Time) {
+	if proj.Spec.OrphanedResources == nil || len(proj.Spec.OrphanedResources.Actions) == 0 {
+		app.Status.OrphanedResources = nil
+		return
+	}
+	logCtx := getAppLog(app)
+
+	previous := map[kube.ResourceKey]appv1.OrphanedResourceStatus{}
+	for _, res := range app.Status.OrphanedResources {
+		previous[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res
+	}
+	// the actions are only reported while the application is deleted or in maintenance mode
+	canApply := app.DeletionTimestamp == nil && !app.Status.Maintenance.IsActive(now.Time)
+
+	var config *rest.Config
+	var pending []appv1.OrphanedResourceStatus
+	for _, node := range tree.OrphanedNodes {
+		if len(node.ParentRefs) > 0 {
+			continue
+		}
+		action := proj.Spec.OrphanedResources.GetAction(node.Group, node.Kind, node.Name)
+		if action == nil {
+			continue
+		}
+		gracePeriod, err := action.GetGracePeriod()
+		if err != nil {
+			logCtx.Warnf("Invalid grace period of orphaned resources action %s: %v", action.Action, err)
+			continue
+		}
+
+		key := kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+		res, ok := previous[key]
+		isNew := !ok || res.Action != action.Action
+		if isNew {
+			res = appv1.OrphanedResourceStatus{
+				Group:         node.Group,
+				Kind:          node.Kind,
+				Namespace:     node.Namespace,
+				Name:          node.Name,
+				Action:        action.Action,
+				OrphanedSince: now,
+			}
+		}
+		res.DryRun = action.DryRun
+		res.ScheduledAt = metav1.NewTime(res.OrphanedSince.Add(gracePeriod))
+		if isNew {
+			ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOrphanedResourceActionPending, Type: v1.EventTypeNormal}, pendingOrphanedResourceActionMessage(res), context.TODO())
+		}
+		if res.DryRun || !canApply || now.Before(&res.ScheduledAt) {
+			pending = append(pending, res)
+			continue
+		}
+
+		if config == nil {
+			config, err = ctrl.getOrphanedResourcesRestConfig(app, proj)
+			if err != nil {
+				res.Message = err.Error()
+				pending = append(pending, res)
+				continue
+			}
+		}
+		if err := ctrl.applyOrphanedResourceAction(app, action.Action, node, config); err != nil {
+			message := fmt.Sprintf("Failed to apply %s action to orphaned resource %s: %v", action.Action, key.String(), err)
+			if res.Message != message {
+				ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonStatusRefreshed, Type: v1.EventTypeWarning}, message, context.TODO())
+			}
+			res.Message = message
+			pending = append(pending, res)
+			continue
+		}
+		reason := argo.EventReasonOrphanedResourceDeleted
+		if action.Action == appv1.OrphanedResourceActionAdopt {
+			reason = argo.EventReasonOrphanedResourceAdopted
+		}
+		message := fmt.Sprintf("Orphaned resource %s %s after being orphaned since %s", key.String(), orphanedResourceActionVerbs[action.Action], res.OrphanedSince.UTC().Format(time.RFC3339))
+		ctrl.logAppEvent(app, argo.EventInfo{Reason: reason, Type: v1.EventTypeNormal}, message, context.TODO())
+	}
+	app.Status.OrphanedResources = pending
+}
+
+// pendingOrphanedResourceActionMessage describes an action pending for an orphaned resource
+func pendingOrphanedResourceActionMessage(res appv1.OrphanedResourceStatus) string {
+	key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+	if res.DryRun {
+		return fmt.Sprintf("Orphaned resource %s would be %s at %s (dry run)", key.String(), orphanedResourceActionVerbs[res.Action], res.ScheduledAt.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("Orphaned resource %s will be %s at %s", key.String(), orphanedResourceActionVerbs[res.Action], res.ScheduledAt.UTC().Format(time.RFC3339))
+}
+
+// getOrphanedResourcesRestConfig returns the config used to modify the orphaned resources of the application,
+// impersonating the service account of its destination if enabled
+func (ctrl *ApplicationController) getOrphanedResourcesRestConfig(app *appv1.Application, proj *appv1.AppProject) (*rest.Config, error) {
+	cluster, err := ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster: %w", err)
+	}
+	config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, app, cluster.RESTConfig())
+	if err := ctrl.impersonateDestinationServiceAccount(app, proj, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// applyOrphanedResourceAction deletes the given orphaned resource, or adds the tracking metadata of the application to it
+func (ctrl *ApplicationController) applyOrphanedResourceAction(app *appv1.Application, action appv1.OrphanedResourceActionType, node appv1.ResourceNode, config *rest.Config) error {
+	gvk := node.GroupKindVersion()
+	switch action {
+	case appv1.OrphanedResourceActionDelete:
+		propagationPolicy := metav1.DeletePropagationBackground
+		err := ctrl.kubectl.DeleteResource(context.Background(), config, gvk, node.Name, node.Namespace, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+		if err != nil && !apierr.IsNotFound(err) {
+			return err
+		}
+		return nil
+	case appv1.OrphanedResourceActionAdopt:
+		// the adopted resource is not part of the target state of the application, so an automated sync would prune it
+		if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil && app.Spec.SyncPolicy.Automated.Prune {
+			return errors.New("the resource would be pruned by the automated sync of the application")
+		}
+		patch, err := ctrl.getAdoptionPatch(app, node)
+		if err != nil {
+			return err
+		}
+		_, err = ctrl.kubectl.PatchResource(context.Background(), config, gvk, node.Name, node.Namespace, types.MergePatchType, patch)
+		return err
+	}
+	return fmt.Errorf("unknown action %s", action)
+}
+
+// getAdoptionPatch returns the merge patch adding the tracking metadata of the application to the given resource
+func (ctrl *ApplicationController) getAdoptionPatch(app *appv1.Application, node appv1.ResourceNode) ([]byte, error) {
+	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(node.GroupKindVersion())
+	obj.SetName(node.Name)
+	obj.SetNamespace(node.Namespace)
+	err = argo.NewResourceTracking().SetAppInstance(obj, appLabelKey, app.InstanceName(ctrl.namespace), app.Spec.Destination.Namespace, argo.GetTrackingMethod(ctrl.settingsMgr))
+	if err != nil {
+		return nil, fmt.Errorf("error setting app instance: %w", err)
+	}
+	metadata := map[string]interface{}{}
+	if labels := obj.GetLabels(); len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if annotations := obj.GetAnnotations(); len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+	return json.Marshal(map[string]interface{}{"metadata": metadata})
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"strings"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// retryAttemptsLimit is the max number of failed attempts kept in the operation state
+const retryAttemptsLimit = 10
+
+var (
+	// transientErrorPatterns match errors which are likely to succeed if the sync is retried
+	transientErrorPatterns = []string{
+		"the object has been modified",
+		"timeout",
+		"timed out",
+		"deadline exceeded",
+		"connection refused",
+		"connection reset",
+		"broken pipe",
+		"unexpected eof",
+		"too many requests",
+		"toomanyrequests",
+		"service unavailable",
+		"serviceunavailable",
+		"server is currently unable to handle the request",
+		"code = unavailable",
+		"failed calling webhook",
+		"no endpoints available for service",
+		"etcdserver: leader changed",
+	}
+	// permanentErrorPatterns match errors which will fail again unless the application or the cluster is changed
+	permanentErrorPatterns = []string{
+		"is invalid",
+		"error validating",
+		"unknown field",
+		"field is immutable",
+		"denied the request",
+		"is forbidden",
+		"could not find the requested resource",
+		"no matches for kind",
+	}
+)
+
+// syncFailureMessages returns the messages of the operation and of all of the failed resources and hooks
+func syncFailureMessages(state *appv1.OperationState) []string {
+	messages := []string{state.Message}
+	if state.SyncResult != nil {
+		for _, res := range state.SyncResult.Resources {
+			if res.Status == synccommon.ResultCodeSyncFailed || res.HookPhase == synccommon.OperationFailed || res.HookPhase == synccommon.OperationError {
+				messages = append(messages, res.Message)
+			}
+		}
+	}
+	return messages
+}
+
+// matchesAnyPattern returns whether any of the messages contains any of the patterns, ignoring case
+func matchesAnyPattern(messages []string, patterns []string) bool {
+	for _, message := range messages {
+		message = strings.ToLower(message)
+		for _, pattern := range patterns {
+			if strings.Contains(message, pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isTransientSyncFailure returns whether an operation failed due to transient errors, e.g. conflicts, timeouts
+// or unavailable webhooks. An operation which also failed due to a permanent error, e.g. a validation error,
+// is not considered to have failed transiently.
+func isTransientSyncFailure(state *appv1.OperationState) bool {
+	messages := syncFailureMessages(state)
+	return !matchesAnyPattern(messages, permanentErrorPatterns) && matchesAnyPattern(messages, transientErrorPatterns)
+}
+
+// attemptStartedAt returns the time the current attempt of an operation started: the start of the operation, or the
+// completion of the previous failed attempt if the operation is being retried
+func attemptStartedAt(state *appv1.OperationState) time.Time {
+	startedAt := state.StartedAt.Time
+	if state.RetryCount > 0 && len(state.RetryAttempts) > 0 {
+		if finishedAt := state.RetryAttempts[len(state.RetryAttempts)-1].FinishedAt.Time; finishedAt.After(startedAt) {
+			startedAt = finishedAt
+		}
+	}
+	return startedAt
+}
+
+// recordRetryAttempt records the failure of the current attempt of the operation, keeping the most recent
+// retryAttemptsLimit attempts
+func recordRetryAttempt(state *appv1.OperationState, transient bool, finishedAt metav1.Time) {
+	state.RetryAttempts = append(state.RetryAttempts, appv1.RetryAttempt{
+		Attempt:    state.RetryCount,
+		Message:    state.Message,
+		Transient:  transient,
+		FinishedAt: finishedAt,
+	})
+	if len(state.RetryAttempts) > retryAttemptsLimit {
+		state.RetryAttempts = state.RetryAttempts[len(state.RetryAttempts)-retryAttemptsLimit:]
+	}
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestIsTransientSyncFailure(t *testing.T) {
+	testCases := []struct {
+		name      string
+		state     v1alpha1.OperationState
+		transient bool
+	}{{
+		name:      "Conflict",
+		state:     v1alpha1.OperationState{Message: `Operation cannot be fulfilled on deployments.apps "guestbook": the object has been modified; please apply your changes to the latest version and try again`},
+		transient: true,
+	}, {
+		name:      "Timeout",
+		state:     v1alpha1.OperationState{Message: "rpc error: code = DeadlineExceeded desc = context deadline exceeded"},
+		transient: true,
+	}, {
+		name: "UnavailableWebhook",
+		state: v1alpha1.OperationState{
+			Message: "one or more objects failed to apply",
+			SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{{
+				Status:  synccommon.ResultCodeSyncFailed,
+				Message: `Internal error occurred: failed calling webhook "validate.nginx.ingress.kubernetes.io": dial tcp 10.0.0.1:443: connect: connection refused`,
+			}}},
+		},
+		transient: true,
+	}, {
+		name:      "Validation",
+		state:     v1alpha1.OperationState{Message: `Deployment.apps "guestbook" is invalid: spec.template.spec.containers[0].image: Required value`},
+		transient: false,
+	}, {
+		name: "ValidationAndConflict",
+		state: v1alpha1.OperationState{
+			Message: "one or more objects failed to apply",
+			SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{{
+				Status:  synccommon.ResultCodeSyncFailed,
+				Message: "the object has been modified",
+			}, {
+				Status:  synccommon.ResultCodeSyncFailed,
+				Message: `admission webhook "policy.example.com" denied the request: missing label`,
+			}}},
+		},
+		transient: false,
+	}, {
+		name:      "Unknown",
+		state:     v1alpha1.OperationState{Message: "Failed to load target state"},
+		transient: false,
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.transient, isTransientSyncFailure(&tc.state))
+		})
+	}
+}
+
+func TestRecordRetryAttempt(t *testing.T) {
+	state := &v1alpha1.OperationState{}
+	for i := 0; i < retryAttemptsLimit+2; i++ {
+		state.RetryCount = int64(i)
+		state.Message = fmt.Sprintf("attempt %d failed", i)
+		recordRetryAttempt(state, true, metav1.Now())
+	}
+	assert.Len(t, state.RetryAttempts, retryAttemptsLimit)
+	assert.Equal(t, int64(2), state.RetryAttempts[0].Attempt)
+	assert.Equal(t, fmt.Sprintf("attempt %d failed", retryAttemptsLimit+1), state.RetryAttempts[retryAttemptsLimit-1].Message)
+}
+
+func TestAttemptStartedAt(t *testing.T) {
+	startedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	state := &v1alpha1.OperationState{StartedAt: startedAt}
+	assert.Equal(t, startedAt.Time, attemptStartedAt(state))
+
+	failedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	recordRetryAttempt(state, true, failedAt)
+	state.RetryCount++
+	assert.Equal(t, failedAt.Time, attemptStartedAt(state))
+}
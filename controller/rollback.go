@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// historyMatchesRevision returns whether the given history item was deployed at the given revision(s)
+func historyMatchesRevision(history appv1.RevisionHistory, revision string, revisions []string, hasMultipleSources bool) bool {
+	if hasMultipleSources {
+		return reflect.DeepEqual(history.Revisions, revisions)
+	}
+	return history.Revision == revision
+}
+
+// markRevisionHealthy records the time the most recently deployed revision was first observed Synced and Healthy.
+// Healthy history items are the candidates of an automated rollback.
+func markRevisionHealthy(app *appv1.Application, syncStatus *appv1.SyncStatus, healthStatus *appv1.HealthStatus, now metav1.Time) {
+	if app.Operation != nil || len(app.Status.History) == 0 {
+		return
+	}
+	if syncStatus.Status != appv1.SyncStatusCodeSynced || healthStatus.Status != health.HealthStatusHealthy {
+		return
+	}
+	latest := &app.Status.History[len(app.Status.History)-1]
+	if latest.HealthyAt != nil || !historyMatchesRevision(*latest, syncStatus.Revision, syncStatus.Revisions, app.Spec.HasMultipleSources()) {
+		return
+	}
+	latest.HealthyAt = &now
+}
+
+// autoRollback will initiate a rollback to the most recent healthy revision for an application configured with
+// automated rollback, if its health degrades shortly after an automated sync. It returns a RollbackWarning
+// condition as long as automated sync is suspended for the revision the application was rolled back from,
+// and whether a rollback operation was initiated.
+func (ctrl *ApplicationController) autoRollback(app *appv1.Application, syncStatus *appv1.SyncStatus, healthStatus *appv1.HealthStatus) (*appv1.ApplicationCondition, bool) {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil || app.Spec.SyncPolicy.Automated.Rollback == nil {
+		return nil, false
+	}
+	logCtx := getAppLog(app)
+	hasMultipleSources := app.Spec.HasMultipleSources()
+
+	var rollbackCond *appv1.ApplicationCondition
+	if rollback := app.Status.Rollback; rollback != nil && rollback.Matches(syncStatus.Revision, syncStatus.Revisions, hasMultipleSources) {
+		rollbackCond = &appv1.ApplicationCondition{Type: appv1.ApplicationConditionRollbackWarning, Message: rollback.Message}
+	}
+
+	if app.Operation != nil {
+		return rollbackCond, false
+	}
+	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
+		return rollbackCond, false
+	}
+	if app.Status.Maintenance.IsActive(time.Now()) {
+		return rollbackCond, false
+	}
+	opState := app.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || opState.SyncResult == nil || opState.FinishedAt == nil ||
+		!opState.Operation.InitiatedBy.Automated || opState.Phase != synccommon.OperationSucceeded {
+		return rollbackCond, false
+	}
+	if rollback := app.Status.Rollback; rollback != nil {
+		if rollback.Matches(opState.SyncResult.Revision, opState.SyncResult.Revisions, hasMultipleSources) {
+			// the application was already rolled back from this revision
+			return rollbackCond, false
+		}
+		if !opState.StartedAt.Before(&rollback.RolledBackAt) {
+			// the most recent operation is the rollback itself
+			return rollbackCond, false
+		}
+	}
+	if len(app.Status.History) == 0 {
+		return rollbackCond, false
+	}
+	latest := app.Status.History.LastRevisionHistory()
+	if !historyMatchesRevision(latest, opState.SyncResult.Revision, opState.SyncResult.Revisions, hasMultipleSources) {
+		return rollbackCond, false
+	}
+
+	var syncedRevision string
+	if hasMultipleSources {
+		syncedRevision = strings.Join(opState.SyncResult.Revisions, ", ")
+	} else {
+		syncedRevision = opState.SyncResult.Revision
+	}
+	sinceSync := time.Since(opState.FinishedAt.Time)
+
+	var reason string
+	switch healthStatus.Status {
+	case health.HealthStatusDegraded:
+		window, err := app.Spec.SyncPolicy.Automated.Rollback.GetWindow()
+		if err != nil {
+			logCtx.Warnf("Skipping auto-rollback: invalid rollback window: %v", err)
+			return rollbackCond, false
+		}
+		if sinceSync > window {
+			return rollbackCond, false
+		}
+		reason = fmt.Sprintf("application became %s after automated sync to '%s'", healthStatus.Status, syncedRevision)
+	case health.HealthStatusProgressing:
+		timeout, err := app.Spec.SyncPolicy.Automated.Rollback.GetProgressingTimeout()
+		if err != nil {
+			logCtx.Warnf("Skipping auto-rollback: invalid progressing timeout: %v", err)
+			return rollbackCond, false
+		}
+		if timeout <= 0 || latest.HealthyAt != nil {
+			return rollbackCond, false
+		}
+		if sinceSync < timeout {
+			retryAfter := timeout - sinceSync
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &retryAfter)
+			return rollbackCond, false
+		}
+		reason = fmt.Sprintf("application is still %s %v after automated sync to '%s'", healthStatus.Status, timeout, syncedRevision)
+	default:
+		return rollbackCond, false
+	}
+
+	target := app.Status.History.LastHealthyRevisionHistory()
+	if target == nil || (target.Source.IsZero() && target.Sources.IsZero()) {
+		message := fmt.Sprintf("Unable to roll back: %s, but no previously healthy revision was found", reason)
+		logCtx.Warn(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionRollbackWarning, Message: message}, false
+	}
+
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:     target.Revision,
+			Revisions:    target.Revisions,
+			Prune:        app.Spec.SyncPolicy.Automated.Prune,
+			SyncOptions:  app.Spec.SyncPolicy.SyncOptions,
+			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
+			Source:       &target.Source,
+			Sources:      target.Sources,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Retry:       appv1.RetryStrategy{Limit: 5},
+	}
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	if err != nil {
+		if goerrors.Is(err, argo.ErrAnotherOperationInProgress) {
+			logCtx.Warnf("Failed to initiate auto-rollback to history ID %d: %v", target.ID, err)
+			return rollbackCond, false
+		}
+		message := fmt.Sprintf("Failed to initiate rollback to history ID %d: %v", target.ID, err)
+		logCtx.Error(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionRollbackWarning, Message: message}, false
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	message := fmt.Sprintf("Initiated automated rollback to history ID %d: %s", target.ID, reason)
+	app.Status.Rollback = &appv1.RollbackStatus{
+		Revision:     opState.SyncResult.Revision,
+		Revisions:    opState.SyncResult.Revisions,
+		HistoryID:    target.ID,
+		RolledBackAt: metav1.Now(),
+		Message:      message,
+	}
+	ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeWarning}, message, context.TODO())
+	logCtx.Warn(message)
+	return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionRollbackWarning, Message: message}, true
+}
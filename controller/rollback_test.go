@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+const (
+	healthyRevision  = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	degradedRevision = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+// newFakeRollbackApp returns an application which was just automatically synced from a healthy revision to another one
+func newFakeRollbackApp() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.Rollback = &v1alpha1.AutomatedRollback{}
+	syncedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	healthyAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 1, Revision: healthyRevision, Source: *app.Spec.Source, HealthyAt: &healthyAt},
+		{ID: 2, Revision: degradedRevision, Source: *app.Spec.Source},
+	}
+	app.Status.OperationState.Operation.InitiatedBy.Automated = true
+	app.Status.OperationState.StartedAt = syncedAt
+	app.Status.OperationState.FinishedAt = &syncedAt
+	app.Status.OperationState.SyncResult.Revision = degradedRevision
+	return app
+}
+
+func TestAutoRollback(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: degradedRevision}
+
+	t.Run("Degraded", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, rolledBack := ctrl.autoRollback(app, &syncStatus, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.True(t, rolledBack)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionRollbackWarning, cond.Type)
+		require.NotNil(t, app.Status.Rollback)
+		assert.Equal(t, degradedRevision, app.Status.Rollback.Revision)
+		assert.Equal(t, int64(1), app.Status.Rollback.HistoryID)
+
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		require.NotNil(t, app.Operation.Sync)
+		assert.Equal(t, healthyRevision, app.Operation.Sync.Revision)
+		assert.True(t, app.Operation.InitiatedBy.Automated)
+	})
+
+	t.Run("DegradedOutsideWindow", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Spec.SyncPolicy.Automated.Rollback.Window = "30s"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, rolledBack := ctrl.autoRollback(app, &syncStatus, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.False(t, rolledBack)
+		assert.Nil(t, cond)
+	})
+
+	t.Run("ManualSync", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Status.OperationState.Operation.InitiatedBy.Automated = false
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		_, rolledBack := ctrl.autoRollback(app, &syncStatus, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.False(t, rolledBack)
+	})
+
+	t.Run("ProgressingWithinTimeout", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Spec.SyncPolicy.Automated.Rollback.ProgressingTimeout = "5m"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		_, rolledBack := ctrl.autoRollback(app, &syncStatus, &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing})
+		assert.False(t, rolledBack)
+	})
+
+	t.Run("ProgressingBeyondTimeout", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Spec.SyncPolicy.Automated.Rollback.ProgressingTimeout = "30s"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		_, rolledBack := ctrl.autoRollback(app, &syncStatus, &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing})
+		assert.True(t, rolledBack)
+	})
+
+	t.Run("NoHealthyRevision", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Status.History[0].HealthyAt = nil
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, rolledBack := ctrl.autoRollback(app, &syncStatus, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.False(t, rolledBack)
+		require.NotNil(t, cond)
+		assert.Contains(t, cond.Message, "no previously healthy revision")
+	})
+
+	t.Run("AlreadyRolledBack", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Status.Rollback = &v1alpha1.RollbackStatus{Revision: degradedRevision, HistoryID: 1, RolledBackAt: metav1.Now(), Message: "rolled back"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, rolledBack := ctrl.autoRollback(app, &syncStatus, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded})
+		assert.False(t, rolledBack)
+		require.NotNil(t, cond)
+		assert.Equal(t, "rolled back", cond.Message)
+	})
+}
+
+func TestSkipAutoSyncOfRolledBackRevision(t *testing.T) {
+	app := newFakeRollbackApp()
+	app.Status.Rollback = &v1alpha1.RollbackStatus{Revision: degradedRevision, HistoryID: 1, RolledBackAt: metav1.Now()}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: degradedRevision}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestMarkRevisionHealthy(t *testing.T) {
+	app := newFakeRollbackApp()
+	syncStatus := v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: degradedRevision}
+
+	markRevisionHealthy(app, &syncStatus, &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing}, metav1.Now())
+	assert.Nil(t, app.Status.History[1].HealthyAt)
+
+	markRevisionHealthy(app, &syncStatus, &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy}, metav1.Now())
+	assert.NotNil(t, app.Status.History[1].HealthyAt)
+}
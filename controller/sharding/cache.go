@@ -1,10 +1,12 @@
 package sharding
 
 import (
+	"strings"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/db"
 )
@@ -18,8 +20,11 @@ type ClusterShardingCache interface {
 	DeleteApp(a *v1alpha1.Application)
 	UpdateApp(a *v1alpha1.Application)
 	IsManagedCluster(c *v1alpha1.Cluster) bool
+	IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool
+	IsApplicationShardedCluster(server string) bool
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
+	UpdateClusterInfo(clusterServer string, info *v1alpha1.ClusterInfo)
 }
 
 type ClusterSharding struct {
@@ -30,6 +35,12 @@ type ClusterSharding struct {
 	Apps            map[string]*v1alpha1.Application
 	lock            sync.RWMutex
 	getClusterShard DistributionFunction
+	// weighted is true if the distribution depends on the cluster info
+	weighted bool
+	// applicationShardedClusters are the servers of the clusters whose applications are distributed across
+	// the shards instead of the clusters themselves
+	applicationShardedClusters map[string]bool
+	applicationShardingKey     string
 }
 
 func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
@@ -40,6 +51,17 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 		Shards:   make(map[string]int),
 		Clusters: make(map[string]*v1alpha1.Cluster),
 		Apps:     make(map[string]*v1alpha1.Application),
+
+		applicationShardedClusters: make(map[string]bool),
+		applicationShardingKey:     ApplicationShardingKey,
+	}
+	if replicas > 1 {
+		for _, server := range ApplicationShardedClusters {
+			if server = strings.TrimSpace(server); server != "" {
+				log.Infof("Distributing applications of cluster %s across all shards", server)
+				clusterSharding.applicationShardedClusters[server] = true
+			}
+		}
 	}
 	distributionFunction := NoShardingDistributionFunction()
 	if replicas > 1 {
@@ -49,6 +71,7 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 		log.Info("Processing all cluster shards")
 	}
 	clusterSharding.getClusterShard = distributionFunction
+	clusterSharding.weighted = replicas > 1 && shardingAlgorithm == common.ResourceWeightedShardingAlgorithm
 	return clusterSharding
 }
 
@@ -59,6 +82,9 @@ func (s *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
 	if c == nil { // nil cluster (in-cluster) is always managed by current clusterShard
 		return true
 	}
+	if s.applicationShardedClusters[c.Server] { // the applications of the cluster are distributed across all shards
+		return true
+	}
 	clusterShard := 0
 	if shard, ok := s.Shards[c.Server]; ok {
 		clusterShard = shard
@@ -69,6 +95,24 @@ func (s *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
 	return clusterShard == s.Shard
 }
 
+// IsManagedApp returns whether or not the application targeting the given cluster should be processed by a given shard.
+// The applications of application-sharded clusters are distributed across all shards, the applications of other
+// clusters are processed by the shard of their cluster.
+func (s *ClusterSharding) IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool {
+	// applicationShardedClusters is not modified after the creation of the sharding cache
+	if c == nil || !s.applicationShardedClusters[c.Server] {
+		return s.IsManagedCluster(c)
+	}
+	return GetApplicationShard(a, s.Replicas, s.applicationShardingKey) == s.Shard
+}
+
+// IsApplicationShardedCluster returns whether the applications of the given cluster, instead of the cluster itself,
+// are distributed across the shards
+func (s *ClusterSharding) IsApplicationShardedCluster(server string) bool {
+	// applicationShardedClusters is not modified after the creation of the sharding cache
+	return s.applicationShardedClusters[server]
+}
+
 func (sharding *ClusterSharding) Init(clusters *v1alpha1.ClusterList, apps *v1alpha1.ApplicationList) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
@@ -93,7 +137,7 @@ func (sharding *ClusterSharding) Add(c *v1alpha1.Cluster) {
 	defer sharding.lock.Unlock()
 
 	old, ok := sharding.Clusters[c.Server]
-	sharding.Clusters[c.Server] = c
+	sharding.Clusters[c.Server] = withClusterInfo(c, old)
 	if !ok || hasShardingUpdates(old, c) {
 		sharding.updateDistribution()
 	} else {
@@ -115,11 +159,12 @@ func (sharding *ClusterSharding) Update(oldCluster *v1alpha1.Cluster, newCluster
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 
-	if _, ok := sharding.Clusters[oldCluster.Server]; ok && oldCluster.Server != newCluster.Server {
+	existing, ok := sharding.Clusters[oldCluster.Server]
+	if ok && oldCluster.Server != newCluster.Server {
 		delete(sharding.Clusters, oldCluster.Server)
 		delete(sharding.Shards, oldCluster.Server)
 	}
-	sharding.Clusters[newCluster.Server] = newCluster
+	sharding.Clusters[newCluster.Server] = withClusterInfo(newCluster, existing)
 	if hasShardingUpdates(oldCluster, newCluster) {
 		sharding.updateDistribution()
 	} else {
@@ -139,8 +184,58 @@ func (sharding *ClusterSharding) GetDistribution() map[string]int {
 	return distribution
 }
 
+// UpdateClusterInfo updates the info of a cluster, such as its resources count, which is used by the
+// resource-weighted distribution to weight the cluster. The resource-weighted distribution only reweights the cluster
+// once its weight changed by more than RebalanceThreshold percent since it was last weighted, so that small changes of
+// the weight do not move clusters between shards.
+func (sharding *ClusterSharding) UpdateClusterInfo(clusterServer string, info *v1alpha1.ClusterInfo) {
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	c, ok := sharding.Clusters[clusterServer]
+	if !ok || info == nil {
+		return
+	}
+	if sharding.weighted && !clusterWeightChanged(c.Info.CacheInfo, info.CacheInfo, RebalanceThreshold) {
+		return
+	}
+	updated := c.DeepCopy()
+	updated.Info = *info
+	sharding.Clusters[clusterServer] = updated
+	if sharding.weighted {
+		sharding.updateDistribution()
+	}
+}
+
+// clusterWeightChanged returns whether the weight of a cluster with the given cache info changed by more than the
+// given percentage
+func clusterWeightChanged(previous v1alpha1.ClusterCacheInfo, current v1alpha1.ClusterCacheInfo, threshold int) bool {
+	previousWeight := previous.ResourcesCount*clusterResourceWeight + previous.APIsCount*clusterAPIWeight
+	currentWeight := current.ResourcesCount*clusterResourceWeight + current.APIsCount*clusterAPIWeight
+	diff := currentWeight - previousWeight
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff > 0 && float64(diff) >= float64(previousWeight)*float64(threshold)/100
+}
+
+// withClusterInfo returns the given cluster with the info of the previously known cluster, since the info is not
+// part of the cluster secret
+func withClusterInfo(c *v1alpha1.Cluster, previous *v1alpha1.Cluster) *v1alpha1.Cluster {
+	if previous == nil || c.Info.ConnectionState.ModifiedAt != nil {
+		return c
+	}
+	withInfo := c.DeepCopy()
+	withInfo.Info = previous.Info
+	return withInfo
+}
+
 func (sharding *ClusterSharding) updateDistribution() {
 	for k, c := range sharding.Clusters {
+		if sharding.applicationShardedClusters[k] {
+			// the cluster is processed by all shards
+			delete(sharding.Shards, k)
+			continue
+		}
 		shard := 0
 		if c.Shard != nil {
 			requestedShard := int(*c.Shard)
@@ -193,6 +288,9 @@ func (d *ClusterSharding) getClusterAccessor() clusterAccessor {
 		// no need to lock, as this is only called from the updateDistribution function
 		clusters := make([]*v1alpha1.Cluster, 0, len(d.Clusters))
 		for _, c := range d.Clusters {
+			if d.applicationShardedClusters[c.Server] {
+				continue
+			}
 			clusters = append(clusters, c)
 		}
 		return clusters
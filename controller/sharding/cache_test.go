@@ -1,9 +1,11 @@
 package sharding
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
@@ -509,3 +511,106 @@ func TestHasShardingUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterSharding_UpdateClusterInfo(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	sharding := NewClusterSharding(db, 0, 2, "resource-weighted").(*ClusterSharding)
+
+	clusterA := &v1alpha1.Cluster{ID: "1", Server: "https://127.0.0.1:6443"}
+	clusterB := &v1alpha1.Cluster{ID: "2", Server: "https://kubernetes.default.svc"}
+	clusterC := &v1alpha1.Cluster{ID: "3", Server: "https://1.1.1.1"}
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{*clusterA, *clusterB, *clusterC}}, &v1alpha1.ApplicationList{})
+
+	sharding.UpdateClusterInfo(clusterA.Server, &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 10000}})
+	distribution := sharding.GetDistribution()
+	assert.NotEqual(t, distribution[clusterA.Server], distribution[clusterB.Server])
+	assert.Equal(t, distribution[clusterB.Server], distribution[clusterC.Server])
+
+	// the info is kept when the cluster secret is updated
+	updatedClusterA := clusterA.DeepCopy()
+	updatedClusterA.Name = "updated"
+	sharding.Update(clusterA, updatedClusterA)
+	assert.Equal(t, int64(10000), sharding.Clusters[clusterA.Server].Info.CacheInfo.ResourcesCount)
+
+	// unknown clusters are ignored
+	sharding.UpdateClusterInfo("https://unknown", &v1alpha1.ClusterInfo{})
+	assert.NotContains(t, sharding.Clusters, "https://unknown")
+}
+
+func TestClusterSharding_UpdateClusterInfoThreshold(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	sharding := NewClusterSharding(db, 0, 2, "resource-weighted").(*ClusterSharding)
+
+	clusterA := &v1alpha1.Cluster{ID: "1", Server: "https://127.0.0.1:6443"}
+	clusterB := &v1alpha1.Cluster{ID: "2", Server: "https://kubernetes.default.svc"}
+	clusterC := &v1alpha1.Cluster{ID: "3", Server: "https://1.1.1.1"}
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{*clusterA, *clusterB, *clusterC}}, &v1alpha1.ApplicationList{})
+	sharding.UpdateClusterInfo(clusterA.Server, &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 1000}})
+	sharding.UpdateClusterInfo(clusterB.Server, &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 1000}})
+	sharding.UpdateClusterInfo(clusterC.Server, &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 1000}})
+	distribution := sharding.GetDistribution()
+
+	// a weight change below the threshold does not reweight the cluster nor reassign any cluster
+	for _, count := range []int64{1010, 1050, 1100, 1150, 1190} {
+		sharding.UpdateClusterInfo(clusterA.Server, &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: count}})
+		assert.Equal(t, int64(1000), sharding.Clusters[clusterA.Server].Info.CacheInfo.ResourcesCount)
+		assert.Equal(t, distribution, sharding.GetDistribution())
+	}
+
+	// a weight change above the threshold reweights the cluster
+	sharding.UpdateClusterInfo(clusterA.Server, &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 1500}})
+	assert.Equal(t, int64(1500), sharding.Clusters[clusterA.Server].Info.CacheInfo.ResourcesCount)
+}
+
+func TestClusterWeightChanged(t *testing.T) {
+	assert.False(t, clusterWeightChanged(v1alpha1.ClusterCacheInfo{ResourcesCount: 100}, v1alpha1.ClusterCacheInfo{ResourcesCount: 100}, 0))
+	assert.True(t, clusterWeightChanged(v1alpha1.ClusterCacheInfo{ResourcesCount: 100}, v1alpha1.ClusterCacheInfo{ResourcesCount: 101}, 0))
+	assert.False(t, clusterWeightChanged(v1alpha1.ClusterCacheInfo{ResourcesCount: 100}, v1alpha1.ClusterCacheInfo{ResourcesCount: 119}, 20))
+	assert.True(t, clusterWeightChanged(v1alpha1.ClusterCacheInfo{ResourcesCount: 100}, v1alpha1.ClusterCacheInfo{ResourcesCount: 80}, 20))
+	assert.True(t, clusterWeightChanged(v1alpha1.ClusterCacheInfo{}, v1alpha1.ClusterCacheInfo{APIsCount: 1}, 20))
+}
+
+func TestClusterSharding_IsManagedApp(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	ApplicationShardedClusters = []string{"https://kubernetes.default.svc"}
+	defer func() { ApplicationShardedClusters = []string{} }()
+	shard0 := NewClusterSharding(db, 0, 2, "round-robin").(*ClusterSharding)
+	shard1 := NewClusterSharding(db, 1, 2, "round-robin").(*ClusterSharding)
+
+	inCluster := &v1alpha1.Cluster{ID: "1", Server: "https://kubernetes.default.svc"}
+	clusterA := &v1alpha1.Cluster{ID: "2", Server: "https://127.0.0.1:6443"}
+	clusters := &v1alpha1.ClusterList{Items: []v1alpha1.Cluster{*inCluster, *clusterA}}
+	shard0.Init(clusters, &v1alpha1.ApplicationList{})
+	shard1.Init(clusters, &v1alpha1.ApplicationList{})
+
+	// the application-sharded cluster is cached by all shards and is not part of the cluster distribution
+	assert.True(t, shard0.IsManagedCluster(inCluster))
+	assert.True(t, shard1.IsManagedCluster(inCluster))
+	assert.NotContains(t, shard0.GetDistribution(), inCluster.Server)
+	assert.Equal(t, 0, shard0.GetDistribution()[clusterA.Server])
+
+	// each application of the application-sharded cluster is processed by exactly one shard
+	managed := map[int]int{}
+	for i := 0; i < 20; i++ {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("app-%d", i), Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: inCluster.Server}},
+		}
+		assert.NotEqual(t, shard0.IsManagedApp(app, inCluster), shard1.IsManagedApp(app, inCluster))
+		if shard0.IsManagedApp(app, inCluster) {
+			managed[0]++
+		} else {
+			managed[1]++
+		}
+	}
+	assert.Positive(t, managed[0])
+	assert.Positive(t, managed[1])
+
+	// the applications of other clusters are processed by the shard of their cluster
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: clusterA.Server}},
+	}
+	assert.True(t, shard0.IsManagedApp(app, clusterA))
+	assert.False(t, shard1.IsManagedApp(app, clusterA))
+}
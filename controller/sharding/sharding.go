@@ -39,6 +39,23 @@ var heartbeatCurrentTime = metav1.Now
 var (
 	HeartbeatDuration = env.ParseNumFromEnv(common.EnvControllerHeartbeatTime, 10, 10, 60)
 	HeartbeatTimeout  = 3 * HeartbeatDuration
+	// RebalanceThreshold is the percentage by which the load of the most and the least loaded shards may differ
+	// from the average load before the resource-weighted distribution reassigns clusters
+	RebalanceThreshold = env.ParseNumFromEnv(common.EnvControllerShardingRebalanceThreshold, 20, 0, 100)
+	// ApplicationShardedClusters are the servers of the clusters whose applications, instead of the clusters
+	// themselves, are distributed across the shards. Every shard caches these clusters.
+	ApplicationShardedClusters = env.StringsFromEnv(common.EnvControllerApplicationShardingClusters, []string{}, ",")
+	// ApplicationShardingKey is the key used to distribute the applications of the application-sharded clusters
+	ApplicationShardingKey = env.StringFromEnv(common.EnvControllerApplicationShardingKey, common.ApplicationShardingKeyApplication)
+)
+
+const (
+	// clusterResourceWeight is the weight of a single cached resource of a cluster
+	clusterResourceWeight = 1
+	// clusterAPIWeight is the weight of a single API watched in a cluster
+	clusterAPIWeight = 10
+	// clusterAppWeight is the weight of a single application targeting a cluster
+	clusterAppWeight = 100
 )
 
 const ShardControllerMappingKey = "shardControllerMapping"
@@ -91,6 +108,8 @@ func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardin
 		distributionFunction = LegacyDistributionFunction(replicasCount)
 	case common.ConsistentHashingWithBoundedLoadsAlgorithm:
 		distributionFunction = ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, replicasCount)
+	case common.ResourceWeightedShardingAlgorithm:
+		distributionFunction = ResourceWeightedDistributionFunction(clusters, apps, replicasCount, RebalanceThreshold)
 	default:
 		log.Warnf("distribution type %s is not supported, defaulting to %s", shardingAlgorithm, common.DefaultShardingAlgorithm)
 	}
@@ -131,6 +150,40 @@ func LegacyDistributionFunction(replicas int) DistributionFunction {
 	}
 }
 
+// ValidateApplicationShardingKey returns an error if the given key to distribute the applications of
+// application-sharded clusters is not supported
+func ValidateApplicationShardingKey(shardingKey string) error {
+	switch shardingKey {
+	case common.ApplicationShardingKeyApplication, common.ApplicationShardingKeyNamespace:
+		return nil
+	}
+	return fmt.Errorf("application sharding key %q is not supported, must be one of: %s, %s", shardingKey, common.ApplicationShardingKeyApplication, common.ApplicationShardingKeyNamespace)
+}
+
+// ShardingDestination returns the destination whose cluster determines the shard processing the given application:
+// an application with multiple destinations is processed by the shard of its first destination
+func ShardingDestination(a *v1alpha1.Application) v1alpha1.ApplicationDestination {
+	return a.Spec.GetDestinations()[0]
+}
+
+// GetApplicationShard returns the shard processing the given application of an application-sharded cluster.
+// The shard is derived from a hash of the application's qualified name or, if shardingKey is namespace, of the
+// namespace of its sharding destination, so that it is stable and can be computed by every shard independently.
+func GetApplicationShard(a *v1alpha1.Application, replicas int, shardingKey string) int {
+	if replicas <= 1 {
+		return 0
+	}
+	key := a.QualifiedName()
+	if namespace := ShardingDestination(a).Namespace; shardingKey == common.ApplicationShardingKeyNamespace && namespace != "" {
+		key = namespace
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	shard := int(h.Sum32() % uint32(replicas))
+	log.Debugf("Application %s will be processed by shard %d", a.QualifiedName(), shard)
+	return shard
+}
+
 // RoundRobinDistributionFunction returns a DistributionFunction using an homogeneous distribution algorithm:
 // for a given cluster the function will return the shard number based on the modulo of the cluster rank in
 // the cluster's list sorted by uid on the shard number.
@@ -251,6 +304,86 @@ func getAppDistribution(getCluster clusterAccessor, getApps appAccessor) map[str
 	return appDistribution
 }
 
+// ResourceWeightedDistributionFunction returns a DistributionFunction using a load aware distribution algorithm:
+// each cluster is weighted by the number of its cached resources and APIs and by the number of applications
+// targeting it, and clusters are assigned to shards so that each shard gets a similar total weight.
+// The assignment only depends on the clusters and applications, so that all the replicas compute the same one.
+// To avoid reshuffling clusters each time their weight changes, each cluster is assigned to the shard its ID hashes
+// to, unless the load of that shard would exceed the average load by more than rebalanceThreshold percent.
+func ResourceWeightedDistributionFunction(clusters clusterAccessor, apps appAccessor, replicas int, rebalanceThreshold int) DistributionFunction {
+	return func(c *v1alpha1.Cluster) int {
+		if replicas > 0 {
+			if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+				return 0
+			}
+			// if Shard is manually set and the assigned value is lower than the number of replicas,
+			// then its value is returned otherwise it is the default calculated value
+			if c.Shard != nil && int(*c.Shard) < replicas {
+				return int(*c.Shard)
+			}
+			shardIndexedByCluster := createResourceWeightedDistribution(replicas, rebalanceThreshold, clusters, apps)
+			shard, ok := shardIndexedByCluster[c.ID]
+			if !ok {
+				log.Warnf("Cluster with id=%s not found in cluster map.", c.ID)
+				return -1
+			}
+			log.Debugf("Cluster with id=%s will be processed by shard %d", c.ID, shard)
+			return shard
+		}
+		log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+		return -1
+	}
+}
+
+// GetClusterWeight returns the weight of a cluster used by the resource-weighted distribution
+func GetClusterWeight(c *v1alpha1.Cluster, appsCount int64) int64 {
+	weight := c.Info.CacheInfo.ResourcesCount*clusterResourceWeight + c.Info.CacheInfo.APIsCount*clusterAPIWeight + appsCount*clusterAppWeight
+	// clusters without resources nor applications still need to be monitored
+	if weight < 1 {
+		weight = 1
+	}
+	return weight
+}
+
+func createResourceWeightedDistribution(replicas int, rebalanceThreshold int, getCluster clusterAccessor, getApps appAccessor) map[string]int {
+	clusters := getSortedClustersList(getCluster)
+	appDistribution := getAppDistribution(getCluster, getApps)
+	shardIndexedByCluster := make(map[string]int, len(clusters))
+	weights := make(map[string]int64, len(clusters))
+	loads := make([]int64, replicas)
+	var total int64
+	var unassigned []*v1alpha1.Cluster
+	for _, c := range clusters {
+		weights[c.ID] = GetClusterWeight(c, appDistribution[c.Server])
+		total += weights[c.ID]
+		if c.Shard != nil && int(*c.Shard) < replicas {
+			shardIndexedByCluster[c.ID] = int(*c.Shard)
+			loads[*c.Shard] += weights[c.ID]
+			continue
+		}
+		unassigned = append(unassigned, c)
+	}
+
+	maxLoad := float64(total) / float64(replicas) * (1 + float64(rebalanceThreshold)/100)
+	// clusters are sorted by ID, so that the assignment does not depend on the order of the clusters
+	for _, c := range unassigned {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(c.ID))
+		shard := int(h.Sum32() % uint32(replicas))
+		if float64(loads[shard]+weights[c.ID]) > maxLoad {
+			// the preferred shard is full, fall back to the least loaded shard
+			for i := range loads {
+				if loads[i] < loads[shard] {
+					shard = i
+				}
+			}
+		}
+		shardIndexedByCluster[c.ID] = shard
+		loads[shard] += weights[c.ID]
+	}
+	return shardIndexedByCluster
+}
+
 // NoShardingDistributionFunction returns a DistributionFunction that will process all cluster by shard 0
 // the function is created for API compatibility purposes and is not supposed to be activated.
 func NoShardingDistributionFunction() DistributionFunction {
@@ -459,6 +592,9 @@ func getDefaultShardMappingData(replicas int) []shardApplicationControllerMappin
 }
 
 func GetClusterSharding(kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager, shardingAlgorithm string, enableDynamicClusterDistribution bool) (ClusterShardingCache, error) {
+	if err := ValidateApplicationShardingKey(ApplicationShardingKey); err != nil {
+		return nil, err
+	}
 	var replicasCount int
 	if enableDynamicClusterDistribution {
 		applicationControllerName := env.StringFromEnv(common.EnvAppControllerName, common.DefaultApplicationControllerName)
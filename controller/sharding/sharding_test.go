@@ -379,6 +379,140 @@ func TestConsistentHashingWhenClusterWithFixedShard(t *testing.T) {
 	assert.Equal(t, fixedShard, int64(distributionFunction(cluster)))
 }
 
+func createWeightedCluster(name string, id string, resourcesCount int64) v1alpha1.Cluster {
+	cluster := createCluster(name, id)
+	cluster.Info.CacheInfo.ResourcesCount = resourcesCount
+	return cluster
+}
+
+func TestResourceWeightedDistribution(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		createWeightedCluster("cluster-01", "01", 100000),
+		createWeightedCluster("cluster-02", "02", 40000),
+		createWeightedCluster("cluster-03", "03", 30000),
+		createWeightedCluster("cluster-04", "04", 20000),
+		createWeightedCluster("cluster-05", "05", 10000),
+	}
+	appAccessor := getAppAccessor(nil)
+	replicasCount := 2
+	distributionFunction := ResourceWeightedDistributionFunction(getClusterAccessor(clusters), appAccessor, replicasCount, 20)
+	assert.Equal(t, 0, distributionFunction(nil))
+
+	// clusters are assigned to the shard their ID hashes to, unless it would exceed the average load by more than 20%
+	shardLoads := map[int]int64{}
+	for i := range clusters {
+		shardLoads[distributionFunction(&clusters[i])] += clusters[i].Info.CacheInfo.ResourcesCount
+	}
+	assert.Equal(t, map[int]int64{0: 110000, 1: 90000}, shardLoads)
+
+	// unknown clusters are not assigned
+	removedCluster := createWeightedCluster("cluster-06", "06", 1)
+	assert.Equal(t, -1, distributionFunction(&removedCluster))
+}
+
+func TestResourceWeightedDistribution_Hysteresis(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		createWeightedCluster("cluster-01", "01", 1000),
+		createWeightedCluster("cluster-02", "02", 1000),
+		createWeightedCluster("cluster-03", "03", 1000),
+		createWeightedCluster("cluster-04", "04", 1000),
+	}
+	appAccessor := getAppAccessor(nil)
+	distributionFunction := ResourceWeightedDistributionFunction(getClusterAccessor(clusters), appAccessor, 2, 20)
+	assignments := map[string]int{}
+	for i := range clusters {
+		assignments[clusters[i].ID] = distributionFunction(&clusters[i])
+	}
+
+	// a small weight change does not reshuffle clusters
+	clusters[0].Info.CacheInfo.ResourcesCount = 1100
+	for i := range clusters {
+		assert.Equal(t, assignments[clusters[i].ID], distributionFunction(&clusters[i]))
+	}
+
+	// a large weight change rebalances clusters
+	clusters[0].Info.CacheInfo.ResourcesCount = 3000
+	shardLoads := map[int]int64{}
+	for i := range clusters {
+		shardLoads[distributionFunction(&clusters[i])] += clusters[i].Info.CacheInfo.ResourcesCount
+	}
+	assert.Equal(t, map[int]int64{0: 3000, 1: 3000}, shardLoads)
+}
+
+func TestResourceWeightedDistribution_SameAssignmentOnAllReplicas(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		createWeightedCluster("cluster-01", "01", 1000),
+		createWeightedCluster("cluster-02", "02", 2000),
+		createWeightedCluster("cluster-03", "03", 3000),
+		createWeightedCluster("cluster-04", "04", 4000),
+	}
+	replica := ResourceWeightedDistributionFunction(getClusterAccessor(clusters), getAppAccessor(nil), 2, 20)
+	for i := range clusters {
+		replica(&clusters[i])
+	}
+	clusters[3].Info.CacheInfo.ResourcesCount = 10000
+
+	// a replica started after the weights changed computes the same assignment as a replica which was running before
+	restartedReplica := ResourceWeightedDistributionFunction(getClusterAccessor(clusters), getAppAccessor(nil), 2, 20)
+	for i := range clusters {
+		assert.Equal(t, replica(&clusters[i]), restartedReplica(&clusters[i]))
+	}
+}
+
+func TestResourceWeightedDistribution_FixedShard(t *testing.T) {
+	var fixedShard int64 = 1
+	cluster := createWeightedCluster("cluster-01", "01", 1000)
+	cluster.Shard = &fixedShard
+	clusters := []v1alpha1.Cluster{cluster, createWeightedCluster("cluster-02", "02", 1000)}
+	distributionFunction := ResourceWeightedDistributionFunction(getClusterAccessor(clusters), getAppAccessor(nil), 2, 20)
+	assert.Equal(t, 1, distributionFunction(&clusters[0]))
+	assert.Equal(t, 0, distributionFunction(&clusters[1]))
+}
+
+func TestGetClusterWeight(t *testing.T) {
+	cluster := createCluster("cluster-01", "01")
+	assert.Equal(t, int64(1), GetClusterWeight(&cluster, 0))
+	cluster.Info.CacheInfo.ResourcesCount = 500
+	cluster.Info.CacheInfo.APIsCount = 10
+	assert.Equal(t, int64(500+10*clusterAPIWeight+2*clusterAppWeight), GetClusterWeight(&cluster, 2))
+}
+
+func TestGetApplicationShard(t *testing.T) {
+	newApp := func(name, destNamespace string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Namespace: destNamespace}},
+		}
+	}
+	t.Run("SingleReplica", func(t *testing.T) {
+		assert.Equal(t, 0, GetApplicationShard(newApp("app", "default"), 1, common.ApplicationShardingKeyApplication))
+	})
+	t.Run("Stable", func(t *testing.T) {
+		app := newApp("app", "default")
+		assert.Equal(t, GetApplicationShard(app, 3, common.ApplicationShardingKeyApplication), GetApplicationShard(app.DeepCopy(), 3, common.ApplicationShardingKeyApplication))
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			app := newApp(fmt.Sprintf("app-%d", i), "team-a")
+			assert.Equal(t, GetApplicationShard(newApp("app", "team-a"), 3, common.ApplicationShardingKeyNamespace), GetApplicationShard(app, 3, common.ApplicationShardingKeyNamespace))
+		}
+	})
+	t.Run("MultipleDestinations", func(t *testing.T) {
+		// an application with multiple destinations is sharded by the namespace of its first destination
+		for i := 0; i < 10; i++ {
+			app := newApp(fmt.Sprintf("app-%d", i), "")
+			app.Spec.Destinations = v1alpha1.ApplicationDestinations{{Server: "https://a", Namespace: "team-a"}, {Server: "https://b", Namespace: "team-b"}}
+			assert.Equal(t, GetApplicationShard(newApp("app", "team-a"), 3, common.ApplicationShardingKeyNamespace), GetApplicationShard(app, 3, common.ApplicationShardingKeyNamespace))
+		}
+	})
+}
+
+func TestValidateApplicationShardingKey(t *testing.T) {
+	assert.NoError(t, ValidateApplicationShardingKey(common.ApplicationShardingKeyApplication))
+	assert.NoError(t, ValidateApplicationShardingKey(common.ApplicationShardingKeyNamespace))
+	assert.ErrorContains(t, ValidateApplicationShardingKey("cluster"), "not supported")
+}
+
 func TestGetShardByIndexModuloReplicasCountDistributionFunction(t *testing.T) {
 	clusters, db, cluster1, cluster2, _, _, _ := createTestClusters()
 	replicasCount := 2
@@ -1,12 +1,25 @@
 package controller
 
 import (
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
+// defaultDeletionWaveTimeout is the amount of time to wait for the resources of a deletion wave to be gone before
+// reporting them as blocked, unless set by the deletion-wave-timeout annotation of the application
+const defaultDeletionWaveTimeout = 10 * time.Minute
+
 type syncWaveSorter []*unstructured.Unstructured
 
 func (s syncWaveSorter) Len() int {
@@ -18,7 +31,31 @@ func (s syncWaveSorter) Swap(i, j int) {
 }
 
 func (s syncWaveSorter) Less(i, j int) bool {
-	return syncwaves.Wave(s[i]) < syncwaves.Wave(s[j])
+	return deletionWave(s[i]) < deletionWave(s[j])
+}
+
+// deletionWave returns the wave in which the given resource is deleted, set by the deletion-wave annotation or
+// defaulting to its sync wave
+func deletionWave(obj *unstructured.Unstructured) int {
+	if text, ok := obj.GetAnnotations()[common.AnnotationKeyDeletionWave]; ok {
+		if wave, err := strconv.Atoi(text); err == nil {
+			return wave
+		}
+	}
+	return syncwaves.Wave(obj)
+}
+
+// warnInvalidDeletionWaves logs the resources which deletion-wave annotation is not an integer, and which are deleted
+// in their sync wave instead
+func warnInvalidDeletionWaves(objs []*unstructured.Unstructured, logCtx *log.Entry) {
+	for _, obj := range objs {
+		if text, ok := obj.GetAnnotations()[common.AnnotationKeyDeletionWave]; ok {
+			if _, err := strconv.Atoi(text); err != nil {
+				key := kube.GetResourceKey(obj)
+				logCtx.Warnf("Ignoring invalid deletion wave %q of %s, deleting it in its sync wave %d", text, key.String(), syncwaves.Wave(obj))
+			}
+		}
+	}
 }
 
 func FilterObjectsForDeletion(objs []*unstructured.Unstructured) []*unstructured.Unstructured {
@@ -28,13 +65,82 @@ func FilterObjectsForDeletion(objs []*unstructured.Unstructured) []*unstructured
 
 	sort.Sort(sort.Reverse(syncWaveSorter(objs)))
 
-	currentSyncWave := syncwaves.Wave(objs[0])
+	currentWave := deletionWave(objs[0])
 	filteredObjs := make([]*unstructured.Unstructured, 0)
 	for _, obj := range objs {
-		if syncwaves.Wave(obj) != currentSyncWave {
+		if deletionWave(obj) != currentWave {
 			break
 		}
 		filteredObjs = append(filteredObjs, obj)
 	}
 	return filteredObjs
 }
+
+// getDeletionWaveTimeout returns the amount of time to wait for the resources of a deletion wave to be gone
+func getDeletionWaveTimeout(app *appv1.Application) time.Duration {
+	timeout, err := parseTimeout(app.GetAnnotations()[common.AnnotationKeyDeletionWaveTimeout])
+	if err != nil {
+		getAppLog(app).Warnf("Ignoring invalid deletion wave timeout: %v", err)
+		return defaultDeletionWaveTimeout
+	}
+	if timeout == 0 {
+		return defaultDeletionWaveTimeout
+	}
+	return timeout
+}
+
+// waitForDeletionWave reports the progress of the given resources pending deletion in the conditions of the application,
+// and returns an error describing the finalizers blocking the resources which were not gone within the timeout
+func (ctrl *ApplicationController) waitForDeletionWave(app *appv1.Application, destination string, pending []*unstructured.Unstructured, remaining int, now time.Time) error {
+	timeout := getDeletionWaveTimeout(app)
+	wave := deletionWave(pending[0])
+	var blocked []string
+	for _, obj := range pending {
+		wave = max(wave, deletionWave(obj))
+		if now.Sub(obj.GetDeletionTimestamp().Time) < timeout {
+			continue
+		}
+		key := kube.GetResourceKey(obj)
+		if finalizers := obj.GetFinalizers(); len(finalizers) > 0 {
+			blocked = append(blocked, fmt.Sprintf("%s (finalizers: %s)", key.String(), strings.Join(finalizers, ", ")))
+		} else {
+			blocked = append(blocked, key.String())
+		}
+	}
+	if len(blocked) > 0 {
+		sort.Strings(blocked)
+		return fmt.Errorf("deletion wave %d timed out after %s waiting for %d resources to be deleted: %s", wave, timeout, len(blocked), strings.Join(blocked, "; "))
+	}
+	ctrl.setDeletionProgress(app, destination, fmt.Sprintf("Waiting for %d resources of deletion wave %d to be deleted, %d resources remaining in later waves", len(pending), wave, remaining))
+	return nil
+}
+
+// setDeletionProgress reports the progress of the cascaded deletion in the conditions of the application, clearing the
+// deletion error reported by a previous attempt. The message is prefixed with the destination being deleted, if any, for
+// applications with multiple destinations.
+func (ctrl *ApplicationController) setDeletionProgress(app *appv1.Application, destination string, message string) {
+	if destination != "" {
+		message = fmt.Sprintf("%s: %s", destination, message)
+	}
+	evaluatedTypes := map[appv1.ApplicationConditionType]bool{
+		appv1.ApplicationConditionDeletionProgress: true,
+		appv1.ApplicationConditionDeletionError:    true,
+	}
+	upToDate := false
+	for _, c := range app.Status.Conditions {
+		if c.Type == appv1.ApplicationConditionDeletionError {
+			upToDate = false
+			break
+		}
+		if c.Type == appv1.ApplicationConditionDeletionProgress && c.Message == message {
+			upToDate = true
+		}
+	}
+	if upToDate {
+		return
+	}
+	app.Status.SetConditions([]appv1.ApplicationCondition{{Type: appv1.ApplicationConditionDeletionProgress, Message: message}}, evaluatedTypes)
+	if err := ctrl.patchAppConditions(app); err != nil {
+		getAppLog(app).Errorf("Unable to set deletion progress: %v", err)
+	}
+}
@@ -3,10 +3,20 @@ package controller
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	log "github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cdcommon "github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 func TestFilterObjectsForDeletion(t *testing.T) {
@@ -39,3 +49,79 @@ func sliceOfObjectsWithSyncWaves(waves []string) []*unstructured.Unstructured {
 	}
 	return objects
 }
+
+func TestFilterObjectsForDeletion_DeletionWave(t *testing.T) {
+	first := Annotate(podWithSyncWave("1"), cdcommon.AnnotationKeyDeletionWave, "10")
+	second := podWithSyncWave("5")
+	third := Annotate(podWithSyncWave("7"), cdcommon.AnnotationKeyDeletionWave, "-1")
+	invalid := Annotate(podWithSyncWave("10"), cdcommon.AnnotationKeyDeletionWave, "last")
+
+	assert.Equal(t, []*unstructured.Unstructured{first, invalid}, FilterObjectsForDeletion([]*unstructured.Unstructured{second, first, third, invalid}))
+	assert.Equal(t, []*unstructured.Unstructured{second}, FilterObjectsForDeletion([]*unstructured.Unstructured{third, second}))
+}
+
+func TestWarnInvalidDeletionWaves(t *testing.T) {
+	logger, hook := logtest.NewNullLogger()
+	invalid := Annotate(podWithSyncWave("10"), cdcommon.AnnotationKeyDeletionWave, "last")
+	warnInvalidDeletionWaves([]*unstructured.Unstructured{Annotate(podWithSyncWave("1"), cdcommon.AnnotationKeyDeletionWave, "10"), invalid}, log.NewEntry(logger))
+	require.Len(t, hook.Entries, 1)
+	assert.Equal(t, log.WarnLevel, hook.LastEntry().Level)
+	assert.Equal(t, `Ignoring invalid deletion wave "last" of /Pod//my-pod, deleting it in its sync wave 10`, hook.LastEntry().Message)
+}
+
+func TestGetDeletionWaveTimeout(t *testing.T) {
+	app := newFakeApp()
+	assert.Equal(t, defaultDeletionWaveTimeout, getDeletionWaveTimeout(app))
+
+	app.Annotations = map[string]string{cdcommon.AnnotationKeyDeletionWaveTimeout: "90"}
+	assert.Equal(t, 90*time.Second, getDeletionWaveTimeout(app))
+
+	app.Annotations = map[string]string{cdcommon.AnnotationKeyDeletionWaveTimeout: "1h"}
+	assert.Equal(t, time.Hour, getDeletionWaveTimeout(app))
+
+	app.Annotations = map[string]string{cdcommon.AnnotationKeyDeletionWaveTimeout: "never"}
+	assert.Equal(t, defaultDeletionWaveTimeout, getDeletionWaveTimeout(app))
+}
+
+func TestWaitForDeletionWave(t *testing.T) {
+	now := time.Now()
+	pendingPod := func(name string, wave string, deletedAt time.Time, finalizers ...string) *unstructured.Unstructured {
+		pod := Annotate(NewPod(), cdcommon.AnnotationKeyDeletionWave, wave)
+		pod.SetName(name)
+		pod.SetNamespace("fake-ns")
+		pod.SetDeletionTimestamp(&metav1.Time{Time: deletedAt})
+		pod.SetFinalizers(finalizers)
+		return pod
+	}
+
+	t.Run("InProgress", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionDeletionError, Message: "previous error"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		err := ctrl.waitForDeletionWave(app, "", []*unstructured.Unstructured{pendingPod("a", "1", now.Add(-time.Minute)), pendingPod("b", "2", now)}, 3, now)
+		require.NoError(t, err)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionDeletionProgress, app.Status.Conditions[0].Type)
+		assert.Equal(t, "Waiting for 2 resources of deletion wave 2 to be deleted, 3 resources remaining in later waves", app.Status.Conditions[0].Message)
+	})
+
+	t.Run("Destination", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		err := ctrl.waitForDeletionWave(app, "in-cluster/guestbook", []*unstructured.Unstructured{pendingPod("a", "1", now)}, 0, now)
+		require.NoError(t, err)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, "in-cluster/guestbook: Waiting for 1 resources of deletion wave 1 to be deleted, 0 resources remaining in later waves", app.Status.Conditions[0].Message)
+	})
+
+	t.Run("TimedOut", func(t *testing.T) {
+		app := newFakeApp()
+		app.Annotations = map[string]string{cdcommon.AnnotationKeyDeletionWaveTimeout: "5m"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		err := ctrl.waitForDeletionWave(app, "", []*unstructured.Unstructured{
+			pendingPod("a", "1", now.Add(-10*time.Minute), "kubernetes.io/pvc-protection", "example.com/cleanup"),
+			pendingPod("b", "1", now.Add(-time.Minute)),
+		}, 0, now)
+		require.EqualError(t, err, "deletion wave 1 timed out after 5m0s waiting for 1 resources to be deleted: /Pod/fake-ns/a (finalizers: kubernetes.io/pvc-protection, example.com/cleanup)")
+	})
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -39,14 +41,19 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/manifestpolicy"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 	"github.com/argoproj/argo-cd/v2/util/stats"
 )
 
 var CompareStateRepoError = errors.New("failed to get repo objects")
 
+// manifestPolicyConditionsLimit is the maximum number of manifest policy violations reported as conditions of each type
+const manifestPolicyConditionsLimit = 10
+
 type resourceInfoProviderStub struct{}
 
 func (r *resourceInfoProviderStub) IsNamespaced(_ schema.GroupKind) (bool, error) {
@@ -68,9 +75,9 @@ type managedResource struct {
 
 // AppStateManager defines methods which allow to compare application spec and actual application state.
 type AppStateManager interface {
-	CompareAppState(app *v1alpha1.Application, project *v1alpha1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localObjects []string, hasMultipleSources bool, rollback bool) (*comparisonResult, error)
+	CompareAppState(ctx context.Context, app *v1alpha1.Application, project *v1alpha1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localObjects []string, hasMultipleSources bool, rollback bool) (*comparisonResult, error)
 	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
-	GetRepoObjs(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject, rollback bool) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, bool, error)
+	GetRepoObjs(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject, rollback bool) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, bool, error)
 }
 
 // comparisonResult holds the state of an application after the reconciliation
@@ -88,7 +95,22 @@ type comparisonResult struct {
 	timings            map[string]time.Duration
 	diffResultList     *diff.DiffResultList
 	hasPostDeleteHooks bool
+	hasPreDeleteHooks  bool
 	revisionUpdated    bool
+	// destinations holds the results of the comparison of each destination of an application with multiple destinations
+	destinations []destinationComparisonResult
+	// destinationStatuses holds the state of each destination of an application with multiple destinations
+	destinationStatuses []v1alpha1.ApplicationDestinationStatus
+}
+
+// comparisonConditionTypes are the types of the application conditions set by the comparison of its state
+var comparisonConditionTypes = map[v1alpha1.ApplicationConditionType]bool{
+	v1alpha1.ApplicationConditionComparisonError:         true,
+	v1alpha1.ApplicationConditionSharedResourceWarning:   true,
+	v1alpha1.ApplicationConditionRepeatedResourceWarning: true,
+	v1alpha1.ApplicationConditionExcludedResourceWarning: true,
+	v1alpha1.ApplicationConditionPolicyViolationError:    true,
+	v1alpha1.ApplicationConditionPolicyViolationWarning:  true,
 }
 
 func (res *comparisonResult) GetSyncStatus() *v1alpha1.SyncStatus {
@@ -124,7 +146,7 @@ type appStateManager struct {
 // task to the repo-server. It returns the list of generated manifests as unstructured
 // objects. It also returns the full response from all calls to the repo server as the
 // second argument.
-func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject, rollback bool) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, bool, error) {
+func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject, rollback bool) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, bool, error) {
 	ts := stats.NewTimingStats()
 	helmRepos, err := m.db.ListHelmRepositories(context.Background())
 	if err != nil {
@@ -218,7 +240,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 
 		if !source.IsHelm() && syncedRevision != "" && keyManifestGenerateAnnotationExists && keyManifestGenerateAnnotationVal != "" {
 			// Validate the manifest-generate-path annotation to avoid generating manifests if it has not changed.
-			updateRevisionResult, err := repoClient.UpdateRevisionForPaths(context.Background(), &apiclient.UpdateRevisionForPathsRequest{
+			updateRevisionResult, err := repoClient.UpdateRevisionForPaths(ctx, &apiclient.UpdateRevisionForPathsRequest{
 				Repo:               repo,
 				Revision:           revision,
 				SyncedRevision:     syncedRevision,
@@ -255,7 +277,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 
 		ts.AddCheckpoint("version_ms")
 		log.WithField("application", app.Name).Debugf("Generating Manifest for source %s revision %s, cache %t, revisionCache %t", source, revisions[i], !noCache, noRevisionCache)
-		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+		manifestInfo, err := repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
 			Repo:                repo,
 			Repos:               permittedHelmRepos,
 			Revision:            revision,
@@ -377,6 +399,35 @@ func (m *appStateManager) getComparisonSettings() (string, map[string]v1alpha1.R
 	return appLabelKey, resourceOverrides, resFilter, nil
 }
 
+// manifestPolicyConditions evaluates the manifest policies of the project against the target objects, and returns
+// a PolicyViolationError or PolicyViolationWarning condition for each violation, depending on the policy severity.
+// At most manifestPolicyConditionsLimit conditions are returned per type, followed by a condition summarizing the
+// number of the other violations.
+func manifestPolicyConditions(project *v1alpha1.AppProject, targetObjs []*unstructured.Unstructured, now metav1.Time) []v1alpha1.ApplicationCondition {
+	if len(project.Spec.ManifestPolicies) == 0 {
+		return nil
+	}
+	var conditions []v1alpha1.ApplicationCondition
+	counts := map[v1alpha1.ApplicationConditionType]int{}
+	for _, violation := range manifestpolicy.Evaluate(project.Spec.ManifestPolicies, targetObjs) {
+		conditionType := v1alpha1.ApplicationConditionPolicyViolationError
+		if violation.Severity == v1alpha1.ManifestPolicySeverityWarn {
+			conditionType = v1alpha1.ApplicationConditionPolicyViolationWarning
+		}
+		counts[conditionType]++
+		if counts[conditionType] <= manifestPolicyConditionsLimit {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: conditionType, Message: violation.String(), LastTransitionTime: &now})
+		}
+	}
+	for _, conditionType := range []v1alpha1.ApplicationConditionType{v1alpha1.ApplicationConditionPolicyViolationError, v1alpha1.ApplicationConditionPolicyViolationWarning} {
+		if count := counts[conditionType]; count > manifestPolicyConditionsLimit {
+			message := fmt.Sprintf("and %d more manifest policy violations", count-manifestPolicyConditionsLimit)
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: conditionType, Message: message, LastTransitionTime: &now})
+		}
+	}
+	return conditions
+}
+
 // verifyGnuPGSignature verifies the result of a GnuPG operation for a given git
 // revision.
 func verifyGnuPGSignature(revision string, project *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) []v1alpha1.ApplicationCondition {
@@ -423,7 +474,9 @@ func isManagedNamespace(ns *unstructured.Unstructured, app *v1alpha1.Application
 // CompareAppState compares application git state to the live app state, using the specified
 // revision and supplied source. If revision or overrides are empty, then compares against
 // revision and overrides in the app spec.
-func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1alpha1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localManifests []string, hasMultipleSources bool, rollback bool) (*comparisonResult, error) {
+func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.Application, project *v1alpha1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localManifests []string, hasMultipleSources bool, rollback bool) (*comparisonResult, error) {
+	ctx, span := tracer.Start(ctx, "CompareAppState", trace.WithAttributes(attribute.Bool("no_cache", noCache), attribute.Bool("no_revision_cache", noRevisionCache)))
+	defer span.End()
 	ts := stats.NewTimingStats()
 	appLabelKey, resourceOverrides, resFilter, err := m.getComparisonSettings()
 
@@ -483,7 +536,9 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			}
 		}
 
-		targetObjs, manifestInfos, revisionUpdated, err = m.GetRepoObjs(app, sources, appLabelKey, revisions, noCache, noRevisionCache, verifySignature, project, rollback)
+		repoCtx, repoSpan := tracer.Start(ctx, "GetRepoObjs", trace.WithAttributes(attribute.StringSlice("revisions", revisions)))
+		targetObjs, manifestInfos, revisionUpdated, err = m.GetRepoObjs(repoCtx, app, sources, appLabelKey, revisions, noCache, noRevisionCache, verifySignature, project, rollback)
+		endSpan(repoSpan, err)
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			msg := fmt.Sprintf("Failed to load target state: %s", err.Error())
@@ -558,7 +613,12 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 	ts.AddCheckpoint("dedup_ms")
 
+	conditions = append(conditions, manifestPolicyConditions(project, targetObjs, now)...)
+	ts.AddCheckpoint("manifest_policies_ms")
+
+	_, liveSpan := tracer.Start(ctx, "GetManagedLiveObjs")
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(app, targetObjs)
+	endSpan(liveSpan, err)
 	if err != nil {
 		logCtx.Errorf("Failed to load live state: %v", err)
 		liveObjByKey = make(map[kubeutil.ResourceKey]*unstructured.Unstructured)
@@ -638,10 +698,14 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		}
 	}
 	hasPostDeleteHooks := false
+	hasPreDeleteHooks := false
 	for _, obj := range targetObjs {
 		if isPostDeleteHook(obj) {
 			hasPostDeleteHooks = true
 		}
+		if isPreDeleteHook(obj) {
+			hasPreDeleteHooks = true
+		}
 	}
 
 	logCtx.Debugf("Resources before reconciliation: target %d, live %d", len(targetObjs), len(liveObjByKey))
@@ -714,7 +778,9 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	// application conditions as argo.StateDiffs will validate this diffConfig again.
 	diffConfig, _ := diffConfigBuilder.Build()
 
+	_, diffSpan := tracer.Start(ctx, "StateDiffs", trace.WithAttributes(attribute.Int("resources", len(reconciliation.Target)), attribute.Bool("server_side_diff", serverSideDiff)))
 	diffResults, err := argodiff.StateDiffs(reconciliation.Live, reconciliation.Target, diffConfig)
+	endSpan(diffSpan, err)
 	if err != nil {
 		diffResults = &diff.DiffResultList{}
 		failedToLoadObjs = true
@@ -871,7 +937,9 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 
 	ts.AddCheckpoint("sync_ms")
 
+	_, healthSpan := tracer.Start(ctx, "setApplicationHealth")
 	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth)
+	endSpan(healthSpan, err)
 	if err != nil {
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: fmt.Sprintf("error setting app health: %s", err.Error()), LastTransitionTime: &now})
 	}
@@ -894,6 +962,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		diffConfig:           diffConfig,
 		diffResultList:       diffResults,
 		hasPostDeleteHooks:   hasPostDeleteHooks,
+		hasPreDeleteHooks:    hasPreDeleteHooks,
 		revisionUpdated:      revisionUpdated,
 	}
 
@@ -908,12 +977,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		}
 	}
 
-	app.Status.SetConditions(conditions, map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:         true,
-		v1alpha1.ApplicationConditionSharedResourceWarning:   true,
-		v1alpha1.ApplicationConditionRepeatedResourceWarning: true,
-		v1alpha1.ApplicationConditionExcludedResourceWarning: true,
-	})
+	app.Status.SetConditions(conditions, comparisonConditionTypes)
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
 	return &compRes, nil
@@ -986,6 +1050,7 @@ func (m *appStateManager) persistRevisionHistory(
 			Sources:         sources,
 			Revisions:       revisions,
 			InitiatedBy:     initiatedBy,
+			CommittedAt:     m.getCommitDate(app, sources, revisions),
 		})
 	} else {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
@@ -995,6 +1060,7 @@ func (m *appStateManager) persistRevisionHistory(
 			ID:              nextID,
 			Source:          source,
 			InitiatedBy:     initiatedBy,
+			CommittedAt:     m.getCommitDate(app, []v1alpha1.ApplicationSource{source}, []string{revision}),
 		})
 	}
 
@@ -1012,6 +1078,45 @@ func (m *appStateManager) persistRevisionHistory(
 	return err
 }
 
+// getCommitDate returns the most recent commit date of the given git revisions, or nil if none of them could be
+// resolved. Failures are only logged since the commit date is informational.
+func (m *appStateManager) getCommitDate(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string) *metav1.Time {
+	var committedAt *metav1.Time
+	var repoClient apiclient.RepoServerServiceClient
+	logCtx := log.WithField("application", app.QualifiedName())
+	for i, source := range sources {
+		if i >= len(revisions) || source.RepoURL == "" || source.IsHelm() || !git.IsCommitSHA(revisions[i]) {
+			continue
+		}
+		if repoClient == nil {
+			conn, client, err := m.repoClientset.NewRepoServerClient()
+			if err != nil {
+				logCtx.Warnf("Failed to connect to repo server to get commit date: %v", err)
+				return nil
+			}
+			defer io.Close(conn)
+			repoClient = client
+		}
+		repo, err := m.db.GetRepository(context.Background(), source.RepoURL, app.Spec.Project)
+		if err != nil {
+			logCtx.Warnf("Failed to get repository %s to get commit date: %v", source.RepoURL, err)
+			continue
+		}
+		metadata, err := repoClient.GetRevisionMetadata(context.Background(), &apiclient.RepoServerRevisionMetadataRequest{
+			Repo:     repo,
+			Revision: revisions[i],
+		})
+		if err != nil {
+			logCtx.Warnf("Failed to get commit date of revision %s: %v", revisions[i], err)
+			continue
+		}
+		if committedAt == nil || metadata.Date.After(committedAt.Time) {
+			committedAt = metadata.Date.DeepCopy()
+		}
+	}
+	return committedAt
+}
+
 // NewAppStateManager creates new instance of AppStateManager
 func NewAppStateManager(
 	db db.ArgoDB,
@@ -18,11 +18,15 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/managedfields"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/util/openapi"
 
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
@@ -81,6 +85,16 @@ func (m *appStateManager) getResourceOperations(server string) (kube.ResourceOpe
 }
 
 func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState) {
+	if app.Spec.HasMultipleDestinations() {
+		m.syncAppDestinations(app, state)
+		return
+	}
+	m.syncAppState(app, state, true)
+}
+
+// syncAppState performs one iteration of the sync operation of the given state on the destination of the
+// application. The sync is recorded in the revision history of the application only if recordHistory is true.
+func (m *appStateManager) syncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState, recordHistory bool) {
 	// Sync requests might be requested with ambiguous revisions (e.g. master, HEAD, v1.2.3).
 	// This can change meaning when resuming operations (e.g a hook sync). After calculating a
 	// concrete git commit SHA, the SHA is remembered in the status.operationState.syncResult field.
@@ -95,6 +109,15 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	logCtx := log.WithField("application", app.Name)
 
+	ctx, span := tracer.Start(operationContext(app, state), "SyncAppState", trace.WithAttributes(appSpanAttributes(app)...))
+	defer func() {
+		span.SetAttributes(attribute.String("phase", string(state.Phase)))
+		if state.Phase.Completed() && !state.Phase.Successful() {
+			span.SetStatus(codes.Error, state.Message)
+		}
+		span.End()
+	}()
+
 	if state.Operation.Sync == nil {
 		state.Phase = common.OperationFailed
 		state.Message = "Invalid operation request: no operation specified"
@@ -185,7 +208,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 
 	// ignore error if CompareStateRepoError, this shouldn't happen as noRevisionCache is true
-	compareResult, err := m.CompareAppState(app, proj, revisions, sources, false, true, syncOp.Manifests, isMultiSourceRevision, rollback)
+	compareResult, err := m.CompareAppState(ctx, app, proj, revisions, sources, false, true, syncOp.Manifests, isMultiSourceRevision, rollback)
 	if err != nil && !goerrors.Is(err, CompareStateRepoError) {
 		state.Phase = common.OperationError
 		state.Message = err.Error()
@@ -197,10 +220,12 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	syncRes.Revision = compareResult.syncStatus.Revision
 	syncRes.Revisions = compareResult.syncStatus.Revisions
 
-	// If there are any comparison or spec errors error conditions do not perform the operation
+	// If there are any comparison or spec errors error conditions, or resources violating manifest policies,
+	// do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:  true,
-		v1alpha1.ApplicationConditionInvalidSpecError: true,
+		v1alpha1.ApplicationConditionComparisonError:      true,
+		v1alpha1.ApplicationConditionInvalidSpecError:     true,
+		v1alpha1.ApplicationConditionPolicyViolationError: true,
 	}); len(errConditions) > 0 {
 		state.Phase = common.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
@@ -217,6 +242,24 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	rawConfig := clst.RawRestConfig()
 	restConfig := metrics.AddMetricsTransportWrapper(m.metricsServer, app, clst.RESTConfig())
 
+	impersonationEnabled, err := m.settingsMgr.IsImpersonationEnabled()
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Failed to load impersonation settings: %v", err)
+		return
+	}
+	if impersonationEnabled {
+		serviceAccount, err := proj.GetDestinationServiceAccount(app.Spec.Destination, app.Namespace)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to find the service account to impersonate: %v", err)
+			return
+		}
+		// resources are applied, pruned and hooks are deleted on behalf of the service account
+		rawConfig.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+		restConfig.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+	}
+
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		state.Phase = common.OperationError
@@ -234,8 +277,20 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	syncId := fmt.Sprintf("%05d-%s", syncIdPrefix, randSuffix)
 
 	logEntry := log.WithFields(log.Fields{"application": app.QualifiedName(), "syncId": syncId})
+	replaceKinds, err := m.settingsMgr.GetReplaceOnImmutableFieldKinds()
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Failed to load the kinds replaceable on immutable field updates: %v", err)
+		return
+	}
+	replacer := newImmutableFieldReplacer(syncOp.SyncOptions.HasOption(syncOptionReplaceOnImmutableField), replaceKinds, app.Spec.Destination.Namespace, syncRes.Resources)
+
 	initialResourcesRes := make([]common.ResourceSyncResult, 0)
 	for i, res := range syncRes.Resources {
+		if replacer.isPending(res) {
+			// the engine applies the resources without result, i.e. recreates the deleted resource
+			continue
+		}
 		key := kube.ResourceKey{Group: res.Group, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
 		initialResourcesRes = append(initialResourcesRes, common.ResourceSyncResult{
 			ResourceKey: key,
@@ -288,9 +343,25 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 	trackingMethod := argo.GetTrackingMethod(m.settingsMgr)
 
+	includeResource := func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
+		return (len(syncOp.Resources) == 0 ||
+			isPostDeleteHook(target) ||
+			isPreDeleteHook(target) ||
+			argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
+			m.isSelfReferencedObj(live, target, app.GetName(), appLabelKey, trackingMethod)
+	}
+
+	var syncWaves *v1alpha1.SyncWavesStrategy
+	if app.Spec.SyncPolicy != nil && !syncOp.DryRun {
+		syncWaves = app.Spec.SyncPolicy.SyncWaves
+	}
+	gate := newWaveGate(syncWaves, app.Spec.Destination.Namespace, reconciliationResult, includeResource,
+		syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult, initialResourcesRes, lua.ResourceHealthOverrides(resourceOverrides))
+
+	var syncStart time.Time
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
-		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
+		sync.WithHealthOverride(gate),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *v1.APIResource) error {
 			if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
 				return fmt.Errorf("resource %s:%s is not permitted in project %s", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
@@ -312,13 +383,21 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
 		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
-			return (len(syncOp.Resources) == 0 ||
-				isPostDeleteHook(target) ||
-				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
-				m.isSelfReferencedObj(live, target, app.GetName(), appLabelKey, trackingMethod)
+			return includeResource(key, target, live) && gate.permits(target)
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
-		sync.WithSyncWaveHook(delayBetweenSyncWaves),
+		sync.WithSyncWaveHook(func(phase common.SyncPhase, wave int, finalWave bool) error {
+			// the span of a wave covers its application, which may span several iterations of the operation, and the
+			// delay before the next wave
+			_, waveSpan := tracer.Start(ctx, "syncWave", trace.WithTimestamp(syncWaveStart(state.SyncResult, phase, wave, syncStart)), trace.WithAttributes(
+				attribute.String("sync_phase", string(phase)),
+				attribute.Int("sync_wave", wave),
+				attribute.Bool("final_wave", finalWave),
+			))
+			err := delayBetweenSyncWaves(phase, wave, finalWave)
+			endSpan(waveSpan, err)
+			return err
+		}),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
@@ -351,14 +430,81 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	start := time.Now()
 
-	if state.Phase == common.OperationTerminating {
+	timeoutMessage := ""
+	if state.Phase == common.OperationRunning {
+		timeout, err := syncOp.GetTimeout(app.Spec.SyncPolicy)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Invalid sync timeout: %v", err)
+			return
+		}
+		if timeout > 0 && time.Since(attemptStartedAt(state)) > timeout {
+			timeoutMessage = fmt.Sprintf("Sync operation timed out after %s", timeout)
+		} else {
+			timeoutMessage = getHungHookMessage(syncRes, reconciliationResult.Live, time.Now(), logEntry)
+		}
+	}
+
+	if state.Phase == common.OperationRunning && !syncOp.DryRun {
+		// the engine deletes the failed hooks according to their delete policy as soon as it observes their failure
+		m.captureFailedHookLogs(app, state, restConfig, logEntry)
+	}
+
+	deleting := replacer.deleting(reconciliationResult)
+	terminating := state.Phase == common.OperationTerminating || timeoutMessage != ""
+	if terminating {
+		if timeoutMessage != "" {
+			logEntry.Warnf("%s, terminating", timeoutMessage)
+		}
+		_, terminateSpan := tracer.Start(ctx, "Terminate")
 		syncCtx.Terminate()
+		terminateSpan.End()
+	} else if gate.blocked() {
+		// the engine would proceed with the next wave, as no resource of the current wave is left to apply
+		logEntry.Info(gate.waitingMessage())
+	} else if len(deleting) > 0 {
+		// the engine would update the resources which are being deleted instead of recreating them
+		logEntry.Info(replacementMessage(deleting, true))
 	} else {
 		logEntry.Infof("Starting sync operation for revision \"%s\"", compareResult.syncStatus.Revision)
+		syncStart = time.Now()
+		_, syncSpan := tracer.Start(ctx, "Sync", trace.WithTimestamp(syncStart), trace.WithAttributes(attribute.String("revision", compareResult.syncStatus.Revision)))
 		syncCtx.Sync()
+		syncSpan.End()
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	if !terminating && gate.hasHiddenResources() && (gate.blocked() || state.Phase == common.OperationSucceeded) {
+		// the engine is not aware of the resources of the current wave which are still to be applied
+		state.Phase = common.OperationRunning
+		state.Message = gate.waitingMessage()
+	}
+	var replaced []kube.ResourceKey
+	if !terminating && !syncOp.DryRun {
+		if len(deleting) > 0 && !gate.blocked() {
+			state.Phase = common.OperationRunning
+			state.Message = replacementMessage(deleting, true)
+		} else if state.Phase == common.OperationFailed {
+			replaced, err = replacer.replace(resState, reconciliationResult, func(live *unstructured.Unstructured) error {
+				logEntry.Infof("Deleting %s/%s to recreate it, as the update of immutable fields was rejected", live.GetKind(), live.GetName())
+				return m.kubectl.DeleteResource(context.TODO(), restConfig, live.GroupVersionKind(), live.GetName(), live.GetNamespace(), v1.DeleteOptions{PropagationPolicy: &prunePropagationPolicy})
+			})
+			if err != nil {
+				state.Message = fmt.Sprintf("%s: %v", state.Message, err)
+			} else if len(replaced) > 0 {
+				state.Phase = common.OperationRunning
+				state.Message = replacementMessage(replaced, false)
+			}
+		}
+	}
+	traceCompletedHooks(ctx, initialResourcesRes, resState, reconciliationResult.Live, state.StartedAt.Time)
+	if timeoutMessage != "" {
+		if state.Phase == common.OperationFailed {
+			state.Message = timeoutMessage
+		} else {
+			state.Message = fmt.Sprintf("%s: %s", timeoutMessage, state.Message)
+		}
+	}
 	state.SyncResult.Resources = nil
 
 	if app.Spec.SyncPolicy != nil {
@@ -397,9 +543,16 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		})
 	}
 
+	state.SyncResult.Resources = replacer.results(state.SyncResult.Resources, replaced)
+	state.SyncResult.Waves = gate.waveResults(resState)
+
+	if timeoutMessage != "" {
+		m.deleteFailedHooks(restConfig, state.SyncResult, v1.DeleteOptions{PropagationPolicy: &prunePropagationPolicy}, logEntry)
+	}
+
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
-	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
+	if recordHistory && !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
 		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceRevision, state.StartedAt, state.Operation.InitiatedBy)
 		if err != nil {
 			state.Phase = common.OperationError
@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// syncOptionReplaceOnImmutableField is the sync option which enables the replacement of the resources which update is
+// rejected because of immutable fields
+const syncOptionReplaceOnImmutableField = "ReplaceOnImmutableField=true"
+
+// immutableFieldErrorPatterns match the messages of the API server rejecting the update of immutable fields
+var immutableFieldErrorPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`field is immutable`),
+	regexp.MustCompile(`may not change once set`),
+	regexp.MustCompile(`updates to statefulset spec for fields other than .* are forbidden`),
+}
+
+func isImmutableFieldError(message string) bool {
+	for _, pattern := range immutableFieldErrorPatterns {
+		if pattern.MatchString(message) {
+			return true
+		}
+	}
+	return false
+}
+
+// immutableFieldReplacer deletes the resources which update was rejected because of immutable fields during a sync
+// operation, so that the next iteration of the operation recreates them. Each resource is replaced at most once per
+// operation.
+type immutableFieldReplacer struct {
+	enabled   bool
+	kinds     []schema.GroupKind
+	namespace string
+	// replaced holds the resources replaced during the operation
+	replaced map[kube.ResourceKey]bool
+	// pending holds the results of the replaced resources which are not recreated yet
+	pending map[kube.ResourceKey]*v1alpha1.ResourceResult
+}
+
+// newImmutableFieldReplacer returns a replacer of the resources of the given kinds, restoring the replacements of the
+// previous iterations of the operation from their results. If enabled is false, only the resources annotated with the
+// sync option are replaced.
+func newImmutableFieldReplacer(enabled bool, kinds []schema.GroupKind, namespace string, previous []*v1alpha1.ResourceResult) *immutableFieldReplacer {
+	r := &immutableFieldReplacer{
+		enabled:   enabled,
+		kinds:     kinds,
+		namespace: namespace,
+		replaced:  map[kube.ResourceKey]bool{},
+		pending:   map[kube.ResourceKey]*v1alpha1.ResourceResult{},
+	}
+	for _, res := range previous {
+		if !res.Replaced {
+			continue
+		}
+		key := kube.ResourceKey{Group: res.Group, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
+		r.replaced[key] = true
+		if res.Status == common.ResultCodeSyncFailed {
+			r.pending[key] = res
+		}
+	}
+	return r
+}
+
+// isPending returns whether the given result is the one of a resource deleted to be recreated, which must not be
+// passed to the engine so that it applies the resource again
+func (r *immutableFieldReplacer) isPending(res *v1alpha1.ResourceResult) bool {
+	return res.Replaced && res.Status == common.ResultCodeSyncFailed
+}
+
+// resultKey returns the key of the sync result of a target resource, which namespace is defaulted by the engine even
+// for cluster-scoped resources
+func (r *immutableFieldReplacer) resultKey(obj *unstructured.Unstructured) kube.ResourceKey {
+	key := kube.GetResourceKey(obj)
+	if key.Namespace == "" {
+		key.Namespace = r.namespace
+	}
+	return key
+}
+
+// deleting returns the replaced resources which deletion is still in progress, and which cannot be recreated yet
+func (r *immutableFieldReplacer) deleting(reconciliationResult sync.ReconciliationResult) []kube.ResourceKey {
+	var keys []kube.ResourceKey
+	for i, target := range reconciliationResult.Target {
+		if target == nil || reconciliationResult.Live[i] == nil {
+			continue
+		}
+		if key := r.resultKey(target); r.pending[key] != nil {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// isReplaceable returns whether the given target resource may be replaced when the update of its immutable fields is rejected
+func (r *immutableFieldReplacer) isReplaceable(target *unstructured.Unstructured) bool {
+	if hook.IsHook(target) {
+		return false
+	}
+	if !r.enabled && !resourceutil.HasAnnotationOption(target, common.AnnotationSyncOptions, syncOptionReplaceOnImmutableField) {
+		return false
+	}
+	gk := target.GroupVersionKind().GroupKind()
+	for _, kind := range r.kinds {
+		if kind == gk {
+			return true
+		}
+	}
+	return false
+}
+
+// replace deletes the live resources which update was rejected because of immutable fields, provided that all the
+// failures of the sync are such rejections of resources which may be replaced. It returns the keys of the replaced
+// resources.
+func (r *immutableFieldReplacer) replace(results []common.ResourceSyncResult, reconciliationResult sync.ReconciliationResult, deleteResource func(live *unstructured.Unstructured) error) ([]kube.ResourceKey, error) {
+	lives := map[kube.ResourceKey]*unstructured.Unstructured{}
+	for i, target := range reconciliationResult.Target {
+		live := reconciliationResult.Live[i]
+		if target != nil && live != nil && r.isReplaceable(target) {
+			lives[r.resultKey(target)] = live
+		}
+	}
+
+	var keys []kube.ResourceKey
+	for _, res := range results {
+		failed := res.Status == common.ResultCodeSyncFailed || res.HookPhase == common.OperationFailed || res.HookPhase == common.OperationError
+		if !failed {
+			continue
+		}
+		if res.HookType != "" || res.SyncPhase != common.SyncPhaseSync || res.Status != common.ResultCodeSyncFailed ||
+			!isImmutableFieldError(res.Message) || lives[res.ResourceKey] == nil || r.replaced[res.ResourceKey] {
+			return nil, nil
+		}
+		keys = append(keys, res.ResourceKey)
+	}
+
+	for _, key := range keys {
+		if err := deleteResource(lives[key]); err != nil {
+			return nil, fmt.Errorf("failed to delete %s/%s to recreate it: %w", key.Kind, key.Name, err)
+		}
+		r.replaced[key] = true
+	}
+	return keys, nil
+}
+
+// results adds the replacement of the resources to the given sync results, along with the results of the replaced
+// resources which are still to be recreated
+func (r *immutableFieldReplacer) results(results []*v1alpha1.ResourceResult, newlyReplaced []kube.ResourceKey) []*v1alpha1.ResourceResult {
+	deleted := map[kube.ResourceKey]bool{}
+	for _, key := range newlyReplaced {
+		deleted[key] = true
+	}
+	reported := map[kube.ResourceKey]bool{}
+	for _, res := range results {
+		key := kube.ResourceKey{Group: res.Group, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
+		if res.HookType != "" || !r.replaced[key] {
+			continue
+		}
+		res.Replaced = true
+		if deleted[key] {
+			res.Message = fmt.Sprintf("deleted to be recreated, as the update of immutable fields was rejected: %s", res.Message)
+		}
+		reported[key] = true
+	}
+	var pending []kube.ResourceKey
+	for key := range r.pending {
+		if !reported[key] {
+			pending = append(pending, key)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].String() < pending[j].String()
+	})
+	for _, key := range pending {
+		results = append(results, r.pending[key])
+	}
+	return results
+}
+
+// replacementMessage describes the operation while the given resources are replaced
+func replacementMessage(keys []kube.ResourceKey, deleting bool) string {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = fmt.Sprintf("%s/%s", key.Kind, key.Name)
+	}
+	if deleting {
+		return fmt.Sprintf("waiting for the deletion of %s before recreation", strings.Join(names, ", "))
+	}
+	return fmt.Sprintf("recreating %s, as the update of immutable fields was rejected", strings.Join(names, ", "))
+}
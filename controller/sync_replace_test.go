@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestIsImmutableFieldError(t *testing.T) {
+	assert.True(t, isImmutableFieldError(`Job.batch "migrate" is invalid: spec.template: Invalid value: core.PodTemplateSpec{}: field is immutable`))
+	assert.True(t, isImmutableFieldError(`Service "web" is invalid: spec.clusterIPs[0]: Invalid value: []string{"None"}: may not change once set`))
+	assert.True(t, isImmutableFieldError(`StatefulSet.apps "db" is invalid: spec: Forbidden: updates to statefulset spec for fields other than 'replicas', 'template' and 'updateStrategy' are forbidden`))
+	assert.False(t, isImmutableFieldError(`Deployment.apps "web" is invalid: spec.replicas: Invalid value: -1: must be greater than or equal to 0`))
+}
+
+func immutableFieldPod(name string, annotated bool) *unstructured.Unstructured {
+	pod := NewPod()
+	pod.SetName(name)
+	pod.SetNamespace("fake-dest-ns")
+	if annotated {
+		pod = Annotate(pod, common.AnnotationSyncOptions, syncOptionReplaceOnImmutableField)
+	}
+	return pod
+}
+
+func immutableFieldFailure(obj *unstructured.Unstructured) common.ResourceSyncResult {
+	return common.ResourceSyncResult{
+		ResourceKey: kube.GetResourceKey(obj),
+		SyncPhase:   common.SyncPhaseSync,
+		Status:      common.ResultCodeSyncFailed,
+		HookPhase:   common.OperationFailed,
+		Message:     `Pod "my-pod" is invalid: spec: Forbidden: pod updates may not change fields other than image: field is immutable`,
+	}
+}
+
+func TestImmutableFieldReplacer_Replace(t *testing.T) {
+	podKinds := []schema.GroupKind{{Kind: kube.PodKind}}
+	a, b := immutableFieldPod("a", false), immutableFieldPod("b", true)
+	reconciliationResult := sync.ReconciliationResult{Target: []*unstructured.Unstructured{a, b}, Live: []*unstructured.Unstructured{a, b}}
+
+	t.Run("Enabled", func(t *testing.T) {
+		replacer := newImmutableFieldReplacer(true, podKinds, "fake-dest-ns", nil)
+		var deleted []string
+		replaced, err := replacer.replace([]common.ResourceSyncResult{immutableFieldFailure(a)}, reconciliationResult, func(live *unstructured.Unstructured) error {
+			deleted = append(deleted, live.GetName())
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(a)}, replaced)
+		assert.Equal(t, []string{"a"}, deleted)
+	})
+
+	t.Run("AnnotatedResource", func(t *testing.T) {
+		replacer := newImmutableFieldReplacer(false, podKinds, "fake-dest-ns", nil)
+		replaced, err := replacer.replace([]common.ResourceSyncResult{immutableFieldFailure(b)}, reconciliationResult, func(live *unstructured.Unstructured) error {
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, replaced, 1)
+	})
+
+	t.Run("NotAnnotatedResource", func(t *testing.T) {
+		replacer := newImmutableFieldReplacer(false, podKinds, "fake-dest-ns", nil)
+		replaced, err := replacer.replace([]common.ResourceSyncResult{immutableFieldFailure(a)}, reconciliationResult, func(live *unstructured.Unstructured) error {
+			t.Fatal("unexpected deletion")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Empty(t, replaced)
+	})
+
+	t.Run("KindNotAllowed", func(t *testing.T) {
+		replacer := newImmutableFieldReplacer(true, []schema.GroupKind{{Group: "batch", Kind: "Job"}}, "fake-dest-ns", nil)
+		replaced, err := replacer.replace([]common.ResourceSyncResult{immutableFieldFailure(a)}, reconciliationResult, func(live *unstructured.Unstructured) error {
+			t.Fatal("unexpected deletion")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Empty(t, replaced)
+	})
+
+	t.Run("OtherFailure", func(t *testing.T) {
+		replacer := newImmutableFieldReplacer(true, podKinds, "fake-dest-ns", nil)
+		other := immutableFieldFailure(b)
+		other.Message = "admission webhook denied the request"
+		replaced, err := replacer.replace([]common.ResourceSyncResult{immutableFieldFailure(a), other}, reconciliationResult, func(live *unstructured.Unstructured) error {
+			t.Fatal("unexpected deletion")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Empty(t, replaced)
+	})
+
+	t.Run("AlreadyReplaced", func(t *testing.T) {
+		previous := []*v1alpha1.ResourceResult{{Kind: kube.PodKind, Namespace: "fake-dest-ns", Name: "a", Status: common.ResultCodeSynced, Replaced: true}}
+		replacer := newImmutableFieldReplacer(true, podKinds, "fake-dest-ns", previous)
+		replaced, err := replacer.replace([]common.ResourceSyncResult{immutableFieldFailure(a)}, reconciliationResult, func(live *unstructured.Unstructured) error {
+			t.Fatal("unexpected deletion")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Empty(t, replaced)
+	})
+
+	t.Run("DeletionFailed", func(t *testing.T) {
+		replacer := newImmutableFieldReplacer(true, podKinds, "fake-dest-ns", nil)
+		_, err := replacer.replace([]common.ResourceSyncResult{immutableFieldFailure(a)}, reconciliationResult, func(live *unstructured.Unstructured) error {
+			return errors.New("forbidden")
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestImmutableFieldReplacer_Results(t *testing.T) {
+	a := immutableFieldPod("a", false)
+	pending := &v1alpha1.ResourceResult{Kind: kube.PodKind, Namespace: "fake-dest-ns", Name: "a", Status: common.ResultCodeSyncFailed, Replaced: true, Message: "deleted to be recreated"}
+	replacer := newImmutableFieldReplacer(true, []schema.GroupKind{{Kind: kube.PodKind}}, "fake-dest-ns", []*v1alpha1.ResourceResult{pending})
+	assert.True(t, replacer.isPending(pending))
+
+	t.Run("Deleting", func(t *testing.T) {
+		deleting := replacer.deleting(sync.ReconciliationResult{Target: []*unstructured.Unstructured{a}, Live: []*unstructured.Unstructured{a}})
+		assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(a)}, deleting)
+		assert.Equal(t, "waiting for the deletion of Pod/a before recreation", replacementMessage(deleting, true))
+		assert.Empty(t, replacer.deleting(sync.ReconciliationResult{Target: []*unstructured.Unstructured{a}, Live: []*unstructured.Unstructured{nil}}))
+	})
+
+	t.Run("NotRecreatedYet", func(t *testing.T) {
+		results := replacer.results(nil, nil)
+		assert.Equal(t, []*v1alpha1.ResourceResult{pending}, results)
+	})
+
+	t.Run("Recreated", func(t *testing.T) {
+		recreated := &v1alpha1.ResourceResult{Kind: kube.PodKind, Namespace: "fake-dest-ns", Name: "a", Status: common.ResultCodeSynced, Message: "pod/a created"}
+		results := replacer.results([]*v1alpha1.ResourceResult{recreated}, nil)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Replaced)
+		assert.Equal(t, common.ResultCodeSynced, results[0].Status)
+	})
+}
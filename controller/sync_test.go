@@ -2,11 +2,13 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	testingutils "github.com/argoproj/gitops-engine/pkg/utils/testing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -187,6 +189,167 @@ func TestSyncComparisonError(t *testing.T) {
 	assert.Equal(t, "abc123", opState.SyncResult.Revision)
 }
 
+func TestSyncManifestPolicyViolation(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			ManifestPolicies: []v1alpha1.ManifestPolicy{
+				{Name: "no-pods", Kinds: []v1.GroupKind{{Kind: "Pod"}}, Expression: "false", Message: "pods are not allowed"},
+			},
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []*apiclient.Manifest{{CompiledManifest: testingutils.PodManifest}},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data, nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+
+	assert.Equal(t, common.OperationError, opState.Phase)
+	assert.Contains(t, opState.Message, "pods are not allowed")
+}
+
+func TestSyncImpersonation(t *testing.T) {
+	setup := func(serviceAccounts []v1alpha1.ApplicationDestinationServiceAccount) (*ApplicationController, *v1alpha1.Application) {
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = nil
+
+		defaultProject := &v1alpha1.AppProject{
+			ObjectMeta: v1.ObjectMeta{
+				Namespace: test.FakeArgoCDNamespace,
+				Name:      "default",
+			},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:                []string{"*"},
+				Destinations:               []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				DestinationServiceAccounts: serviceAccounts,
+			},
+		}
+		data := fakeData{
+			apps: []runtime.Object{app, defaultProject},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []*apiclient.Manifest{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			configMapData: map[string]string{
+				"application.sync.impersonation.enabled": "true",
+			},
+		}
+		return newFakeController(&data, nil), app
+	}
+
+	t.Run("NoMatchingServiceAccount", func(t *testing.T) {
+		ctrl, app := setup([]v1alpha1.ApplicationDestinationServiceAccount{{Server: "*", Namespace: "other", DefaultServiceAccount: "deployer"}})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{},
+		}}
+		ctrl.appStateManager.SyncAppState(app, opState)
+
+		assert.Equal(t, common.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "Failed to find the service account to impersonate")
+	})
+
+	t.Run("MatchingServiceAccount", func(t *testing.T) {
+		ctrl, app := setup([]v1alpha1.ApplicationDestinationServiceAccount{{Server: "*", Namespace: "*", DefaultServiceAccount: "deployer"}})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{},
+		}}
+		ctrl.appStateManager.SyncAppState(app, opState)
+
+		assert.Equal(t, common.OperationSucceeded, opState.Phase)
+	})
+}
+
+func TestSyncWavesMaxConcurrency(t *testing.T) {
+	newPod := func(name string) *unstructured.Unstructured {
+		pod := testingutils.NewPod()
+		pod.SetName(name)
+		pod.SetNamespace(test.FakeDestNamespace)
+		return pod
+	}
+	podManifest := func(name string) string {
+		data, err := json.Marshal(newPod(name))
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncWaves: &v1alpha1.SyncWavesStrategy{MaxConcurrency: 1}}
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	livePod := newPod("pod-1")
+	require.NoError(t, unstructured.SetNestedField(livePod.Object, "Pending", "status", "phase"))
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []*apiclient.Manifest{{CompiledManifest: podManifest("pod-1")}, {CompiledManifest: podManifest("pod-2")}},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(livePod): livePod,
+		},
+	}
+	ctrl := newFakeController(&data, nil)
+
+	// pod-1 has been applied by a previous iteration of the operation, but is not healthy yet
+	opState := &v1alpha1.OperationState{
+		Phase:     common.OperationRunning,
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+		SyncResult: &v1alpha1.SyncOperationResult{Resources: []*v1alpha1.ResourceResult{{
+			Kind:      "Pod",
+			Namespace: test.FakeDestNamespace,
+			Name:      "pod-1",
+			Version:   "v1",
+			Status:    common.ResultCodeSynced,
+			HookPhase: common.OperationRunning,
+			SyncPhase: common.SyncPhaseSync,
+		}}},
+	}
+	ctrl.appStateManager.SyncAppState(app, opState)
+
+	assert.Equal(t, common.OperationRunning, opState.Phase)
+	assert.Equal(t, "waiting for the resources of sync wave 0 to become healthy before applying 1 more", opState.Message)
+	require.Len(t, opState.SyncResult.Resources, 1)
+	assert.Equal(t, "pod-1", opState.SyncResult.Resources[0].Name)
+	assert.Equal(t, []v1alpha1.SyncWaveResult{{Wave: 0, Resources: 2, Applied: 1}}, opState.SyncResult.Waves)
+}
+
 func TestAppStateManager_SyncAppState(t *testing.T) {
 	type fixture struct {
 		project     *v1alpha1.AppProject
@@ -0,0 +1,244 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+type waveResource struct {
+	key      kube.ResourceKey
+	wave     int
+	applied  bool
+	healthy  bool
+	degraded bool
+}
+
+// waveGate applies the sync waves strategy of an application to one iteration of a sync operation. The sync engine
+// applies all the pending resources of a wave at once, and waits for all of them to be healthy before proceeding with
+// the next wave, so the gate:
+//   - hides the pending resources exceeding the maximum concurrency of the current wave from the engine
+//   - reports the resources of the waves which reached their health threshold as healthy to the engine
+type waveGate struct {
+	strategy  v1alpha1.SyncWavesStrategy
+	namespace string
+	override  health.HealthOverride
+	resources map[kube.ResourceKey]*waveResource
+	hidden    map[kube.ResourceKey]bool
+	released  map[kube.ResourceKey]bool
+	// currentWave is the lowest wave of the Sync phase with resources which have not been applied yet
+	currentWave int
+	// visible is the number of pending resources of the current wave applied during this iteration
+	visible int
+}
+
+// newWaveGate evaluates the waves of the resources of the Sync phase included by the filter, using the results of
+// the previous iterations of the operation. The resources which are in sync are ignored if outOfSyncOnly is set, as
+// the engine does not apply them.
+func newWaveGate(strategy *v1alpha1.SyncWavesStrategy, namespace string, reconciliationResult sync.ReconciliationResult, filter func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool, outOfSyncOnly bool, compareResult *comparisonResult, previous []common.ResourceSyncResult, override health.HealthOverride) *waveGate {
+	gate := &waveGate{
+		namespace: namespace,
+		override:  override,
+		resources: map[kube.ResourceKey]*waveResource{},
+		hidden:    map[kube.ResourceKey]bool{},
+		released:  map[kube.ResourceKey]bool{},
+	}
+	if strategy != nil {
+		gate.strategy = *strategy
+	}
+
+	applied := map[kube.ResourceKey]bool{}
+	for _, res := range previous {
+		if res.HookType == "" && res.SyncPhase == common.SyncPhaseSync {
+			applied[res.ResourceKey] = true
+		}
+	}
+	var modified map[kube.ResourceKey]bool
+	if outOfSyncOnly && compareResult != nil && compareResult.diffResultList != nil {
+		modified = map[kube.ResourceKey]bool{}
+		for key, res := range groupDiffResults(compareResult.diffResultList) {
+			modified[key] = res.Modified
+		}
+	}
+
+	for i, target := range reconciliationResult.Target {
+		live := reconciliationResult.Live[i]
+		if target == nil || hook.IsHook(target) {
+			continue
+		}
+		key := kube.GetResourceKey(target)
+		if live != nil {
+			key = kube.GetResourceKey(live)
+		}
+		if filter != nil && !filter(key, target, live) {
+			continue
+		}
+		if isModified, ok := modified[key]; ok && !isModified && live != nil {
+			continue
+		}
+		res := &waveResource{key: gate.resourceKey(target), wave: syncwaves.Wave(target), applied: applied[gate.resourceKey(target)]}
+		if live != nil {
+			healthStatus, err := health.GetResourceHealth(live, override)
+			res.healthy = err == nil && (healthStatus == nil || healthStatus.Status == health.HealthStatusHealthy)
+			res.degraded = err == nil && healthStatus != nil && healthStatus.Status == health.HealthStatusDegraded
+		}
+		gate.resources[res.key] = res
+	}
+
+	waves := gate.waves()
+	if gate.strategy.HealthThreshold > 0 {
+		for _, resources := range waves {
+			// the resources which were healthy before being applied by this operation do not count towards the
+			// threshold, as their live state may not reflect their target state yet
+			healthy := 0
+			for _, res := range resources {
+				if res.applied && res.healthy {
+					healthy++
+				}
+			}
+			if int64(healthy*100) < gate.strategy.HealthThreshold*int64(len(resources)) {
+				continue
+			}
+			for _, res := range resources {
+				if !res.healthy && !res.degraded {
+					gate.released[res.key] = true
+				}
+			}
+		}
+	}
+
+	pendingWave := false
+	for _, wave := range sortedWaves(waves) {
+		for _, res := range waves[wave] {
+			if !res.applied {
+				gate.currentWave = wave
+				pendingWave = true
+				break
+			}
+		}
+		if pendingWave {
+			break
+		}
+	}
+	if !pendingWave || gate.strategy.MaxConcurrency <= 0 {
+		return gate
+	}
+
+	var pending []*waveResource
+	available := int(gate.strategy.MaxConcurrency)
+	for _, res := range waves[gate.currentWave] {
+		if !res.applied {
+			pending = append(pending, res)
+		} else if !res.healthy && !gate.released[res.key] {
+			available--
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].key.String() < pending[j].key.String()
+	})
+	for i, res := range pending {
+		if i < available {
+			gate.visible++
+		} else {
+			gate.hidden[res.key] = true
+		}
+	}
+	return gate
+}
+
+// resourceKey returns the key of the sync result of a target resource, which namespace is defaulted by the engine
+// even for cluster-scoped resources
+func (g *waveGate) resourceKey(obj *unstructured.Unstructured) kube.ResourceKey {
+	key := kube.GetResourceKey(obj)
+	if key.Namespace == "" {
+		key.Namespace = g.namespace
+	}
+	return key
+}
+
+func (g *waveGate) waves() map[int][]*waveResource {
+	waves := map[int][]*waveResource{}
+	for _, res := range g.resources {
+		waves[res.wave] = append(waves[res.wave], res)
+	}
+	return waves
+}
+
+func sortedWaves(waves map[int][]*waveResource) []int {
+	var sorted []int
+	for wave := range waves {
+		sorted = append(sorted, wave)
+	}
+	sort.Ints(sorted)
+	return sorted
+}
+
+// permits returns whether the engine may apply the given target resource during this iteration
+func (g *waveGate) permits(target *unstructured.Unstructured) bool {
+	return target == nil || !g.hidden[g.resourceKey(target)]
+}
+
+// hasHiddenResources returns whether resources of the current wave are kept from the engine during this iteration
+func (g *waveGate) hasHiddenResources() bool {
+	return len(g.hidden) > 0
+}
+
+// blocked returns whether no resource of the current wave may be applied until the applied ones become healthy, in
+// which case the engine must not be run, as it would proceed with the next wave
+func (g *waveGate) blocked() bool {
+	return g.hasHiddenResources() && g.visible == 0
+}
+
+// waitingMessage describes the resources the operation waits for
+func (g *waveGate) waitingMessage() string {
+	return fmt.Sprintf("waiting for the resources of sync wave %d to become healthy before applying %d more", g.currentWave, len(g.hidden))
+}
+
+// GetResourceHealth reports the resources released by the health threshold of their wave as healthy
+func (g *waveGate) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	healthStatus, err := health.GetResourceHealth(obj, g.override)
+	if err != nil || healthStatus == nil || !g.released[g.resourceKey(obj)] {
+		return healthStatus, err
+	}
+	if healthStatus.Status == health.HealthStatusDegraded || healthStatus.Status == health.HealthStatusHealthy {
+		return healthStatus, nil
+	}
+	return &health.HealthStatus{
+		Status:  health.HealthStatusHealthy,
+		Message: fmt.Sprintf("health threshold of %d%% reached by the sync wave", g.strategy.HealthThreshold),
+	}, nil
+}
+
+// waveResults returns the progress of each wave of the Sync phase, given the results of the sync tasks
+func (g *waveGate) waveResults(results []common.ResourceSyncResult) []v1alpha1.SyncWaveResult {
+	applied := map[kube.ResourceKey]bool{}
+	for _, res := range results {
+		if res.HookType == "" && res.SyncPhase == common.SyncPhaseSync {
+			applied[res.ResourceKey] = true
+		}
+	}
+	waves := g.waves()
+	var waveResults []v1alpha1.SyncWaveResult
+	for _, wave := range sortedWaves(waves) {
+		waveResult := v1alpha1.SyncWaveResult{Wave: int64(wave), Resources: int64(len(waves[wave]))}
+		for _, res := range waves[wave] {
+			if applied[res.key] {
+				waveResult.Applied++
+			}
+			if res.healthy {
+				waveResult.Healthy++
+			}
+		}
+		waveResults = append(waveResults, waveResult)
+	}
+	return waveResults
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func wavePod(name string, wave string, phase string) *unstructured.Unstructured {
+	pod := Annotate(NewPod(), common.AnnotationSyncWave, wave)
+	pod.SetName(name)
+	pod.SetNamespace("fake-dest-ns")
+	if phase != "" {
+		_ = unstructured.SetNestedField(pod.Object, phase, "status", "phase")
+	}
+	return pod
+}
+
+func appliedResult(obj *unstructured.Unstructured) common.ResourceSyncResult {
+	return common.ResourceSyncResult{ResourceKey: kube.GetResourceKey(obj), SyncPhase: common.SyncPhaseSync, Status: common.ResultCodeSynced, HookPhase: common.OperationRunning}
+}
+
+func TestWaveGate_MaxConcurrency(t *testing.T) {
+	targets := []*unstructured.Unstructured{
+		wavePod("a", "0", ""), wavePod("b", "0", ""), wavePod("c", "0", ""), wavePod("d", "1", ""),
+	}
+	strategy := &v1alpha1.SyncWavesStrategy{MaxConcurrency: 2}
+
+	t.Run("FirstBatch", func(t *testing.T) {
+		gate := newWaveGate(strategy, "fake-dest-ns", sync.ReconciliationResult{Target: targets, Live: make([]*unstructured.Unstructured, len(targets))}, nil, false, nil, nil, nil)
+		assert.True(t, gate.permits(targets[0]))
+		assert.True(t, gate.permits(targets[1]))
+		assert.False(t, gate.permits(targets[2]))
+		assert.True(t, gate.permits(targets[3]))
+		assert.True(t, gate.hasHiddenResources())
+		assert.False(t, gate.blocked())
+	})
+
+	t.Run("WaitingForAppliedResources", func(t *testing.T) {
+		live := []*unstructured.Unstructured{wavePod("a", "0", "Pending"), wavePod("b", "0", "Pending"), nil, nil}
+		previous := []common.ResourceSyncResult{appliedResult(targets[0]), appliedResult(targets[1])}
+		gate := newWaveGate(strategy, "fake-dest-ns", sync.ReconciliationResult{Target: targets, Live: live}, nil, false, nil, previous, nil)
+		assert.False(t, gate.permits(targets[2]))
+		assert.True(t, gate.blocked())
+		assert.Equal(t, "waiting for the resources of sync wave 0 to become healthy before applying 1 more", gate.waitingMessage())
+	})
+
+	t.Run("NextBatch", func(t *testing.T) {
+		live := []*unstructured.Unstructured{wavePod("a", "0", "Succeeded"), wavePod("b", "0", "Succeeded"), nil, nil}
+		previous := []common.ResourceSyncResult{appliedResult(targets[0]), appliedResult(targets[1])}
+		gate := newWaveGate(strategy, "fake-dest-ns", sync.ReconciliationResult{Target: targets, Live: live}, nil, false, nil, previous, nil)
+		assert.True(t, gate.permits(targets[2]))
+		assert.False(t, gate.hasHiddenResources())
+
+		waves := gate.waveResults(append(previous, appliedResult(targets[2])))
+		assert.Equal(t, []v1alpha1.SyncWaveResult{
+			{Wave: 0, Resources: 3, Applied: 3, Healthy: 2},
+			{Wave: 1, Resources: 1},
+		}, waves)
+	})
+
+	t.Run("FilteredResources", func(t *testing.T) {
+		filter := func(key kube.ResourceKey, _ *unstructured.Unstructured, _ *unstructured.Unstructured) bool {
+			return key.Name != "a"
+		}
+		gate := newWaveGate(strategy, "fake-dest-ns", sync.ReconciliationResult{Target: targets, Live: make([]*unstructured.Unstructured, len(targets))}, filter, false, nil, nil, nil)
+		assert.False(t, gate.hasHiddenResources())
+	})
+}
+
+func TestWaveGate_HealthThreshold(t *testing.T) {
+	targets := []*unstructured.Unstructured{
+		wavePod("a", "0", ""), wavePod("b", "0", ""), wavePod("c", "0", ""), wavePod("d", "0", ""),
+	}
+	previous := []common.ResourceSyncResult{appliedResult(targets[0]), appliedResult(targets[1]), appliedResult(targets[2]), appliedResult(targets[3])}
+
+	t.Run("ThresholdReached", func(t *testing.T) {
+		live := []*unstructured.Unstructured{wavePod("a", "0", "Succeeded"), wavePod("b", "0", "Succeeded"), wavePod("c", "0", "Succeeded"), wavePod("d", "0", "Pending")}
+		gate := newWaveGate(&v1alpha1.SyncWavesStrategy{HealthThreshold: 75}, "fake-dest-ns", sync.ReconciliationResult{Target: targets, Live: live}, nil, false, nil, previous, nil)
+		healthStatus, err := gate.GetResourceHealth(live[3])
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
+		assert.Equal(t, "health threshold of 75% reached by the sync wave", healthStatus.Message)
+	})
+
+	t.Run("ThresholdNotReached", func(t *testing.T) {
+		live := []*unstructured.Unstructured{wavePod("a", "0", "Succeeded"), wavePod("b", "0", "Succeeded"), wavePod("c", "0", "Pending"), wavePod("d", "0", "Pending")}
+		gate := newWaveGate(&v1alpha1.SyncWavesStrategy{HealthThreshold: 75}, "fake-dest-ns", sync.ReconciliationResult{Target: targets, Live: live}, nil, false, nil, previous, nil)
+		healthStatus, err := gate.GetResourceHealth(live[3])
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+
+	t.Run("HealthyBeforeApplied", func(t *testing.T) {
+		live := []*unstructured.Unstructured{wavePod("a", "0", "Succeeded"), wavePod("b", "0", "Succeeded"), wavePod("c", "0", "Succeeded"), wavePod("d", "0", "Pending")}
+		// c was healthy before the operation and has not been applied yet
+		gate := newWaveGate(&v1alpha1.SyncWavesStrategy{HealthThreshold: 75}, "fake-dest-ns", sync.ReconciliationResult{Target: targets, Live: live}, nil, false, nil, []common.ResourceSyncResult{appliedResult(targets[0]), appliedResult(targets[1]), appliedResult(targets[3])}, nil)
+		healthStatus, err := gate.GetResourceHealth(live[3])
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+
+	t.Run("DegradedResource", func(t *testing.T) {
+		live := []*unstructured.Unstructured{wavePod("a", "0", "Succeeded"), wavePod("b", "0", "Succeeded"), wavePod("c", "0", "Succeeded"), wavePod("d", "0", "Failed")}
+		gate := newWaveGate(&v1alpha1.SyncWavesStrategy{HealthThreshold: 75}, "fake-dest-ns", sync.ReconciliationResult{Target: targets, Live: live}, nil, false, nil, previous, nil)
+		healthStatus, err := gate.GetResourceHealth(live[3])
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	})
+}
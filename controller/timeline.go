@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+)
+
+const (
+	// statusTimelineLimit is the maximum number of status transitions kept per application
+	statusTimelineLimit = 100
+	// transitionReasonResourcesLimit is the maximum number of resources listed in the reason of a status transition
+	transitionReasonResourcesLimit = 5
+)
+
+// recordStatusTransitions appends the transitions of the health and sync status of the application to its status
+// timeline. The timeline is only loaded and updated from the cache when one of the statuses changed.
+func (ctrl *ApplicationController) recordStatusTransitions(orig *appv1.Application, newStatus *appv1.ApplicationStatus, now metav1.Time) {
+	revision := newStatus.Sync.Revision
+	if revision == "" {
+		revision = strings.Join(newStatus.Sync.Revisions, ",")
+	}
+	var transitions []appv1.StatusTransition
+	if orig.Status.Health.Status != newStatus.Health.Status {
+		transitions = append(transitions, appv1.StatusTransition{
+			Type:           appv1.StatusTransitionHealth,
+			From:           string(orig.Status.Health.Status),
+			To:             string(newStatus.Health.Status),
+			Reason:         healthTransitionReason(newStatus),
+			Revision:       revision,
+			TransitionedAt: now,
+		})
+	}
+	if orig.Status.Sync.Status != newStatus.Sync.Status {
+		transitions = append(transitions, appv1.StatusTransition{
+			Type:           appv1.StatusTransitionSync,
+			From:           string(orig.Status.Sync.Status),
+			To:             string(newStatus.Sync.Status),
+			Reason:         syncTransitionReason(newStatus),
+			Revision:       revision,
+			TransitionedAt: now,
+		})
+	}
+	if len(transitions) == 0 {
+		return
+	}
+
+	logCtx := getAppLog(orig)
+	appName := orig.InstanceName(ctrl.namespace)
+	var timeline []appv1.StatusTransition
+	if err := ctrl.cache.GetAppStatusTimeline(appName, &timeline); err != nil && !errors.Is(err, appstatecache.ErrCacheMiss) {
+		logCtx.Warnf("Failed to get status timeline: %v", err)
+		return
+	}
+	timeline = append(timeline, transitions...)
+	if len(timeline) > statusTimelineLimit {
+		timeline = timeline[len(timeline)-statusTimelineLimit:]
+	}
+	if err := ctrl.cache.SetAppStatusTimeline(appName, timeline); err != nil {
+		logCtx.Warnf("Failed to set status timeline: %v", err)
+	}
+}
+
+// healthTransitionReason lists the resources which have the new health status of the application, unless it is healthy
+func healthTransitionReason(status *appv1.ApplicationStatus) string {
+	if status.Health.Status == health.HealthStatusHealthy {
+		return ""
+	}
+	var resources []string
+	for _, res := range status.Resources {
+		if res.Health == nil || res.Health.Status != status.Health.Status {
+			continue
+		}
+		key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+		resource := key.String()
+		if res.Health.Message != "" {
+			resource = fmt.Sprintf("%s: %s", resource, res.Health.Message)
+		}
+		resources = append(resources, resource)
+	}
+	return formatTransitionReason(resources)
+}
+
+// syncTransitionReason lists the resources which are out of sync, if any
+func syncTransitionReason(status *appv1.ApplicationStatus) string {
+	var resources []string
+	for _, res := range status.Resources {
+		if res.Status == appv1.SyncStatusCodeOutOfSync {
+			key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+			resources = append(resources, key.String())
+		}
+	}
+	return formatTransitionReason(resources)
+}
+
+func formatTransitionReason(resources []string) string {
+	if len(resources) > transitionReasonResourcesLimit {
+		return fmt.Sprintf("%s and %d more", strings.Join(resources[:transitionReasonResourcesLimit], "; "), len(resources)-transitionReasonResourcesLimit)
+	}
+	return strings.Join(resources, "; ")
+}
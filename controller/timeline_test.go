@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestRecordStatusTransitions(t *testing.T) {
+	app := newFakeApp()
+	app.Status.Health.Status = health.HealthStatusHealthy
+	app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+	// no transition
+	ctrl.recordStatusTransitions(app, app.Status.DeepCopy(), metav1.Now())
+	var timeline []v1alpha1.StatusTransition
+	require.Error(t, ctrl.cache.GetAppStatusTimeline(app.InstanceName(ctrl.namespace), &timeline))
+
+	// the application degrades and goes out of sync
+	degradedAt := metav1.NewTime(time.Now().Truncate(time.Second))
+	newStatus := app.Status.DeepCopy()
+	newStatus.Health.Status = health.HealthStatusDegraded
+	newStatus.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+	newStatus.Sync.Revision = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	newStatus.Resources = []v1alpha1.ResourceStatus{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: v1alpha1.SyncStatusCodeOutOfSync, Health: &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded, Message: "Deployment exceeded its progress deadline"}},
+		{Kind: "Service", Namespace: "default", Name: "guestbook", Status: v1alpha1.SyncStatusCodeSynced, Health: &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy}},
+	}
+	ctrl.recordStatusTransitions(app, newStatus, degradedAt)
+
+	require.NoError(t, ctrl.cache.GetAppStatusTimeline(app.InstanceName(ctrl.namespace), &timeline))
+	require.Len(t, timeline, 2)
+	assert.Equal(t, v1alpha1.StatusTransition{
+		Type:           v1alpha1.StatusTransitionHealth,
+		From:           "Healthy",
+		To:             "Degraded",
+		Reason:         "apps/Deployment/default/guestbook: Deployment exceeded its progress deadline",
+		Revision:       "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		TransitionedAt: degradedAt,
+	}, timeline[0])
+	assert.Equal(t, v1alpha1.StatusTransitionSync, timeline[1].Type)
+	assert.Equal(t, "Synced", timeline[1].From)
+	assert.Equal(t, "OutOfSync", timeline[1].To)
+	assert.Equal(t, "apps/Deployment/default/guestbook", timeline[1].Reason)
+
+	// the application recovers
+	app.Status = *newStatus
+	newStatus = app.Status.DeepCopy()
+	newStatus.Health.Status = health.HealthStatusHealthy
+	ctrl.recordStatusTransitions(app, newStatus, metav1.Now())
+	require.NoError(t, ctrl.cache.GetAppStatusTimeline(app.InstanceName(ctrl.namespace), &timeline))
+	require.Len(t, timeline, 3)
+	assert.Equal(t, "Healthy", timeline[2].To)
+	assert.Empty(t, timeline[2].Reason)
+}
+
+func TestRecordStatusTransitionsLimit(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+	for i := 0; i < statusTimelineLimit+5; i++ {
+		newStatus := app.Status.DeepCopy()
+		newStatus.Health.Status = health.HealthStatusCode(fmt.Sprintf("status-%d", i))
+		ctrl.recordStatusTransitions(app, newStatus, metav1.Now())
+		app.Status = *newStatus
+	}
+
+	var timeline []v1alpha1.StatusTransition
+	require.NoError(t, ctrl.cache.GetAppStatusTimeline(app.InstanceName(ctrl.namespace), &timeline))
+	require.Len(t, timeline, statusTimelineLimit)
+	assert.Equal(t, fmt.Sprintf("status-%d", statusTimelineLimit+4), timeline[len(timeline)-1].To)
+}
+
+func TestFormatTransitionReason(t *testing.T) {
+	assert.Empty(t, formatTransitionReason(nil))
+	assert.Equal(t, "a; b", formatTransitionReason([]string{"a", "b"}))
+	assert.Equal(t, "a; b; c; d; e and 2 more", formatTransitionReason([]string{"a", "b", "c", "d", "e", "f", "g"}))
+}
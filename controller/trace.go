@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+var tracer = otel.Tracer("github.com/argoproj/argo-cd/v2/controller")
+
+// appSpanAttributes returns the attributes identifying an application in the spans of the controller
+func appSpanAttributes(app *v1alpha1.Application) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("application", app.QualifiedName()),
+		attribute.String("project", app.Spec.GetProject()),
+		attribute.String("dest_server", app.Spec.Destination.Server),
+		attribute.String("dest_namespace", app.Spec.Destination.Namespace),
+	}
+}
+
+// endSpan records the given error, if any, and ends the span
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// operationContext returns a context whose parent span is derived from the application and the start time of its
+// operation. An operation is processed in several iterations, e.g. one per sync wave, so the spans of all of its
+// iterations belong to the same trace, which is identified by the same ID in every controller replica.
+func operationContext(app *v1alpha1.Application, state *v1alpha1.OperationState) context.Context {
+	hash := sha256.Sum256([]byte(string(app.UID) + "/" + app.QualifiedName() + "/" + state.StartedAt.UTC().Format(time.RFC3339Nano)))
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], hash[:16])
+	copy(spanID[:], hash[16:24])
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	return trace.ContextWithRemoteSpanContext(context.Background(), spanContext)
+}
+
+// syncWaveStart returns the time at which the given wave started to be applied. The engine reports a wave each time
+// it applies resources of the wave, which happens in several iterations of the operation when the concurrency of the
+// wave is limited, so the start of the wave is recorded in the result of the operation, and the given start of the
+// current iteration is recorded for a wave which was not applied before.
+func syncWaveStart(syncRes *v1alpha1.SyncOperationResult, phase common.SyncPhase, wave int, iterationStart time.Time) time.Time {
+	if syncRes.CurrentWaveStartedAt == nil || syncRes.CurrentWavePhase != phase || syncRes.CurrentWave != int64(wave) {
+		syncRes.CurrentWavePhase = phase
+		syncRes.CurrentWave = int64(wave)
+		syncRes.CurrentWaveStartedAt = &metav1.Time{Time: iterationStart}
+	}
+	return syncRes.CurrentWaveStartedAt.Time
+}
+
+// traceCompletedHooks records a span for each hook of a sync operation which completed since the previous iteration
+// of the operation. The span of a hook starts when its live resource was created, or when the operation started if
+// the live resource is gone, and ends now.
+func traceCompletedHooks(ctx context.Context, previous, current []common.ResourceSyncResult, liveObjs []*unstructured.Unstructured, operationStartedAt time.Time) {
+	completed := map[kube.ResourceKey]bool{}
+	for _, res := range previous {
+		if res.HookType != "" && res.HookPhase.Completed() {
+			completed[res.ResourceKey] = true
+		}
+	}
+	createdAt := map[kube.ResourceKey]time.Time{}
+	for _, obj := range liveObjs {
+		if obj != nil {
+			createdAt[kube.GetResourceKey(obj)] = obj.GetCreationTimestamp().Time
+		}
+	}
+	now := time.Now()
+	for _, res := range current {
+		if res.HookType == "" || !res.HookPhase.Completed() || completed[res.ResourceKey] {
+			continue
+		}
+		start, ok := createdAt[res.ResourceKey]
+		if !ok || start.IsZero() {
+			start = operationStartedAt
+		}
+		_, span := tracer.Start(ctx, "hook", trace.WithTimestamp(start), trace.WithAttributes(
+			attribute.String("hook_type", string(res.HookType)),
+			attribute.String("sync_phase", string(res.SyncPhase)),
+			attribute.String("kind", res.ResourceKey.Kind),
+			attribute.String("namespace", res.ResourceKey.Namespace),
+			attribute.String("name", res.ResourceKey.Name),
+			attribute.String("hook_phase", string(res.HookPhase)),
+		))
+		if !res.HookPhase.Successful() {
+			span.SetStatus(codes.Error, res.Message)
+		}
+		span.End(trace.WithTimestamp(now))
+	}
+}
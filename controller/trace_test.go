@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := tracer
+	tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+	t.Cleanup(func() {
+		tracer = previous
+	})
+	return recorder
+}
+
+func TestOperationContext(t *testing.T) {
+	app := newFakeApp()
+	state := &v1alpha1.OperationState{StartedAt: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))}
+
+	spanContext := trace.SpanContextFromContext(operationContext(app, state))
+	assert.True(t, spanContext.IsValid())
+	assert.True(t, spanContext.IsRemote())
+	assert.Equal(t, spanContext, trace.SpanContextFromContext(operationContext(app, state)))
+
+	otherState := &v1alpha1.OperationState{StartedAt: metav1.NewTime(state.StartedAt.Add(time.Second))}
+	assert.NotEqual(t, spanContext.TraceID(), trace.SpanContextFromContext(operationContext(app, otherState)).TraceID())
+}
+
+func TestSyncWaveStart(t *testing.T) {
+	syncRes := &v1alpha1.SyncOperationResult{}
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, first, syncWaveStart(syncRes, common.SyncPhaseSync, 1, first))
+	// the wave is applied again in a later iteration of the operation
+	assert.Equal(t, first, syncWaveStart(syncRes, common.SyncPhaseSync, 1, first.Add(time.Minute)))
+	assert.EqualValues(t, common.SyncPhaseSync, syncRes.CurrentWavePhase)
+	assert.Equal(t, int64(1), syncRes.CurrentWave)
+
+	next := first.Add(2 * time.Minute)
+	assert.Equal(t, next, syncWaveStart(syncRes, common.SyncPhaseSync, 2, next))
+	postSync := first.Add(3 * time.Minute)
+	assert.Equal(t, postSync, syncWaveStart(syncRes, common.SyncPhasePostSync, 2, postSync))
+}
+
+func TestTraceCompletedHooks(t *testing.T) {
+	recorder := newSpanRecorder(t)
+	startedAt := time.Now().Add(-time.Minute)
+	createdAt := time.Now().Add(-30 * time.Second).Truncate(time.Second)
+
+	preSync := kube.ResourceKey{Group: "batch", Kind: "Job", Namespace: "default", Name: "pre-sync"}
+	postSync := kube.ResourceKey{Group: "batch", Kind: "Job", Namespace: "default", Name: "post-sync"}
+	failed := kube.ResourceKey{Group: "batch", Kind: "Job", Namespace: "default", Name: "failed"}
+	running := kube.ResourceKey{Group: "batch", Kind: "Job", Namespace: "default", Name: "running"}
+
+	postSyncObj := &unstructured.Unstructured{}
+	postSyncObj.SetAPIVersion("batch/v1")
+	postSyncObj.SetKind("Job")
+	postSyncObj.SetNamespace("default")
+	postSyncObj.SetName("post-sync")
+	postSyncObj.SetCreationTimestamp(metav1.NewTime(createdAt))
+
+	previous := []common.ResourceSyncResult{
+		{ResourceKey: preSync, HookType: common.HookTypePreSync, HookPhase: common.OperationSucceeded, SyncPhase: common.SyncPhasePreSync},
+		{ResourceKey: postSync, HookType: common.HookTypePostSync, HookPhase: common.OperationRunning, SyncPhase: common.SyncPhasePostSync},
+	}
+	current := []common.ResourceSyncResult{
+		{ResourceKey: preSync, HookType: common.HookTypePreSync, HookPhase: common.OperationSucceeded, SyncPhase: common.SyncPhasePreSync},
+		{ResourceKey: postSync, HookType: common.HookTypePostSync, HookPhase: common.OperationSucceeded, SyncPhase: common.SyncPhasePostSync},
+		{ResourceKey: failed, HookType: common.HookTypePostSync, HookPhase: common.OperationFailed, SyncPhase: common.SyncPhasePostSync, Message: "job failed"},
+		{ResourceKey: running, HookType: common.HookTypePostSync, HookPhase: common.OperationRunning, SyncPhase: common.SyncPhasePostSync},
+		{ResourceKey: kube.ResourceKey{Kind: "ConfigMap", Namespace: "default", Name: "cm"}, Status: common.ResultCodeSynced, SyncPhase: common.SyncPhaseSync},
+	}
+
+	traceCompletedHooks(context.Background(), previous, current, []*unstructured.Unstructured{postSyncObj}, startedAt)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "hook", spans[0].Name())
+	assert.Equal(t, createdAt, spans[0].StartTime())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Contains(t, spans[0].Attributes(), attribute.String("name", "post-sync"))
+
+	assert.Equal(t, startedAt, spans[1].StartTime())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "job failed", spans[1].Status().Description)
+	assert.Contains(t, spans[1].Attributes(), attribute.String("name", "failed"))
+}
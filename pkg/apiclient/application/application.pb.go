@@ -1103,6 +1103,7 @@ type ApplicationSyncRequest struct {
 	Project              *string                           `protobuf:"bytes,13,opt,name=project" json:"project,omitempty"`
 	SourcePositions      []int64                           `protobuf:"varint,14,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
 	Revisions            []string                          `protobuf:"bytes,15,rep,name=revisions" json:"revisions,omitempty"`
+	Timeout              *string                           `protobuf:"bytes,16,opt,name=timeout" json:"timeout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_unrecognized     []byte                            `json:"-"`
 	XXX_sizecache        int32                             `json:"-"`
@@ -1239,6 +1240,13 @@ func (m *ApplicationSyncRequest) GetRevisions() []string {
 	return nil
 }
 
+func (m *ApplicationSyncRequest) GetTimeout() string {
+	if m != nil && m.Timeout != nil {
+		return *m.Timeout
+	}
+	return ""
+}
+
 type ApplicationValidationRequest struct {
 	Application          *v1alpha1.Application `protobuf:"bytes,1,req,name=application" json:"application,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -2586,6 +2594,206 @@ func (m *OperationTerminateRequest) GetProject() string {
 	return ""
 }
 
+type ApplicationMaintenanceRequest struct {
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// Reason describes why the application is put in maintenance mode
+	Reason *string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+	// Duration of the maintenance mode, e.g. 2h. Defaults to 24h
+	Duration             *string  `protobuf:"bytes,3,opt,name=duration" json:"duration,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,4,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,5,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationMaintenanceRequest) Reset()         { *m = ApplicationMaintenanceRequest{} }
+func (m *ApplicationMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationMaintenanceRequest) ProtoMessage()    {}
+func (*ApplicationMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{32}
+}
+func (m *ApplicationMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationMaintenanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationMaintenanceRequest.Merge(m, src)
+}
+func (m *ApplicationMaintenanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationMaintenanceRequest proto.InternalMessageInfo
+
+func (m *ApplicationMaintenanceRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationMaintenanceRequest) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return ""
+}
+
+func (m *ApplicationMaintenanceRequest) GetDuration() string {
+	if m != nil && m.Duration != nil {
+		return *m.Duration
+	}
+	return ""
+}
+
+func (m *ApplicationMaintenanceRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationMaintenanceRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+type ApplicationQueuedOperationRequest struct {
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// ID of the queued operation
+	Id                   *string  `protobuf:"bytes,2,req,name=id" json:"id,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationQueuedOperationRequest) Reset()         { *m = ApplicationQueuedOperationRequest{} }
+func (m *ApplicationQueuedOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationQueuedOperationRequest) ProtoMessage()    {}
+func (*ApplicationQueuedOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{33}
+}
+func (m *ApplicationQueuedOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationQueuedOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationQueuedOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationQueuedOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationQueuedOperationRequest.Merge(m, src)
+}
+func (m *ApplicationQueuedOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationQueuedOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationQueuedOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationQueuedOperationRequest proto.InternalMessageInfo
+
+func (m *ApplicationQueuedOperationRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationQueuedOperationRequest) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *ApplicationQueuedOperationRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationQueuedOperationRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+type OperationQueueResponse struct {
+	Items                []*v1alpha1.QueuedOperation `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *OperationQueueResponse) Reset()         { *m = OperationQueueResponse{} }
+func (m *OperationQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*OperationQueueResponse) ProtoMessage()    {}
+func (*OperationQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{34}
+}
+func (m *OperationQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperationQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationQueueResponse.Merge(m, src)
+}
+func (m *OperationQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationQueueResponse proto.InternalMessageInfo
+
+func (m *OperationQueueResponse) GetItems() []*v1alpha1.QueuedOperation {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type ApplicationSyncWindowsQuery struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
@@ -2599,7 +2807,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{32}
+	return fileDescriptor_df6e82b174b5eaec, []int{35}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2662,7 +2870,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{33}
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2726,7 +2934,7 @@ func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{34}
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2793,7 +3001,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{35}
+	return fileDescriptor_df6e82b174b5eaec, []int{38}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2840,7 +3048,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{36}
+	return fileDescriptor_df6e82b174b5eaec, []int{39}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2936,7 +3144,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{37}
+	return fileDescriptor_df6e82b174b5eaec, []int{40}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2972,28 +3180,25 @@ func (m *ManagedResourcesResponse) GetItems() []*v1alpha1.ResourceDiff {
 	return nil
 }
 
-type LinkInfo struct {
-	Title                *string  `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
-	Url                  *string  `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
-	Description          *string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
-	IconClass            *string  `protobuf:"bytes,4,opt,name=iconClass" json:"iconClass,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DriftHistoryResponse struct {
+	Items                []*v1alpha1.DriftRecord `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
-func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
-func (*LinkInfo) ProtoMessage()    {}
-func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{38}
+func (m *DriftHistoryResponse) Reset()         { *m = DriftHistoryResponse{} }
+func (m *DriftHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*DriftHistoryResponse) ProtoMessage()    {}
+func (*DriftHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{41}
 }
-func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
+func (m *DriftHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LinkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DriftHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LinkInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DriftHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3003,65 +3208,91 @@ func (m *LinkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LinkInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LinkInfo.Merge(m, src)
+func (m *DriftHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriftHistoryResponse.Merge(m, src)
 }
-func (m *LinkInfo) XXX_Size() int {
+func (m *DriftHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LinkInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_LinkInfo.DiscardUnknown(m)
+func (m *DriftHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriftHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LinkInfo proto.InternalMessageInfo
+var xxx_messageInfo_DriftHistoryResponse proto.InternalMessageInfo
 
-func (m *LinkInfo) GetTitle() string {
-	if m != nil && m.Title != nil {
-		return *m.Title
+func (m *DriftHistoryResponse) GetItems() []*v1alpha1.DriftRecord {
+	if m != nil {
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-func (m *LinkInfo) GetUrl() string {
-	if m != nil && m.Url != nil {
-		return *m.Url
-	}
-	return ""
+type HookLogsResponse struct {
+	Items                []*v1alpha1.HookLogs `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *LinkInfo) GetDescription() string {
-	if m != nil && m.Description != nil {
-		return *m.Description
+func (m *HookLogsResponse) Reset()         { *m = HookLogsResponse{} }
+func (m *HookLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*HookLogsResponse) ProtoMessage()    {}
+func (*HookLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{42}
+}
+func (m *HookLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *HookLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookLogsResponse.Merge(m, src)
+}
+func (m *HookLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookLogsResponse.DiscardUnknown(m)
 }
 
-func (m *LinkInfo) GetIconClass() string {
-	if m != nil && m.IconClass != nil {
-		return *m.IconClass
+var xxx_messageInfo_HookLogsResponse proto.InternalMessageInfo
+
+func (m *HookLogsResponse) GetItems() []*v1alpha1.HookLogs {
+	if m != nil {
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-type LinksResponse struct {
-	Items                []*LinkInfo `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type StatusTimelineResponse struct {
+	Items                []*v1alpha1.StatusTransition `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
-func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
-func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
-func (*LinksResponse) ProtoMessage()    {}
-func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{39}
+func (m *StatusTimelineResponse) Reset()         { *m = StatusTimelineResponse{} }
+func (m *StatusTimelineResponse) String() string { return proto.CompactTextString(m) }
+func (*StatusTimelineResponse) ProtoMessage()    {}
+func (*StatusTimelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{43}
 }
-func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
+func (m *StatusTimelineResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StatusTimelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LinksResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StatusTimelineResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3071,46 +3302,44 @@ func (m *LinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *LinksResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LinksResponse.Merge(m, src)
+func (m *StatusTimelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusTimelineResponse.Merge(m, src)
 }
-func (m *LinksResponse) XXX_Size() int {
+func (m *StatusTimelineResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LinksResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LinksResponse.DiscardUnknown(m)
+func (m *StatusTimelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusTimelineResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LinksResponse proto.InternalMessageInfo
+var xxx_messageInfo_StatusTimelineResponse proto.InternalMessageInfo
 
-func (m *LinksResponse) GetItems() []*LinkInfo {
+func (m *StatusTimelineResponse) GetItems() []*v1alpha1.StatusTransition {
 	if m != nil {
 		return m.Items
 	}
 	return nil
 }
 
-type ListAppLinksRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            *string  `protobuf:"bytes,3,opt,name=namespace" json:"namespace,omitempty"`
-	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DORAMetricsResponse struct {
+	Items                []*v1alpha1.DORAMetrics `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
-func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
-func (*ListAppLinksRequest) ProtoMessage()    {}
-func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{40}
+func (m *DORAMetricsResponse) Reset()         { *m = DORAMetricsResponse{} }
+func (m *DORAMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*DORAMetricsResponse) ProtoMessage()    {}
+func (*DORAMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{44}
 }
-func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
+func (m *DORAMetricsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListAppLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DORAMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListAppLinksRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DORAMetricsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3120,20 +3349,187 @@ func (m *ListAppLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ListAppLinksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListAppLinksRequest.Merge(m, src)
+func (m *DORAMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DORAMetricsResponse.Merge(m, src)
 }
-func (m *ListAppLinksRequest) XXX_Size() int {
+func (m *DORAMetricsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListAppLinksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListAppLinksRequest.DiscardUnknown(m)
+func (m *DORAMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DORAMetricsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListAppLinksRequest proto.InternalMessageInfo
+var xxx_messageInfo_DORAMetricsResponse proto.InternalMessageInfo
 
-func (m *ListAppLinksRequest) GetName() string {
-	if m != nil && m.Name != nil {
+func (m *DORAMetricsResponse) GetItems() []*v1alpha1.DORAMetrics {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type LinkInfo struct {
+	Title                *string  `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
+	Url                  *string  `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
+	Description          *string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	IconClass            *string  `protobuf:"bytes,4,opt,name=iconClass" json:"iconClass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
+func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
+func (*LinkInfo) ProtoMessage()    {}
+func (*LinkInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{45}
+}
+func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkInfo.Merge(m, src)
+}
+func (m *LinkInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkInfo proto.InternalMessageInfo
+
+func (m *LinkInfo) GetTitle() string {
+	if m != nil && m.Title != nil {
+		return *m.Title
+	}
+	return ""
+}
+
+func (m *LinkInfo) GetUrl() string {
+	if m != nil && m.Url != nil {
+		return *m.Url
+	}
+	return ""
+}
+
+func (m *LinkInfo) GetDescription() string {
+	if m != nil && m.Description != nil {
+		return *m.Description
+	}
+	return ""
+}
+
+func (m *LinkInfo) GetIconClass() string {
+	if m != nil && m.IconClass != nil {
+		return *m.IconClass
+	}
+	return ""
+}
+
+type LinksResponse struct {
+	Items                []*LinkInfo `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
+func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
+func (*LinksResponse) ProtoMessage()    {}
+func (*LinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{46}
+}
+func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinksResponse.Merge(m, src)
+}
+func (m *LinksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinksResponse proto.InternalMessageInfo
+
+func (m *LinksResponse) GetItems() []*LinkInfo {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListAppLinksRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace            *string  `protobuf:"bytes,3,opt,name=namespace" json:"namespace,omitempty"`
+	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
+func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAppLinksRequest) ProtoMessage()    {}
+func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{47}
+}
+func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAppLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAppLinksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAppLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAppLinksRequest.Merge(m, src)
+}
+func (m *ListAppLinksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAppLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAppLinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAppLinksRequest proto.InternalMessageInfo
+
+func (m *ListAppLinksRequest) GetName() string {
+	if m != nil && m.Name != nil {
 		return *m.Name
 	}
 	return ""
@@ -3186,12 +3582,19 @@ func init() {
 	proto.RegisterType((*ApplicationPodLogsQuery)(nil), "application.ApplicationPodLogsQuery")
 	proto.RegisterType((*LogEntry)(nil), "application.LogEntry")
 	proto.RegisterType((*OperationTerminateRequest)(nil), "application.OperationTerminateRequest")
+	proto.RegisterType((*ApplicationMaintenanceRequest)(nil), "application.ApplicationMaintenanceRequest")
+	proto.RegisterType((*ApplicationQueuedOperationRequest)(nil), "application.ApplicationQueuedOperationRequest")
+	proto.RegisterType((*OperationQueueResponse)(nil), "application.OperationQueueResponse")
 	proto.RegisterType((*ApplicationSyncWindowsQuery)(nil), "application.ApplicationSyncWindowsQuery")
 	proto.RegisterType((*ApplicationSyncWindowsResponse)(nil), "application.ApplicationSyncWindowsResponse")
 	proto.RegisterType((*ApplicationSyncWindow)(nil), "application.ApplicationSyncWindow")
 	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
 	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
 	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
+	proto.RegisterType((*DriftHistoryResponse)(nil), "application.DriftHistoryResponse")
+	proto.RegisterType((*HookLogsResponse)(nil), "application.HookLogsResponse")
+	proto.RegisterType((*StatusTimelineResponse)(nil), "application.StatusTimelineResponse")
+	proto.RegisterType((*DORAMetricsResponse)(nil), "application.DORAMetricsResponse")
 	proto.RegisterType((*LinkInfo)(nil), "application.LinkInfo")
 	proto.RegisterType((*LinksResponse)(nil), "application.LinksResponse")
 	proto.RegisterType((*ListAppLinksRequest)(nil), "application.ListAppLinksRequest")
@@ -3202,197 +3605,224 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 3034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcd, 0x8f, 0x1c, 0x47,
-	0x15, 0xa7, 0x66, 0x77, 0x76, 0x67, 0xdf, 0xd8, 0x5e, 0xbb, 0x62, 0x2f, 0x9d, 0xf6, 0xc6, 0x6c,
-	0xda, 0x76, 0x3c, 0x59, 0x7b, 0x67, 0xec, 0xc5, 0x40, 0xb2, 0x49, 0x04, 0xce, 0xda, 0x71, 0x4c,
-	0xd6, 0x8e, 0xe9, 0x75, 0x62, 0x14, 0x0e, 0xa4, 0xd3, 0x5d, 0x3b, 0xdb, 0xec, 0x4c, 0x77, 0xbb,
-	0xba, 0x67, 0xcc, 0x2a, 0xe4, 0x12, 0x14, 0x09, 0x41, 0x04, 0x02, 0x72, 0x40, 0x80, 0x00, 0x05,
-	0x45, 0x42, 0x08, 0xc4, 0x05, 0x45, 0x48, 0x28, 0x12, 0x1c, 0x40, 0x70, 0x88, 0x14, 0xc1, 0x3f,
-	0x80, 0x22, 0xc4, 0x11, 0x2e, 0x39, 0x23, 0x54, 0xd5, 0x55, 0xdd, 0x55, 0xf3, 0xd1, 0x33, 0xcb,
-	0x2c, 0xc4, 0xa7, 0xed, 0x57, 0x5d, 0xfd, 0xea, 0xf7, 0x5e, 0xbd, 0xaf, 0x7a, 0x35, 0x0b, 0xa7,
-	0x62, 0x42, 0xbb, 0x84, 0x36, 0x9c, 0x28, 0x6a, 0xf9, 0xae, 0x93, 0xf8, 0x61, 0xa0, 0x3e, 0xd7,
-	0x23, 0x1a, 0x26, 0x21, 0xae, 0x2a, 0x43, 0xe6, 0x62, 0x33, 0x0c, 0x9b, 0x2d, 0xd2, 0x70, 0x22,
-	0xbf, 0xe1, 0x04, 0x41, 0x98, 0xf0, 0xe1, 0x38, 0x9d, 0x6a, 0x5a, 0x3b, 0x8f, 0xc4, 0x75, 0x3f,
-	0xe4, 0x6f, 0xdd, 0x90, 0x92, 0x46, 0xf7, 0x42, 0xa3, 0x49, 0x02, 0x42, 0x9d, 0x84, 0x78, 0x62,
-	0xce, 0xc5, 0x7c, 0x4e, 0xdb, 0x71, 0xb7, 0xfd, 0x80, 0xd0, 0xdd, 0x46, 0xb4, 0xd3, 0x64, 0x03,
-	0x71, 0xa3, 0x4d, 0x12, 0x67, 0xd0, 0x57, 0x1b, 0x4d, 0x3f, 0xd9, 0xee, 0xbc, 0x54, 0x77, 0xc3,
-	0x76, 0xc3, 0xa1, 0xcd, 0x30, 0xa2, 0xe1, 0x97, 0xf8, 0xc3, 0x8a, 0xeb, 0x35, 0xba, 0xab, 0x39,
-	0x03, 0x55, 0x96, 0xee, 0x05, 0xa7, 0x15, 0x6d, 0x3b, 0xfd, 0xdc, 0xae, 0x8c, 0xe0, 0x46, 0x49,
-	0x14, 0x0a, 0xdd, 0xf0, 0x47, 0x3f, 0x09, 0xe9, 0xae, 0xf2, 0x98, 0xb2, 0xb1, 0x3e, 0x40, 0x70,
-	0xf8, 0x52, 0xbe, 0xde, 0xe7, 0x3a, 0x84, 0xee, 0x62, 0x0c, 0xd3, 0x81, 0xd3, 0x26, 0x06, 0x5a,
-	0x42, 0xb5, 0x39, 0x9b, 0x3f, 0x63, 0x03, 0x66, 0x29, 0xd9, 0xa2, 0x24, 0xde, 0x36, 0x4a, 0x7c,
-	0x58, 0x92, 0xd8, 0x84, 0x0a, 0x5b, 0x9c, 0xb8, 0x49, 0x6c, 0x4c, 0x2d, 0x4d, 0xd5, 0xe6, 0xec,
-	0x8c, 0xc6, 0x35, 0x98, 0xa7, 0x24, 0x0e, 0x3b, 0xd4, 0x25, 0xcf, 0x13, 0x1a, 0xfb, 0x61, 0x60,
-	0x4c, 0xf3, 0xaf, 0x7b, 0x87, 0x19, 0x97, 0x98, 0xb4, 0x88, 0x9b, 0x84, 0xd4, 0x28, 0xf3, 0x29,
-	0x19, 0xcd, 0xf0, 0x30, 0xe0, 0xc6, 0x4c, 0x8a, 0x87, 0x3d, 0x63, 0x0b, 0x0e, 0x38, 0x51, 0x74,
-	0xc3, 0x69, 0x93, 0x38, 0x72, 0x5c, 0x62, 0xcc, 0xf2, 0x77, 0xda, 0x18, 0xc3, 0x2c, 0x90, 0x18,
-	0x15, 0x0e, 0x4c, 0x92, 0xd6, 0x3a, 0xcc, 0xdd, 0x08, 0x3d, 0x32, 0x5c, 0xdc, 0x5e, 0xf6, 0xa5,
-	0x7e, 0xf6, 0xd6, 0x1f, 0x10, 0x1c, 0xb3, 0x49, 0xd7, 0x67, 0xf8, 0xaf, 0x93, 0xc4, 0xf1, 0x9c,
-	0xc4, 0xe9, 0xe5, 0x58, 0xca, 0x38, 0x9a, 0x50, 0xa1, 0x62, 0xb2, 0x51, 0xe2, 0xe3, 0x19, 0xdd,
-	0xb7, 0xda, 0x54, 0xb1, 0x30, 0xa9, 0x0a, 0x25, 0x89, 0x97, 0xa0, 0x9a, 0xea, 0xf2, 0x5a, 0xe0,
-	0x91, 0x2f, 0x73, 0xed, 0x95, 0x6d, 0x75, 0x08, 0x2f, 0xc2, 0x5c, 0x37, 0xd5, 0xf3, 0x35, 0x8f,
-	0x6b, 0xb1, 0x6c, 0xe7, 0x03, 0xd6, 0x3f, 0x10, 0x9c, 0x50, 0x6c, 0xc0, 0x16, 0x3b, 0x73, 0xa5,
-	0x4b, 0x82, 0x24, 0x1e, 0x2e, 0xd0, 0x39, 0x38, 0x22, 0x37, 0xb1, 0x57, 0x4f, 0xfd, 0x2f, 0x98,
-	0x88, 0xea, 0xa0, 0x14, 0x51, 0x1d, 0x63, 0x82, 0x48, 0xfa, 0xb9, 0x6b, 0x97, 0x85, 0x98, 0xea,
-	0x50, 0x9f, 0xa2, 0xca, 0xc5, 0x8a, 0x9a, 0xd1, 0x14, 0x65, 0xbd, 0x87, 0xc0, 0x50, 0x04, 0xbd,
-	0xee, 0x04, 0xfe, 0x16, 0x89, 0x93, 0x71, 0xf7, 0x0c, 0xed, 0xe3, 0x9e, 0xd5, 0x60, 0x3e, 0x95,
-	0xea, 0x26, 0xf3, 0x47, 0x16, 0x7f, 0x8c, 0xf2, 0xd2, 0x54, 0x6d, 0xca, 0xee, 0x1d, 0x66, 0x7b,
-	0x27, 0xd7, 0x8c, 0x8d, 0x19, 0x6e, 0xc6, 0xf9, 0x80, 0xf5, 0x20, 0xcc, 0x3d, 0xe5, 0xb7, 0xc8,
-	0xfa, 0x76, 0x27, 0xd8, 0xc1, 0x47, 0xa1, 0xec, 0xb2, 0x07, 0x2e, 0xc3, 0x01, 0x3b, 0x25, 0xac,
-	0x6f, 0x23, 0x78, 0x70, 0x98, 0xd4, 0xb7, 0xfd, 0x64, 0x9b, 0x7d, 0x1f, 0x0f, 0x13, 0xdf, 0xdd,
-	0x26, 0xee, 0x4e, 0xdc, 0x69, 0x4b, 0x93, 0x95, 0xf4, 0x64, 0xe2, 0x5b, 0xcf, 0xc0, 0x71, 0x05,
-	0xd2, 0xf3, 0x4e, 0xcb, 0xf7, 0x9c, 0x84, 0xd8, 0x24, 0x8e, 0xc2, 0x20, 0x26, 0x4c, 0x10, 0x42,
-	0x69, 0x48, 0x85, 0x4b, 0xa6, 0x04, 0x5e, 0x80, 0x19, 0x12, 0x24, 0x7e, 0xb2, 0x2b, 0xf6, 0x42,
-	0x50, 0xd6, 0x8b, 0x60, 0xa9, 0xe6, 0x1b, 0xb6, 0x5a, 0x61, 0x27, 0x61, 0x7f, 0x5e, 0x72, 0xdc,
-	0x9d, 0x8c, 0x27, 0x0b, 0x60, 0xe9, 0x2b, 0x21, 0xa3, 0x24, 0x99, 0xd9, 0x05, 0xe4, 0xae, 0xad,
-	0x3a, 0xe7, 0x94, 0xad, 0x0e, 0x59, 0x3f, 0x47, 0x50, 0x1b, 0xa9, 0xc2, 0xdb, 0xd4, 0x89, 0x22,
-	0x42, 0xf1, 0x53, 0x50, 0xbe, 0xc3, 0x5e, 0x70, 0xf0, 0xd5, 0xd5, 0x7a, 0x5d, 0xcd, 0x47, 0x23,
-	0xb9, 0x3c, 0xfd, 0x11, 0x3b, 0xfd, 0x1c, 0xd7, 0xe5, 0x6e, 0x96, 0x38, 0x9f, 0x05, 0x8d, 0x4f,
-	0xb6, 0xe9, 0x6c, 0x3e, 0x9f, 0xf6, 0xe4, 0x0c, 0x4c, 0x47, 0x0e, 0x4d, 0xac, 0x63, 0x70, 0x9f,
-	0xee, 0xcd, 0x5c, 0x7e, 0xeb, 0xb7, 0xba, 0xf1, 0xaf, 0x53, 0xc2, 0x35, 0x7e, 0xa7, 0x43, 0xe2,
-	0x04, 0xef, 0x80, 0x9a, 0x22, 0xb9, 0x82, 0xaa, 0xab, 0xd7, 0xea, 0x79, 0x8e, 0xa9, 0xcb, 0x1c,
-	0xc3, 0x1f, 0xbe, 0xe8, 0x7a, 0xf5, 0xee, 0x6a, 0x3d, 0xda, 0x69, 0xd6, 0x59, 0xc6, 0xd2, 0x90,
-	0xc9, 0x8c, 0xa5, 0x8a, 0x6a, 0xab, 0xdc, 0xd9, 0x3e, 0x76, 0xa2, 0x98, 0xd0, 0x84, 0x4b, 0x56,
-	0xb1, 0x05, 0xc5, 0xcc, 0xad, 0x2b, 0x2c, 0x81, 0x9b, 0x53, 0xc5, 0xce, 0x68, 0xeb, 0x1d, 0x1d,
-	0xfd, 0x73, 0x91, 0xf7, 0x61, 0xa1, 0x57, 0x51, 0x96, 0x74, 0x94, 0xaa, 0xc1, 0x4f, 0xe9, 0x06,
-	0xff, 0x6b, 0x1d, 0xff, 0x65, 0xd2, 0x22, 0x39, 0xfe, 0x41, 0xbe, 0x67, 0xc0, 0xac, 0xeb, 0xc4,
-	0xae, 0xe3, 0xc9, 0x55, 0x24, 0xc9, 0xe2, 0x6e, 0x44, 0xc3, 0xc8, 0x69, 0x72, 0x4e, 0x37, 0xc3,
-	0x96, 0xef, 0xee, 0x8a, 0xe5, 0xfa, 0x5f, 0xf4, 0xf9, 0xe9, 0x74, 0xb1, 0x9f, 0x96, 0x75, 0xd8,
-	0x27, 0xa1, 0xba, 0xb9, 0x1b, 0xb8, 0xcf, 0x46, 0x69, 0x2c, 0x3a, 0x0a, 0x65, 0x3f, 0x21, 0xed,
-	0xd8, 0x40, 0x3c, 0x0e, 0xa5, 0x84, 0xf5, 0xef, 0x32, 0x2c, 0x28, 0xb2, 0xb1, 0x0f, 0x8a, 0x24,
-	0x2b, 0x0a, 0xaa, 0x0b, 0x30, 0xe3, 0xd1, 0x5d, 0xbb, 0x13, 0x08, 0x03, 0x10, 0x14, 0x5b, 0x38,
-	0xa2, 0x9d, 0x20, 0x85, 0x5f, 0xb1, 0x53, 0x02, 0x6f, 0x41, 0x25, 0x4e, 0x58, 0x51, 0xd4, 0xdc,
-	0xe5, 0xc0, 0xab, 0xab, 0x9f, 0x9d, 0x6c, 0xd3, 0x19, 0xf4, 0x4d, 0xc1, 0xd1, 0xce, 0x78, 0xe3,
-	0x3b, 0x2c, 0x04, 0xa7, 0x71, 0x39, 0x36, 0x66, 0x97, 0xa6, 0x6a, 0xd5, 0xd5, 0xcd, 0xc9, 0x17,
-	0x7a, 0x36, 0x62, 0x05, 0x9d, 0x92, 0x70, 0xed, 0x7c, 0x15, 0x16, 0xf5, 0xdb, 0x22, 0x3e, 0xc4,
-	0xa2, 0x78, 0xc9, 0x07, 0xf0, 0xe7, 0xa1, 0xec, 0x07, 0x5b, 0x61, 0x6c, 0xcc, 0x71, 0x30, 0x4f,
-	0x4e, 0x06, 0xe6, 0x5a, 0xb0, 0x15, 0xda, 0x29, 0x43, 0x7c, 0x07, 0x0e, 0x52, 0x92, 0xd0, 0x5d,
-	0xa9, 0x05, 0x03, 0xb8, 0x5e, 0x9f, 0x99, 0x6c, 0x05, 0x5b, 0x65, 0x69, 0xeb, 0x2b, 0xe0, 0x35,
-	0xa8, 0xc6, 0xb9, 0x8d, 0x19, 0x55, 0xbe, 0xa0, 0xa1, 0x31, 0x52, 0x6c, 0xd0, 0x56, 0x27, 0xf7,
-	0x59, 0xf7, 0x81, 0x62, 0xeb, 0x3e, 0x38, 0x32, 0x09, 0x1f, 0x1a, 0x23, 0x09, 0xcf, 0xf7, 0x26,
-	0xe1, 0x6f, 0x20, 0x58, 0xec, 0x4f, 0x67, 0x7c, 0x67, 0xff, 0xff, 0x01, 0xca, 0x7a, 0x57, 0xcf,
-	0xf7, 0x7d, 0xf9, 0x70, 0xb8, 0x67, 0x2e, 0xc2, 0x5c, 0xa0, 0x54, 0x72, 0xec, 0x45, 0x3e, 0xc0,
-	0xab, 0xb3, 0x94, 0x97, 0x28, 0xe0, 0x4a, 0xbc, 0x3a, 0xcb, 0x87, 0xf0, 0x32, 0x1c, 0x56, 0x48,
-	0x19, 0x6f, 0xd8, 0xb4, 0xbe, 0x71, 0x7e, 0x32, 0x10, 0xc8, 0x64, 0x30, 0x28, 0xf3, 0xc4, 0xdb,
-	0x3b, 0x6c, 0xfd, 0x4b, 0xd7, 0x6e, 0x1a, 0xfa, 0x37, 0x23, 0x52, 0x18, 0x64, 0x1c, 0x98, 0x8e,
-	0x23, 0xe2, 0x72, 0x29, 0xaa, 0xab, 0xd7, 0xf7, 0x4d, 0xd5, 0x7c, 0x5d, 0xce, 0xba, 0x28, 0x5d,
-	0x4d, 0x18, 0x75, 0x7f, 0x8c, 0xe0, 0xa3, 0xca, 0x9a, 0x37, 0x9d, 0xc4, 0xdd, 0x2e, 0x12, 0x96,
-	0x45, 0x47, 0x36, 0x47, 0xec, 0x59, 0x4a, 0xb0, 0xdd, 0xe4, 0x0f, 0xb7, 0x76, 0x23, 0xb9, 0x5b,
-	0xf9, 0xc0, 0x84, 0x95, 0xf4, 0x2f, 0x10, 0x98, 0x3d, 0x36, 0x36, 0xca, 0xb8, 0x0e, 0x41, 0xc9,
-	0xf7, 0x44, 0x71, 0x55, 0xf2, 0xbd, 0x3d, 0x86, 0xfa, 0x5e, 0xb8, 0x33, 0xc5, 0x70, 0x67, 0x75,
-	0xb8, 0x1f, 0xf4, 0xc0, 0x95, 0x01, 0x77, 0x7c, 0x5f, 0x40, 0xba, 0x2f, 0xf4, 0x9f, 0x66, 0x4a,
-	0x7d, 0xa7, 0x19, 0x03, 0x66, 0xbb, 0xd9, 0x99, 0x97, 0x17, 0x9c, 0x82, 0x64, 0x22, 0x36, 0x69,
-	0xd8, 0x89, 0x84, 0xd2, 0x53, 0x82, 0xa1, 0xd8, 0xf1, 0x03, 0x76, 0x3e, 0xe3, 0x28, 0xd8, 0xf3,
-	0xde, 0x4f, 0xb9, 0x9a, 0xd8, 0x6f, 0x21, 0x38, 0xb6, 0xbe, 0xed, 0x04, 0x4d, 0x22, 0x9d, 0x49,
-	0x4a, 0x6c, 0xc0, 0xac, 0xe0, 0x21, 0x8b, 0x61, 0x41, 0x8e, 0x90, 0xbb, 0x06, 0xf3, 0x6e, 0x87,
-	0x52, 0x12, 0xe4, 0x5e, 0x9b, 0x56, 0x1e, 0xbd, 0xc3, 0x2c, 0x16, 0x44, 0x2c, 0x42, 0x86, 0x9d,
-	0x38, 0x9b, 0x9a, 0x7a, 0x41, 0xdf, 0xb8, 0x75, 0x11, 0x16, 0x7a, 0x61, 0x8a, 0xa2, 0x5d, 0xad,
-	0x15, 0x90, 0x7e, 0x68, 0xb6, 0x7e, 0x59, 0x82, 0x8f, 0x0d, 0xd8, 0xd4, 0x91, 0xde, 0x72, 0x6f,
-	0xec, 0x6c, 0xe6, 0xb3, 0xb3, 0x43, 0x7d, 0xb6, 0x32, 0xca, 0x67, 0xe7, 0x8a, 0xad, 0x01, 0x74,
-	0x6b, 0xf8, 0x59, 0x09, 0x96, 0x06, 0xe8, 0x6b, 0x74, 0x29, 0x7a, 0xcf, 0x28, 0x6c, 0x2b, 0xa4,
-	0xc2, 0x07, 0x2a, 0x76, 0x4a, 0xb0, 0x28, 0x12, 0xd2, 0x68, 0xdb, 0x09, 0xb8, 0xed, 0x57, 0x6c,
-	0x41, 0x4d, 0xa8, 0xaa, 0xaf, 0x97, 0xc0, 0x90, 0xfa, 0xb9, 0xe4, 0x72, 0x6d, 0x75, 0x82, 0x7b,
-	0x5f, 0x45, 0x0b, 0x30, 0xe3, 0x70, 0xb4, 0xc2, 0xa8, 0x04, 0xd5, 0xa7, 0x8c, 0x4a, 0xb1, 0x32,
-	0xe6, 0x74, 0x65, 0xbc, 0x86, 0xe0, 0xb8, 0xae, 0x8c, 0x78, 0xc3, 0x8f, 0x93, 0xcc, 0x47, 0xb7,
-	0x60, 0x36, 0x5d, 0x27, 0x3d, 0x16, 0x54, 0x57, 0x37, 0x26, 0x2d, 0x16, 0x35, 0xc5, 0x4b, 0xe6,
-	0xd6, 0xa3, 0x5a, 0xcf, 0x20, 0x8f, 0xe1, 0x79, 0xa8, 0x90, 0x05, 0xb2, 0x0c, 0x15, 0x92, 0xb6,
-	0x5e, 0x9b, 0xd6, 0x13, 0x6a, 0xe8, 0x6d, 0x84, 0xcd, 0x82, 0xd6, 0x56, 0xf1, 0x76, 0x32, 0x55,
-	0x85, 0x9e, 0xd2, 0xc5, 0x92, 0x24, 0xfb, 0xce, 0x0d, 0x83, 0xc4, 0xf1, 0x03, 0x42, 0x45, 0xb4,
-	0xcb, 0x07, 0xd8, 0x36, 0xc4, 0x7e, 0xe0, 0x92, 0x4d, 0xe2, 0x86, 0x81, 0x17, 0xf3, 0xfd, 0x9c,
-	0xb2, 0xb5, 0x31, 0xfc, 0x34, 0xcc, 0x71, 0xfa, 0x96, 0xdf, 0x4e, 0x93, 0x5c, 0x75, 0x75, 0xb9,
-	0x9e, 0xb6, 0x9b, 0xeb, 0x6a, 0xbb, 0x39, 0xd7, 0x61, 0x9b, 0x24, 0x4e, 0xbd, 0x7b, 0xa1, 0xce,
-	0xbe, 0xb0, 0xf3, 0x8f, 0x19, 0x96, 0xc4, 0xf1, 0x5b, 0x1b, 0x7e, 0xc0, 0x0f, 0x2d, 0x6c, 0xa9,
-	0x7c, 0x80, 0x99, 0xca, 0x16, 0xab, 0xb3, 0xee, 0x4a, 0xbf, 0x49, 0x29, 0xf6, 0x55, 0x27, 0x48,
-	0xfc, 0x16, 0x5f, 0x3f, 0x35, 0x84, 0x7c, 0x80, 0x7f, 0xe5, 0xb7, 0x12, 0x42, 0x85, 0xc3, 0x08,
-	0x2a, 0x33, 0xc6, 0x6a, 0xda, 0x41, 0x95, 0xfe, 0x9a, 0x9a, 0xed, 0x01, 0xd5, 0x6c, 0x7b, 0x5d,
-	0xe1, 0xe0, 0x80, 0x36, 0x20, 0x6f, 0x28, 0xa7, 0x29, 0xc2, 0x38, 0x94, 0x16, 0x56, 0x92, 0xee,
-	0x33, 0xe5, 0xf9, 0x62, 0x53, 0x3e, 0xac, 0x9b, 0xf2, 0xef, 0x10, 0x54, 0x36, 0xc2, 0xe6, 0x95,
-	0x20, 0xa1, 0xbb, 0xfc, 0x84, 0x1d, 0x06, 0x09, 0x09, 0xb2, 0x86, 0x90, 0x20, 0xd9, 0x26, 0x24,
-	0x7e, 0x9b, 0x6c, 0x26, 0x4e, 0x3b, 0x12, 0x15, 0xe4, 0x9e, 0x36, 0x21, 0xfb, 0x98, 0x29, 0xa6,
-	0xe5, 0xc4, 0x09, 0xf7, 0xf8, 0x8a, 0xcd, 0x9f, 0x99, 0x08, 0xd9, 0x84, 0xcd, 0x84, 0x0a, 0x77,
-	0xd7, 0xc6, 0x54, 0x13, 0x2b, 0xa7, 0xd8, 0x04, 0x69, 0xb5, 0xe1, 0xfe, 0xec, 0xe0, 0x78, 0x8b,
-	0xd0, 0xb6, 0x1f, 0x38, 0xc5, 0xd1, 0x7b, 0x8c, 0x4e, 0x76, 0x41, 0xdf, 0x22, 0xd4, 0x9c, 0x8e,
-	0x9d, 0xc3, 0x6e, 0xfb, 0x81, 0x17, 0xde, 0x2d, 0x70, 0x9e, 0xc9, 0x16, 0xfc, 0x8b, 0xde, 0x8c,
-	0x56, 0x56, 0xcc, 0x3c, 0xfd, 0x69, 0x38, 0xc8, 0x62, 0x42, 0x97, 0x88, 0x17, 0x22, 0xec, 0x58,
-	0xc3, 0x1a, 0x6d, 0x39, 0x0f, 0x5b, 0xff, 0x10, 0x6f, 0xc0, 0xbc, 0x13, 0xc7, 0x7e, 0x33, 0x20,
-	0x9e, 0xe4, 0x55, 0x1a, 0x9b, 0x57, 0xef, 0xa7, 0x69, 0xcb, 0x86, 0xcf, 0x10, 0xfb, 0x2d, 0x49,
-	0xeb, 0xab, 0x08, 0x8e, 0x0d, 0x64, 0x92, 0x79, 0x0e, 0x52, 0xc2, 0xb8, 0x09, 0x95, 0xd8, 0xdd,
-	0x26, 0x5e, 0xa7, 0x25, 0x4f, 0x61, 0x19, 0xcd, 0xde, 0x79, 0x9d, 0x74, 0xf7, 0x45, 0x1a, 0xc9,
-	0x68, 0x7c, 0x02, 0xa0, 0xed, 0x04, 0x1d, 0xa7, 0xc5, 0x21, 0x4c, 0x73, 0x08, 0xca, 0x88, 0xb5,
-	0x08, 0xe6, 0x20, 0xd3, 0x11, 0xfd, 0xc1, 0x7f, 0x22, 0x38, 0x24, 0x83, 0xaa, 0xd8, 0xdd, 0x1a,
-	0xcc, 0x2b, 0x6a, 0x50, 0xaa, 0xc5, 0xde, 0xe1, 0x11, 0x01, 0x53, 0x5a, 0xc9, 0x94, 0x7e, 0x9f,
-	0xd4, 0xd5, 0x6e, 0x84, 0xc6, 0xce, 0x77, 0x68, 0x9f, 0xaa, 0xe3, 0xaf, 0x80, 0x71, 0xdd, 0x09,
-	0x9c, 0x26, 0xf1, 0x32, 0xb1, 0x33, 0x13, 0x7b, 0x51, 0x6d, 0x74, 0x4d, 0xdc, 0x56, 0xca, 0x4a,
-	0x2d, 0x7f, 0x6b, 0x4b, 0x36, 0xcd, 0x28, 0x54, 0x36, 0xfc, 0x60, 0xe7, 0x5a, 0xb0, 0x15, 0x32,
-	0x89, 0x13, 0x3f, 0x69, 0x49, 0xed, 0xa6, 0x04, 0x3e, 0x0c, 0x53, 0x1d, 0xda, 0x12, 0x16, 0xc0,
-	0x1e, 0xd9, 0x09, 0xdc, 0x23, 0xb1, 0x4b, 0xfd, 0x28, 0xc9, 0x2b, 0x6f, 0x75, 0x88, 0xed, 0x83,
-	0xef, 0x86, 0xc1, 0x7a, 0xcb, 0x89, 0x63, 0x99, 0x80, 0xb2, 0x01, 0xeb, 0x71, 0x38, 0xc8, 0xd6,
-	0xcc, 0xc5, 0x3c, 0xab, 0x8b, 0x79, 0x4c, 0x83, 0x2f, 0xe1, 0x49, 0xc4, 0x0e, 0xdc, 0xc7, 0xf2,
-	0xfe, 0xa5, 0x28, 0x12, 0x4c, 0xc6, 0x2c, 0x87, 0xa6, 0x06, 0xe5, 0xcf, 0x81, 0xd7, 0x02, 0xab,
-	0xef, 0x9c, 0x01, 0xac, 0xfa, 0x09, 0xa1, 0x5d, 0xdf, 0x25, 0xf8, 0x3b, 0x08, 0xa6, 0xd9, 0xd2,
-	0xf8, 0x81, 0x61, 0x6e, 0xc9, 0xed, 0xd5, 0xdc, 0xbf, 0x63, 0x3e, 0x5b, 0xcd, 0x5a, 0x7c, 0xf5,
-	0xaf, 0x7f, 0xff, 0x6e, 0x69, 0x01, 0x1f, 0xe5, 0x97, 0xc1, 0xdd, 0x0b, 0xea, 0xc5, 0x6c, 0x8c,
-	0x5f, 0x47, 0x80, 0x45, 0x1d, 0xa4, 0x5c, 0x97, 0xe1, 0xb3, 0xc3, 0x20, 0x0e, 0xb8, 0x56, 0x33,
-	0x1f, 0x50, 0xb2, 0x4a, 0xdd, 0x0d, 0x29, 0x61, 0x39, 0x84, 0x4f, 0xe0, 0x00, 0x96, 0x39, 0x80,
-	0x53, 0xd8, 0x1a, 0x04, 0xa0, 0xf1, 0x32, 0xd3, 0xe8, 0x2b, 0x0d, 0x92, 0xae, 0xfb, 0x26, 0x82,
-	0xf2, 0x6d, 0x7e, 0x86, 0x18, 0xa1, 0xa4, 0xcd, 0x7d, 0x53, 0x12, 0x5f, 0x8e, 0xa3, 0xb5, 0x4e,
-	0x72, 0xa4, 0x0f, 0xe0, 0xe3, 0x12, 0x69, 0x9c, 0x50, 0xe2, 0xb4, 0x35, 0xc0, 0xe7, 0x11, 0x7e,
-	0x0b, 0xc1, 0x4c, 0x7a, 0xf1, 0x80, 0x4f, 0x0f, 0x43, 0xa9, 0x5d, 0x4c, 0x98, 0xfb, 0xd7, 0x24,
-	0xb3, 0x1e, 0xe6, 0x18, 0x4f, 0x5a, 0x03, 0xb7, 0x73, 0x4d, 0xeb, 0xf1, 0xbf, 0x81, 0x60, 0xea,
-	0x2a, 0x19, 0x69, 0x6f, 0xfb, 0x08, 0xae, 0x4f, 0x81, 0x03, 0xb6, 0x1a, 0xff, 0x14, 0xc1, 0xfd,
-	0x57, 0x49, 0x32, 0x38, 0x3d, 0xe2, 0xda, 0xe8, 0x9c, 0x25, 0xcc, 0xee, 0xec, 0x18, 0x33, 0xb3,
-	0xbc, 0xd0, 0xe0, 0xc8, 0x1e, 0xc6, 0x67, 0x8a, 0x8c, 0x30, 0xde, 0x0d, 0xdc, 0xbb, 0x02, 0xc7,
-	0x9f, 0x11, 0x1c, 0xee, 0xbd, 0x16, 0xc7, 0x7a, 0x42, 0x1d, 0x78, 0x6b, 0x6e, 0xde, 0x98, 0x34,
-	0xca, 0xea, 0x4c, 0xad, 0x4b, 0x1c, 0xf9, 0x63, 0xf8, 0xd1, 0x22, 0xe4, 0x59, 0x17, 0xb7, 0xf1,
-	0xb2, 0x7c, 0x7c, 0x85, 0xff, 0x84, 0x83, 0xc3, 0x7e, 0x17, 0xc1, 0x51, 0xc9, 0x77, 0x7d, 0xdb,
-	0xa1, 0xc9, 0x65, 0xc2, 0x6a, 0xe8, 0x78, 0x2c, 0x79, 0x26, 0xcc, 0x1a, 0xea, 0x7a, 0xd6, 0x15,
-	0x2e, 0xcb, 0xa7, 0xf1, 0x13, 0x7b, 0x96, 0xc5, 0x65, 0x6c, 0x3c, 0x01, 0xfb, 0x55, 0x04, 0x07,
-	0xae, 0x92, 0xe4, 0x7a, 0x76, 0x93, 0x70, 0x7a, 0xac, 0xdb, 0x49, 0x73, 0xb1, 0xae, 0xfc, 0x72,
-	0x44, 0xbe, 0xca, 0x4c, 0x64, 0x85, 0x83, 0x3b, 0x83, 0x4f, 0x17, 0x81, 0xcb, 0x6f, 0x2f, 0xde,
-	0x44, 0x70, 0x4c, 0x05, 0x91, 0x5f, 0x42, 0x7f, 0x62, 0x6f, 0x77, 0xa5, 0xe2, 0xc6, 0x75, 0x04,
-	0xba, 0x55, 0x8e, 0xee, 0x9c, 0x35, 0xd8, 0x80, 0xdb, 0x7d, 0x28, 0xd6, 0xd0, 0x72, 0x0d, 0xe1,
-	0xdf, 0x23, 0x98, 0x49, 0x5b, 0xcd, 0xc3, 0x75, 0xa4, 0xdd, 0x42, 0xee, 0x67, 0x34, 0x10, 0xbb,
-	0x6d, 0x9e, 0x1f, 0xac, 0x50, 0xf5, 0x7b, 0x69, 0xaa, 0x75, 0xae, 0x65, 0x3d, 0x8c, 0xbd, 0x8d,
-	0x00, 0xf2, 0x76, 0x39, 0x7e, 0xb8, 0x58, 0x0e, 0xa5, 0xa5, 0x6e, 0xee, 0x6f, 0xc3, 0xdc, 0xaa,
-	0x73, 0x79, 0x6a, 0xe6, 0x52, 0x61, 0x0c, 0x89, 0x88, 0xbb, 0x96, 0xb6, 0xd6, 0x7f, 0x82, 0xa0,
-	0xcc, 0xfb, 0x78, 0xf8, 0xd4, 0x30, 0xcc, 0x6a, 0x9b, 0x6f, 0x3f, 0x55, 0xff, 0x10, 0x87, 0xba,
-	0xb4, 0x5a, 0x14, 0x88, 0xd7, 0xd0, 0x32, 0xee, 0xc2, 0x4c, 0xda, 0x39, 0x1b, 0x6e, 0x1e, 0x5a,
-	0x67, 0xcd, 0x5c, 0x2a, 0x28, 0x0c, 0x52, 0x43, 0x15, 0x39, 0x60, 0x79, 0x54, 0x0e, 0x98, 0x66,
-	0x61, 0x1a, 0x9f, 0x2c, 0x0a, 0xe2, 0xff, 0x03, 0xc5, 0x9c, 0xe5, 0xe8, 0x4e, 0x5b, 0x4b, 0xa3,
-	0xf2, 0x00, 0xd3, 0xce, 0xf7, 0x10, 0x1c, 0xee, 0x2d, 0xae, 0xf1, 0xf1, 0x9e, 0x98, 0xa9, 0x9e,
-	0x35, 0x4c, 0x5d, 0x8b, 0xc3, 0x0a, 0x73, 0xeb, 0x33, 0x1c, 0xc5, 0x1a, 0x7e, 0x64, 0xa4, 0x67,
-	0xdc, 0x90, 0x51, 0x87, 0x31, 0x5a, 0xc9, 0x6f, 0x56, 0x7f, 0x83, 0xe0, 0x80, 0xe4, 0x7b, 0x8b,
-	0x12, 0x52, 0x0c, 0x6b, 0xff, 0x1c, 0x81, 0xad, 0x65, 0x3d, 0xce, 0xe1, 0x7f, 0x12, 0x5f, 0x1c,
-	0x13, 0xbe, 0x84, 0xbd, 0x92, 0x30, 0xa4, 0x7f, 0x44, 0x70, 0xe4, 0x76, 0x6a, 0xf7, 0x1f, 0x12,
-	0xfe, 0x75, 0x8e, 0xff, 0x09, 0xfc, 0x58, 0x41, 0x9d, 0x37, 0x4a, 0x8c, 0xf3, 0x08, 0xff, 0x0a,
-	0x41, 0x45, 0xde, 0x19, 0xe1, 0x33, 0x43, 0x1d, 0x43, 0xbf, 0x55, 0xda, 0x4f, 0x63, 0x16, 0x45,
-	0x8d, 0x75, 0xaa, 0x30, 0x9d, 0x8a, 0xf5, 0x99, 0x41, 0xbf, 0x81, 0x00, 0x67, 0x67, 0xe6, 0xec,
-	0x14, 0x8d, 0x1f, 0xd2, 0x96, 0x1a, 0xda, 0x98, 0x31, 0xcf, 0x8c, 0x9c, 0xa7, 0xa7, 0xd2, 0xe5,
-	0xc2, 0x54, 0x1a, 0x66, 0xeb, 0x7f, 0x13, 0x41, 0xf5, 0x2a, 0xc9, 0xce, 0x20, 0x05, 0xba, 0xd4,
-	0xaf, 0xbc, 0xcc, 0xda, 0xe8, 0x89, 0x02, 0xd1, 0x39, 0x8e, 0xe8, 0x21, 0x5c, 0xac, 0x2a, 0x09,
-	0xe0, 0x87, 0x08, 0x0e, 0xde, 0x54, 0x4d, 0x14, 0x9f, 0x1b, 0xb5, 0x92, 0x16, 0xc9, 0xc7, 0xc7,
-	0xf5, 0x71, 0x8e, 0x6b, 0xc5, 0x1a, 0x0b, 0xd7, 0x9a, 0xb8, 0x5f, 0xf9, 0x11, 0x4a, 0x0f, 0xb1,
-	0x3d, 0xfd, 0xec, 0xff, 0x56, 0x6f, 0x05, 0x6d, 0x71, 0xeb, 0x22, 0xc7, 0x57, 0xc7, 0xe7, 0xc6,
-	0xc1, 0xd7, 0x10, 0x4d, 0x6e, 0xfc, 0x7d, 0x04, 0x47, 0xf8, 0x5d, 0x83, 0xca, 0xb8, 0x27, 0xc5,
-	0x0c, 0xbb, 0x99, 0x18, 0x23, 0xc5, 0x88, 0xf8, 0x63, 0xed, 0x09, 0xd4, 0x9a, 0xbc, 0x47, 0x78,
-	0x1b, 0x81, 0x29, 0x9d, 0xb2, 0xff, 0x17, 0x06, 0xb8, 0x5e, 0xe4, 0xc8, 0xfd, 0x3f, 0x41, 0x30,
-	0x1b, 0x63, 0xcf, 0x17, 0xe8, 0x3f, 0xc5, 0xd1, 0x5f, 0x18, 0x81, 0x3e, 0xfd, 0x78, 0x45, 0xf5,
-	0xde, 0x6f, 0x21, 0x38, 0x24, 0xb3, 0xb1, 0x30, 0xcb, 0x95, 0x51, 0x3b, 0xbe, 0xd7, 0xec, 0x2d,
-	0xfc, 0x64, 0x79, 0x3c, 0x3f, 0xf9, 0x01, 0x82, 0x23, 0xf2, 0x67, 0x8f, 0x9b, 0xd4, 0xbd, 0x14,
-	0x78, 0x97, 0xe3, 0x64, 0x78, 0x85, 0xd6, 0xf7, 0x93, 0x92, 0xe1, 0x8e, 0xd2, 0xfb, 0x63, 0x4a,
-	0xeb, 0x02, 0x07, 0x76, 0xd6, 0x5a, 0x1c, 0x00, 0x6c, 0x45, 0xfe, 0x62, 0x41, 0x2f, 0x1c, 0xdf,
-	0x42, 0x30, 0x2b, 0x2e, 0x49, 0x0a, 0x2a, 0x30, 0xe5, 0x16, 0xc5, 0xec, 0x69, 0x1d, 0x89, 0x1e,
-	0xbb, 0xf5, 0x05, 0xbe, 0xf6, 0x73, 0xb8, 0x51, 0xa4, 0x94, 0x28, 0xf4, 0xe2, 0xc6, 0xcb, 0xa2,
-	0xc1, 0xfd, 0x4a, 0xa3, 0x15, 0x36, 0xe3, 0x17, 0x2c, 0x5c, 0x58, 0x67, 0xb0, 0x39, 0xe7, 0x11,
-	0x4e, 0x60, 0x8e, 0xf9, 0x1c, 0xef, 0x47, 0xe1, 0xa5, 0x9e, 0xee, 0x55, 0x5f, 0xab, 0xca, 0x34,
-	0xfb, 0xfa, 0x5b, 0x79, 0x61, 0x21, 0xba, 0x03, 0xf8, 0xc1, 0xc2, 0x65, 0xf9, 0x42, 0xaf, 0x23,
-	0x38, 0xa2, 0x06, 0x91, 0x74, 0xf9, 0xb1, 0x43, 0x48, 0x11, 0x0a, 0x71, 0x56, 0xc1, 0xcb, 0x63,
-	0xf9, 0x67, 0x0a, 0xe7, 0x6b, 0x08, 0x8e, 0x5c, 0x25, 0x89, 0x7e, 0x83, 0xde, 0x73, 0x40, 0x1d,
-	0xf8, 0x2b, 0x00, 0xf3, 0x64, 0xe1, 0x1c, 0x01, 0xa9, 0xa8, 0x09, 0xc5, 0x0e, 0x97, 0xca, 0x37,
-	0x4f, 0x3e, 0xf5, 0xa7, 0xf7, 0x4f, 0xa0, 0xf7, 0xde, 0x3f, 0x81, 0xfe, 0xf6, 0xfe, 0x09, 0xf4,
-	0xc2, 0x23, 0xe3, 0xfd, 0xc3, 0x83, 0xdb, 0xf2, 0x49, 0x90, 0xa8, 0x6c, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x31, 0x6d, 0xd9, 0xbc, 0xd6, 0x31, 0x00, 0x00,
+	// 3459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdb, 0x8f, 0x1c, 0x47,
+	0xd5, 0xff, 0x6a, 0xf6, 0x36, 0x7b, 0xd6, 0x97, 0x75, 0xc5, 0xde, 0x6f, 0x32, 0x5e, 0xfb, 0x5b,
+	0xb7, 0xed, 0x78, 0xb3, 0xf6, 0xce, 0xd8, 0xfb, 0x19, 0x70, 0x36, 0x89, 0xc0, 0xd9, 0xf5, 0x2d,
+	0x59, 0x3b, 0x4e, 0xaf, 0x13, 0xa3, 0x80, 0x94, 0x74, 0xba, 0x6b, 0x67, 0x9b, 0x9d, 0xe9, 0x6e,
+	0x57, 0xf7, 0x8c, 0x59, 0x19, 0x23, 0x11, 0xb0, 0x84, 0x20, 0x02, 0x01, 0x79, 0x88, 0x00, 0x01,
+	0x0a, 0xb2, 0x04, 0x08, 0xc4, 0x0b, 0x8a, 0x90, 0xb8, 0x08, 0x1e, 0x40, 0x20, 0x11, 0x29, 0x82,
+	0x07, 0x5e, 0x51, 0x84, 0x78, 0x84, 0x97, 0xfc, 0x01, 0xa8, 0xaa, 0xab, 0xba, 0xab, 0x7a, 0x66,
+	0x7a, 0x66, 0x99, 0x09, 0xf1, 0xd3, 0xf6, 0xa9, 0xe9, 0xae, 0xf3, 0x3b, 0xa7, 0xce, 0xb5, 0xaa,
+	0x16, 0x8e, 0x85, 0x84, 0xb6, 0x08, 0xad, 0x5a, 0x41, 0x50, 0x77, 0x6d, 0x2b, 0x72, 0x7d, 0x4f,
+	0x7d, 0xae, 0x04, 0xd4, 0x8f, 0x7c, 0x3c, 0xa5, 0x0c, 0x95, 0x67, 0x6b, 0xbe, 0x5f, 0xab, 0x93,
+	0xaa, 0x15, 0xb8, 0x55, 0xcb, 0xf3, 0xfc, 0x88, 0x0f, 0x87, 0xf1, 0xab, 0x65, 0x63, 0xeb, 0x5c,
+	0x58, 0x71, 0x7d, 0xfe, 0xab, 0xed, 0x53, 0x52, 0x6d, 0x9d, 0xa9, 0xd6, 0x88, 0x47, 0xa8, 0x15,
+	0x11, 0x47, 0xbc, 0x73, 0x36, 0x7d, 0xa7, 0x61, 0xd9, 0x9b, 0xae, 0x47, 0xe8, 0x76, 0x35, 0xd8,
+	0xaa, 0xb1, 0x81, 0xb0, 0xda, 0x20, 0x91, 0xd5, 0xe9, 0xab, 0xb5, 0x9a, 0x1b, 0x6d, 0x36, 0x5f,
+	0xa9, 0xd8, 0x7e, 0xa3, 0x6a, 0xd1, 0x9a, 0x1f, 0x50, 0xff, 0x53, 0xfc, 0x61, 0xd1, 0x76, 0xaa,
+	0xad, 0xa5, 0x74, 0x02, 0x55, 0x96, 0xd6, 0x19, 0xab, 0x1e, 0x6c, 0x5a, 0xed, 0xb3, 0x5d, 0xe8,
+	0x31, 0x1b, 0x25, 0x81, 0x2f, 0x74, 0xc3, 0x1f, 0xdd, 0xc8, 0xa7, 0xdb, 0xca, 0x63, 0x3c, 0x8d,
+	0xf1, 0x1e, 0x82, 0xe9, 0xf3, 0x29, 0xbf, 0xe7, 0x9a, 0x84, 0x6e, 0x63, 0x0c, 0xa3, 0x9e, 0xd5,
+	0x20, 0x25, 0x34, 0x87, 0xe6, 0x27, 0x4d, 0xfe, 0x8c, 0x4b, 0x30, 0x41, 0xc9, 0x06, 0x25, 0xe1,
+	0x66, 0xa9, 0xc0, 0x87, 0x25, 0x89, 0xcb, 0x50, 0x64, 0xcc, 0x89, 0x1d, 0x85, 0xa5, 0x91, 0xb9,
+	0x91, 0xf9, 0x49, 0x33, 0xa1, 0xf1, 0x3c, 0xec, 0xa5, 0x24, 0xf4, 0x9b, 0xd4, 0x26, 0x2f, 0x10,
+	0x1a, 0xba, 0xbe, 0x57, 0x1a, 0xe5, 0x5f, 0x67, 0x87, 0xd9, 0x2c, 0x21, 0xa9, 0x13, 0x3b, 0xf2,
+	0x69, 0x69, 0x8c, 0xbf, 0x92, 0xd0, 0x0c, 0x0f, 0x03, 0x5e, 0x1a, 0x8f, 0xf1, 0xb0, 0x67, 0x6c,
+	0xc0, 0x2e, 0x2b, 0x08, 0xae, 0x59, 0x0d, 0x12, 0x06, 0x96, 0x4d, 0x4a, 0x13, 0xfc, 0x37, 0x6d,
+	0x8c, 0x61, 0x16, 0x48, 0x4a, 0x45, 0x0e, 0x4c, 0x92, 0xc6, 0x0a, 0x4c, 0x5e, 0xf3, 0x1d, 0xd2,
+	0x5d, 0xdc, 0xec, 0xf4, 0x85, 0xf6, 0xe9, 0x8d, 0xdf, 0x21, 0x38, 0x60, 0x92, 0x96, 0xcb, 0xf0,
+	0x5f, 0x25, 0x91, 0xe5, 0x58, 0x91, 0x95, 0x9d, 0xb1, 0x90, 0xcc, 0x58, 0x86, 0x22, 0x15, 0x2f,
+	0x97, 0x0a, 0x7c, 0x3c, 0xa1, 0xdb, 0xb8, 0x8d, 0xe4, 0x0b, 0x13, 0xab, 0x50, 0x92, 0x78, 0x0e,
+	0xa6, 0x62, 0x5d, 0x5e, 0xf1, 0x1c, 0xf2, 0x69, 0xae, 0xbd, 0x31, 0x53, 0x1d, 0xc2, 0xb3, 0x30,
+	0xd9, 0x8a, 0xf5, 0x7c, 0xc5, 0xe1, 0x5a, 0x1c, 0x33, 0xd3, 0x01, 0xe3, 0x1f, 0x08, 0x0e, 0x2b,
+	0x36, 0x60, 0x8a, 0x95, 0xb9, 0xd0, 0x22, 0x5e, 0x14, 0x76, 0x17, 0xe8, 0x14, 0xec, 0x93, 0x8b,
+	0x98, 0xd5, 0x53, 0xfb, 0x0f, 0x4c, 0x44, 0x75, 0x50, 0x8a, 0xa8, 0x8e, 0x31, 0x41, 0x24, 0xfd,
+	0xfc, 0x95, 0x55, 0x21, 0xa6, 0x3a, 0xd4, 0xa6, 0xa8, 0xb1, 0x7c, 0x45, 0x8d, 0x6b, 0x8a, 0x32,
+	0xde, 0x41, 0x50, 0x52, 0x04, 0xbd, 0x6a, 0x79, 0xee, 0x06, 0x09, 0xa3, 0x7e, 0xd7, 0x0c, 0x0d,
+	0x71, 0xcd, 0xe6, 0x61, 0x6f, 0x2c, 0xd5, 0x75, 0xe6, 0x8f, 0x2c, 0xfe, 0x94, 0xc6, 0xe6, 0x46,
+	0xe6, 0x47, 0xcc, 0xec, 0x30, 0x5b, 0x3b, 0xc9, 0x33, 0x2c, 0x8d, 0x73, 0x33, 0x4e, 0x07, 0x8c,
+	0x23, 0x30, 0x79, 0xd1, 0xad, 0x93, 0x95, 0xcd, 0xa6, 0xb7, 0x85, 0xf7, 0xc3, 0x98, 0xcd, 0x1e,
+	0xb8, 0x0c, 0xbb, 0xcc, 0x98, 0x30, 0xbe, 0x86, 0xe0, 0x48, 0x37, 0xa9, 0x6f, 0xba, 0xd1, 0x26,
+	0xfb, 0x3e, 0xec, 0x26, 0xbe, 0xbd, 0x49, 0xec, 0xad, 0xb0, 0xd9, 0x90, 0x26, 0x2b, 0xe9, 0xc1,
+	0xc4, 0x37, 0x9e, 0x81, 0x83, 0x0a, 0xa4, 0x17, 0xac, 0xba, 0xeb, 0x58, 0x11, 0x31, 0x49, 0x18,
+	0xf8, 0x5e, 0x48, 0x98, 0x20, 0x84, 0x52, 0x9f, 0x0a, 0x97, 0x8c, 0x09, 0x3c, 0x03, 0xe3, 0xc4,
+	0x8b, 0xdc, 0x68, 0x5b, 0xac, 0x85, 0xa0, 0x8c, 0x97, 0xc1, 0x50, 0xcd, 0xd7, 0xaf, 0xd7, 0xfd,
+	0x66, 0xc4, 0xfe, 0xbc, 0x62, 0xd9, 0x5b, 0xc9, 0x9c, 0x2c, 0x80, 0xc5, 0x3f, 0x09, 0x19, 0x25,
+	0xc9, 0xcc, 0xce, 0x23, 0xb7, 0x4d, 0xd5, 0x39, 0x47, 0x4c, 0x75, 0xc8, 0xf8, 0x11, 0x82, 0xf9,
+	0x9e, 0x2a, 0xbc, 0x49, 0xad, 0x20, 0x20, 0x14, 0x5f, 0x84, 0xb1, 0x5b, 0xec, 0x07, 0x0e, 0x7e,
+	0x6a, 0xa9, 0x52, 0x51, 0xf3, 0x51, 0xcf, 0x59, 0x2e, 0xff, 0x8f, 0x19, 0x7f, 0x8e, 0x2b, 0x72,
+	0x35, 0x0b, 0x7c, 0x9e, 0x19, 0x6d, 0x9e, 0x64, 0xd1, 0xd9, 0xfb, 0xfc, 0xb5, 0xa7, 0xc6, 0x61,
+	0x34, 0xb0, 0x68, 0x64, 0x1c, 0x80, 0x87, 0x74, 0x6f, 0xe6, 0xf2, 0x1b, 0xbf, 0xd0, 0x8d, 0x7f,
+	0x85, 0x12, 0xae, 0xf1, 0x5b, 0x4d, 0x12, 0x46, 0x78, 0x0b, 0xd4, 0x14, 0xc9, 0x15, 0x34, 0xb5,
+	0x74, 0xa5, 0x92, 0xe6, 0x98, 0x8a, 0xcc, 0x31, 0xfc, 0xe1, 0x25, 0xdb, 0xa9, 0xb4, 0x96, 0x2a,
+	0xc1, 0x56, 0xad, 0xc2, 0x32, 0x96, 0x86, 0x4c, 0x66, 0x2c, 0x55, 0x54, 0x53, 0x9d, 0x9d, 0xad,
+	0x63, 0x33, 0x08, 0x09, 0x8d, 0xb8, 0x64, 0x45, 0x53, 0x50, 0xcc, 0xdc, 0x5a, 0xc2, 0x12, 0xb8,
+	0x39, 0x15, 0xcd, 0x84, 0x36, 0x7e, 0xa5, 0xa3, 0x7f, 0x3e, 0x70, 0x3e, 0x28, 0xf4, 0x2a, 0xca,
+	0x82, 0x8e, 0x52, 0x35, 0xf8, 0x11, 0xdd, 0xe0, 0x7f, 0xa6, 0xe3, 0x5f, 0x25, 0x75, 0x92, 0xe2,
+	0xef, 0xe4, 0x7b, 0x25, 0x98, 0xb0, 0xad, 0xd0, 0xb6, 0x1c, 0xc9, 0x45, 0x92, 0x2c, 0xee, 0x06,
+	0xd4, 0x0f, 0xac, 0x1a, 0x9f, 0xe9, 0xba, 0x5f, 0x77, 0xed, 0x6d, 0xc1, 0xae, 0xfd, 0x87, 0x36,
+	0x3f, 0x1d, 0xcd, 0xf7, 0xd3, 0x31, 0x1d, 0xf6, 0x51, 0x98, 0x5a, 0xdf, 0xf6, 0xec, 0x67, 0x83,
+	0x38, 0x16, 0xed, 0x87, 0x31, 0x37, 0x22, 0x8d, 0xb0, 0x84, 0x78, 0x1c, 0x8a, 0x09, 0xe3, 0x8d,
+	0x71, 0x98, 0x51, 0x64, 0x63, 0x1f, 0xe4, 0x49, 0x96, 0x17, 0x54, 0x67, 0x60, 0xdc, 0xa1, 0xdb,
+	0x66, 0xd3, 0x13, 0x06, 0x20, 0x28, 0xc6, 0x38, 0xa0, 0x4d, 0x2f, 0x86, 0x5f, 0x34, 0x63, 0x02,
+	0x6f, 0x40, 0x31, 0x8c, 0x58, 0x51, 0x54, 0xdb, 0xe6, 0xc0, 0xa7, 0x96, 0x9e, 0x1e, 0x6c, 0xd1,
+	0x19, 0xf4, 0x75, 0x31, 0xa3, 0x99, 0xcc, 0x8d, 0x6f, 0xb1, 0x10, 0x1c, 0xc7, 0xe5, 0xb0, 0x34,
+	0x31, 0x37, 0x32, 0x3f, 0xb5, 0xb4, 0x3e, 0x38, 0xa3, 0x67, 0x03, 0x56, 0xd0, 0x29, 0x09, 0xd7,
+	0x4c, 0xb9, 0xb0, 0xa8, 0xdf, 0x10, 0xf1, 0x21, 0x14, 0xc5, 0x4b, 0x3a, 0x80, 0x3f, 0x0e, 0x63,
+	0xae, 0xb7, 0xe1, 0x87, 0xa5, 0x49, 0x0e, 0xe6, 0xa9, 0xc1, 0xc0, 0x5c, 0xf1, 0x36, 0x7c, 0x33,
+	0x9e, 0x10, 0xdf, 0x82, 0xdd, 0x94, 0x44, 0x74, 0x5b, 0x6a, 0xa1, 0x04, 0x5c, 0xaf, 0xcf, 0x0c,
+	0xc6, 0xc1, 0x54, 0xa7, 0x34, 0x75, 0x0e, 0x78, 0x19, 0xa6, 0xc2, 0xd4, 0xc6, 0x4a, 0x53, 0x9c,
+	0x61, 0x49, 0x9b, 0x48, 0xb1, 0x41, 0x53, 0x7d, 0xb9, 0xcd, 0xba, 0x77, 0xe5, 0x5b, 0xf7, 0xee,
+	0x9e, 0x49, 0x78, 0x4f, 0x1f, 0x49, 0x78, 0x6f, 0x26, 0x09, 0x33, 0x0e, 0x91, 0xdb, 0x20, 0x2c,
+	0xb5, 0x4c, 0xc7, 0x1c, 0x04, 0x69, 0x7c, 0x19, 0xc1, 0x6c, 0x7b, 0xa2, 0xe3, 0x6b, 0xfe, 0xdf,
+	0x0f, 0x5d, 0xc6, 0xdb, 0x7a, 0x25, 0xd0, 0x96, 0x29, 0xbb, 0xfb, 0xec, 0x2c, 0x4c, 0x7a, 0x4a,
+	0x8d, 0xc7, 0x7e, 0x48, 0x07, 0x78, 0xdd, 0x16, 0xcf, 0x25, 0x4a, 0xbb, 0x02, 0xaf, 0xdb, 0xd2,
+	0x21, 0xbc, 0x00, 0xd3, 0x0a, 0x29, 0x23, 0x11, 0x7b, 0xad, 0x6d, 0x9c, 0xf7, 0x0c, 0x02, 0x99,
+	0x0c, 0x13, 0x63, 0x3c, 0x25, 0x67, 0x87, 0x8d, 0x7f, 0xe9, 0xda, 0x8d, 0x93, 0xc2, 0x7a, 0x40,
+	0x72, 0xc3, 0x8f, 0x05, 0xa3, 0x61, 0x40, 0x6c, 0x2e, 0xc5, 0xd4, 0xd2, 0xd5, 0xa1, 0xa9, 0x9a,
+	0xf3, 0xe5, 0x53, 0xe7, 0x25, 0xb2, 0x01, 0xe3, 0xf1, 0x77, 0x11, 0xfc, 0xaf, 0xc2, 0xf3, 0xba,
+	0x15, 0xd9, 0x9b, 0x79, 0xc2, 0xb2, 0xb8, 0xc9, 0xde, 0x11, 0x6b, 0x16, 0x13, 0x6c, 0x35, 0xf9,
+	0xc3, 0x8d, 0xed, 0x40, 0xae, 0x56, 0x3a, 0x30, 0x60, 0x8d, 0xfd, 0x63, 0x04, 0xe5, 0x8c, 0x8d,
+	0xf5, 0x32, 0xae, 0x3d, 0x50, 0x70, 0x1d, 0x51, 0x76, 0x15, 0x5c, 0x67, 0x87, 0x49, 0x20, 0x0b,
+	0x77, 0x3c, 0x1f, 0xee, 0x84, 0x0e, 0xf7, 0xbd, 0x0c, 0x5c, 0x19, 0x8a, 0xfb, 0xf7, 0x05, 0xa4,
+	0xfb, 0x42, 0x7b, 0x9f, 0x53, 0x68, 0xeb, 0x73, 0x4a, 0x30, 0xd1, 0x4a, 0xba, 0x61, 0x5e, 0x8a,
+	0x0a, 0x92, 0x89, 0x58, 0xa3, 0x7e, 0x33, 0x10, 0x4a, 0x8f, 0x09, 0x86, 0x62, 0xcb, 0xf5, 0x58,
+	0xe7, 0xc6, 0x51, 0xb0, 0xe7, 0x9d, 0xf7, 0xbf, 0x9a, 0xd8, 0xf7, 0x11, 0x1c, 0x58, 0xd9, 0xb4,
+	0xbc, 0x1a, 0x91, 0xce, 0x24, 0x25, 0x2e, 0xc1, 0x84, 0x98, 0x43, 0x96, 0xc9, 0x82, 0xec, 0x21,
+	0xf7, 0x3c, 0xec, 0xb5, 0x9b, 0x94, 0x12, 0x2f, 0xf5, 0xda, 0xb8, 0x26, 0xc9, 0x0e, 0xb3, 0x58,
+	0x10, 0xb0, 0xd8, 0xe9, 0x37, 0xc3, 0xe4, 0xd5, 0xd8, 0x0b, 0xda, 0xc6, 0x8d, 0xb3, 0x30, 0x93,
+	0x85, 0x29, 0xca, 0x79, 0xb5, 0x8a, 0x40, 0x7a, 0x3b, 0x6d, 0xfc, 0xa4, 0x00, 0xff, 0xd7, 0x61,
+	0x51, 0x7b, 0x7a, 0xcb, 0x83, 0xb1, 0xb2, 0x89, 0xcf, 0x4e, 0x74, 0xf5, 0xd9, 0x62, 0x2f, 0x9f,
+	0x9d, 0xcc, 0xb7, 0x06, 0xd0, 0xad, 0xe1, 0x07, 0x05, 0x98, 0xeb, 0xa0, 0xaf, 0xde, 0x45, 0xea,
+	0x03, 0xa3, 0xb0, 0x0d, 0x9f, 0x0a, 0x1f, 0x28, 0x9a, 0x31, 0xc1, 0xa2, 0x88, 0x4f, 0x83, 0x4d,
+	0xcb, 0xe3, 0xb6, 0x5f, 0x34, 0x05, 0x35, 0xa0, 0xaa, 0xbe, 0x54, 0x80, 0x92, 0xd4, 0xcf, 0x79,
+	0x9b, 0x6b, 0xab, 0xe9, 0x3d, 0xf8, 0x2a, 0x9a, 0x81, 0x71, 0x8b, 0xa3, 0x15, 0x46, 0x25, 0xa8,
+	0x36, 0x65, 0x14, 0xf3, 0x95, 0x31, 0xa9, 0x2b, 0xe3, 0x1e, 0x82, 0x83, 0xba, 0x32, 0xc2, 0x35,
+	0x37, 0x8c, 0x12, 0x1f, 0xdd, 0x80, 0x89, 0x98, 0x4f, 0xdc, 0x30, 0x4c, 0x2d, 0xad, 0x0d, 0x5a,
+	0x46, 0x6a, 0x8a, 0x97, 0x93, 0x1b, 0x8f, 0x69, 0xbb, 0x09, 0x69, 0x0c, 0x4f, 0x43, 0x85, 0x2c,
+	0x9d, 0x65, 0xa8, 0x90, 0xb4, 0x71, 0x6f, 0x54, 0x4f, 0xa8, 0xbe, 0xb3, 0xe6, 0xd7, 0x72, 0x36,
+	0xbd, 0xf2, 0x97, 0x93, 0xa9, 0xca, 0x77, 0x94, 0xfd, 0x2d, 0x49, 0xb2, 0xef, 0x6c, 0xdf, 0x8b,
+	0x2c, 0xd7, 0x23, 0x54, 0x44, 0xbb, 0x74, 0x80, 0x2d, 0x43, 0xe8, 0x7a, 0x36, 0x59, 0x27, 0xb6,
+	0xef, 0x39, 0x21, 0x5f, 0xcf, 0x11, 0x53, 0x1b, 0xc3, 0x97, 0x61, 0x92, 0xd3, 0x37, 0xdc, 0x46,
+	0x9c, 0xe4, 0xa6, 0x96, 0x16, 0x2a, 0xf1, 0x46, 0x74, 0x45, 0xdd, 0x88, 0x4e, 0x75, 0xd8, 0x20,
+	0x91, 0x55, 0x69, 0x9d, 0xa9, 0xb0, 0x2f, 0xcc, 0xf4, 0x63, 0x86, 0x25, 0xb2, 0xdc, 0xfa, 0x9a,
+	0xeb, 0xf1, 0x76, 0x86, 0xb1, 0x4a, 0x07, 0x98, 0xa9, 0x6c, 0xb0, 0x3a, 0xeb, 0xb6, 0xf4, 0x9b,
+	0x98, 0x62, 0x5f, 0x35, 0xbd, 0xc8, 0xad, 0x73, 0xfe, 0xb1, 0x21, 0xa4, 0x03, 0xfc, 0x2b, 0xb7,
+	0x1e, 0x11, 0x2a, 0x1c, 0x46, 0x50, 0x89, 0x31, 0x4e, 0xc5, 0x7b, 0xab, 0xd2, 0x5f, 0x63, 0xb3,
+	0xdd, 0xa5, 0x9a, 0x6d, 0xd6, 0x15, 0x76, 0x77, 0xd8, 0x20, 0xe4, 0x5b, 0xcd, 0x71, 0x8a, 0x28,
+	0xed, 0x89, 0x0b, 0x2b, 0x49, 0xb7, 0x99, 0xf2, 0xde, 0x7c, 0x53, 0x9e, 0xd6, 0x4d, 0xf9, 0x37,
+	0x08, 0x8a, 0x6b, 0x7e, 0xed, 0x82, 0x17, 0xd1, 0x6d, 0xde, 0x7b, 0xfb, 0x5e, 0x44, 0xbc, 0x64,
+	0xab, 0x48, 0x90, 0x6c, 0x11, 0x58, 0x69, 0xbf, 0x1e, 0x59, 0x8d, 0x40, 0x54, 0x90, 0x3b, 0x5a,
+	0x84, 0xe4, 0x63, 0xa6, 0x98, 0xba, 0x15, 0x46, 0xdc, 0xe3, 0x8b, 0x26, 0x7f, 0x66, 0x22, 0x24,
+	0x2f, 0xac, 0x47, 0x54, 0xb8, 0xbb, 0x36, 0xa6, 0x9a, 0xd8, 0x58, 0x8c, 0x4d, 0x90, 0x46, 0x03,
+	0x1e, 0x4e, 0x5a, 0xca, 0x1b, 0x84, 0x36, 0x5c, 0xcf, 0xca, 0x8f, 0xde, 0x7d, 0xec, 0x71, 0xe7,
+	0xec, 0x68, 0xdc, 0x47, 0x70, 0x48, 0xdb, 0xcd, 0x72, 0x99, 0x8a, 0x2c, 0x2f, 0xbf, 0x78, 0x9a,
+	0x81, 0x71, 0x4a, 0xac, 0x30, 0x69, 0xfd, 0x05, 0xc5, 0x56, 0xd6, 0x69, 0xc6, 0xd8, 0x05, 0xa3,
+	0x84, 0x1e, 0xb0, 0x64, 0xbe, 0xa7, 0x37, 0x3d, 0xcf, 0x35, 0x49, 0x93, 0x38, 0x4a, 0xef, 0xdd,
+	0x4f, 0x5d, 0x3a, 0xc9, 0xeb, 0xd2, 0xc1, 0xb6, 0x3c, 0xef, 0xc2, 0x4c, 0xc2, 0x95, 0x83, 0x48,
+	0xe2, 0x93, 0xad, 0xee, 0xaa, 0x0c, 0xdc, 0x92, 0x64, 0x05, 0x14, 0x9b, 0x34, 0xbe, 0x16, 0x23,
+	0x59, 0x43, 0x7d, 0xd3, 0xf5, 0x1c, 0xff, 0x76, 0x4e, 0xac, 0x1b, 0xcc, 0x3e, 0xfe, 0xac, 0x9f,
+	0x2a, 0x28, 0x1c, 0x13, 0xc1, 0x2f, 0xc3, 0x6e, 0x16, 0xc2, 0x5b, 0x44, 0xfc, 0x20, 0x14, 0x60,
+	0x74, 0xdb, 0x31, 0x4d, 0xe7, 0x30, 0xf5, 0x0f, 0xf1, 0x1a, 0xec, 0xb5, 0xc2, 0xd0, 0xad, 0x79,
+	0xc4, 0x91, 0x73, 0x15, 0xfa, 0x9e, 0x2b, 0xfb, 0x69, 0xbc, 0xf7, 0xc6, 0xdf, 0x10, 0xee, 0x29,
+	0x49, 0xe3, 0xf3, 0x08, 0x0e, 0x74, 0x9c, 0x24, 0x09, 0x74, 0x48, 0xc9, 0xba, 0x65, 0x28, 0x86,
+	0xf6, 0x26, 0x71, 0x9a, 0x75, 0xd9, 0x34, 0x27, 0x74, 0xc6, 0xe0, 0x0b, 0x9a, 0xc1, 0x1f, 0x06,
+	0x68, 0x58, 0x5e, 0xd3, 0xaa, 0x73, 0x08, 0xa3, 0x1c, 0x82, 0x32, 0x62, 0xcc, 0x42, 0xb9, 0x93,
+	0xa7, 0x8b, 0x8d, 0xde, 0x7f, 0x22, 0xd8, 0x23, 0x73, 0xa0, 0x58, 0xdd, 0x79, 0xd8, 0xab, 0xa8,
+	0x41, 0x29, 0xee, 0xb3, 0xc3, 0x3d, 0xf2, 0x9b, 0xb4, 0x92, 0x11, 0xfd, 0x60, 0xb0, 0xa5, 0x1d,
+	0xed, 0xf5, 0x5d, 0x9e, 0xa0, 0x21, 0x35, 0x33, 0x9f, 0x81, 0xd2, 0x55, 0xcb, 0xb3, 0x6a, 0xc4,
+	0x49, 0xc4, 0x4e, 0x4c, 0xec, 0x65, 0xdd, 0xb7, 0x9e, 0x1e, 0x4e, 0x01, 0xb2, 0xea, 0x6e, 0x6c,
+	0x48, 0xc7, 0xba, 0x0d, 0xfb, 0x57, 0xa9, 0xbb, 0x11, 0x5d, 0x76, 0xc3, 0xc8, 0xa7, 0xdb, 0x09,
+	0xe7, 0x97, 0x74, 0xce, 0x03, 0xee, 0xe9, 0x70, 0x16, 0x26, 0xb1, 0x7d, 0xea, 0x48, 0xc6, 0x01,
+	0x4c, 0x5f, 0xf6, 0xfd, 0x2d, 0x56, 0xaf, 0x24, 0x4c, 0x3f, 0xa9, 0x33, 0xbd, 0x38, 0x18, 0xd3,
+	0x64, 0x7a, 0xc1, 0xf1, 0xb3, 0x30, 0xb3, 0x1e, 0x59, 0x51, 0x33, 0x64, 0xc9, 0xac, 0xee, 0x7a,
+	0x69, 0x08, 0x73, 0x74, 0xbe, 0xd7, 0x06, 0xdc, 0x1d, 0x8d, 0x99, 0x50, 0xcb, 0x8b, 0xf7, 0xdf,
+	0x24, 0xff, 0x16, 0x3c, 0xb4, 0xfa, 0xac, 0x79, 0xfe, 0x2a, 0x89, 0xa8, 0x6b, 0x87, 0xef, 0x97,
+	0xa6, 0x15, 0x0e, 0x82, 0x2f, 0x85, 0xe2, 0x9a, 0xeb, 0x6d, 0x5d, 0xf1, 0x36, 0x7c, 0x66, 0xd4,
+	0x91, 0x1b, 0xd5, 0xa5, 0x03, 0xc5, 0x04, 0x9e, 0x86, 0x91, 0x26, 0xad, 0x0b, 0x27, 0x67, 0x8f,
+	0x78, 0x0e, 0xa6, 0x1c, 0x12, 0xda, 0xd4, 0x0d, 0x94, 0x9c, 0xa6, 0x0e, 0x31, 0x57, 0x73, 0x6d,
+	0xdf, 0x5b, 0xa9, 0x5b, 0x61, 0x28, 0x4b, 0xc2, 0x64, 0xc0, 0x78, 0x02, 0x76, 0x33, 0x9e, 0xa9,
+	0x94, 0x27, 0x75, 0x29, 0x0f, 0x68, 0xe8, 0x25, 0x3c, 0x89, 0xd8, 0x82, 0x87, 0x58, 0x25, 0x7e,
+	0x3e, 0x08, 0xc4, 0x24, 0x7d, 0x36, 0x28, 0x23, 0x9d, 0x2a, 0xda, 0x8e, 0xf9, 0x6c, 0xe9, 0xaf,
+	0x4b, 0x80, 0xd5, 0x50, 0x48, 0x68, 0xcb, 0xb5, 0x09, 0xfe, 0x3a, 0x82, 0x51, 0xc6, 0x1a, 0x1f,
+	0xea, 0x16, 0x79, 0x79, 0x48, 0x2a, 0x0f, 0x6f, 0xe3, 0x8d, 0x71, 0x33, 0x66, 0x5f, 0xfd, 0xcb,
+	0xdf, 0xbf, 0x51, 0x98, 0xc1, 0xfb, 0xf9, 0xc5, 0x8d, 0xd6, 0x19, 0xf5, 0x12, 0x45, 0x88, 0x5f,
+	0x43, 0x80, 0x45, 0x67, 0xa2, 0x1c, 0x6d, 0xe3, 0x93, 0xdd, 0x20, 0x76, 0x38, 0x02, 0x2f, 0x1f,
+	0x52, 0xea, 0xbc, 0x8a, 0xed, 0x53, 0xc2, 0xaa, 0x3a, 0xfe, 0x02, 0x07, 0xb0, 0xc0, 0x01, 0x1c,
+	0xc3, 0x46, 0x27, 0x00, 0xd5, 0x3b, 0x4c, 0xa3, 0x77, 0xab, 0x24, 0xe6, 0xfb, 0x26, 0x82, 0xb1,
+	0x9b, 0xbc, 0xab, 0xef, 0xa1, 0xa4, 0xf5, 0xa1, 0x29, 0x89, 0xb3, 0xe3, 0x68, 0x8d, 0xa3, 0x1c,
+	0xe9, 0x21, 0x7c, 0x50, 0x22, 0x0d, 0x23, 0x4a, 0xac, 0x86, 0x06, 0xf8, 0x34, 0xc2, 0xf7, 0x11,
+	0x8c, 0xc7, 0x87, 0x84, 0xf8, 0x78, 0x37, 0x94, 0xda, 0x21, 0x62, 0x79, 0x78, 0xdb, 0xd6, 0xc6,
+	0xa3, 0x1c, 0xe3, 0x51, 0xa3, 0xe3, 0x72, 0x2e, 0x6b, 0xe7, 0x71, 0xaf, 0x23, 0x18, 0xb9, 0x44,
+	0x7a, 0xda, 0xdb, 0x10, 0xc1, 0xb5, 0x29, 0xb0, 0xc3, 0x52, 0xe3, 0xef, 0x23, 0x78, 0xf8, 0x12,
+	0x89, 0x3a, 0x57, 0x40, 0x78, 0xbe, 0x77, 0x59, 0x22, 0xcc, 0xee, 0x64, 0x1f, 0x6f, 0x26, 0xa9,
+	0xbf, 0xca, 0x91, 0x3d, 0x8a, 0x4f, 0xe4, 0x19, 0x61, 0xb8, 0xed, 0xd9, 0xb7, 0x05, 0x8e, 0x3f,
+	0x22, 0x98, 0xce, 0x5e, 0x61, 0xc1, 0x7a, 0xcd, 0xd4, 0xf1, 0x86, 0x4b, 0xf9, 0xda, 0xa0, 0x89,
+	0x54, 0x9f, 0xd4, 0x38, 0xcf, 0x91, 0x3f, 0x8e, 0x1f, 0xcb, 0x43, 0x9e, 0x9c, 0xb8, 0x54, 0xef,
+	0xc8, 0xc7, 0xbb, 0xfc, 0xba, 0x15, 0x87, 0xfd, 0x36, 0x82, 0xfd, 0x72, 0xde, 0x95, 0x4d, 0x8b,
+	0x46, 0xab, 0x84, 0x75, 0xb5, 0x61, 0x5f, 0xf2, 0x0c, 0x58, 0x18, 0xa8, 0xfc, 0x8c, 0x0b, 0x5c,
+	0x96, 0x8f, 0xe2, 0x27, 0x77, 0x2c, 0x8b, 0xcd, 0xa6, 0x71, 0x04, 0xec, 0x57, 0x11, 0xec, 0xba,
+	0x44, 0xa2, 0xab, 0xc9, 0xa9, 0xdf, 0xf1, 0xbe, 0x6e, 0x12, 0x94, 0x67, 0x2b, 0xca, 0x2d, 0x2f,
+	0xf9, 0x53, 0x62, 0x22, 0x8b, 0x1c, 0xdc, 0x09, 0x7c, 0x3c, 0x0f, 0x5c, 0x7a, 0xd2, 0xf8, 0x26,
+	0x82, 0x03, 0x2a, 0x88, 0xf4, 0xc2, 0xc8, 0x87, 0x76, 0x76, 0xaf, 0x41, 0xdc, 0x8e, 0xe8, 0x81,
+	0x6e, 0x89, 0xa3, 0x3b, 0x65, 0x74, 0x36, 0xe0, 0x46, 0x1b, 0x8a, 0x65, 0xb4, 0x30, 0x8f, 0xf0,
+	0x6f, 0x11, 0x8c, 0xc7, 0x87, 0x3f, 0xdd, 0x75, 0xa4, 0xdd, 0x18, 0x18, 0x66, 0x34, 0x10, 0xab,
+	0x5d, 0x3e, 0xdd, 0x59, 0xa1, 0xea, 0xf7, 0xd2, 0x54, 0x2b, 0x5c, 0xcb, 0x7a, 0x18, 0x7b, 0x0b,
+	0x01, 0xa4, 0x07, 0x58, 0xf8, 0xd1, 0x7c, 0x39, 0x94, 0x43, 0xae, 0xf2, 0x70, 0x8f, 0xb0, 0x8c,
+	0x0a, 0x97, 0x67, 0xbe, 0x3c, 0x97, 0x1b, 0x43, 0x02, 0x62, 0x2f, 0xc7, 0x87, 0x5d, 0xdf, 0x43,
+	0x30, 0xc6, 0x77, 0xd6, 0xf1, 0xb1, 0x6e, 0x98, 0xd5, 0x8d, 0xf7, 0x61, 0xaa, 0xfe, 0x11, 0x0e,
+	0x75, 0x6e, 0x29, 0x2f, 0x10, 0x2f, 0xa3, 0x05, 0xdc, 0x82, 0xf1, 0x78, 0x2f, 0xbb, 0xbb, 0x79,
+	0x68, 0x7b, 0xdd, 0xe5, 0xb9, 0x9c, 0xc2, 0x20, 0x36, 0x54, 0x91, 0x03, 0x16, 0x7a, 0xe5, 0x80,
+	0x51, 0x16, 0xa6, 0xf1, 0xd1, 0xbc, 0x20, 0xfe, 0x3e, 0x28, 0xe6, 0x24, 0x47, 0x77, 0xdc, 0x98,
+	0xeb, 0x95, 0x07, 0x98, 0x76, 0x7e, 0x88, 0xa0, 0x78, 0xbd, 0x1e, 0x37, 0xb8, 0xfd, 0x21, 0xbd,
+	0x38, 0xf8, 0xe5, 0x07, 0xc6, 0xd0, 0x38, 0xcd, 0x61, 0x2e, 0x18, 0xc7, 0x7b, 0xc1, 0xac, 0x06,
+	0x75, 0xcb, 0x63, 0x58, 0xdf, 0x40, 0x30, 0x9d, 0xed, 0xf5, 0xf0, 0xc1, 0x4c, 0x7c, 0x57, 0x5b,
+	0xdf, 0xb2, 0xbe, 0xe2, 0xdd, 0xfa, 0x44, 0xe3, 0x63, 0x1c, 0xca, 0x32, 0x3e, 0xd7, 0xd3, 0x8b,
+	0xaf, 0xc9, 0x08, 0xc9, 0x26, 0x5a, 0x4c, 0x6f, 0x6c, 0x7c, 0x0e, 0xc1, 0x24, 0xab, 0x04, 0x79,
+	0xa7, 0x96, 0x8f, 0xe9, 0x88, 0xf6, 0x63, 0xa7, 0xee, 0xd1, 0x38, 0xcb, 0xf1, 0x54, 0xf0, 0xa9,
+	0x3e, 0xf1, 0x38, 0x9c, 0xeb, 0x17, 0x10, 0xec, 0x62, 0x18, 0x64, 0xe3, 0x96, 0x0f, 0x43, 0xaf,
+	0x98, 0xb2, 0xbd, 0xa4, 0x71, 0x8e, 0x43, 0x58, 0xc2, 0xa7, 0xfb, 0x84, 0xb0, 0xe9, 0xfb, 0x5b,
+	0x8b, 0x75, 0xc6, 0xf5, 0x1e, 0x82, 0x7d, 0x97, 0x48, 0xa4, 0xf7, 0x8a, 0xbd, 0x0a, 0x34, 0xdd,
+	0xf2, 0x3a, 0xf7, 0x99, 0xc6, 0x29, 0x8e, 0xe9, 0x11, 0x7c, 0x2c, 0xcf, 0x62, 0x22, 0xc9, 0xf1,
+	0x0e, 0xec, 0xb9, 0x44, 0x22, 0xa5, 0xa1, 0xeb, 0x85, 0x41, 0xf7, 0xfb, 0x0e, 0xbd, 0xa6, 0x31,
+	0xcf, 0x01, 0x18, 0x38, 0xd7, 0xb3, 0x1c, 0x9f, 0x5a, 0xf8, 0xe7, 0x08, 0x76, 0x49, 0x85, 0xdf,
+	0xa0, 0x84, 0xe4, 0xaf, 0xc5, 0xf0, 0x82, 0x38, 0xe3, 0x65, 0x3c, 0xc1, 0x61, 0x7e, 0x18, 0x9f,
+	0xed, 0x73, 0xed, 0xa4, 0x19, 0x2f, 0x46, 0x0c, 0xe9, 0xef, 0x11, 0xec, 0xbb, 0x19, 0xc7, 0xec,
+	0x0f, 0x08, 0xff, 0x0a, 0xc7, 0xff, 0x24, 0x7e, 0x3c, 0xa7, 0x47, 0xe9, 0x25, 0xc6, 0x69, 0x84,
+	0x7f, 0x8a, 0xa0, 0x28, 0x6f, 0x20, 0xe0, 0x13, 0x5d, 0x83, 0xba, 0x7e, 0x47, 0x61, 0x98, 0x81,
+	0x58, 0x14, 0xe4, 0x46, 0xae, 0xbd, 0x52, 0xc1, 0x9f, 0x05, 0xb8, 0xd7, 0x11, 0xe0, 0x64, 0x4b,
+	0x2f, 0xd9, 0xe4, 0xc3, 0x8f, 0x68, 0xac, 0xba, 0x6e, 0xf3, 0x97, 0x4f, 0xf4, 0x7c, 0x4f, 0x2f,
+	0x03, 0x17, 0x72, 0x43, 0xaf, 0x9f, 0xf0, 0xff, 0x35, 0x82, 0x7d, 0x17, 0x3c, 0xeb, 0x95, 0x3a,
+	0x51, 0xf6, 0xf9, 0xf1, 0x42, 0xf7, 0x12, 0x30, 0x7b, 0x18, 0x30, 0x4c, 0xa5, 0xe6, 0x17, 0x89,
+	0x49, 0x09, 0x9b, 0x40, 0x60, 0x7a, 0xfd, 0x25, 0x02, 0xbc, 0xea, 0x86, 0x0f, 0x88, 0x04, 0xc2,
+	0x2c, 0x16, 0xfa, 0x95, 0x00, 0xbf, 0x16, 0x47, 0x54, 0xfd, 0x00, 0x61, 0x67, 0x11, 0xb5, 0xf3,
+	0xe1, 0x83, 0xf1, 0xff, 0x1c, 0xca, 0x22, 0x3e, 0xd9, 0x97, 0x21, 0x54, 0x6f, 0x71, 0xc6, 0x7f,
+	0x42, 0x70, 0x60, 0x85, 0x01, 0xab, 0x67, 0x4e, 0x1b, 0x70, 0x25, 0x07, 0x52, 0x87, 0x73, 0x97,
+	0x61, 0x2a, 0x55, 0xe4, 0xab, 0x85, 0xd3, 0x3b, 0x90, 0xa4, 0x7a, 0xc7, 0x75, 0xee, 0xe2, 0xaf,
+	0x20, 0x98, 0xba, 0x44, 0x92, 0xdd, 0xa1, 0x9c, 0x48, 0xa1, 0x5f, 0x0f, 0x2a, 0xcf, 0xf7, 0x7e,
+	0x71, 0x27, 0x89, 0x4b, 0x86, 0x2f, 0xfc, 0x6d, 0x04, 0xbb, 0xaf, 0xab, 0x01, 0x18, 0x9f, 0xea,
+	0xc5, 0x49, 0xab, 0xb1, 0xfb, 0xc7, 0x25, 0x96, 0xdf, 0xe8, 0x0b, 0xd7, 0xb2, 0xb8, 0x8b, 0xf2,
+	0x1d, 0x14, 0x6f, 0x2f, 0x66, 0xce, 0xfe, 0xff, 0x53, 0xbd, 0xe5, 0x5c, 0x21, 0xe8, 0x55, 0x07,
+	0xe9, 0xf8, 0xaa, 0xe2, 0x42, 0x00, 0xfe, 0x26, 0x82, 0x7d, 0xfc, 0x5e, 0x86, 0x3a, 0x71, 0xa6,
+	0xf8, 0xef, 0x76, 0x8b, 0xa3, 0x8f, 0xe2, 0x5f, 0x64, 0x57, 0x63, 0x47, 0xa0, 0x96, 0xe5, 0x9d,
+	0x8b, 0xb7, 0x10, 0x94, 0x65, 0xca, 0x69, 0xbf, 0x8d, 0xd9, 0xdd, 0x83, 0x3a, 0x5f, 0xd7, 0x2c,
+	0x57, 0xfb, 0x7e, 0x5f, 0xa0, 0xff, 0x08, 0x47, 0x7f, 0xa6, 0x07, 0xfa, 0xf8, 0xe3, 0x45, 0x35,
+	0x37, 0x7d, 0x15, 0xc1, 0x1e, 0xd9, 0x27, 0x09, 0xb3, 0x5c, 0xec, 0xb5, 0xe2, 0x3b, 0xed, 0xab,
+	0x84, 0x9f, 0x2c, 0xf4, 0xe7, 0x27, 0xdf, 0x42, 0xb0, 0x4f, 0xfe, 0xf3, 0xc8, 0x3a, 0xb5, 0xcf,
+	0x7b, 0xce, 0x6a, 0x18, 0x75, 0xef, 0x9d, 0xdb, 0xae, 0xdf, 0x76, 0x77, 0x94, 0xec, 0xbf, 0xa4,
+	0x18, 0x67, 0x38, 0xb0, 0x93, 0xc6, 0x6c, 0x07, 0x60, 0x8b, 0xf2, 0x76, 0xa7, 0xde, 0xd2, 0xdf,
+	0x47, 0x30, 0x21, 0x2e, 0x94, 0xe4, 0xf4, 0xc6, 0xca, 0x8d, 0x93, 0x72, 0x66, 0x53, 0x5f, 0xdc,
+	0x47, 0x30, 0x3e, 0xc1, 0x79, 0x3f, 0x8f, 0xab, 0x79, 0x4a, 0x09, 0x7c, 0x27, 0xac, 0xde, 0x11,
+	0x97, 0x01, 0xee, 0x56, 0x59, 0x21, 0xfe, 0x62, 0x8f, 0x3a, 0x95, 0xbd, 0x73, 0x1a, 0xe1, 0x28,
+	0x6e, 0x5c, 0xf8, 0x49, 0x01, 0x9e, 0xcb, 0x9c, 0x2b, 0xb4, 0x1d, 0x22, 0x94, 0xcb, 0x6d, 0x27,
+	0x0f, 0x69, 0x79, 0x2c, 0xf6, 0x6d, 0xf1, 0x91, 0x5c, 0xb6, 0x9c, 0x11, 0x4b, 0x69, 0x6a, 0x10,
+	0x89, 0xd9, 0xf7, 0x1d, 0x42, 0xf2, 0x50, 0x88, 0x02, 0x01, 0x2f, 0xf4, 0xe5, 0x9f, 0x31, 0x9c,
+	0x2f, 0xc6, 0x19, 0x56, 0xbf, 0x6d, 0x98, 0xd9, 0x3a, 0xec, 0x78, 0x63, 0x32, 0x93, 0x66, 0x3b,
+	0x5f, 0x57, 0xcc, 0x3d, 0x1e, 0xa8, 0xda, 0xda, 0x37, 0x4f, 0x5d, 0xfc, 0xc3, 0xbb, 0x87, 0xd1,
+	0x3b, 0xef, 0x1e, 0x46, 0x7f, 0x7b, 0xf7, 0x30, 0x7a, 0xf1, 0x5c, 0x7f, 0xff, 0x36, 0x6a, 0xd7,
+	0x5d, 0xe2, 0x45, 0xea, 0xb4, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x2c, 0xeb, 0xe7, 0x1c,
+	0x3b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3437,8 +3867,18 @@ type ApplicationServiceClient interface {
 	Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// Sync syncs an application to its target state
 	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// PlanSync returns the changes a sync of an application would perform, without changing the live resources
+	PlanSync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.SyncPlan, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
+	// ListDrift returns the drift history of the application resources
+	ListDrift(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*DriftHistoryResponse, error)
+	// ListHookLogs returns the logs captured from the pods of the failed hooks of the last sync operation of the application
+	ListHookLogs(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*HookLogsResponse, error)
+	// GetStatusTimeline returns the transitions of the health and sync status of the application, oldest first
+	GetStatusTimeline(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*StatusTimelineResponse, error)
+	// GetDORAMetrics returns the DORA metrics of the application over rolling windows, computed from its revision history
+	GetDORAMetrics(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*DORAMetricsResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
@@ -3447,6 +3887,14 @@ type ApplicationServiceClient interface {
 	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error)
+	// EnableMaintenance puts an application in maintenance mode, suspending automated sync and self-heal until it expires
+	EnableMaintenance(ctx context.Context, in *ApplicationMaintenanceRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// DisableMaintenance ends the maintenance mode of an application
+	DisableMaintenance(ctx context.Context, in *ApplicationMaintenanceRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// GetOperationQueue returns the operations queued while an operation is in progress, in order
+	GetOperationQueue(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*OperationQueueResponse, error)
+	// CancelQueuedOperation removes an operation from the queue of the application
+	CancelQueuedOperation(ctx context.Context, in *ApplicationQueuedOperationRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// GetResource returns single application resource
 	GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
 	// PatchResource patch single application resource
@@ -3661,6 +4109,15 @@ func (c *applicationServiceClient) Sync(ctx context.Context, in *ApplicationSync
 	return out, nil
 }
 
+func (c *applicationServiceClient) PlanSync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.SyncPlan, error) {
+	out := new(v1alpha1.SyncPlan)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/PlanSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error) {
 	out := new(ManagedResourcesResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ManagedResources", in, out, opts...)
@@ -3670,6 +4127,42 @@ func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *applicationServiceClient) ListDrift(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*DriftHistoryResponse, error) {
+	out := new(DriftHistoryResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListHookLogs(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*HookLogsResponse, error) {
+	out := new(HookLogsResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListHookLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetStatusTimeline(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*StatusTimelineResponse, error) {
+	out := new(StatusTimelineResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetStatusTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetDORAMetrics(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*DORAMetricsResponse, error) {
+	out := new(DORAMetricsResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetDORAMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	out := new(v1alpha1.ApplicationTree)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceTree", in, out, opts...)
@@ -3729,6 +4222,42 @@ func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *O
 	return out, nil
 }
 
+func (c *applicationServiceClient) EnableMaintenance(ctx context.Context, in *ApplicationMaintenanceRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/EnableMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DisableMaintenance(ctx context.Context, in *ApplicationMaintenanceRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/DisableMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetOperationQueue(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*OperationQueueResponse, error) {
+	out := new(OperationQueueResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetOperationQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CancelQueuedOperation(ctx context.Context, in *ApplicationQueuedOperationRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/CancelQueuedOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
 	out := new(ApplicationResourceResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetResource", in, out, opts...)
@@ -3883,8 +4412,18 @@ type ApplicationServiceServer interface {
 	Delete(context.Context, *ApplicationDeleteRequest) (*ApplicationResponse, error)
 	// Sync syncs an application to its target state
 	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
+	// PlanSync returns the changes a sync of an application would perform, without changing the live resources
+	PlanSync(context.Context, *ApplicationSyncRequest) (*v1alpha1.SyncPlan, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
+	// ListDrift returns the drift history of the application resources
+	ListDrift(context.Context, *ResourcesQuery) (*DriftHistoryResponse, error)
+	// ListHookLogs returns the logs captured from the pods of the failed hooks of the last sync operation of the application
+	ListHookLogs(context.Context, *ResourcesQuery) (*HookLogsResponse, error)
+	// GetStatusTimeline returns the transitions of the health and sync status of the application, oldest first
+	GetStatusTimeline(context.Context, *ApplicationQuery) (*StatusTimelineResponse, error)
+	// GetDORAMetrics returns the DORA metrics of the application over rolling windows, computed from its revision history
+	GetDORAMetrics(context.Context, *ApplicationQuery) (*DORAMetricsResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
@@ -3893,6 +4432,14 @@ type ApplicationServiceServer interface {
 	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(context.Context, *OperationTerminateRequest) (*OperationTerminateResponse, error)
+	// EnableMaintenance puts an application in maintenance mode, suspending automated sync and self-heal until it expires
+	EnableMaintenance(context.Context, *ApplicationMaintenanceRequest) (*v1alpha1.Application, error)
+	// DisableMaintenance ends the maintenance mode of an application
+	DisableMaintenance(context.Context, *ApplicationMaintenanceRequest) (*v1alpha1.Application, error)
+	// GetOperationQueue returns the operations queued while an operation is in progress, in order
+	GetOperationQueue(context.Context, *ApplicationQuery) (*OperationQueueResponse, error)
+	// CancelQueuedOperation removes an operation from the queue of the application
+	CancelQueuedOperation(context.Context, *ApplicationQueuedOperationRequest) (*v1alpha1.Application, error)
 	// GetResource returns single application resource
 	GetResource(context.Context, *ApplicationResourceRequest) (*ApplicationResourceResponse, error)
 	// PatchResource patch single application resource
@@ -3965,9 +4512,24 @@ func (*UnimplementedApplicationServiceServer) Delete(ctx context.Context, req *A
 func (*UnimplementedApplicationServiceServer) Sync(ctx context.Context, req *ApplicationSyncRequest) (*v1alpha1.Application, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
 }
+func (*UnimplementedApplicationServiceServer) PlanSync(ctx context.Context, req *ApplicationSyncRequest) (*v1alpha1.SyncPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanSync not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Context, req *ResourcesQuery) (*ManagedResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManagedResources not implemented")
 }
+func (*UnimplementedApplicationServiceServer) ListDrift(ctx context.Context, req *ResourcesQuery) (*DriftHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrift not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ListHookLogs(ctx context.Context, req *ResourcesQuery) (*HookLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookLogs not implemented")
+}
+func (*UnimplementedApplicationServiceServer) GetStatusTimeline(ctx context.Context, req *ApplicationQuery) (*StatusTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusTimeline not implemented")
+}
+func (*UnimplementedApplicationServiceServer) GetDORAMetrics(ctx context.Context, req *ApplicationQuery) (*DORAMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDORAMetrics not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ResourceTree(ctx context.Context, req *ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
 }
@@ -3980,6 +4542,18 @@ func (*UnimplementedApplicationServiceServer) Rollback(ctx context.Context, req
 func (*UnimplementedApplicationServiceServer) TerminateOperation(ctx context.Context, req *OperationTerminateRequest) (*OperationTerminateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateOperation not implemented")
 }
+func (*UnimplementedApplicationServiceServer) EnableMaintenance(ctx context.Context, req *ApplicationMaintenanceRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableMaintenance not implemented")
+}
+func (*UnimplementedApplicationServiceServer) DisableMaintenance(ctx context.Context, req *ApplicationMaintenanceRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMaintenance not implemented")
+}
+func (*UnimplementedApplicationServiceServer) GetOperationQueue(ctx context.Context, req *ApplicationQuery) (*OperationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperationQueue not implemented")
+}
+func (*UnimplementedApplicationServiceServer) CancelQueuedOperation(ctx context.Context, req *ApplicationQueuedOperationRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedOperation not implemented")
+}
 func (*UnimplementedApplicationServiceServer) GetResource(ctx context.Context, req *ApplicationResourceRequest) (*ApplicationResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
 }
@@ -4299,6 +4873,24 @@ func _ApplicationService_Sync_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_PlanSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).PlanSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/PlanSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).PlanSync(ctx, req.(*ApplicationSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcesQuery)
 	if err := dec(in); err != nil {
@@ -4317,28 +4909,100 @@ func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApplicationService_ListDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcesQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ResourceTree(ctx, in)
+		return srv.(ApplicationServiceServer).ListDrift(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/application.ApplicationService/ResourceTree",
+		FullMethod: "/application.ApplicationService/ListDrift",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ResourceTree(ctx, req.(*ResourcesQuery))
+		return srv.(ApplicationServiceServer).ListDrift(ctx, req.(*ResourcesQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_WatchResourceTree_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ResourcesQuery)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _ApplicationService_ListHookLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourcesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListHookLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListHookLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListHookLogs(ctx, req.(*ResourcesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetStatusTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetStatusTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/GetStatusTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetStatusTimeline(ctx, req.(*ApplicationQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetDORAMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetDORAMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/GetDORAMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetDORAMetrics(ctx, req.(*ApplicationQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourcesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ResourceTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ResourceTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ResourceTree(ctx, req.(*ResourcesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_WatchResourceTree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResourcesQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
 	return srv.(ApplicationServiceServer).WatchResourceTree(m, &applicationServiceWatchResourceTreeServer{stream})
 }
@@ -4392,6 +5056,78 @@ func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_EnableMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).EnableMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/EnableMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).EnableMaintenance(ctx, req.(*ApplicationMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DisableMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DisableMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/DisableMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DisableMaintenance(ctx, req.(*ApplicationMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetOperationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetOperationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/GetOperationQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetOperationQueue(ctx, req.(*ApplicationQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_CancelQueuedOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationQueuedOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CancelQueuedOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/CancelQueuedOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CancelQueuedOperation(ctx, req.(*ApplicationQueuedOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationResourceRequest)
 	if err := dec(in); err != nil {
@@ -4649,10 +5385,30 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Sync",
 			Handler:    _ApplicationService_Sync_Handler,
 		},
+		{
+			MethodName: "PlanSync",
+			Handler:    _ApplicationService_PlanSync_Handler,
+		},
 		{
 			MethodName: "ManagedResources",
 			Handler:    _ApplicationService_ManagedResources_Handler,
 		},
+		{
+			MethodName: "ListDrift",
+			Handler:    _ApplicationService_ListDrift_Handler,
+		},
+		{
+			MethodName: "ListHookLogs",
+			Handler:    _ApplicationService_ListHookLogs_Handler,
+		},
+		{
+			MethodName: "GetStatusTimeline",
+			Handler:    _ApplicationService_GetStatusTimeline_Handler,
+		},
+		{
+			MethodName: "GetDORAMetrics",
+			Handler:    _ApplicationService_GetDORAMetrics_Handler,
+		},
 		{
 			MethodName: "ResourceTree",
 			Handler:    _ApplicationService_ResourceTree_Handler,
@@ -4665,6 +5421,22 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TerminateOperation",
 			Handler:    _ApplicationService_TerminateOperation_Handler,
 		},
+		{
+			MethodName: "EnableMaintenance",
+			Handler:    _ApplicationService_EnableMaintenance_Handler,
+		},
+		{
+			MethodName: "DisableMaintenance",
+			Handler:    _ApplicationService_DisableMaintenance_Handler,
+		},
+		{
+			MethodName: "GetOperationQueue",
+			Handler:    _ApplicationService_GetOperationQueue_Handler,
+		},
+		{
+			MethodName: "CancelQueuedOperation",
+			Handler:    _ApplicationService_CancelQueuedOperation_Handler,
+		},
 		{
 			MethodName: "GetResource",
 			Handler:    _ApplicationService_GetResource_Handler,
@@ -5602,6 +6374,15 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Timeout != nil {
+		i -= len(*m.Timeout)
+		copy(dAtA[i:], *m.Timeout)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Timeout)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.Revisions) > 0 {
 		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Revisions[iNdEx])
@@ -6935,7 +7716,7 @@ func (m *OperationTerminateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSyncWindowsQuery) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationMaintenanceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6945,12 +7726,12 @@ func (m *ApplicationSyncWindowsQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationSyncWindowsQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationMaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationMaintenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6964,13 +7745,27 @@ func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, er
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
+		dAtA[i] = 0x22
+	}
+	if m.Duration != nil {
+		i -= len(*m.Duration)
+		copy(dAtA[i:], *m.Duration)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Duration)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Reason)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.Name == nil {
@@ -6985,7 +7780,7 @@ func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationQueuedOperationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6995,12 +7790,12 @@ func (m *ApplicationSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationSyncWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationQueuedOperationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationQueuedOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7009,36 +7804,69 @@ func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CanSync == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
 	} else {
+		i -= len(*m.Id)
+		copy(dAtA[i:], *m.Id)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Id)))
 		i--
-		if *m.CanSync {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
 	}
-	if len(m.AssignedWindows) > 0 {
-		for iNdEx := len(m.AssignedWindows) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssignedWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplication(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ActiveWindows) > 0 {
-		for iNdEx := len(m.ActiveWindows) - 1; iNdEx >= 0; iNdEx-- {
+	return dAtA[:n], nil
+}
+
+func (m *OperationQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ActiveWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7052,7 +7880,7 @@ func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSyncWindow) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationSyncWindowsQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7062,12 +7890,12 @@ func (m *ApplicationSyncWindow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationSyncWindow) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationSyncWindowsQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7076,17 +7904,134 @@ func (m *ApplicationSyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ManualSync == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
-	} else {
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		if *m.ManualSync {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CanSync == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
+	} else {
+		i--
+		if *m.CanSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssignedWindows) > 0 {
+		for iNdEx := len(m.AssignedWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssignedWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ActiveWindows) > 0 {
+		for iNdEx := len(m.ActiveWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSyncWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ManualSync == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
+	} else {
+		i--
+		if *m.ManualSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Duration == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
@@ -7271,7 +8216,7 @@ func (m *ManagedResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
+func (m *DriftHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7281,12 +8226,12 @@ func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinkInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *DriftHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DriftHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7295,42 +8240,24 @@ func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IconClass != nil {
-		i -= len(*m.IconClass)
-		copy(dAtA[i:], *m.IconClass)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.IconClass)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Description != nil {
-		i -= len(*m.Description)
-		copy(dAtA[i:], *m.Description)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Url == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
-	} else {
-		i -= len(*m.Url)
-		copy(dAtA[i:], *m.Url)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Url)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Title == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
-	} else {
-		i -= len(*m.Title)
-		copy(dAtA[i:], *m.Title)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
+func (m *HookLogsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7340,12 +8267,12 @@ func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *HookLogsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HookLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7371,7 +8298,7 @@ func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
+func (m *StatusTimelineResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7381,12 +8308,12 @@ func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListAppLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StatusTimelineResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StatusTimelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7395,64 +8322,246 @@ func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplication(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DORAMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ApplicationQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DORAMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DORAMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Refresh != nil {
-		l = len(*m.Refresh)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.ResourceVersion != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IconClass != nil {
+		i -= len(*m.IconClass)
+		copy(dAtA[i:], *m.IconClass)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.IconClass)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Description != nil {
+		i -= len(*m.Description)
+		copy(dAtA[i:], *m.Description)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Url == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
+	} else {
+		i -= len(*m.Url)
+		copy(dAtA[i:], *m.Url)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Title == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	} else {
+		i -= len(*m.Title)
+		copy(dAtA[i:], *m.Title)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAppLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplication(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ApplicationQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Refresh != nil {
+		l = len(*m.Refresh)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.ResourceVersion != nil {
 		l = len(*m.ResourceVersion)
 		n += 1 + l + sovApplication(uint64(l))
 	}
@@ -7906,6 +9015,10 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.Timeout != nil {
+		l = len(*m.Timeout)
+		n += 2 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8454,7 +9567,7 @@ func (m *OperationTerminateRequest) Size() (n int) {
 	return n
 }
 
-func (m *ApplicationSyncWindowsQuery) Size() (n int) {
+func (m *ApplicationMaintenanceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8464,6 +9577,14 @@ func (m *ApplicationSyncWindowsQuery) Size() (n int) {
 		l = len(*m.Name)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Duration != nil {
+		l = len(*m.Duration)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.AppNamespace != nil {
 		l = len(*m.AppNamespace)
 		n += 1 + l + sovApplication(uint64(l))
@@ -8478,26 +9599,27 @@ func (m *ApplicationSyncWindowsQuery) Size() (n int) {
 	return n
 }
 
-func (m *ApplicationSyncWindowsResponse) Size() (n int) {
+func (m *ApplicationQueuedOperationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ActiveWindows) > 0 {
-		for _, e := range m.ActiveWindows {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
 	}
-	if len(m.AssignedWindows) > 0 {
-		for _, e := range m.AssignedWindows {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
+	if m.Id != nil {
+		l = len(*m.Id)
+		n += 1 + l + sovApplication(uint64(l))
 	}
-	if m.CanSync != nil {
-		n += 2
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8505,26 +9627,17 @@ func (m *ApplicationSyncWindowsResponse) Size() (n int) {
 	return n
 }
 
-func (m *ApplicationSyncWindow) Size() (n int) {
+func (m *OperationQueueResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Kind != nil {
-		l = len(*m.Kind)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Schedule != nil {
-		l = len(*m.Schedule)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Duration != nil {
-		l = len(*m.Duration)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ManualSync != nil {
-		n += 2
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8532,25 +9645,103 @@ func (m *ApplicationSyncWindow) Size() (n int) {
 	return n
 }
 
-func (m *OperationTerminateResponse) Size() (n int) {
+func (m *ApplicationSyncWindowsQuery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ResourcesQuery) Size() (n int) {
+func (m *ApplicationSyncWindowsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ApplicationName != nil {
+	if len(m.ActiveWindows) > 0 {
+		for _, e := range m.ActiveWindows {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.AssignedWindows) > 0 {
+		for _, e := range m.AssignedWindows {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.CanSync != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = len(*m.Schedule)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Duration != nil {
+		l = len(*m.Duration)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ManualSync != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperationTerminateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourcesQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationName != nil {
 		l = len(*m.ApplicationName)
 		n += 1 + l + sovApplication(uint64(l))
 	}
@@ -8606,6 +9797,78 @@ func (m *ManagedResourcesResponse) Size() (n int) {
 	return n
 }
 
+func (m *DriftHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HookLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusTimelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DORAMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *LinkInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11671,6 +12934,39 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Timeout = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -15530,7 +16826,7 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationMaintenanceRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -15554,10 +16850,10 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationMaintenanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationMaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15595,6 +16891,72 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Duration = &s
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
@@ -15627,7 +16989,7 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
@@ -15685,7 +17047,7 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplicationQueuedOperationRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -15709,17 +17071,17 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationQueuedOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationQueuedOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -15729,140 +17091,29 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
-			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
-			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.CanSync = &b
-			hasFields[0] |= uint64(0x00000001)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15891,12 +17142,12 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.Id = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15925,12 +17176,11 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Schedule = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15959,31 +17209,8 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Duration = &s
+			m.Project = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ManualSync = &b
-			hasFields[0] |= uint64(0x00000008)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -16001,16 +17228,10 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
 	}
 
 	if iNdEx > l {
@@ -16018,7 +17239,7 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
+func (m *OperationQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16041,12 +17262,46 @@ func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &v1alpha1.QueuedOperation{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -16069,7 +17324,7 @@ func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -16093,15 +17348,15 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16130,12 +17385,12 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ApplicationName = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16164,11 +17419,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16197,46 +17452,771 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Project = &s
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var stringLen uint64
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
+			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
+			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.CanSync = &b
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Schedule = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Duration = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ManualSync = &b
+			hasFields[0] |= uint64(0x00000008)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ApplicationName = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Version = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Group = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -16246,30 +18226,82 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Group = &s
+			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DriftHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DriftHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DriftHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -16279,30 +18311,82 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.Items = append(m.Items, &v1alpha1.DriftRecord{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -16312,30 +18396,82 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.Items = append(m.Items, &v1alpha1.HookLogs{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusTimelineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusTimelineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusTimelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -16345,24 +18481,25 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Items = append(m.Items, &v1alpha1.StatusTransition{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16380,16 +18517,13 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
+func (m *DORAMetricsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16412,10 +18546,10 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DORAMetricsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DORAMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16447,7 +18581,7 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
+			m.Items = append(m.Items, &v1alpha1.DORAMetrics{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -702,70 +702,73 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 1007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x96, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0xcc, 0xee, 0x76, 0xdd, 0xd0, 0x1f, 0x61, 0xd0,
-	0x56, 0x51, 0xa1, 0xb6, 0x9a, 0x82, 0xb4, 0x82, 0x13, 0xdb, 0xad, 0x02, 0x52, 0x0f, 0xe0, 0x82,
-	0x40, 0x1c, 0x40, 0x8e, 0xfd, 0x94, 0x9d, 0x8d, 0x63, 0x1b, 0xcf, 0x24, 0xdb, 0x10, 0xf5, 0x82,
-	0x04, 0x48, 0x1c, 0x38, 0xc0, 0x89, 0x0b, 0x47, 0xfe, 0x0f, 0x6e, 0x1c, 0x91, 0xf8, 0x07, 0x50,
-	0xc5, 0x1f, 0x82, 0x66, 0x3c, 0x76, 0xe2, 0xa4, 0xe6, 0x87, 0x1a, 0x38, 0x65, 0x3c, 0x7e, 0xfe,
-	0xbe, 0xef, 0xbd, 0x79, 0xf3, 0xcd, 0x04, 0xb6, 0x39, 0x26, 0x43, 0x4c, 0xec, 0x38, 0x89, 0x9e,
-	0xa2, 0x27, 0xb2, 0x5f, 0x2b, 0x4e, 0x22, 0x11, 0x91, 0x5b, 0xfa, 0xb1, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0x1b, 0x33, 0xdb, 0x0d, 0xc3, 0x48, 0xb8, 0x82, 0x45, 0x21, 0x4f, 0xc3, 0xea,
-	0xb4, 0xf7, 0x90, 0x5b, 0x2c, 0x52, 0x6f, 0xbd, 0x28, 0x41, 0x7b, 0x78, 0x64, 0x77, 0x31, 0xc4,
-	0xc4, 0x15, 0xe8, 0xeb, 0x98, 0xb3, 0x2e, 0x13, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x9b,
-	0x74, 0x23, 0x89, 0xac, 0x06, 0x87, 0x9e, 0x6f, 0x0f, 0x5b, 0x76, 0xdc, 0xeb, 0xca, 0xef, 0xb9,
-	0xed, 0xc6, 0x71, 0xc0, 0x3c, 0x85, 0x6f, 0x0f, 0x8f, 0xdc, 0x20, 0x7e, 0xe2, 0xce, 0xa3, 0x9d,
-	0xfc, 0x0d, 0x9a, 0xce, 0x6a, 0x1a, 0x6b, 0x6a, 0x9c, 0x82, 0xd0, 0xef, 0x0c, 0xb8, 0xfb, 0x6e,
-	0x9a, 0xe0, 0x49, 0x82, 0xae, 0x40, 0x07, 0x3f, 0x1b, 0x20, 0x17, 0xa4, 0x03, 0x59, 0xe2, 0xa6,
-	0xd1, 0x30, 0x9a, 0xab, 0xad, 0xb7, 0xad, 0x09, 0x9f, 0x95, 0xf1, 0xa9, 0xc1, 0xa7, 0x9e, 0x6f,
-	0x0d, 0x5b, 0x56, 0xdc, 0xeb, 0x5a, 0x52, 0xbd, 0x35, 0xcd, 0x92, 0xa9, 0xb7, 0xde, 0x8a, 0x63,
-	0xcd, 0xe3, 0x64, 0xc0, 0x64, 0x13, 0x6a, 0x83, 0x98, 0x63, 0x22, 0xcc, 0x4a, 0xc3, 0x68, 0xde,
-	0x76, 0xf4, 0x13, 0xed, 0xc1, 0x96, 0x8e, 0x7d, 0x3f, 0xea, 0x61, 0xf8, 0x18, 0x03, 0x9c, 0x08,
-	0x33, 0x8b, 0xc2, 0x56, 0x26, 0x70, 0x04, 0x96, 0x92, 0x28, 0x40, 0x05, 0xb6, 0xe2, 0xa8, 0x31,
-	0xd9, 0x80, 0x2a, 0x73, 0x85, 0x59, 0x6d, 0x18, 0xcd, 0xaa, 0x23, 0x87, 0x64, 0x1d, 0x2a, 0xcc,
-	0x37, 0x97, 0x54, 0x4c, 0x85, 0xf9, 0xf4, 0x07, 0xa3, 0xc8, 0x56, 0x2c, 0x43, 0x39, 0x5b, 0x03,
-	0x56, 0x7d, 0xe4, 0x5e, 0xc2, 0x62, 0x99, 0xa8, 0x26, 0x9d, 0x9e, 0xca, 0xf5, 0x54, 0xa7, 0xf4,
-	0x6c, 0xc3, 0x0a, 0x5e, 0xc4, 0x2c, 0x41, 0xfe, 0x4e, 0xa8, 0x44, 0x54, 0x9d, 0xc9, 0x84, 0xd6,
-	0xb6, 0x9c, 0x6b, 0x7b, 0x35, 0x5f, 0x1c, 0x25, 0xcd, 0x41, 0x1e, 0x47, 0x21, 0x47, 0x72, 0x17,
-	0x96, 0x85, 0x9c, 0xd0, 0x9a, 0xd2, 0x07, 0x4a, 0x61, 0x4d, 0x47, 0xbf, 0x37, 0xc0, 0x64, 0x24,
-	0xf9, 0x43, 0xb7, 0x8f, 0x3a, 0x48, 0x8d, 0xe9, 0xe7, 0x39, 0xe2, 0x07, 0xb1, 0xff, 0xff, 0x2e,
-	0x37, 0x7d, 0x1e, 0x9e, 0x3b, 0xed, 0xc7, 0x62, 0x94, 0xa5, 0x41, 0xf7, 0x61, 0xe3, 0x7c, 0x14,
-	0x7a, 0x1f, 0xb2, 0xd0, 0x8f, 0x9e, 0xf1, 0x72, 0xd1, 0x23, 0xb8, 0x33, 0x15, 0x97, 0x57, 0xa1,
-	0x03, 0xb7, 0x9e, 0xa5, 0x53, 0xa6, 0xd1, 0xa8, 0xde, 0x5c, 0xf3, 0x84, 0xc3, 0xc9, 0x80, 0xe9,
-	0x05, 0x6c, 0xb6, 0x83, 0xa8, 0xe3, 0x06, 0x3a, 0x9b, 0x09, 0xfb, 0x27, 0xb0, 0xcc, 0x04, 0xf6,
-	0x17, 0xc4, 0x3d, 0x55, 0xaf, 0x14, 0x96, 0xfe, 0x5c, 0x05, 0xf3, 0x31, 0x0a, 0x97, 0x05, 0xe8,
-	0xcf, 0x91, 0xc7, 0xb0, 0xde, 0x2d, 0xc8, 0x5a, 0xb8, 0x8a, 0x19, 0xfc, 0xe9, 0x06, 0xa9, 0xfc,
-	0x57, 0x7e, 0x10, 0xc0, 0x5a, 0x82, 0x71, 0xc4, 0x99, 0x88, 0x12, 0x86, 0xdc, 0xac, 0x2e, 0x22,
-	0x27, 0x27, 0x43, 0x1c, 0x39, 0x05, 0x74, 0xe2, 0xc2, 0x6d, 0x2f, 0x18, 0x70, 0x81, 0x09, 0x37,
-	0x97, 0x14, 0xd3, 0xe9, 0xcd, 0x98, 0x4e, 0x52, 0x34, 0x27, 0x87, 0xa5, 0x87, 0x70, 0xff, 0x8c,
-	0x71, 0xa1, 0x13, 0x3d, 0x63, 0x61, 0x8f, 0x67, 0x1b, 0xee, 0x9a, 0x3e, 0x6f, 0xfd, 0xb8, 0x06,
-	0xeb, 0x3a, 0xf6, 0x1c, 0x93, 0x21, 0xf3, 0x90, 0x7c, 0x63, 0xc0, 0x6a, 0xea, 0x48, 0xca, 0x01,
-	0x08, 0xb5, 0xb2, 0xd3, 0xa9, 0xd4, 0xb3, 0xea, 0x3b, 0xd7, 0xc6, 0xe4, 0xbb, 0xee, 0xe1, 0x17,
-	0xbf, 0xfd, 0xf1, 0x7d, 0xa5, 0x45, 0x0f, 0xd5, 0x59, 0x35, 0x3c, 0xca, 0xce, 0x3b, 0x6e, 0x8f,
-	0xf5, 0xe8, 0xd2, 0x96, 0x5e, 0xc5, 0xed, 0xb1, 0xfc, 0xb9, 0xb4, 0x95, 0xbb, 0xbc, 0x61, 0x1c,
-	0x90, 0xaf, 0x0c, 0x58, 0x4d, 0xcd, 0xf8, 0xaf, 0xc4, 0x14, 0xec, 0xba, 0xbe, 0x99, 0xc7, 0x14,
-	0xf7, 0xfe, 0x9b, 0x4a, 0xc5, 0xeb, 0x07, 0xc7, 0xff, 0x4a, 0x85, 0x3d, 0x66, 0xae, 0xb8, 0x24,
-	0xdf, 0x1a, 0x50, 0x4b, 0x73, 0x26, 0x73, 0xc9, 0x16, 0x6b, 0xb1, 0xb0, 0x2e, 0xa5, 0x2f, 0x2a,
-	0xc1, 0xf7, 0xe8, 0xc6, 0xac, 0x60, 0x59, 0x99, 0x2f, 0x0d, 0x58, 0x92, 0x2b, 0x4d, 0xee, 0xcd,
-	0xca, 0x51, 0xae, 0x56, 0x3f, 0x5b, 0x94, 0x0c, 0x49, 0x42, 0x4d, 0x25, 0x85, 0x90, 0x39, 0x29,
-	0xe4, 0x02, 0x48, 0x1b, 0xc5, 0x8c, 0x6d, 0x94, 0x89, 0x7a, 0x29, 0x9f, 0x2e, 0xf3, 0x19, 0xda,
-	0x54, 0x4c, 0x94, 0x34, 0xe6, 0x57, 0x49, 0x76, 0xec, 0xa5, 0xed, 0xeb, 0x2f, 0xc9, 0xd7, 0x06,
-	0x54, 0xdb, 0x58, 0xca, 0xb5, 0xb8, 0x75, 0xd8, 0x53, 0x92, 0xb6, 0xc8, 0xfd, 0x12, 0x49, 0x64,
-	0x0c, 0x2f, 0xb4, 0x51, 0x14, 0x5d, 0xbb, 0x4c, 0xd6, 0x5e, 0x3e, 0x7d, 0xbd, 0xcb, 0x53, 0x4b,
-	0xb1, 0x35, 0xc9, 0x7e, 0x59, 0x01, 0x52, 0x9b, 0xcc, 0x17, 0xe0, 0x27, 0x03, 0x6a, 0xe9, 0xc9,
-	0x3a, 0xdf, 0x99, 0x85, 0x13, 0x77, 0x81, 0x15, 0x39, 0x56, 0x1a, 0x0f, 0xeb, 0xcd, 0xd2, 0xad,
-	0x64, 0xf5, 0x51, 0xb8, 0xbe, 0x2b, 0x5c, 0x4b, 0x89, 0x96, 0x1d, 0xfb, 0x11, 0xd4, 0xd2, 0x8d,
-	0x5a, 0x56, 0x9a, 0xb2, 0x8d, 0xab, 0xeb, 0x7f, 0x50, 0x5a, 0xff, 0xa7, 0x00, 0xb2, 0x4b, 0x4f,
-	0x87, 0x18, 0x96, 0x17, 0x7e, 0xc7, 0x4a, 0xef, 0xcb, 0x32, 0x43, 0x4b, 0xde, 0x97, 0xad, 0xe1,
-	0x91, 0xa5, 0x3e, 0x51, 0x1d, 0xbe, 0xaf, 0x48, 0x1a, 0x64, 0xb7, 0xac, 0xec, 0x98, 0xa2, 0x8f,
-	0xe1, 0x4e, 0x1b, 0xc5, 0xd4, 0xe5, 0xe0, 0x5c, 0xc8, 0xd2, 0x6f, 0xe5, 0xa4, 0xb3, 0xf7, 0x8b,
-	0xfa, 0xf6, 0x75, 0xaf, 0xf2, 0xe4, 0x5e, 0x51, 0xbc, 0x0f, 0xc8, 0xcb, 0x65, 0xbc, 0x7c, 0x14,
-	0x7a, 0xfa, 0x6e, 0x40, 0x62, 0x58, 0x91, 0x62, 0x95, 0xad, 0x93, 0x46, 0x8e, 0x5b, 0xe2, 0xf8,
-	0xf5, 0x7a, 0x61, 0x21, 0xf5, 0x2b, 0xcd, 0xfb, 0x40, 0xf1, 0xee, 0x91, 0x9d, 0x32, 0xde, 0x40,
-	0x86, 0x3f, 0x7a, 0xf4, 0xcb, 0xd5, 0xae, 0xf1, 0xeb, 0xd5, 0xae, 0xf1, 0xfb, 0xd5, 0xae, 0xf1,
-	0xf1, 0x6b, 0xff, 0xec, 0xef, 0x84, 0x17, 0x30, 0x0c, 0xf3, 0x7f, 0x35, 0x9d, 0x9a, 0xba, 0xf8,
-	0x1f, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x28, 0x9d, 0xea, 0xa8, 0xf6, 0x0c, 0x00, 0x00,
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x6e, 0xe3, 0xc4,
+	0x17, 0x96, 0x9b, 0xb6, 0xbb, 0x9d, 0xee, 0xf6, 0xd7, 0xdf, 0xec, 0x6e, 0xd7, 0x0d, 0xfd, 0x13,
+	0x06, 0xb6, 0x8a, 0x0a, 0xb5, 0xd5, 0x14, 0xa4, 0x15, 0x5c, 0xed, 0x76, 0xab, 0x80, 0x54, 0x04,
+	0xb8, 0x20, 0x10, 0x17, 0x20, 0xc7, 0x3e, 0xca, 0xce, 0xc6, 0xf1, 0x18, 0xcf, 0x24, 0xdb, 0x10,
+	0xf5, 0x06, 0x09, 0x90, 0xb8, 0x00, 0x09, 0xae, 0x78, 0x01, 0xde, 0x83, 0x3b, 0x2e, 0x91, 0x78,
+	0x01, 0x54, 0xf1, 0x20, 0x68, 0xc6, 0x63, 0xc7, 0x4e, 0x3a, 0xfc, 0xd1, 0x06, 0xae, 0x3c, 0x1e,
+	0x9f, 0xf9, 0xbe, 0xef, 0x9c, 0x39, 0xe7, 0xcc, 0x18, 0x6d, 0x71, 0x48, 0x87, 0x90, 0xba, 0x49,
+	0xca, 0x9e, 0x40, 0x20, 0xf2, 0xa7, 0x93, 0xa4, 0x4c, 0x30, 0x7c, 0x4d, 0xbf, 0xd6, 0xb7, 0xba,
+	0x8c, 0x75, 0x23, 0x70, 0xfd, 0x84, 0xba, 0x7e, 0x1c, 0x33, 0xe1, 0x0b, 0xca, 0x62, 0x9e, 0x99,
+	0xd5, 0x49, 0xef, 0x3e, 0x77, 0x28, 0x53, 0x5f, 0x03, 0x96, 0x82, 0x3b, 0x3c, 0x74, 0xbb, 0x10,
+	0x43, 0xea, 0x0b, 0x08, 0xb5, 0xcd, 0x69, 0x97, 0x8a, 0xc7, 0x83, 0x8e, 0x13, 0xb0, 0xbe, 0xeb,
+	0xa7, 0x5d, 0x26, 0x91, 0xd5, 0xe0, 0x20, 0x08, 0xdd, 0x61, 0xcb, 0x4d, 0x7a, 0x5d, 0xb9, 0x9e,
+	0xbb, 0x7e, 0x92, 0x44, 0x34, 0x50, 0xf8, 0xee, 0xf0, 0xd0, 0x8f, 0x92, 0xc7, 0xfe, 0x2c, 0xda,
+	0xf1, 0x5f, 0xa0, 0x69, 0xaf, 0xca, 0x58, 0xa5, 0x71, 0x06, 0x42, 0xbe, 0xb3, 0xd0, 0xed, 0x77,
+	0x32, 0x07, 0x8f, 0x53, 0xf0, 0x05, 0x78, 0xf0, 0xe9, 0x00, 0xb8, 0xc0, 0x1d, 0x94, 0x3b, 0x6e,
+	0x5b, 0x0d, 0xab, 0xb9, 0xda, 0x7a, 0xc3, 0x99, 0xf0, 0x39, 0x39, 0x9f, 0x1a, 0x7c, 0x12, 0x84,
+	0xce, 0xb0, 0xe5, 0x24, 0xbd, 0xae, 0x23, 0xd5, 0x3b, 0x65, 0x96, 0x5c, 0xbd, 0xf3, 0x20, 0x49,
+	0x34, 0x8f, 0x97, 0x03, 0xe3, 0x0d, 0xb4, 0x3c, 0x48, 0x38, 0xa4, 0xc2, 0x5e, 0x68, 0x58, 0xcd,
+	0xeb, 0x9e, 0x7e, 0x23, 0x3d, 0xb4, 0xa9, 0x6d, 0xdf, 0x63, 0x3d, 0x88, 0x1f, 0x41, 0x04, 0x13,
+	0x61, 0x76, 0x55, 0xd8, 0xca, 0x04, 0x0e, 0xa3, 0xc5, 0x94, 0x45, 0xa0, 0xc0, 0x56, 0x3c, 0x35,
+	0xc6, 0xeb, 0xa8, 0x46, 0x7d, 0x61, 0xd7, 0x1a, 0x56, 0xb3, 0xe6, 0xc9, 0x21, 0x5e, 0x43, 0x0b,
+	0x34, 0xb4, 0x17, 0x95, 0xcd, 0x02, 0x0d, 0xc9, 0x0f, 0x56, 0x95, 0xad, 0x1a, 0x06, 0x33, 0x5b,
+	0x03, 0xad, 0x86, 0xc0, 0x83, 0x94, 0x26, 0xd2, 0x51, 0x4d, 0x5a, 0x9e, 0x2a, 0xf4, 0xd4, 0x4a,
+	0x7a, 0xb6, 0xd0, 0x0a, 0x9c, 0x27, 0x34, 0x05, 0xfe, 0x66, 0xac, 0x44, 0xd4, 0xbc, 0xc9, 0x84,
+	0xd6, 0xb6, 0x54, 0x68, 0x7b, 0xb9, 0xd8, 0x1c, 0x25, 0xcd, 0x03, 0x9e, 0xb0, 0x98, 0x03, 0xbe,
+	0x8d, 0x96, 0x84, 0x9c, 0xd0, 0x9a, 0xb2, 0x17, 0x42, 0xd0, 0x0d, 0x6d, 0xfd, 0xee, 0x00, 0xd2,
+	0x91, 0xe4, 0x8f, 0xfd, 0x3e, 0x68, 0x23, 0x35, 0x26, 0x9f, 0x15, 0x88, 0xef, 0x27, 0xe1, 0x7f,
+	0xbb, 0xdd, 0xe4, 0x7f, 0xe8, 0xe6, 0x49, 0x3f, 0x11, 0xa3, 0xdc, 0x0d, 0xb2, 0x87, 0xd6, 0xcf,
+	0x46, 0x71, 0xf0, 0x01, 0x8d, 0x43, 0xf6, 0x94, 0x9b, 0x45, 0x8f, 0xd0, 0xad, 0x92, 0x5d, 0x11,
+	0x85, 0x0e, 0xba, 0xf6, 0x34, 0x9b, 0xb2, 0xad, 0x46, 0xed, 0xd9, 0x35, 0x4f, 0x38, 0xbc, 0x1c,
+	0x98, 0x9c, 0xa3, 0x8d, 0x76, 0xc4, 0x3a, 0x7e, 0xa4, 0xbd, 0x99, 0xb0, 0x7f, 0x8c, 0x96, 0xa8,
+	0x80, 0xfe, 0x9c, 0xb8, 0x4b, 0xf1, 0xca, 0x60, 0xc9, 0x4f, 0x35, 0x64, 0x3f, 0x02, 0xe1, 0xd3,
+	0x08, 0xc2, 0x19, 0xf2, 0x04, 0xad, 0x75, 0x2b, 0xb2, 0xe6, 0xae, 0x62, 0x0a, 0xbf, 0x9c, 0x20,
+	0x0b, 0xff, 0x56, 0x3f, 0x88, 0xd0, 0x8d, 0x14, 0x12, 0xc6, 0xa9, 0x60, 0x29, 0x05, 0x6e, 0xd7,
+	0xe6, 0xe1, 0x93, 0x97, 0x23, 0x8e, 0xbc, 0x0a, 0x3a, 0xf6, 0xd1, 0xf5, 0x20, 0x1a, 0x70, 0x01,
+	0x29, 0xb7, 0x17, 0x15, 0xd3, 0xc9, 0xb3, 0x31, 0x1d, 0x67, 0x68, 0x5e, 0x01, 0x4b, 0x0e, 0xd0,
+	0xdd, 0x53, 0xca, 0x85, 0x76, 0xf4, 0x94, 0xc6, 0x3d, 0x9e, 0x17, 0xdc, 0x15, 0x79, 0xde, 0xfa,
+	0xf6, 0x26, 0x5a, 0xd3, 0xb6, 0x67, 0x90, 0x0e, 0x69, 0x00, 0xf8, 0x6b, 0x0b, 0xad, 0x66, 0x1d,
+	0x49, 0x75, 0x00, 0x4c, 0x9c, 0xfc, 0x74, 0x32, 0xf6, 0xac, 0xfa, 0xf6, 0x95, 0x36, 0x45, 0xd5,
+	0xdd, 0xff, 0xfc, 0xd7, 0xdf, 0xbf, 0x5f, 0x68, 0x91, 0x03, 0x75, 0x56, 0x0d, 0x0f, 0xf3, 0xf3,
+	0x8e, 0xbb, 0x63, 0x3d, 0xba, 0x70, 0x65, 0xaf, 0xe2, 0xee, 0x58, 0x3e, 0x2e, 0x5c, 0xd5, 0x5d,
+	0x5e, 0xb3, 0xf6, 0xf1, 0x97, 0x16, 0x5a, 0xcd, 0x9a, 0xf1, 0x9f, 0x89, 0xa9, 0xb4, 0xeb, 0xfa,
+	0x46, 0x61, 0x53, 0xad, 0xfd, 0xd7, 0x95, 0x8a, 0x57, 0xf7, 0x8f, 0xfe, 0x91, 0x0a, 0x77, 0x4c,
+	0x7d, 0x71, 0x81, 0xbf, 0xb1, 0xd0, 0x72, 0xe6, 0x33, 0x9e, 0x71, 0xb6, 0x1a, 0x8b, 0xb9, 0x65,
+	0x29, 0x79, 0x4e, 0x09, 0xbe, 0x43, 0xd6, 0xa7, 0x05, 0xcb, 0xc8, 0x7c, 0x61, 0xa1, 0x45, 0xb9,
+	0xd3, 0xf8, 0xce, 0xb4, 0x1c, 0xd5, 0xd5, 0xea, 0xa7, 0xf3, 0x92, 0x21, 0x49, 0x88, 0xad, 0xa4,
+	0x60, 0x3c, 0x23, 0x05, 0x9f, 0x23, 0xdc, 0x06, 0x31, 0xd5, 0x36, 0x4c, 0xa2, 0x9e, 0x2f, 0xa6,
+	0x4d, 0x7d, 0x86, 0x34, 0x15, 0x13, 0xc1, 0x8d, 0xd9, 0x5d, 0x92, 0x19, 0x7b, 0xe1, 0x86, 0x7a,
+	0x25, 0xfe, 0xca, 0x42, 0xb5, 0x36, 0x18, 0xb9, 0xe6, 0xb7, 0x0f, 0xbb, 0x4a, 0xd2, 0x26, 0xbe,
+	0x6b, 0x90, 0x84, 0xc7, 0xe8, 0xff, 0x6d, 0x10, 0xd5, 0xae, 0x6d, 0x92, 0xb5, 0x5b, 0x4c, 0x5f,
+	0xdd, 0xe5, 0x89, 0xa3, 0xd8, 0x9a, 0x78, 0xcf, 0x14, 0x80, 0xac, 0x4d, 0x16, 0x1b, 0xf0, 0xa3,
+	0x85, 0x96, 0xb3, 0x93, 0x75, 0x36, 0x33, 0x2b, 0x27, 0xee, 0x1c, 0x23, 0x72, 0xa4, 0x34, 0x1e,
+	0xd4, 0x9b, 0xc6, 0x52, 0x72, 0xfa, 0x20, 0xfc, 0xd0, 0x17, 0xbe, 0xa3, 0x44, 0xcb, 0x8c, 0xfd,
+	0x10, 0x2d, 0x67, 0x85, 0x6a, 0x0a, 0x8d, 0xa9, 0x70, 0x75, 0xfc, 0xf7, 0x8d, 0xf1, 0x7f, 0x82,
+	0x90, 0xcc, 0xd2, 0x93, 0x21, 0xc4, 0xe6, 0xc0, 0x6f, 0x3b, 0xd9, 0x7d, 0x59, 0x7a, 0xe8, 0xc8,
+	0xfb, 0xb2, 0x33, 0x3c, 0x74, 0xd4, 0x12, 0x95, 0xe1, 0x7b, 0x8a, 0xa4, 0x81, 0x77, 0x4c, 0x61,
+	0x87, 0x0c, 0x7d, 0x8c, 0x6e, 0xb5, 0x41, 0x94, 0x2e, 0x07, 0x67, 0x42, 0x86, 0x7e, 0xb3, 0x20,
+	0x9d, 0xbe, 0x5f, 0xd4, 0xb7, 0xae, 0xfa, 0x54, 0x38, 0xf7, 0x92, 0xe2, 0xbd, 0x87, 0x5f, 0x30,
+	0xf1, 0xf2, 0x51, 0x1c, 0xe8, 0xbb, 0x01, 0x66, 0x68, 0x4d, 0x16, 0xdb, 0xdb, 0xde, 0x83, 0xb7,
+	0x40, 0xa4, 0x34, 0x30, 0x3a, 0xdb, 0xa8, 0x6c, 0x61, 0x69, 0x41, 0xc1, 0xfb, 0xa2, 0xe2, 0xdd,
+	0xc1, 0x5b, 0xc6, 0x3a, 0x63, 0xa9, 0x8f, 0x13, 0xb4, 0x22, 0xa3, 0xa3, 0xce, 0x11, 0xdc, 0x28,
+	0xb8, 0x0c, 0x47, 0x4c, 0xbd, 0x5e, 0xa1, 0xd5, 0x9f, 0x34, 0xe1, 0x3d, 0x45, 0xb8, 0x8b, 0xb7,
+	0x4d, 0x84, 0x91, 0x34, 0x7f, 0xf8, 0xf0, 0xe7, 0xcb, 0x1d, 0xeb, 0x97, 0xcb, 0x1d, 0xeb, 0xb7,
+	0xcb, 0x1d, 0xeb, 0xa3, 0x57, 0xfe, 0xde, 0xff, 0x4b, 0x10, 0x51, 0x88, 0x8b, 0xdf, 0xa8, 0xce,
+	0xb2, 0xfa, 0xd3, 0x38, 0xfa, 0x23, 0x00, 0x00, 0xff, 0xff, 0x49, 0x86, 0x10, 0x8d, 0x67, 0x0d,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -802,6 +805,8 @@ type ProjectServiceClient interface {
 	ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(ctx context.Context, in *SyncWindowsQuery, opts ...grpc.CallOption) (*SyncWindowsResponse, error)
+	// GetDORAMetrics returns the DORA metrics of the applications of the project over rolling windows, computed from their revision history
+	GetDORAMetrics(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*application.DORAMetricsResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error)
 }
@@ -913,6 +918,15 @@ func (c *projectServiceClient) GetSyncWindowsState(ctx context.Context, in *Sync
 	return out, nil
 }
 
+func (c *projectServiceClient) GetDORAMetrics(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*application.DORAMetricsResponse, error) {
+	out := new(application.DORAMetricsResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/GetDORAMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error) {
 	out := new(application.LinksResponse)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/ListLinks", in, out, opts...)
@@ -946,6 +960,8 @@ type ProjectServiceServer interface {
 	ListEvents(context.Context, *ProjectQuery) (*v1.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(context.Context, *SyncWindowsQuery) (*SyncWindowsResponse, error)
+	// GetDORAMetrics returns the DORA metrics of the applications of the project over rolling windows, computed from their revision history
+	GetDORAMetrics(context.Context, *ProjectQuery) (*application.DORAMetricsResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(context.Context, *ListProjectLinksRequest) (*application.LinksResponse, error)
 }
@@ -987,6 +1003,9 @@ func (*UnimplementedProjectServiceServer) ListEvents(ctx context.Context, req *P
 func (*UnimplementedProjectServiceServer) GetSyncWindowsState(ctx context.Context, req *SyncWindowsQuery) (*SyncWindowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSyncWindowsState not implemented")
 }
+func (*UnimplementedProjectServiceServer) GetDORAMetrics(ctx context.Context, req *ProjectQuery) (*application.DORAMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDORAMetrics not implemented")
+}
 func (*UnimplementedProjectServiceServer) ListLinks(ctx context.Context, req *ListProjectLinksRequest) (*application.LinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
 }
@@ -1193,6 +1212,24 @@ func _ProjectService_GetSyncWindowsState_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_GetDORAMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetDORAMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/GetDORAMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetDORAMetrics(ctx, req.(*ProjectQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectLinksRequest)
 	if err := dec(in); err != nil {
@@ -1259,6 +1296,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSyncWindowsState",
 			Handler:    _ProjectService_GetSyncWindowsState_Handler,
 		},
+		{
+			MethodName: "GetDORAMetrics",
+			Handler:    _ProjectService_GetDORAMetrics_Handler,
+		},
 		{
 			MethodName: "ListLinks",
 			Handler:    _ProjectService_ListLinks_Handler,
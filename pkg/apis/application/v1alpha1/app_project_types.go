@@ -265,9 +265,91 @@ func (p *AppProject) ValidateProject() error {
 		}
 	}
 
+	manifestPolicyNames := make(map[string]bool)
+	for _, policy := range p.Spec.ManifestPolicies {
+		if policy.Name == "" {
+			return status.Errorf(codes.InvalidArgument, "manifest policy name is required")
+		}
+		if _, ok := manifestPolicyNames[policy.Name]; ok {
+			return status.Errorf(codes.AlreadyExists, "manifest policy '%s' already exists", policy.Name)
+		}
+		if policy.Expression == "" {
+			return status.Errorf(codes.InvalidArgument, "manifest policy '%s' requires an expression", policy.Name)
+		}
+		if severity := policy.GetSeverity(); severity != ManifestPolicySeverityDeny && severity != ManifestPolicySeverityWarn {
+			return status.Errorf(codes.InvalidArgument, "manifest policy '%s' has an invalid severity '%s', must be one of: %s, %s", policy.Name, severity, ManifestPolicySeverityDeny, ManifestPolicySeverityWarn)
+		}
+		manifestPolicyNames[policy.Name] = true
+	}
+
+	if p.Spec.OrphanedResources != nil {
+		for _, action := range p.Spec.OrphanedResources.Actions {
+			if action.Action != OrphanedResourceActionDelete && action.Action != OrphanedResourceActionAdopt {
+				return status.Errorf(codes.InvalidArgument, "orphaned resources action '%s' is invalid, must be one of: %s, %s", action.Action, OrphanedResourceActionDelete, OrphanedResourceActionAdopt)
+			}
+			if _, err := action.GetGracePeriod(); err != nil {
+				return status.Errorf(codes.InvalidArgument, "orphaned resources action '%s' has an invalid grace period: %v", action.Action, err)
+			}
+		}
+	}
+
+	destServiceAccounts := make(map[string]bool)
+	for _, destServiceAccount := range p.Spec.DestinationServiceAccounts {
+		if destServiceAccount.Server == "" {
+			return status.Errorf(codes.InvalidArgument, "destination service account server is required")
+		}
+		if isDenyPattern(destServiceAccount.Server) || isDenyPattern(destServiceAccount.Namespace) {
+			return status.Errorf(codes.InvalidArgument, "destination service account server and namespace cannot be negated")
+		}
+		key := fmt.Sprintf("%s/%s", destServiceAccount.Server, destServiceAccount.Namespace)
+		if _, ok := destServiceAccounts[key]; ok {
+			return status.Errorf(codes.AlreadyExists, "service account of destination '%s' already exists", key)
+		}
+		if err := validateServiceAccountName(destServiceAccount.DefaultServiceAccount); err != nil {
+			return status.Errorf(codes.InvalidArgument, "service account of destination '%s' is invalid: %v", key, err)
+		}
+		destServiceAccounts[key] = true
+	}
+
+	return nil
+}
+
+func validateServiceAccountName(serviceAccount string) error {
+	parts := strings.Split(serviceAccount, ":")
+	if len(parts) > 2 {
+		return fmt.Errorf("'%s' must be of the form <name> or <namespace>:<name>", serviceAccount)
+	}
+	for _, part := range parts {
+		if part == "" || strings.TrimSpace(part) != part || strings.ContainsAny(part, "*?[]!") {
+			return fmt.Errorf("'%s' must be of the form <name> or <namespace>:<name>", serviceAccount)
+		}
+	}
 	return nil
 }
 
+// GetDestinationServiceAccount returns the Kubernetes user name of the service account to impersonate when modifying
+// resources in the given destination, which server must be set. Service accounts which are not qualified with a
+// namespace are looked up in the destination namespace, or in defaultNamespace if the destination has none.
+func (proj AppProject) GetDestinationServiceAccount(dst ApplicationDestination, defaultNamespace string) (string, error) {
+	namespace := dst.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	for _, item := range proj.Spec.DestinationServiceAccounts {
+		if !globMatch(item.Server, dst.Server, false) || !globMatch(item.Namespace, dst.Namespace, false) {
+			continue
+		}
+		if err := validateServiceAccountName(item.DefaultServiceAccount); err != nil {
+			return "", fmt.Errorf("invalid service account for destination server %s and namespace %s: %w", dst.Server, dst.Namespace, err)
+		}
+		if strings.Contains(item.DefaultServiceAccount, ":") {
+			return "system:serviceaccount:" + item.DefaultServiceAccount, nil
+		}
+		return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, item.DefaultServiceAccount), nil
+	}
+	return "", fmt.Errorf("no service account matches destination server %s and namespace %s in project %s", dst.Server, dst.Namespace, proj.Name)
+}
+
 // AddGroupToRole adds an OIDC group to a role
 func (p *AppProject) AddGroupToRole(roleName, group string) (bool, error) {
 	role, roleIndex, err := p.GetRoleByName(roleName)
@@ -6,12 +6,17 @@ const (
 	DefaultSyncRetryMaxDuration time.Duration = 180000000000 // 3m0s
 	DefaultSyncRetryDuration    time.Duration = 5000000000   // 5s
 	DefaultSyncRetryFactor                    = int64(2)
+	// DefaultAutomatedRollbackWindow is the default period after an automated sync during which a health degradation triggers a rollback
+	DefaultAutomatedRollbackWindow time.Duration = 600000000000 // 10m0s
 	// ResourcesFinalizerName is the finalizer value which we inject to finalize deletion of an application
 	ResourcesFinalizerName string = "resources-finalizer.argocd.argoproj.io"
 
 	// PostDeleteFinalizerName is the finalizer that controls post-delete hooks execution
 	PostDeleteFinalizerName string = "post-delete-finalizer.argocd.argoproj.io"
 
+	// PreDeleteFinalizerName is the finalizer that controls pre-delete hooks execution
+	PreDeleteFinalizerName string = "pre-delete-finalizer.argocd.argoproj.io"
+
 	// ForegroundPropagationPolicyFinalizer is the finalizer we inject to delete application with foreground propagation policy
 	ForegroundPropagationPolicyFinalizer string = "resources-finalizer.argocd.argoproj.io/foreground"
 
@@ -263,10 +263,66 @@ func (m *ApplicationDestination) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 
+func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
+func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
+func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{8}
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.Merge(m, src)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDestinationServiceAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageInfo
+
+func (m *ApplicationDestinationStatus) Reset()      { *m = ApplicationDestinationStatus{} }
+func (*ApplicationDestinationStatus) ProtoMessage() {}
+func (*ApplicationDestinationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{9}
+}
+func (m *ApplicationDestinationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDestinationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDestinationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDestinationStatus.Merge(m, src)
+}
+func (m *ApplicationDestinationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDestinationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDestinationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDestinationStatus proto.InternalMessageInfo
+
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{8}
+	return fileDescriptor_030104ce3b95bcac, []int{10}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +350,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{9}
+	return fileDescriptor_030104ce3b95bcac, []int{11}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +378,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{10}
+	return fileDescriptor_030104ce3b95bcac, []int{12}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +406,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{11}
+	return fileDescriptor_030104ce3b95bcac, []int{13}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +434,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{12}
+	return fileDescriptor_030104ce3b95bcac, []int{14}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +462,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{13}
+	return fileDescriptor_030104ce3b95bcac, []int{15}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +490,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{14}
+	return fileDescriptor_030104ce3b95bcac, []int{16}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +518,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{15}
+	return fileDescriptor_030104ce3b95bcac, []int{17}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +546,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{16}
+	return fileDescriptor_030104ce3b95bcac, []int{18}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +576,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{17}
+	return fileDescriptor_030104ce3b95bcac, []int{19}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +604,7 @@ var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessag
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{18}
+	return fileDescriptor_030104ce3b95bcac, []int{20}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +632,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{19}
+	return fileDescriptor_030104ce3b95bcac, []int{21}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +660,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{20}
+	return fileDescriptor_030104ce3b95bcac, []int{22}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +688,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{21}
+	return fileDescriptor_030104ce3b95bcac, []int{23}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +716,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{22}
+	return fileDescriptor_030104ce3b95bcac, []int{24}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +744,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{23}
+	return fileDescriptor_030104ce3b95bcac, []int{25}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +772,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{24}
+	return fileDescriptor_030104ce3b95bcac, []int{26}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +800,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{25}
+	return fileDescriptor_030104ce3b95bcac, []int{27}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +828,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{26}
+	return fileDescriptor_030104ce3b95bcac, []int{28}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +856,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{27}
+	return fileDescriptor_030104ce3b95bcac, []int{29}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +884,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{28}
+	return fileDescriptor_030104ce3b95bcac, []int{30}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +912,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{29}
+	return fileDescriptor_030104ce3b95bcac, []int{31}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +940,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{30}
+	return fileDescriptor_030104ce3b95bcac, []int{32}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +968,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{31}
+	return fileDescriptor_030104ce3b95bcac, []int{33}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +996,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{32}
+	return fileDescriptor_030104ce3b95bcac, []int{34}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1024,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{33}
+	return fileDescriptor_030104ce3b95bcac, []int{35}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1052,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{34}
+	return fileDescriptor_030104ce3b95bcac, []int{36}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1080,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{35}
+	return fileDescriptor_030104ce3b95bcac, []int{37}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1108,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{36}
+	return fileDescriptor_030104ce3b95bcac, []int{38}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1136,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{37}
+	return fileDescriptor_030104ce3b95bcac, []int{39}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1164,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{38}
+	return fileDescriptor_030104ce3b95bcac, []int{40}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1192,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{39}
+	return fileDescriptor_030104ce3b95bcac, []int{41}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1161,10 +1217,38 @@ func (m *ApplicationWatchEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 
+func (m *AutomatedRollback) Reset()      { *m = AutomatedRollback{} }
+func (*AutomatedRollback) ProtoMessage() {}
+func (*AutomatedRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{42}
+}
+func (m *AutomatedRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutomatedRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutomatedRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutomatedRollback.Merge(m, src)
+}
+func (m *AutomatedRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutomatedRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutomatedRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutomatedRollback proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{40}
+	return fileDescriptor_030104ce3b95bcac, []int{43}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1276,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{41}
+	return fileDescriptor_030104ce3b95bcac, []int{44}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1304,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{42}
+	return fileDescriptor_030104ce3b95bcac, []int{45}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1332,7 @@ var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{43}
+	return fileDescriptor_030104ce3b95bcac, []int{46}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1360,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{44}
+	return fileDescriptor_030104ce3b95bcac, []int{47}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1388,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{45}
+	return fileDescriptor_030104ce3b95bcac, []int{48}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1416,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{46}
+	return fileDescriptor_030104ce3b95bcac, []int{49}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1444,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{47}
+	return fileDescriptor_030104ce3b95bcac, []int{50}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1472,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{48}
+	return fileDescriptor_030104ce3b95bcac, []int{51}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1500,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{49}
+	return fileDescriptor_030104ce3b95bcac, []int{52}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1528,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{50}
+	return fileDescriptor_030104ce3b95bcac, []int{53}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1556,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{51}
+	return fileDescriptor_030104ce3b95bcac, []int{54}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1584,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{52}
+	return fileDescriptor_030104ce3b95bcac, []int{55}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1612,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{53}
+	return fileDescriptor_030104ce3b95bcac, []int{56}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1640,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{54}
+	return fileDescriptor_030104ce3b95bcac, []int{57}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1581,10 +1665,150 @@ func (m *ConnectionState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 
+func (m *ContainerLogs) Reset()      { *m = ContainerLogs{} }
+func (*ContainerLogs) ProtoMessage() {}
+func (*ContainerLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{58}
+}
+func (m *ContainerLogs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerLogs.Merge(m, src)
+}
+func (m *ContainerLogs) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerLogs proto.InternalMessageInfo
+
+func (m *DORAMetrics) Reset()      { *m = DORAMetrics{} }
+func (*DORAMetrics) ProtoMessage() {}
+func (*DORAMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{59}
+}
+func (m *DORAMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DORAMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DORAMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DORAMetrics.Merge(m, src)
+}
+func (m *DORAMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *DORAMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_DORAMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DORAMetrics proto.InternalMessageInfo
+
+func (m *DestinationOperationState) Reset()      { *m = DestinationOperationState{} }
+func (*DestinationOperationState) ProtoMessage() {}
+func (*DestinationOperationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{60}
+}
+func (m *DestinationOperationState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DestinationOperationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DestinationOperationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationOperationState.Merge(m, src)
+}
+func (m *DestinationOperationState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DestinationOperationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationOperationState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationOperationState proto.InternalMessageInfo
+
+func (m *DestinationRollout) Reset()      { *m = DestinationRollout{} }
+func (*DestinationRollout) ProtoMessage() {}
+func (*DestinationRollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{61}
+}
+func (m *DestinationRollout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DestinationRollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DestinationRollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationRollout.Merge(m, src)
+}
+func (m *DestinationRollout) XXX_Size() int {
+	return m.Size()
+}
+func (m *DestinationRollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationRollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationRollout proto.InternalMessageInfo
+
+func (m *DriftRecord) Reset()      { *m = DriftRecord{} }
+func (*DriftRecord) ProtoMessage() {}
+func (*DriftRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{62}
+}
+func (m *DriftRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DriftRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DriftRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriftRecord.Merge(m, src)
+}
+func (m *DriftRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DriftRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriftRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DriftRecord proto.InternalMessageInfo
+
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{55}
+	return fileDescriptor_030104ce3b95bcac, []int{63}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1836,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{56}
+	return fileDescriptor_030104ce3b95bcac, []int{64}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1864,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ErrApplicationNotAllowedToUseProject) Reset()      { *m = ErrApplicationNotAllowedToUseProject{} }
 func (*ErrApplicationNotAllowedToUseProject) ProtoMessage() {}
 func (*ErrApplicationNotAllowedToUseProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{57}
+	return fileDescriptor_030104ce3b95bcac, []int{65}
 }
 func (m *ErrApplicationNotAllowedToUseProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1892,7 @@ var xxx_messageInfo_ErrApplicationNotAllowedToUseProject proto.InternalMessageIn
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{58}
+	return fileDescriptor_030104ce3b95bcac, []int{66}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1920,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{59}
+	return fileDescriptor_030104ce3b95bcac, []int{67}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1948,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{60}
+	return fileDescriptor_030104ce3b95bcac, []int{68}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1976,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{61}
+	return fileDescriptor_030104ce3b95bcac, []int{69}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +2004,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{62}
+	return fileDescriptor_030104ce3b95bcac, []int{70}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +2032,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{63}
+	return fileDescriptor_030104ce3b95bcac, []int{71}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +2060,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{64}
+	return fileDescriptor_030104ce3b95bcac, []int{72}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +2088,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{65}
+	return fileDescriptor_030104ce3b95bcac, []int{73}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +2116,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{66}
+	return fileDescriptor_030104ce3b95bcac, []int{74}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2144,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{67}
+	return fileDescriptor_030104ce3b95bcac, []int{75}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1945,10 +2169,38 @@ func (m *HelmParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 
+func (m *HookLogs) Reset()      { *m = HookLogs{} }
+func (*HookLogs) ProtoMessage() {}
+func (*HookLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{76}
+}
+func (m *HookLogs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HookLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookLogs.Merge(m, src)
+}
+func (m *HookLogs) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookLogs proto.InternalMessageInfo
+
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{68}
+	return fileDescriptor_030104ce3b95bcac, []int{77}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2228,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{69}
+	return fileDescriptor_030104ce3b95bcac, []int{78}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2256,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{70}
+	return fileDescriptor_030104ce3b95bcac, []int{79}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2284,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{71}
+	return fileDescriptor_030104ce3b95bcac, []int{80}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2312,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{72}
+	return fileDescriptor_030104ce3b95bcac, []int{81}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2340,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{73}
+	return fileDescriptor_030104ce3b95bcac, []int{82}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2368,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{74}
+	return fileDescriptor_030104ce3b95bcac, []int{83}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2396,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{75}
+	return fileDescriptor_030104ce3b95bcac, []int{84}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2424,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{76}
+	return fileDescriptor_030104ce3b95bcac, []int{85}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2452,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{77}
+	return fileDescriptor_030104ce3b95bcac, []int{86}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2480,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{78}
+	return fileDescriptor_030104ce3b95bcac, []int{87}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2508,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{79}
+	return fileDescriptor_030104ce3b95bcac, []int{88}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2536,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{80}
+	return fileDescriptor_030104ce3b95bcac, []int{89}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2564,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{81}
+	return fileDescriptor_030104ce3b95bcac, []int{90}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2592,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{82}
+	return fileDescriptor_030104ce3b95bcac, []int{91}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2365,10 +2617,38 @@ func (m *ListGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 
+func (m *MaintenanceStatus) Reset()      { *m = MaintenanceStatus{} }
+func (*MaintenanceStatus) ProtoMessage() {}
+func (*MaintenanceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{92}
+}
+func (m *MaintenanceStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceStatus.Merge(m, src)
+}
+func (m *MaintenanceStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceStatus proto.InternalMessageInfo
+
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{83}
+	return fileDescriptor_030104ce3b95bcac, []int{93}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2393,10 +2673,38 @@ func (m *ManagedNamespaceMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 
+func (m *ManifestPolicy) Reset()      { *m = ManifestPolicy{} }
+func (*ManifestPolicy) ProtoMessage() {}
+func (*ManifestPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{94}
+}
+func (m *ManifestPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ManifestPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestPolicy.Merge(m, src)
+}
+func (m *ManifestPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestPolicy proto.InternalMessageInfo
+
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{84}
+	return fileDescriptor_030104ce3b95bcac, []int{95}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2732,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{85}
+	return fileDescriptor_030104ce3b95bcac, []int{96}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2760,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{86}
+	return fileDescriptor_030104ce3b95bcac, []int{97}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2788,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{87}
+	return fileDescriptor_030104ce3b95bcac, []int{98}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2816,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{88}
+	return fileDescriptor_030104ce3b95bcac, []int{99}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2844,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{89}
+	return fileDescriptor_030104ce3b95bcac, []int{100}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2561,10 +2869,38 @@ func (m *OperationInitiator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 
+func (m *OperationQueuePolicy) Reset()      { *m = OperationQueuePolicy{} }
+func (*OperationQueuePolicy) ProtoMessage() {}
+func (*OperationQueuePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{101}
+}
+func (m *OperationQueuePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationQueuePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OperationQueuePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationQueuePolicy.Merge(m, src)
+}
+func (m *OperationQueuePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationQueuePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationQueuePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationQueuePolicy proto.InternalMessageInfo
+
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{90}
+	return fileDescriptor_030104ce3b95bcac, []int{102}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2928,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{91}
+	return fileDescriptor_030104ce3b95bcac, []int{103}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2956,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{92}
+	return fileDescriptor_030104ce3b95bcac, []int{104}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2645,10 +2981,38 @@ func (m *OptionalMap) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 
+func (m *OrphanedResourceAction) Reset()      { *m = OrphanedResourceAction{} }
+func (*OrphanedResourceAction) ProtoMessage() {}
+func (*OrphanedResourceAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{105}
+}
+func (m *OrphanedResourceAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourceAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OrphanedResourceAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourceAction.Merge(m, src)
+}
+func (m *OrphanedResourceAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourceAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourceAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourceAction proto.InternalMessageInfo
+
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{93}
+	return fileDescriptor_030104ce3b95bcac, []int{106}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2673,10 +3037,38 @@ func (m *OrphanedResourceKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 
+func (m *OrphanedResourceStatus) Reset()      { *m = OrphanedResourceStatus{} }
+func (*OrphanedResourceStatus) ProtoMessage() {}
+func (*OrphanedResourceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{107}
+}
+func (m *OrphanedResourceStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OrphanedResourceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourceStatus.Merge(m, src)
+}
+func (m *OrphanedResourceStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourceStatus proto.InternalMessageInfo
+
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{94}
+	return fileDescriptor_030104ce3b95bcac, []int{108}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +3096,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{95}
+	return fileDescriptor_030104ce3b95bcac, []int{109}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +3124,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{96}
+	return fileDescriptor_030104ce3b95bcac, []int{110}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +3152,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{97}
+	return fileDescriptor_030104ce3b95bcac, []int{111}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +3180,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{98}
+	return fileDescriptor_030104ce3b95bcac, []int{112}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +3208,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{99}
+	return fileDescriptor_030104ce3b95bcac, []int{113}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3236,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{100}
+	return fileDescriptor_030104ce3b95bcac, []int{114}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3264,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{101}
+	return fileDescriptor_030104ce3b95bcac, []int{115}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3292,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{102}
+	return fileDescriptor_030104ce3b95bcac, []int{116}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3320,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{103}
+	return fileDescriptor_030104ce3b95bcac, []int{117}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3348,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{104}
+	return fileDescriptor_030104ce3b95bcac, []int{118}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3376,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{105}
+	return fileDescriptor_030104ce3b95bcac, []int{119}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3404,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{106}
+	return fileDescriptor_030104ce3b95bcac, []int{120}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3432,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{107}
+	return fileDescriptor_030104ce3b95bcac, []int{121}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3065,10 +3457,38 @@ func (m *PullRequestGeneratorGithub) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 
+func (m *QueuedOperation) Reset()      { *m = QueuedOperation{} }
+func (*QueuedOperation) ProtoMessage() {}
+func (*QueuedOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{122}
+}
+func (m *QueuedOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QueuedOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedOperation.Merge(m, src)
+}
+func (m *QueuedOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedOperation proto.InternalMessageInfo
+
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{108}
+	return fileDescriptor_030104ce3b95bcac, []int{123}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3516,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{109}
+	return fileDescriptor_030104ce3b95bcac, []int{124}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3544,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{110}
+	return fileDescriptor_030104ce3b95bcac, []int{125}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3572,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{111}
+	return fileDescriptor_030104ce3b95bcac, []int{126}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3600,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{112}
+	return fileDescriptor_030104ce3b95bcac, []int{127}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3628,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{113}
+	return fileDescriptor_030104ce3b95bcac, []int{128}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3656,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{114}
+	return fileDescriptor_030104ce3b95bcac, []int{129}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3684,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{115}
+	return fileDescriptor_030104ce3b95bcac, []int{130}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3712,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{116}
+	return fileDescriptor_030104ce3b95bcac, []int{131}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3740,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{117}
+	return fileDescriptor_030104ce3b95bcac, []int{132}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3768,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{118}
+	return fileDescriptor_030104ce3b95bcac, []int{133}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3796,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{119}
+	return fileDescriptor_030104ce3b95bcac, []int{134}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3824,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{120}
+	return fileDescriptor_030104ce3b95bcac, []int{135}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3852,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{121}
+	return fileDescriptor_030104ce3b95bcac, []int{136}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3880,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{122}
+	return fileDescriptor_030104ce3b95bcac, []int{137}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3908,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{123}
+	return fileDescriptor_030104ce3b95bcac, []int{138}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3936,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{124}
+	return fileDescriptor_030104ce3b95bcac, []int{139}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3964,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{125}
+	return fileDescriptor_030104ce3b95bcac, []int{140}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3992,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{126}
+	return fileDescriptor_030104ce3b95bcac, []int{141}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3597,10 +4017,38 @@ func (m *ResourceStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 
+func (m *RetryAttempt) Reset()      { *m = RetryAttempt{} }
+func (*RetryAttempt) ProtoMessage() {}
+func (*RetryAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{142}
+}
+func (m *RetryAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryAttempt.Merge(m, src)
+}
+func (m *RetryAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryAttempt proto.InternalMessageInfo
+
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{127}
+	return fileDescriptor_030104ce3b95bcac, []int{143}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +4076,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{128}
+	return fileDescriptor_030104ce3b95bcac, []int{144}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +4104,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{129}
+	return fileDescriptor_030104ce3b95bcac, []int{145}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3681,10 +4129,38 @@ func (m *RevisionMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 
+func (m *RollbackStatus) Reset()      { *m = RollbackStatus{} }
+func (*RollbackStatus) ProtoMessage() {}
+func (*RollbackStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{146}
+}
+func (m *RollbackStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RollbackStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackStatus.Merge(m, src)
+}
+func (m *RollbackStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackStatus proto.InternalMessageInfo
+
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{130}
+	return fileDescriptor_030104ce3b95bcac, []int{147}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +4188,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{131}
+	return fileDescriptor_030104ce3b95bcac, []int{148}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +4216,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{132}
+	return fileDescriptor_030104ce3b95bcac, []int{149}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +4244,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{133}
+	return fileDescriptor_030104ce3b95bcac, []int{150}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4272,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{134}
+	return fileDescriptor_030104ce3b95bcac, []int{151}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4300,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{135}
+	return fileDescriptor_030104ce3b95bcac, []int{152}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4328,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{136}
+	return fileDescriptor_030104ce3b95bcac, []int{153}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4356,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{137}
+	return fileDescriptor_030104ce3b95bcac, []int{154}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4384,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{138}
+	return fileDescriptor_030104ce3b95bcac, []int{155}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4412,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{139}
+	return fileDescriptor_030104ce3b95bcac, []int{156}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4440,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{140}
+	return fileDescriptor_030104ce3b95bcac, []int{157}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3989,10 +4465,38 @@ func (m *SignatureKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 
+func (m *StatusTransition) Reset()      { *m = StatusTransition{} }
+func (*StatusTransition) ProtoMessage() {}
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{158}
+}
+func (m *StatusTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StatusTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusTransition.Merge(m, src)
+}
+func (m *StatusTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusTransition proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{141}
+	return fileDescriptor_030104ce3b95bcac, []int{159}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4524,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{142}
+	return fileDescriptor_030104ce3b95bcac, []int{160}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4552,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{143}
+	return fileDescriptor_030104ce3b95bcac, []int{161}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4073,10 +4577,66 @@ func (m *SyncOperationResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 
+func (m *SyncPlan) Reset()      { *m = SyncPlan{} }
+func (*SyncPlan) ProtoMessage() {}
+func (*SyncPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{162}
+}
+func (m *SyncPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPlan.Merge(m, src)
+}
+func (m *SyncPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPlan proto.InternalMessageInfo
+
+func (m *SyncPlanResource) Reset()      { *m = SyncPlanResource{} }
+func (*SyncPlanResource) ProtoMessage() {}
+func (*SyncPlanResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{163}
+}
+func (m *SyncPlanResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPlanResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPlanResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPlanResource.Merge(m, src)
+}
+func (m *SyncPlanResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPlanResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPlanResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPlanResource proto.InternalMessageInfo
+
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{144}
+	return fileDescriptor_030104ce3b95bcac, []int{164}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4664,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{145}
+	return fileDescriptor_030104ce3b95bcac, []int{165}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4692,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{146}
+	return fileDescriptor_030104ce3b95bcac, []int{166}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4720,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{147}
+	return fileDescriptor_030104ce3b95bcac, []int{167}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4748,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{148}
+	return fileDescriptor_030104ce3b95bcac, []int{168}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4776,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{149}
+	return fileDescriptor_030104ce3b95bcac, []int{169}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4241,10 +4801,66 @@ func (m *SyncStrategyHook) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 
+func (m *SyncWaveResult) Reset()      { *m = SyncWaveResult{} }
+func (*SyncWaveResult) ProtoMessage() {}
+func (*SyncWaveResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{170}
+}
+func (m *SyncWaveResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWaveResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWaveResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWaveResult.Merge(m, src)
+}
+func (m *SyncWaveResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWaveResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWaveResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWaveResult proto.InternalMessageInfo
+
+func (m *SyncWavesStrategy) Reset()      { *m = SyncWavesStrategy{} }
+func (*SyncWavesStrategy) ProtoMessage() {}
+func (*SyncWavesStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{171}
+}
+func (m *SyncWavesStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWavesStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWavesStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWavesStrategy.Merge(m, src)
+}
+func (m *SyncWavesStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWavesStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWavesStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWavesStrategy proto.InternalMessageInfo
+
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{150}
+	return fileDescriptor_030104ce3b95bcac, []int{172}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4888,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{151}
+	return fileDescriptor_030104ce3b95bcac, []int{173}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4916,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{152}
+	return fileDescriptor_030104ce3b95bcac, []int{174}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4335,6 +4951,8 @@ func init() {
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationCondition")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestination")
+	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
+	proto.RegisterType((*ApplicationDestinationStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestinationStatus")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationList")
 	proto.RegisterType((*ApplicationMatchExpression)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationMatchExpression")
 	proto.RegisterType((*ApplicationPreservedFields)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationPreservedFields")
@@ -4371,6 +4989,7 @@ func init() {
 	proto.RegisterType((*ApplicationSummary)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSummary")
 	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*AutomatedRollback)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.AutomatedRollback")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuthBitbucketServer)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.BasicAuthBitbucketServer")
 	proto.RegisterType((*BearerTokenBitbucketCloud)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.BearerTokenBitbucketCloud")
@@ -4389,6 +5008,11 @@ func init() {
 	proto.RegisterType((*ComponentParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ComponentParameter")
 	proto.RegisterType((*ConfigManagementPlugin)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ConfigManagementPlugin")
 	proto.RegisterType((*ConnectionState)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ConnectionState")
+	proto.RegisterType((*ContainerLogs)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ContainerLogs")
+	proto.RegisterType((*DORAMetrics)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.DORAMetrics")
+	proto.RegisterType((*DestinationOperationState)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.DestinationOperationState")
+	proto.RegisterType((*DestinationRollout)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.DestinationRollout")
+	proto.RegisterType((*DriftRecord)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.DriftRecord")
 	proto.RegisterType((*DuckTypeGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.DuckTypeGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.DuckTypeGenerator.ValuesEntry")
 	proto.RegisterType((*EnvEntry)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.EnvEntry")
@@ -4405,6 +5029,7 @@ func init() {
 	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmFileParameter")
 	proto.RegisterType((*HelmOptions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmOptions")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmParameter")
+	proto.RegisterType((*HookLogs)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HookLogs")
 	proto.RegisterType((*HostInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HostInfo")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HostResourceInfo")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Info")
@@ -4421,20 +5046,25 @@ func init() {
 	proto.RegisterType((*KustomizeResId)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KustomizeResId")
 	proto.RegisterType((*KustomizeSelector)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KustomizeSelector")
 	proto.RegisterType((*ListGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ListGenerator")
+	proto.RegisterType((*MaintenanceStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.MaintenanceStatus")
 	proto.RegisterType((*ManagedNamespaceMetadata)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.LabelsEntry")
+	proto.RegisterType((*ManifestPolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManifestPolicy")
 	proto.RegisterType((*MatrixGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.MatrixGenerator")
 	proto.RegisterType((*MergeGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.MergeGenerator")
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
 	proto.RegisterType((*NestedMergeGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.NestedMergeGenerator")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Operation")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationInitiator")
+	proto.RegisterType((*OperationQueuePolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationQueuePolicy")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationState")
 	proto.RegisterType((*OptionalArray)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalArray")
 	proto.RegisterType((*OptionalMap)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalMap")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalMap.MapEntry")
+	proto.RegisterType((*OrphanedResourceAction)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourceAction")
 	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourceKey")
+	proto.RegisterType((*OrphanedResourceStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourceStatus")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
 	proto.RegisterType((*OverrideIgnoreDiff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OverrideIgnoreDiff")
 	proto.RegisterType((*PluginConfigMapRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginConfigMapRef")
@@ -4451,6 +5081,7 @@ func init() {
 	proto.RegisterType((*PullRequestGeneratorGitLab)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorGitLab")
 	proto.RegisterType((*PullRequestGeneratorGitea)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorGitea")
 	proto.RegisterType((*PullRequestGeneratorGithub)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorGithub")
+	proto.RegisterType((*QueuedOperation)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.QueuedOperation")
 	proto.RegisterType((*RefTarget)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RefTarget")
 	proto.RegisterType((*RepoCreds)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RepoCreds")
 	proto.RegisterType((*RepoCredsList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RepoCredsList")
@@ -4474,9 +5105,11 @@ func init() {
 	proto.RegisterType((*ResourceRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceRef")
 	proto.RegisterType((*ResourceResult)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceResult")
 	proto.RegisterType((*ResourceStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceStatus")
+	proto.RegisterType((*RetryAttempt)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RetryAttempt")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RetryStrategy")
 	proto.RegisterType((*RevisionHistory)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RevisionHistory")
 	proto.RegisterType((*RevisionMetadata)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RevisionMetadata")
+	proto.RegisterType((*RollbackStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RollbackStatus")
 	proto.RegisterType((*SCMProviderGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGenerator.ValuesEntry")
 	proto.RegisterType((*SCMProviderGeneratorAWSCodeCommit)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGeneratorAWSCodeCommit")
@@ -4489,15 +5122,20 @@ func init() {
 	proto.RegisterType((*SCMProviderGeneratorGitlab)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitlab")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SecretRef")
 	proto.RegisterType((*SignatureKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SignatureKey")
+	proto.RegisterType((*StatusTransition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.StatusTransition")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResult")
+	proto.RegisterType((*SyncPlan)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncPlan")
+	proto.RegisterType((*SyncPlanResource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncPlanResource")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncPolicy")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
 	proto.RegisterType((*SyncStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStatus")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncWaveResult)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncWaveResult")
+	proto.RegisterType((*SyncWavesStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncWavesStrategy")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.TagFilter")
@@ -4508,709 +5146,826 @@ func init() {
 }
 
 var fileDescriptor_030104ce3b95bcac = []byte{
-	// 11230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0xc7,
-	0x75, 0x98, 0x66, 0x17, 0x0b, 0xec, 0x3e, 0x7c, 0xdd, 0xf5, 0xdd, 0x91, 0xb8, 0x13, 0x49, 0x9c,
-	0x87, 0x36, 0x45, 0x47, 0x24, 0x60, 0x9e, 0x48, 0x99, 0x11, 0x2d, 0xca, 0xf8, 0xb8, 0xc3, 0xe1,
-	0x0e, 0x38, 0x80, 0x0d, 0xdc, 0x9d, 0x44, 0x99, 0xa2, 0x06, 0xbb, 0x8d, 0xc5, 0x1c, 0x66, 0x67,
-	0x86, 0x33, 0xb3, 0x38, 0x80, 0x96, 0x64, 0xc9, 0x92, 0x6c, 0x25, 0xfa, 0xa0, 0x2c, 0x25, 0x65,
-	0x3a, 0xb1, 0x14, 0xd9, 0x72, 0x52, 0x71, 0x25, 0xaa, 0x38, 0xc9, 0x8f, 0x38, 0x72, 0xaa, 0x5c,
-	0xb1, 0x53, 0x29, 0x25, 0x8e, 0xcb, 0x8e, 0xcb, 0x65, 0x39, 0x89, 0x8d, 0x48, 0x97, 0x4a, 0x25,
-	0x95, 0xaa, 0xb8, 0xca, 0x89, 0x7f, 0x24, 0x97, 0xfc, 0x48, 0xf5, 0x77, 0xcf, 0xec, 0x2c, 0xb0,
-	0x00, 0x06, 0x77, 0x27, 0x85, 0xff, 0x76, 0xfb, 0xbd, 0x79, 0xaf, 0xa7, 0xa7, 0xfb, 0xbd, 0xd7,
-	0xaf, 0xdf, 0x7b, 0x0d, 0x0b, 0x4d, 0x37, 0xd9, 0x68, 0xaf, 0x4d, 0xd4, 0x83, 0xd6, 0xa4, 0x13,
-	0x35, 0x83, 0x30, 0x0a, 0x6e, 0xb1, 0x1f, 0x4f, 0xd7, 0x1b, 0x93, 0x5b, 0x17, 0x26, 0xc3, 0xcd,
-	0xe6, 0xa4, 0x13, 0xba, 0xf1, 0xa4, 0x13, 0x86, 0x9e, 0x5b, 0x77, 0x12, 0x37, 0xf0, 0x27, 0xb7,
-	0x9e, 0x71, 0xbc, 0x70, 0xc3, 0x79, 0x66, 0xb2, 0x49, 0x7c, 0x12, 0x39, 0x09, 0x69, 0x4c, 0x84,
-	0x51, 0x90, 0x04, 0xe8, 0xc7, 0x34, 0xb5, 0x09, 0x49, 0x8d, 0xfd, 0x78, 0xb5, 0xde, 0x98, 0xd8,
-	0xba, 0x30, 0x11, 0x6e, 0x36, 0x27, 0x28, 0xb5, 0x09, 0x83, 0xda, 0x84, 0xa4, 0x76, 0xee, 0x69,
-	0xa3, 0x2f, 0xcd, 0xa0, 0x19, 0x4c, 0x32, 0xa2, 0x6b, 0xed, 0x75, 0xf6, 0x8f, 0xfd, 0x61, 0xbf,
-	0x38, 0xb3, 0x73, 0xf6, 0xe6, 0xf3, 0xf1, 0x84, 0x1b, 0xd0, 0xee, 0x4d, 0xd6, 0x83, 0x88, 0x4c,
-	0x6e, 0x75, 0x74, 0xe8, 0xdc, 0x65, 0x8d, 0x43, 0xb6, 0x13, 0xe2, 0xc7, 0x6e, 0xe0, 0xc7, 0x4f,
-	0xd3, 0x2e, 0x90, 0x68, 0x8b, 0x44, 0xe6, 0xeb, 0x19, 0x08, 0x79, 0x94, 0x9e, 0xd5, 0x94, 0x5a,
-	0x4e, 0x7d, 0xc3, 0xf5, 0x49, 0xb4, 0xa3, 0x1f, 0x6f, 0x91, 0xc4, 0xc9, 0x7b, 0x6a, 0xb2, 0xdb,
-	0x53, 0x51, 0xdb, 0x4f, 0xdc, 0x16, 0xe9, 0x78, 0xe0, 0xdd, 0xfb, 0x3d, 0x10, 0xd7, 0x37, 0x48,
-	0xcb, 0xe9, 0x78, 0xee, 0x5d, 0xdd, 0x9e, 0x6b, 0x27, 0xae, 0x37, 0xe9, 0xfa, 0x49, 0x9c, 0x44,
-	0xd9, 0x87, 0xec, 0x5f, 0xb4, 0x60, 0x78, 0xea, 0xe6, 0xca, 0x54, 0x3b, 0xd9, 0x98, 0x09, 0xfc,
-	0x75, 0xb7, 0x89, 0x9e, 0x83, 0xc1, 0xba, 0xd7, 0x8e, 0x13, 0x12, 0x5d, 0x73, 0x5a, 0x64, 0xcc,
-	0x3a, 0x6f, 0x3d, 0x59, 0x9b, 0x3e, 0xf5, 0xad, 0xdd, 0xf1, 0xb7, 0xdd, 0xd9, 0x1d, 0x1f, 0x9c,
-	0xd1, 0x20, 0x6c, 0xe2, 0xa1, 0x1f, 0x86, 0x81, 0x28, 0xf0, 0xc8, 0x14, 0xbe, 0x36, 0x56, 0x62,
-	0x8f, 0x8c, 0x8a, 0x47, 0x06, 0x30, 0x6f, 0xc6, 0x12, 0x4e, 0x51, 0xc3, 0x28, 0x58, 0x77, 0x3d,
-	0x32, 0x56, 0x4e, 0xa3, 0x2e, 0xf3, 0x66, 0x2c, 0xe1, 0xf6, 0x1f, 0x95, 0x00, 0xa6, 0xc2, 0x70,
-	0x39, 0x0a, 0x6e, 0x91, 0x7a, 0x82, 0x3e, 0x0c, 0x55, 0x3a, 0xcc, 0x0d, 0x27, 0x71, 0x58, 0xc7,
-	0x06, 0x2f, 0xfc, 0xc8, 0x04, 0x7f, 0xeb, 0x09, 0xf3, 0xad, 0xf5, 0x24, 0xa3, 0xd8, 0x13, 0x5b,
-	0xcf, 0x4c, 0x2c, 0xad, 0xd1, 0xe7, 0x17, 0x49, 0xe2, 0x4c, 0x23, 0xc1, 0x0c, 0x74, 0x1b, 0x56,
-	0x54, 0x91, 0x0f, 0x7d, 0x71, 0x48, 0xea, 0xec, 0x1d, 0x06, 0x2f, 0x2c, 0x4c, 0x1c, 0x65, 0x36,
-	0x4f, 0xe8, 0x9e, 0xaf, 0x84, 0xa4, 0x3e, 0x3d, 0x24, 0x38, 0xf7, 0xd1, 0x7f, 0x98, 0xf1, 0x41,
-	0x5b, 0xd0, 0x1f, 0x27, 0x4e, 0xd2, 0x8e, 0xd9, 0x50, 0x0c, 0x5e, 0xb8, 0x56, 0x18, 0x47, 0x46,
-	0x75, 0x7a, 0x44, 0xf0, 0xec, 0xe7, 0xff, 0xb1, 0xe0, 0x66, 0xff, 0xa9, 0x05, 0x23, 0x1a, 0x79,
-	0xc1, 0x8d, 0x13, 0xf4, 0x13, 0x1d, 0x83, 0x3b, 0xd1, 0xdb, 0xe0, 0xd2, 0xa7, 0xd9, 0xd0, 0x9e,
-	0x10, 0xcc, 0xaa, 0xb2, 0xc5, 0x18, 0xd8, 0x16, 0x54, 0xdc, 0x84, 0xb4, 0xe2, 0xb1, 0xd2, 0xf9,
-	0xf2, 0x93, 0x83, 0x17, 0x2e, 0x17, 0xf5, 0x9e, 0xd3, 0xc3, 0x82, 0x69, 0x65, 0x9e, 0x92, 0xc7,
-	0x9c, 0x8b, 0xfd, 0xab, 0x43, 0xe6, 0xfb, 0xd1, 0x01, 0x47, 0xcf, 0xc0, 0x60, 0x1c, 0xb4, 0xa3,
-	0x3a, 0xc1, 0x24, 0x0c, 0xe2, 0x31, 0xeb, 0x7c, 0x99, 0x4e, 0x3d, 0x3a, 0xa9, 0x57, 0x74, 0x33,
-	0x36, 0x71, 0xd0, 0x17, 0x2c, 0x18, 0x6a, 0x90, 0x38, 0x71, 0x7d, 0xc6, 0x5f, 0x76, 0x7e, 0xf5,
-	0xc8, 0x9d, 0x97, 0x8d, 0xb3, 0x9a, 0xf8, 0xf4, 0x69, 0xf1, 0x22, 0x43, 0x46, 0x63, 0x8c, 0x53,
-	0xfc, 0xe9, 0xe2, 0x6c, 0x90, 0xb8, 0x1e, 0xb9, 0x21, 0xfd, 0x2f, 0x96, 0x8f, 0x5a, 0x9c, 0xb3,
-	0x1a, 0x84, 0x4d, 0x3c, 0xe4, 0x43, 0x85, 0x2e, 0xbe, 0x78, 0xac, 0x8f, 0xf5, 0x7f, 0xfe, 0x68,
-	0xfd, 0x17, 0x83, 0x4a, 0xd7, 0xb5, 0x1e, 0x7d, 0xfa, 0x2f, 0xc6, 0x9c, 0x0d, 0xfa, 0xbc, 0x05,
-	0x63, 0x42, 0x38, 0x60, 0xc2, 0x07, 0xf4, 0xe6, 0x86, 0x9b, 0x10, 0xcf, 0x8d, 0x93, 0xb1, 0x0a,
-	0xeb, 0xc3, 0x64, 0x6f, 0x73, 0x6b, 0x2e, 0x0a, 0xda, 0xe1, 0x55, 0xd7, 0x6f, 0x4c, 0x9f, 0x17,
-	0x9c, 0xc6, 0x66, 0xba, 0x10, 0xc6, 0x5d, 0x59, 0xa2, 0x2f, 0x5b, 0x70, 0xce, 0x77, 0x5a, 0x24,
-	0x0e, 0x1d, 0xfa, 0x69, 0x39, 0x78, 0xda, 0x73, 0xea, 0x9b, 0xac, 0x47, 0xfd, 0x87, 0xeb, 0x91,
-	0x2d, 0x7a, 0x74, 0xee, 0x5a, 0x57, 0xd2, 0x78, 0x0f, 0xb6, 0xe8, 0xeb, 0x16, 0x9c, 0x0c, 0xa2,
-	0x70, 0xc3, 0xf1, 0x49, 0x43, 0x42, 0xe3, 0xb1, 0x01, 0xb6, 0xf4, 0x3e, 0x74, 0xb4, 0x4f, 0xb4,
-	0x94, 0x25, 0xbb, 0x18, 0xf8, 0x6e, 0x12, 0x44, 0x2b, 0x24, 0x49, 0x5c, 0xbf, 0x19, 0x4f, 0x9f,
-	0xb9, 0xb3, 0x3b, 0x7e, 0xb2, 0x03, 0x0b, 0x77, 0xf6, 0x07, 0xfd, 0x24, 0x0c, 0xc6, 0x3b, 0x7e,
-	0xfd, 0xa6, 0xeb, 0x37, 0x82, 0xdb, 0xf1, 0x58, 0xb5, 0x88, 0xe5, 0xbb, 0xa2, 0x08, 0x8a, 0x05,
-	0xa8, 0x19, 0x60, 0x93, 0x5b, 0xfe, 0x87, 0xd3, 0x53, 0xa9, 0x56, 0xf4, 0x87, 0xd3, 0x93, 0x69,
-	0x0f, 0xb6, 0xe8, 0x67, 0x2d, 0x18, 0x8e, 0xdd, 0xa6, 0xef, 0x24, 0xed, 0x88, 0x5c, 0x25, 0x3b,
-	0xf1, 0x18, 0xb0, 0x8e, 0x5c, 0x39, 0xe2, 0xa8, 0x18, 0x24, 0xa7, 0xcf, 0x88, 0x3e, 0x0e, 0x9b,
-	0xad, 0x31, 0x4e, 0xf3, 0xcd, 0x5b, 0x68, 0x7a, 0x5a, 0x0f, 0x16, 0xbb, 0xd0, 0xf4, 0xa4, 0xee,
-	0xca, 0x12, 0xfd, 0x38, 0x9c, 0xe0, 0x4d, 0x6a, 0x64, 0xe3, 0xb1, 0x21, 0x26, 0x68, 0x4f, 0xdf,
-	0xd9, 0x1d, 0x3f, 0xb1, 0x92, 0x81, 0xe1, 0x0e, 0x6c, 0xf4, 0x1a, 0x8c, 0x87, 0x24, 0x6a, 0xb9,
-	0xc9, 0x92, 0xef, 0xed, 0x48, 0xf1, 0x5d, 0x0f, 0x42, 0xd2, 0x10, 0xdd, 0x89, 0xc7, 0x86, 0xcf,
-	0x5b, 0x4f, 0x56, 0xa7, 0xdf, 0x21, 0xba, 0x39, 0xbe, 0xbc, 0x37, 0x3a, 0xde, 0x8f, 0x9e, 0xfd,
-	0xaf, 0x4a, 0x70, 0x22, 0xab, 0x38, 0xd1, 0xdf, 0xb1, 0x60, 0xf4, 0xd6, 0xed, 0x64, 0x35, 0xd8,
-	0x24, 0x7e, 0x3c, 0xbd, 0x43, 0xc5, 0x1b, 0x53, 0x19, 0x83, 0x17, 0xea, 0xc5, 0xaa, 0xe8, 0x89,
-	0x2b, 0x69, 0x2e, 0x17, 0xfd, 0x24, 0xda, 0x99, 0x7e, 0x58, 0xbc, 0xdd, 0xe8, 0x95, 0x9b, 0xab,
-	0x26, 0x14, 0x67, 0x3b, 0x75, 0xee, 0xb3, 0x16, 0x9c, 0xce, 0x23, 0x81, 0x4e, 0x40, 0x79, 0x93,
-	0xec, 0x70, 0x03, 0x0e, 0xd3, 0x9f, 0xe8, 0x15, 0xa8, 0x6c, 0x39, 0x5e, 0x9b, 0x08, 0xeb, 0x66,
-	0xee, 0x68, 0x2f, 0xa2, 0x7a, 0x86, 0x39, 0xd5, 0xf7, 0x94, 0x9e, 0xb7, 0xec, 0xdf, 0x2b, 0xc3,
-	0xa0, 0xa1, 0xdf, 0xee, 0x81, 0xc5, 0x16, 0xa4, 0x2c, 0xb6, 0xc5, 0xc2, 0x54, 0x73, 0x57, 0x93,
-	0xed, 0x76, 0xc6, 0x64, 0x5b, 0x2a, 0x8e, 0xe5, 0x9e, 0x36, 0x1b, 0x4a, 0xa0, 0x16, 0x84, 0xd4,
-	0x7a, 0xa7, 0xaa, 0xbf, 0xaf, 0x88, 0x4f, 0xb8, 0x24, 0xc9, 0x4d, 0x0f, 0xdf, 0xd9, 0x1d, 0xaf,
-	0xa9, 0xbf, 0x58, 0x33, 0xb2, 0xbf, 0x6d, 0xc1, 0x69, 0xa3, 0x8f, 0x33, 0x81, 0xdf, 0x70, 0xd9,
-	0xa7, 0x3d, 0x0f, 0x7d, 0xc9, 0x4e, 0x28, 0x77, 0x08, 0x6a, 0xa4, 0x56, 0x77, 0x42, 0x82, 0x19,
-	0x84, 0x1a, 0xfa, 0x2d, 0x12, 0xc7, 0x4e, 0x93, 0x64, 0xf7, 0x04, 0x8b, 0xbc, 0x19, 0x4b, 0x38,
-	0x8a, 0x00, 0x79, 0x4e, 0x9c, 0xac, 0x46, 0x8e, 0x1f, 0x33, 0xf2, 0xab, 0x6e, 0x8b, 0x88, 0x01,
-	0xfe, 0x4b, 0xbd, 0xcd, 0x18, 0xfa, 0xc4, 0xf4, 0x43, 0x77, 0x76, 0xc7, 0xd1, 0x42, 0x07, 0x25,
-	0x9c, 0x43, 0xdd, 0xfe, 0xb2, 0x05, 0x0f, 0xe5, 0xdb, 0x62, 0xe8, 0x09, 0xe8, 0xe7, 0xdb, 0x43,
-	0xf1, 0x76, 0xfa, 0x93, 0xb0, 0x56, 0x2c, 0xa0, 0x68, 0x12, 0x6a, 0x4a, 0x4f, 0x88, 0x77, 0x3c,
-	0x29, 0x50, 0x6b, 0x5a, 0xb9, 0x68, 0x1c, 0x3a, 0x68, 0xf4, 0x8f, 0xb0, 0xdc, 0xd4, 0xa0, 0xb1,
-	0xfd, 0x14, 0x83, 0xd8, 0xff, 0xd1, 0x82, 0x51, 0xa3, 0x57, 0xf7, 0xc0, 0x34, 0xf7, 0xd3, 0xa6,
-	0xf9, 0x7c, 0x61, 0xf3, 0xb9, 0x8b, 0x6d, 0xfe, 0x79, 0x0b, 0xce, 0x19, 0x58, 0x8b, 0x4e, 0x52,
-	0xdf, 0xb8, 0xb8, 0x1d, 0x46, 0x24, 0xa6, 0x5b, 0x6f, 0xf4, 0xa8, 0x21, 0xb7, 0xa6, 0x07, 0x05,
-	0x85, 0xf2, 0x55, 0xb2, 0xc3, 0x85, 0xd8, 0x53, 0x50, 0xe5, 0x93, 0x33, 0x88, 0xc4, 0x88, 0xab,
-	0x77, 0x5b, 0x12, 0xed, 0x58, 0x61, 0x20, 0x1b, 0xfa, 0x99, 0x70, 0xa2, 0x8b, 0x95, 0xaa, 0x21,
-	0xa0, 0x1f, 0xf1, 0x06, 0x6b, 0xc1, 0x02, 0x62, 0xc7, 0xa9, 0xee, 0x2c, 0x47, 0x84, 0x7d, 0xdc,
-	0xc6, 0x25, 0x97, 0x78, 0x8d, 0x98, 0x6e, 0x1b, 0x1c, 0xdf, 0x0f, 0x12, 0xb1, 0x03, 0x30, 0xb6,
-	0x0d, 0x53, 0xba, 0x19, 0x9b, 0x38, 0x94, 0xa9, 0xe7, 0xac, 0x11, 0x8f, 0x8f, 0xa8, 0x60, 0xba,
-	0xc0, 0x5a, 0xb0, 0x80, 0xd8, 0x77, 0x4a, 0x6c, 0x83, 0xa2, 0x96, 0x3e, 0xb9, 0x17, 0xbb, 0xdb,
-	0x28, 0x25, 0x2b, 0x97, 0x8b, 0x13, 0x5c, 0xa4, 0xfb, 0x0e, 0xf7, 0xf5, 0x8c, 0xb8, 0xc4, 0x85,
-	0x72, 0xdd, 0x7b, 0x97, 0xfb, 0xf1, 0x32, 0x8c, 0xa7, 0x1f, 0xe8, 0x90, 0xb6, 0x74, 0x4b, 0x65,
-	0x30, 0xca, 0xfa, 0x3b, 0x0c, 0x7c, 0x6c, 0xe2, 0x75, 0x11, 0x58, 0xa5, 0xe3, 0x14, 0x58, 0xa6,
-	0x3c, 0x2d, 0xef, 0x23, 0x4f, 0x9f, 0x50, 0xa3, 0xde, 0x97, 0x11, 0x60, 0x69, 0x9d, 0x72, 0x1e,
-	0xfa, 0xe2, 0x84, 0x84, 0x63, 0x95, 0xb4, 0x3c, 0x5a, 0x49, 0x48, 0x88, 0x19, 0x04, 0xbd, 0x17,
-	0x46, 0x13, 0x27, 0x6a, 0x92, 0x24, 0x22, 0x5b, 0x2e, 0xf3, 0x8d, 0xb1, 0xfd, 0x52, 0x6d, 0xfa,
-	0x14, 0x35, 0x4f, 0x56, 0x19, 0x08, 0x4b, 0x10, 0xce, 0xe2, 0xda, 0xff, 0xad, 0x04, 0x0f, 0xa7,
-	0x3f, 0x81, 0xd6, 0x20, 0xef, 0x4b, 0x69, 0x90, 0x77, 0x9a, 0x1a, 0xe4, 0xee, 0xee, 0xf8, 0xdb,
-	0xbb, 0x3c, 0xf6, 0x3d, 0xa3, 0x60, 0xd0, 0x5c, 0xe6, 0x23, 0x4c, 0xa6, 0x3f, 0xc2, 0xdd, 0xdd,
-	0xf1, 0x47, 0xbb, 0xbc, 0x63, 0xe6, 0x2b, 0x3d, 0x01, 0xfd, 0x11, 0x71, 0xe2, 0xc0, 0x17, 0xdf,
-	0x49, 0x7d, 0x4d, 0xcc, 0x5a, 0xb1, 0x80, 0xda, 0x7f, 0x50, 0xcb, 0x0e, 0xf6, 0x1c, 0xf7, 0xf7,
-	0x05, 0x11, 0x72, 0xa1, 0x8f, 0xed, 0x0a, 0xb8, 0x64, 0xb9, 0x7a, 0xb4, 0x55, 0x48, 0xb5, 0x88,
-	0x22, 0x3d, 0x5d, 0xa5, 0x5f, 0x8d, 0x36, 0x61, 0xc6, 0x02, 0x6d, 0x43, 0xb5, 0x2e, 0x8d, 0xf5,
-	0x52, 0x11, 0x6e, 0x2d, 0x61, 0xaa, 0x6b, 0x8e, 0x43, 0x54, 0xdc, 0x2b, 0x0b, 0x5f, 0x71, 0x43,
-	0x04, 0xca, 0x4d, 0x37, 0x11, 0x9f, 0xf5, 0x88, 0xdb, 0xb1, 0x39, 0xd7, 0x78, 0xc5, 0x01, 0xaa,
-	0x83, 0xe6, 0xdc, 0x04, 0x53, 0xfa, 0xe8, 0xd3, 0x16, 0x0c, 0xc6, 0xf5, 0xd6, 0x72, 0x14, 0x6c,
-	0xb9, 0x0d, 0x12, 0x09, 0x63, 0xec, 0x88, 0x92, 0x6d, 0x65, 0x66, 0x51, 0x12, 0xd4, 0x7c, 0xf9,
-	0xf6, 0x58, 0x43, 0xb0, 0xc9, 0x97, 0x6e, 0x52, 0x1e, 0x16, 0xef, 0x3e, 0x4b, 0xea, 0x6c, 0xc5,
-	0xc9, 0x3d, 0x19, 0x9b, 0x29, 0x47, 0x36, 0x4e, 0x67, 0xdb, 0xf5, 0x4d, 0xba, 0xde, 0x74, 0x87,
-	0xde, 0x7e, 0x67, 0x77, 0xfc, 0xe1, 0x99, 0x7c, 0x9e, 0xb8, 0x5b, 0x67, 0xd8, 0x80, 0x85, 0x6d,
-	0xcf, 0xc3, 0xe4, 0xb5, 0x36, 0x61, 0x1e, 0x97, 0x02, 0x06, 0x6c, 0x59, 0x13, 0xcc, 0x0c, 0x98,
-	0x01, 0xc1, 0x26, 0x5f, 0xf4, 0x1a, 0xf4, 0xb7, 0x9c, 0x24, 0x72, 0xb7, 0x85, 0x9b, 0xe5, 0x88,
-	0xdb, 0x85, 0x45, 0x46, 0x4b, 0x33, 0x67, 0x8a, 0x9e, 0x37, 0x62, 0xc1, 0x08, 0xb5, 0xa0, 0xd2,
-	0x22, 0x51, 0x93, 0x8c, 0x55, 0x8b, 0x70, 0x29, 0x2f, 0x52, 0x52, 0x9a, 0x61, 0x8d, 0x1a, 0x57,
-	0xac, 0x0d, 0x73, 0x2e, 0xe8, 0x15, 0xa8, 0xc6, 0xc4, 0x23, 0x75, 0x6a, 0x1e, 0xd5, 0x18, 0xc7,
-	0x77, 0xf5, 0x68, 0x2a, 0x52, 0xbb, 0x64, 0x45, 0x3c, 0xca, 0x17, 0x98, 0xfc, 0x87, 0x15, 0x49,
-	0x3a, 0x80, 0xa1, 0xd7, 0x6e, 0xba, 0xfe, 0x18, 0x14, 0x31, 0x80, 0xcb, 0x8c, 0x56, 0x66, 0x00,
-	0x79, 0x23, 0x16, 0x8c, 0xec, 0xff, 0x6c, 0x01, 0x4a, 0x0b, 0xb5, 0x7b, 0x60, 0x13, 0xbf, 0x96,
-	0xb6, 0x89, 0x17, 0x8a, 0x34, 0x5a, 0xba, 0x98, 0xc5, 0xbf, 0x51, 0x83, 0x8c, 0x3a, 0xb8, 0x46,
-	0xe2, 0x84, 0x34, 0xde, 0x12, 0xe1, 0x6f, 0x89, 0xf0, 0xb7, 0x44, 0xb8, 0x12, 0xe1, 0x6b, 0x19,
-	0x11, 0xfe, 0xa2, 0xb1, 0xea, 0xf5, 0xf9, 0xed, 0xab, 0xea, 0x80, 0xd7, 0xec, 0x81, 0x81, 0x40,
-	0x25, 0xc1, 0x95, 0x95, 0xa5, 0x6b, 0xb9, 0x32, 0xfb, 0xd5, 0xb4, 0xcc, 0x3e, 0x2a, 0x8b, 0xff,
-	0x1f, 0xa4, 0xf4, 0xbf, 0xb4, 0xe0, 0x1d, 0x69, 0xe9, 0x25, 0x67, 0xce, 0x7c, 0xd3, 0x0f, 0x22,
-	0x32, 0xeb, 0xae, 0xaf, 0x93, 0x88, 0xf8, 0x75, 0x12, 0x2b, 0x27, 0x88, 0xd5, 0xcd, 0x09, 0x82,
-	0x9e, 0x85, 0xa1, 0x5b, 0x71, 0xe0, 0x2f, 0x07, 0xae, 0x2f, 0x44, 0x10, 0xdd, 0x71, 0x9c, 0xb8,
-	0xb3, 0x3b, 0x3e, 0x44, 0x47, 0x54, 0xb6, 0xe3, 0x14, 0x16, 0x9a, 0x81, 0x93, 0xb7, 0x5e, 0x5b,
-	0x76, 0x12, 0xc3, 0x9b, 0x20, 0xf7, 0xfd, 0xec, 0xbc, 0xe3, 0xca, 0x4b, 0x19, 0x20, 0xee, 0xc4,
-	0xb7, 0xff, 0x66, 0x09, 0xce, 0x66, 0x5e, 0x24, 0xf0, 0xbc, 0xa0, 0x9d, 0xd0, 0x3d, 0x11, 0xfa,
-	0xaa, 0x05, 0x27, 0x5a, 0x69, 0x87, 0x45, 0x2c, 0xfc, 0xc2, 0xef, 0x2f, 0x4c, 0x47, 0x64, 0x3c,
-	0x22, 0xd3, 0x63, 0x62, 0x84, 0x4e, 0x64, 0x00, 0x31, 0xee, 0xe8, 0x0b, 0x7a, 0x05, 0x6a, 0x2d,
-	0x67, 0xfb, 0x7a, 0xd8, 0x70, 0x12, 0xb9, 0x1d, 0xed, 0xee, 0x45, 0x68, 0x27, 0xae, 0x37, 0xc1,
-	0x23, 0x03, 0x26, 0xe6, 0xfd, 0x64, 0x29, 0x5a, 0x49, 0x22, 0xd7, 0x6f, 0x72, 0x6f, 0xe0, 0xa2,
-	0x24, 0x83, 0x35, 0x45, 0xfb, 0x2b, 0x56, 0x56, 0x49, 0xa9, 0xd1, 0x89, 0x9c, 0x84, 0x34, 0x77,
-	0xd0, 0x47, 0xa0, 0x42, 0xf7, 0x8d, 0x72, 0x54, 0x6e, 0x16, 0xa9, 0x39, 0x8d, 0x2f, 0xa1, 0x95,
-	0x28, 0xfd, 0x17, 0x63, 0xce, 0xd4, 0xfe, 0x6a, 0x2d, 0x6b, 0x2c, 0xb0, 0xb3, 0xdf, 0x0b, 0x00,
-	0xcd, 0x60, 0x95, 0xb4, 0x42, 0x8f, 0x0e, 0x8b, 0xc5, 0x0e, 0x10, 0x94, 0xab, 0x64, 0x4e, 0x41,
-	0xb0, 0x81, 0x85, 0xfe, 0x8a, 0x05, 0xd0, 0x94, 0x73, 0x5e, 0x1a, 0x02, 0xd7, 0x8b, 0x7c, 0x1d,
-	0xbd, 0xa2, 0x74, 0x5f, 0x14, 0x43, 0x6c, 0x30, 0x47, 0x3f, 0x6d, 0x41, 0x35, 0x91, 0xdd, 0xe7,
-	0xaa, 0x71, 0xb5, 0xc8, 0x9e, 0xc8, 0x97, 0xd6, 0x36, 0x91, 0x1a, 0x12, 0xc5, 0x17, 0xfd, 0x8c,
-	0x05, 0x10, 0xef, 0xf8, 0xf5, 0xe5, 0xc0, 0x73, 0xeb, 0x3b, 0x42, 0x63, 0xde, 0x28, 0xd4, 0x9d,
-	0xa3, 0xa8, 0x4f, 0x8f, 0xd0, 0xd1, 0xd0, 0xff, 0xb1, 0xc1, 0x19, 0x7d, 0x0c, 0xaa, 0xb1, 0x98,
-	0x6e, 0x42, 0x47, 0xae, 0x16, 0xeb, 0x54, 0xe2, 0xb4, 0x85, 0x78, 0x15, 0xff, 0xb0, 0xe2, 0x89,
-	0x7e, 0xde, 0x82, 0xd1, 0x30, 0xed, 0x26, 0x14, 0xea, 0xb0, 0x38, 0x19, 0x90, 0x71, 0x43, 0x72,
-	0x6f, 0x4b, 0xa6, 0x11, 0x67, 0x7b, 0x41, 0x25, 0xa0, 0x9e, 0xc1, 0x4b, 0x21, 0x77, 0x59, 0x0e,
-	0x68, 0x09, 0x38, 0x97, 0x05, 0xe2, 0x4e, 0x7c, 0xb4, 0x0c, 0xa7, 0x69, 0xef, 0x76, 0xb8, 0xf9,
-	0x29, 0xd5, 0x4b, 0xcc, 0x94, 0x61, 0x75, 0xfa, 0x11, 0x31, 0x43, 0xd8, 0xa1, 0x40, 0x16, 0x07,
-	0xe7, 0x3e, 0x89, 0x7e, 0xcf, 0x82, 0x47, 0x5c, 0xa6, 0x06, 0x4c, 0x7f, 0xbb, 0xd6, 0x08, 0xe2,
-	0x20, 0x97, 0x14, 0x2a, 0x2b, 0xba, 0xa9, 0x9f, 0xe9, 0x1f, 0x14, 0x6f, 0xf0, 0xc8, 0xfc, 0x1e,
-	0x5d, 0xc2, 0x7b, 0x76, 0x18, 0xfd, 0x28, 0x0c, 0xcb, 0x75, 0xb1, 0x4c, 0x45, 0x30, 0x53, 0xb4,
-	0xb5, 0xe9, 0x93, 0x77, 0x76, 0xc7, 0x87, 0x57, 0x4d, 0x00, 0x4e, 0xe3, 0xd9, 0xff, 0xba, 0x9c,
-	0x3a, 0x4e, 0x51, 0x3e, 0x4c, 0x26, 0x6e, 0xea, 0xd2, 0xff, 0x23, 0xa5, 0x67, 0xa1, 0xe2, 0x46,
-	0x79, 0x97, 0xb4, 0xb8, 0x51, 0x4d, 0x31, 0x36, 0x98, 0x53, 0xa3, 0xf4, 0xa4, 0x93, 0xf5, 0x94,
-	0x0a, 0x09, 0xf8, 0x4a, 0x91, 0x5d, 0xea, 0x3c, 0xfc, 0x3a, 0x2b, 0xba, 0x76, 0xb2, 0x03, 0x84,
-	0x3b, 0xbb, 0x84, 0x3e, 0x0a, 0xb5, 0x48, 0x45, 0x4e, 0x94, 0x8b, 0xd8, 0xaa, 0xc9, 0x69, 0x23,
-	0xba, 0xa3, 0x4e, 0x73, 0x74, 0x8c, 0x84, 0xe6, 0x68, 0xff, 0x4e, 0xfa, 0x04, 0xc9, 0x90, 0x1d,
-	0x3d, 0x9c, 0x8e, 0x7d, 0xc1, 0x82, 0xc1, 0x28, 0xf0, 0x3c, 0xd7, 0x6f, 0x52, 0x39, 0x27, 0x94,
-	0xf5, 0x07, 0x8f, 0x45, 0x5f, 0x0a, 0x81, 0xc6, 0x2c, 0x6b, 0xac, 0x79, 0x62, 0xb3, 0x03, 0xf6,
-	0x9f, 0x5a, 0x30, 0xd6, 0x4d, 0x1e, 0x23, 0x02, 0x6f, 0x97, 0xc2, 0x46, 0x0d, 0xc5, 0x92, 0x3f,
-	0x4b, 0x3c, 0xa2, 0xdc, 0xe6, 0xd5, 0xe9, 0xc7, 0xc5, 0x6b, 0xbe, 0x7d, 0xb9, 0x3b, 0x2a, 0xde,
-	0x8b, 0x0e, 0x7a, 0x19, 0x4e, 0x18, 0xef, 0x15, 0xab, 0x81, 0xa9, 0x4d, 0x4f, 0x50, 0x03, 0x68,
-	0x2a, 0x03, 0xbb, 0xbb, 0x3b, 0xfe, 0x50, 0xb6, 0x4d, 0x28, 0x8c, 0x0e, 0x3a, 0xf6, 0xaf, 0x94,
-	0xb2, 0x5f, 0x4b, 0xe9, 0xfa, 0x37, 0xad, 0x0e, 0x6f, 0xc2, 0xfb, 0x8f, 0x43, 0xbf, 0x32, 0xbf,
-	0x83, 0x0a, 0x3f, 0xe9, 0x8e, 0x73, 0x1f, 0xcf, 0xb7, 0xed, 0x7f, 0xd3, 0x07, 0x7b, 0xf4, 0xac,
-	0x07, 0xe3, 0xfd, 0xc0, 0x87, 0xa2, 0x9f, 0xb3, 0xd4, 0x81, 0x19, 0x5f, 0xc3, 0x8d, 0xe3, 0x1a,
-	0x7b, 0xbe, 0x7f, 0x8a, 0x79, 0x8c, 0x85, 0xf2, 0xa2, 0xa7, 0x8f, 0xe6, 0xd0, 0xd7, 0xac, 0xf4,
-	0x91, 0x1f, 0x0f, 0x9a, 0x73, 0x8f, 0xad, 0x4f, 0xc6, 0x39, 0x22, 0xef, 0x98, 0x3e, 0x7d, 0xea,
-	0x76, 0xc2, 0x38, 0x01, 0xb0, 0xee, 0xfa, 0x8e, 0xe7, 0xbe, 0x4e, 0x77, 0x47, 0x15, 0xa6, 0xe0,
-	0x99, 0xc5, 0x74, 0x49, 0xb5, 0x62, 0x03, 0xe3, 0xdc, 0x5f, 0x86, 0x41, 0xe3, 0xcd, 0x73, 0x42,
-	0x43, 0x4e, 0x9b, 0xa1, 0x21, 0x35, 0x23, 0xa2, 0xe3, 0xdc, 0x8b, 0x70, 0x22, 0xdb, 0xc1, 0x83,
-	0x3c, 0x6f, 0xff, 0xaf, 0x81, 0xec, 0x19, 0xdc, 0x2a, 0x89, 0x5a, 0xb4, 0x6b, 0x6f, 0x39, 0xb6,
-	0xde, 0x72, 0x6c, 0xbd, 0xe5, 0xd8, 0x32, 0xcf, 0x26, 0x84, 0xd3, 0x66, 0xe0, 0x1e, 0x39, 0x6d,
-	0x52, 0x6e, 0xa8, 0x6a, 0xe1, 0x6e, 0x28, 0xfb, 0xd3, 0x1d, 0x9e, 0xfb, 0xd5, 0x88, 0x10, 0x14,
-	0x40, 0xc5, 0x0f, 0x1a, 0x44, 0xda, 0xb8, 0x57, 0x8a, 0x31, 0xd8, 0xae, 0x05, 0x0d, 0x23, 0x1c,
-	0x99, 0xfe, 0x8b, 0x31, 0xe7, 0x63, 0xdf, 0xa9, 0x40, 0xca, 0x9c, 0xe4, 0xdf, 0xfd, 0x87, 0x61,
-	0x20, 0x22, 0x61, 0x70, 0x1d, 0x2f, 0x08, 0x5d, 0xa6, 0x33, 0x16, 0x78, 0x33, 0x96, 0x70, 0xaa,
-	0xf3, 0x42, 0x27, 0xd9, 0x10, 0xca, 0x4c, 0xe9, 0xbc, 0x65, 0x27, 0xd9, 0xc0, 0x0c, 0x82, 0x5e,
-	0x84, 0x91, 0x24, 0x75, 0x14, 0x2e, 0x8e, 0x7c, 0x1f, 0x12, 0xb8, 0x23, 0xe9, 0x83, 0x72, 0x9c,
-	0xc1, 0x46, 0xaf, 0x41, 0xdf, 0x06, 0xf1, 0x5a, 0xe2, 0xd3, 0xaf, 0x14, 0xa7, 0x6b, 0xd8, 0xbb,
-	0x5e, 0x26, 0x5e, 0x8b, 0x4b, 0x42, 0xfa, 0x0b, 0x33, 0x56, 0x74, 0xde, 0xd7, 0x36, 0xdb, 0x71,
-	0x12, 0xb4, 0xdc, 0xd7, 0xa5, 0xa7, 0xf3, 0xfd, 0x05, 0x33, 0xbe, 0x2a, 0xe9, 0x73, 0x97, 0x92,
-	0xfa, 0x8b, 0x35, 0x67, 0xd6, 0x8f, 0x86, 0x1b, 0xb1, 0x29, 0xb3, 0x23, 0x1c, 0x96, 0x45, 0xf7,
-	0x63, 0x56, 0xd2, 0xe7, 0xfd, 0x50, 0x7f, 0xb1, 0xe6, 0x8c, 0x76, 0xd4, 0xfa, 0x1b, 0x64, 0x7d,
-	0xb8, 0x5e, 0x70, 0x1f, 0xf8, 0xda, 0xcb, 0x5d, 0x87, 0x8f, 0x43, 0xa5, 0xbe, 0xe1, 0x44, 0xc9,
-	0xd8, 0x10, 0x9b, 0x34, 0x6a, 0x16, 0xcf, 0xd0, 0x46, 0xcc, 0x61, 0xe8, 0x51, 0x28, 0x47, 0x64,
-	0x9d, 0x45, 0xbf, 0x1a, 0x71, 0x51, 0x98, 0xac, 0x63, 0xda, 0x6e, 0xff, 0x52, 0x29, 0x6d, 0xb6,
-	0xa5, 0xdf, 0x9b, 0xcf, 0xf6, 0x7a, 0x3b, 0x8a, 0xa5, 0xfb, 0xcb, 0x98, 0xed, 0xac, 0x19, 0x4b,
-	0x38, 0xfa, 0x84, 0x05, 0x03, 0xb7, 0xe2, 0xc0, 0xf7, 0x49, 0x22, 0x54, 0xe4, 0x8d, 0x82, 0x87,
-	0xe2, 0x0a, 0xa7, 0xae, 0xfb, 0x20, 0x1a, 0xb0, 0xe4, 0x4b, 0xbb, 0x4b, 0xb6, 0xeb, 0x5e, 0xbb,
-	0xd1, 0x11, 0xea, 0x72, 0x91, 0x37, 0x63, 0x09, 0xa7, 0xa8, 0xae, 0xcf, 0x51, 0xfb, 0xd2, 0xa8,
-	0xf3, 0xbe, 0x40, 0x15, 0x70, 0xfb, 0xaf, 0xf7, 0xc3, 0x99, 0xdc, 0xc5, 0x41, 0x0d, 0x2a, 0x66,
-	0xb2, 0x5c, 0x72, 0x3d, 0x22, 0x83, 0xbc, 0x98, 0x41, 0x75, 0x43, 0xb5, 0x62, 0x03, 0x03, 0xfd,
-	0x14, 0x40, 0xe8, 0x44, 0x4e, 0x8b, 0x28, 0xf7, 0xf4, 0x91, 0xed, 0x16, 0xda, 0x8f, 0x65, 0x49,
-	0x53, 0x6f, 0xd1, 0x55, 0x53, 0x8c, 0x0d, 0x96, 0xe8, 0x39, 0x18, 0x8c, 0x88, 0x47, 0x9c, 0x98,
-	0x05, 0x4f, 0x67, 0x33, 0x41, 0xb0, 0x06, 0x61, 0x13, 0x0f, 0x3d, 0xa1, 0xe2, 0xe1, 0x32, 0x71,
-	0x41, 0xe9, 0x98, 0x38, 0xf4, 0x86, 0x05, 0x23, 0xeb, 0xae, 0x47, 0x34, 0x77, 0x91, 0xb7, 0xb1,
-	0x74, 0xf4, 0x97, 0xbc, 0x64, 0xd2, 0xd5, 0x12, 0x32, 0xd5, 0x1c, 0xe3, 0x0c, 0x7b, 0xfa, 0x99,
-	0xb7, 0x48, 0xc4, 0x44, 0x6b, 0x7f, 0xfa, 0x33, 0xdf, 0xe0, 0xcd, 0x58, 0xc2, 0xd1, 0x14, 0x8c,
-	0x86, 0x4e, 0x1c, 0xcf, 0x44, 0xa4, 0x41, 0xfc, 0xc4, 0x75, 0x3c, 0x9e, 0x55, 0x51, 0xd5, 0x51,
-	0xd5, 0xcb, 0x69, 0x30, 0xce, 0xe2, 0xa3, 0x0f, 0xc0, 0xc3, 0xdc, 0xff, 0xb3, 0xe8, 0xc6, 0xb1,
-	0xeb, 0x37, 0xf5, 0x34, 0x10, 0x6e, 0xb0, 0x71, 0x41, 0xea, 0xe1, 0xf9, 0x7c, 0x34, 0xdc, 0xed,
-	0x79, 0xf4, 0x14, 0x54, 0xe3, 0x4d, 0x37, 0x9c, 0x89, 0x1a, 0x31, 0x3b, 0xfb, 0xa9, 0x6a, 0xa7,
-	0xeb, 0x8a, 0x68, 0xc7, 0x0a, 0x03, 0xd5, 0x61, 0x88, 0x7f, 0x12, 0x1e, 0xd0, 0x27, 0xe4, 0xe3,
-	0xd3, 0x5d, 0xd5, 0xb4, 0x48, 0x12, 0x9c, 0xc0, 0xce, 0xed, 0x8b, 0xf2, 0x24, 0x8a, 0x1f, 0x9c,
-	0xdc, 0x30, 0xc8, 0xe0, 0x14, 0x51, 0xfb, 0x17, 0x4a, 0xe9, 0x9d, 0xbf, 0xb9, 0x48, 0x51, 0x4c,
-	0x97, 0x62, 0x72, 0xc3, 0x89, 0xa4, 0xc2, 0x3e, 0x62, 0xf2, 0x87, 0xa0, 0x7b, 0xc3, 0x89, 0xcc,
-	0x45, 0xcd, 0x18, 0x60, 0xc9, 0x09, 0xdd, 0x82, 0xbe, 0xc4, 0x73, 0x0a, 0xca, 0x16, 0x33, 0x38,
-	0x6a, 0x47, 0xcc, 0xc2, 0x54, 0x8c, 0x19, 0x0f, 0xf4, 0x08, 0xdd, 0x7d, 0xac, 0xc9, 0x93, 0x22,
-	0xb1, 0x61, 0x58, 0x8b, 0x31, 0x6b, 0xb5, 0xbf, 0x39, 0x98, 0x23, 0x57, 0x95, 0x22, 0x43, 0x17,
-	0x00, 0xe8, 0x46, 0x76, 0x39, 0x22, 0xeb, 0xee, 0xb6, 0x30, 0x24, 0xd4, 0xda, 0xbd, 0xa6, 0x20,
-	0xd8, 0xc0, 0x92, 0xcf, 0xac, 0xb4, 0xd7, 0xe9, 0x33, 0xa5, 0xce, 0x67, 0x38, 0x04, 0x1b, 0x58,
-	0xe8, 0x59, 0xe8, 0x77, 0x5b, 0x4e, 0x53, 0x05, 0xb2, 0x3e, 0x42, 0x17, 0xed, 0x3c, 0x6b, 0xb9,
-	0xbb, 0x3b, 0x3e, 0xa2, 0x3a, 0xc4, 0x9a, 0xb0, 0xc0, 0x45, 0xbf, 0x62, 0xc1, 0x50, 0x3d, 0x68,
-	0xb5, 0x02, 0x9f, 0x6f, 0xff, 0xc4, 0x5e, 0xf6, 0xd6, 0x71, 0xa9, 0xf9, 0x89, 0x19, 0x83, 0x19,
-	0xdf, 0xcc, 0xaa, 0xb4, 0x36, 0x13, 0x84, 0x53, 0xbd, 0x32, 0xd7, 0x76, 0x65, 0x9f, 0xb5, 0xfd,
-	0xeb, 0x16, 0x9c, 0xe4, 0xcf, 0x1a, 0xbb, 0x52, 0x91, 0xc1, 0x15, 0x1c, 0xf3, 0x6b, 0x75, 0x6c,
-	0xd4, 0x95, 0xb3, 0xb2, 0x03, 0x8e, 0x3b, 0x3b, 0x89, 0xe6, 0xe0, 0xe4, 0x7a, 0x10, 0xd5, 0x89,
-	0x39, 0x10, 0x42, 0x30, 0x29, 0x42, 0x97, 0xb2, 0x08, 0xb8, 0xf3, 0x19, 0x74, 0x03, 0x1e, 0x32,
-	0x1a, 0xcd, 0x71, 0xe0, 0xb2, 0xe9, 0x31, 0x41, 0xed, 0xa1, 0x4b, 0xb9, 0x58, 0xb8, 0xcb, 0xd3,
-	0xd4, 0x88, 0x65, 0x10, 0xe5, 0xa4, 0x11, 0xf2, 0x49, 0x8b, 0xe8, 0x14, 0x14, 0x67, 0xb0, 0xd3,
-	0x8e, 0x1f, 0xe8, 0xc1, 0xf1, 0xf3, 0x2a, 0x9c, 0xad, 0x77, 0x8e, 0xec, 0x56, 0xdc, 0x5e, 0x63,
-	0xe9, 0x4b, 0x94, 0xf7, 0x0f, 0x08, 0x02, 0x67, 0x67, 0xba, 0x21, 0xe2, 0xee, 0x34, 0xd0, 0x47,
-	0xa0, 0x1a, 0x11, 0xf6, 0x55, 0x79, 0x1e, 0xd2, 0x91, 0x77, 0xfb, 0xda, 0x82, 0xe5, 0x64, 0xb5,
-	0xec, 0x16, 0x0d, 0x31, 0x56, 0x1c, 0xd1, 0x6d, 0x18, 0x08, 0x9d, 0xa4, 0xbe, 0x41, 0xe2, 0xb1,
-	0xe1, 0x22, 0x7c, 0xd3, 0x8a, 0x39, 0x3b, 0x4a, 0x30, 0xd2, 0xa6, 0x39, 0x13, 0x2c, 0xb9, 0x51,
-	0x6b, 0xa6, 0x1e, 0xb4, 0xc2, 0xc0, 0x27, 0x7e, 0x12, 0x8f, 0x8d, 0x68, 0x6b, 0x66, 0x46, 0xb5,
-	0x62, 0x03, 0x03, 0x2d, 0xc3, 0x69, 0xe6, 0xfb, 0xba, 0xe9, 0x26, 0x1b, 0x41, 0x3b, 0x91, 0x5b,
-	0xb9, 0xb1, 0xd1, 0xf4, 0x89, 0xcf, 0x42, 0x0e, 0x0e, 0xce, 0x7d, 0xf2, 0xdc, 0xfb, 0xe0, 0x64,
-	0x87, 0x28, 0x38, 0x90, 0xdb, 0x69, 0x16, 0x1e, 0xca, 0x5f, 0x74, 0x07, 0x72, 0x3e, 0xfd, 0xe3,
-	0x4c, 0xf4, 0xb1, 0x61, 0x88, 0xf7, 0xe0, 0xc8, 0x74, 0xa0, 0x4c, 0xfc, 0x2d, 0xa1, 0x83, 0x2e,
-	0x1d, 0xed, 0xdb, 0x5d, 0xf4, 0xb7, 0xb8, 0xcc, 0x60, 0xde, 0x9a, 0x8b, 0xfe, 0x16, 0xa6, 0xb4,
-	0xd1, 0x97, 0xac, 0x94, 0x21, 0xc9, 0xdd, 0x9f, 0x1f, 0x3a, 0x96, 0x9d, 0x47, 0xcf, 0xb6, 0xa5,
-	0xfd, 0xbb, 0x25, 0x38, 0xbf, 0x1f, 0x91, 0x1e, 0x86, 0xef, 0x71, 0xe8, 0x8f, 0x59, 0x3c, 0x81,
-	0x10, 0xea, 0x83, 0x74, 0xae, 0xf2, 0x08, 0x83, 0x57, 0xb1, 0x00, 0x21, 0x0f, 0xca, 0x2d, 0x27,
-	0x14, 0x5e, 0xb1, 0xf9, 0xa3, 0xa6, 0x33, 0xd1, 0xff, 0x8e, 0xb7, 0xe8, 0x84, 0xdc, 0xd7, 0x62,
-	0x34, 0x60, 0xca, 0x06, 0x25, 0x50, 0x71, 0xa2, 0xc8, 0x91, 0x87, 0xd7, 0x57, 0x8b, 0xe1, 0x37,
-	0x45, 0x49, 0xf2, 0xb3, 0xbf, 0x54, 0x13, 0xe6, 0xcc, 0xec, 0xcf, 0x0d, 0xa4, 0x52, 0x7a, 0x58,
-	0x44, 0x42, 0x0c, 0xfd, 0xc2, 0x19, 0x66, 0x15, 0x9d, 0x45, 0xc6, 0x73, 0x32, 0xd9, 0x3e, 0x53,
-	0x64, 0xb6, 0x0b, 0x56, 0xe8, 0xb3, 0x16, 0xcb, 0x1f, 0x97, 0x69, 0x4e, 0x62, 0x77, 0x77, 0x3c,
-	0xe9, 0xec, 0x66, 0x56, 0xba, 0x6c, 0xc4, 0x26, 0x77, 0x51, 0x07, 0x82, 0x59, 0xb5, 0x9d, 0x75,
-	0x20, 0x98, 0x95, 0x2a, 0xe1, 0x68, 0x3b, 0x27, 0xf2, 0xa0, 0x80, 0x1c, 0xe4, 0x1e, 0x62, 0x0d,
-	0xbe, 0x66, 0xc1, 0x49, 0x37, 0x7b, 0x84, 0x2c, 0xf6, 0x42, 0x37, 0x8b, 0xf1, 0x5c, 0x75, 0x9e,
-	0x50, 0x2b, 0x73, 0xa0, 0x03, 0x84, 0x3b, 0x3b, 0x83, 0x1a, 0xd0, 0xe7, 0xfa, 0xeb, 0x81, 0x30,
-	0x82, 0xa6, 0x8f, 0xd6, 0xa9, 0x79, 0x7f, 0x3d, 0xd0, 0xab, 0x99, 0xfe, 0xc3, 0x8c, 0x3a, 0x5a,
-	0x80, 0xd3, 0x32, 0xab, 0xe3, 0xb2, 0x1b, 0x27, 0x41, 0xb4, 0xb3, 0xe0, 0xb6, 0xdc, 0x84, 0x19,
-	0x30, 0xe5, 0xe9, 0x31, 0xaa, 0x1f, 0x70, 0x0e, 0x1c, 0xe7, 0x3e, 0x85, 0x5e, 0x87, 0x01, 0x79,
-	0x6c, 0x5b, 0x2d, 0x62, 0x5f, 0xd9, 0x39, 0xff, 0xd5, 0x64, 0x5a, 0x11, 0xe7, 0xb6, 0x92, 0xa1,
-	0xfd, 0xc6, 0x20, 0x74, 0x9e, 0x2e, 0xa7, 0x8f, 0x92, 0xad, 0x7b, 0x7d, 0x94, 0x4c, 0x37, 0x3c,
-	0xb1, 0x3e, 0x05, 0x2e, 0x60, 0x6e, 0x0b, 0xae, 0xfa, 0x84, 0x6f, 0xc7, 0xaf, 0x63, 0xc6, 0x03,
-	0x45, 0xd0, 0xbf, 0x41, 0x1c, 0x2f, 0xd9, 0x28, 0xe6, 0x30, 0xe2, 0x32, 0xa3, 0x95, 0x4d, 0xc5,
-	0xe2, 0xad, 0x58, 0x70, 0x42, 0xdb, 0x30, 0xb0, 0xc1, 0x27, 0x80, 0xd8, 0x83, 0x2c, 0x1e, 0x75,
-	0x70, 0x53, 0xb3, 0x4a, 0x7f, 0x6e, 0xd1, 0x80, 0x25, 0x3b, 0x16, 0xb6, 0x64, 0x04, 0x56, 0xf0,
-	0xa5, 0x5b, 0x5c, 0x16, 0x5a, 0xef, 0x51, 0x15, 0x1f, 0x86, 0xa1, 0x88, 0xd4, 0x03, 0xbf, 0xee,
-	0x7a, 0xa4, 0x31, 0x25, 0x0f, 0x1a, 0x0e, 0x92, 0x7c, 0xc4, 0xf6, 0xf1, 0xd8, 0xa0, 0x81, 0x53,
-	0x14, 0xd1, 0x67, 0x2c, 0x18, 0x51, 0x99, 0xbb, 0xf4, 0x83, 0x10, 0xe1, 0x50, 0x5e, 0x28, 0x28,
-	0x4f, 0x98, 0xd1, 0x9c, 0x46, 0x74, 0x2f, 0x90, 0x6e, 0xc3, 0x19, 0xbe, 0xe8, 0x65, 0x80, 0x60,
-	0x8d, 0xc7, 0x26, 0x4d, 0x25, 0xc2, 0xbb, 0x7c, 0x90, 0x57, 0x1d, 0xe1, 0x49, 0x8c, 0x92, 0x02,
-	0x36, 0xa8, 0xa1, 0xab, 0x00, 0x7c, 0xd9, 0xac, 0xee, 0x84, 0x7c, 0x8f, 0xa2, 0xb3, 0xc7, 0x60,
-	0x45, 0x41, 0xee, 0xee, 0x8e, 0x77, 0x7a, 0xfb, 0x58, 0x00, 0x86, 0xf1, 0x38, 0xfa, 0x49, 0x18,
-	0x88, 0xdb, 0xad, 0x96, 0xa3, 0x7c, 0xcf, 0x05, 0xa6, 0x45, 0x72, 0xba, 0x86, 0x28, 0xe2, 0x0d,
-	0x58, 0x72, 0x44, 0xb7, 0xa8, 0x50, 0x8d, 0x85, 0x1b, 0x92, 0xad, 0x22, 0x6e, 0x13, 0x0c, 0xb2,
-	0x77, 0x7a, 0xb7, 0x34, 0xbc, 0x71, 0x0e, 0xce, 0xdd, 0xdd, 0xf1, 0x87, 0xd2, 0xed, 0x0b, 0x81,
-	0x48, 0x54, 0xcc, 0xa5, 0x89, 0xae, 0xc8, 0xfa, 0x37, 0xf4, 0xb5, 0x65, 0x59, 0x86, 0x27, 0x75,
-	0xfd, 0x1b, 0xd6, 0xdc, 0x7d, 0xcc, 0xcc, 0x87, 0xd1, 0x22, 0x9c, 0xaa, 0x07, 0x7e, 0x12, 0x05,
-	0x9e, 0xc7, 0xeb, 0x3f, 0xf1, 0x3d, 0x1f, 0xf7, 0x4d, 0xbf, 0x5d, 0x74, 0xfb, 0xd4, 0x4c, 0x27,
-	0x0a, 0xce, 0x7b, 0xce, 0xf6, 0xd3, 0xe7, 0x44, 0x62, 0x70, 0x9e, 0x85, 0x21, 0xb2, 0x9d, 0x90,
-	0xc8, 0x77, 0xbc, 0xeb, 0x78, 0x41, 0x7a, 0x65, 0xd9, 0x1a, 0xb8, 0x68, 0xb4, 0xe3, 0x14, 0x16,
-	0xb2, 0x95, 0xa3, 0xc4, 0x48, 0xbe, 0xe5, 0x8e, 0x12, 0xe9, 0x16, 0xb1, 0xff, 0x77, 0x29, 0x65,
-	0x90, 0xdd, 0x97, 0x53, 0x29, 0x56, 0x45, 0x44, 0x96, 0x5b, 0x61, 0x00, 0xb1, 0xd1, 0x28, 0x92,
-	0xb3, 0xaa, 0x22, 0xb2, 0x64, 0x32, 0xc2, 0x69, 0xbe, 0x68, 0x13, 0x2a, 0x1b, 0x41, 0x9c, 0xc8,
-	0xed, 0xc7, 0x11, 0x77, 0x3a, 0x97, 0x83, 0x38, 0x61, 0x56, 0x84, 0x7a, 0x6d, 0xda, 0x12, 0x63,
-	0xce, 0xc3, 0xfe, 0x2f, 0x56, 0xca, 0x07, 0x7f, 0x93, 0x05, 0x30, 0x6f, 0x11, 0x9f, 0x2e, 0x6b,
-	0x33, 0x64, 0xea, 0x47, 0x33, 0xe9, 0xa0, 0xef, 0xe8, 0x56, 0xde, 0xec, 0x36, 0xa5, 0x30, 0xc1,
-	0x48, 0x18, 0xd1, 0x55, 0x1f, 0xb7, 0xd2, 0x79, 0xbd, 0xa5, 0x22, 0x36, 0x18, 0x66, 0x6e, 0xfb,
-	0xbe, 0x29, 0xc2, 0xf6, 0x97, 0x2c, 0x18, 0x98, 0x76, 0xea, 0x9b, 0xc1, 0xfa, 0x3a, 0x7a, 0x0a,
-	0xaa, 0x8d, 0x76, 0x64, 0xa6, 0x18, 0x2b, 0xc7, 0xc1, 0xac, 0x68, 0xc7, 0x0a, 0x83, 0xce, 0xe1,
-	0x75, 0xa7, 0x2e, 0x33, 0xdc, 0xcb, 0x7c, 0x0e, 0x5f, 0x62, 0x2d, 0x58, 0x40, 0xd0, 0x73, 0x30,
-	0xd8, 0x72, 0xb6, 0xe5, 0xc3, 0xd9, 0x03, 0x80, 0x45, 0x0d, 0xc2, 0x26, 0x9e, 0xfd, 0x2f, 0x2c,
-	0x18, 0x9b, 0x76, 0x62, 0xb7, 0x3e, 0xd5, 0x4e, 0x36, 0xa6, 0xdd, 0x64, 0xad, 0x5d, 0xdf, 0x24,
-	0x09, 0x2f, 0x6b, 0x40, 0x7b, 0xd9, 0x8e, 0xe9, 0x52, 0x52, 0xfb, 0x3a, 0xd5, 0xcb, 0xeb, 0xa2,
-	0x1d, 0x2b, 0x0c, 0xf4, 0x3a, 0x0c, 0x86, 0x4e, 0x1c, 0xdf, 0x0e, 0xa2, 0x06, 0x26, 0xeb, 0xc5,
-	0x14, 0x15, 0x59, 0x21, 0xf5, 0x88, 0x24, 0x98, 0xac, 0x8b, 0xc3, 0x72, 0x4d, 0x1f, 0x9b, 0xcc,
-	0xec, 0x2f, 0x58, 0x70, 0x76, 0x9a, 0x38, 0x11, 0x89, 0x58, 0x0d, 0x12, 0xf5, 0x22, 0x33, 0x5e,
-	0xd0, 0x6e, 0xa0, 0xd7, 0xa0, 0x9a, 0xd0, 0x66, 0xda, 0x2d, 0xab, 0xd8, 0x6e, 0xb1, 0xb3, 0xee,
-	0x55, 0x41, 0x1c, 0x2b, 0x36, 0xf6, 0xdf, 0xb0, 0x60, 0x88, 0x1d, 0xd7, 0xcd, 0x92, 0xc4, 0x71,
-	0xbd, 0x8e, 0x52, 0x5d, 0x56, 0x8f, 0xa5, 0xba, 0xce, 0x43, 0xdf, 0x46, 0xd0, 0x22, 0xd9, 0xa3,
-	0xe6, 0xcb, 0x01, 0xdd, 0x56, 0x53, 0x08, 0x7a, 0x86, 0x7e, 0x78, 0xd7, 0x4f, 0x1c, 0xba, 0x04,
-	0xa4, 0x3b, 0x78, 0x94, 0x7f, 0x74, 0xd5, 0x8c, 0x4d, 0x1c, 0xfb, 0x9f, 0xd7, 0x60, 0x40, 0xc4,
-	0x45, 0xf4, 0x5c, 0xda, 0x42, 0xee, 0xef, 0x4b, 0x5d, 0xf7, 0xf7, 0x31, 0xf4, 0xd7, 0x59, 0xcd,
-	0x40, 0x61, 0x46, 0x5e, 0x2d, 0x24, 0x90, 0x86, 0x97, 0x21, 0xd4, 0xdd, 0xe2, 0xff, 0xb1, 0x60,
-	0x85, 0xbe, 0x68, 0xc1, 0x68, 0x3d, 0xf0, 0x7d, 0x52, 0xd7, 0x36, 0x4e, 0x5f, 0x11, 0xf1, 0x12,
-	0x33, 0x69, 0xa2, 0xfa, 0xac, 0x28, 0x03, 0xc0, 0x59, 0xf6, 0xe8, 0x05, 0x18, 0xe6, 0x63, 0x76,
-	0x23, 0xe5, 0xc3, 0xd6, 0x15, 0x9c, 0x4c, 0x20, 0x4e, 0xe3, 0xa2, 0x09, 0x7e, 0x16, 0x20, 0x6a,
-	0x25, 0xf5, 0x6b, 0x57, 0x9d, 0x51, 0x25, 0xc9, 0xc0, 0x40, 0x11, 0xa0, 0x88, 0xac, 0x47, 0x24,
-	0xde, 0x10, 0x71, 0x23, 0xcc, 0xbe, 0x1a, 0x38, 0x5c, 0x1e, 0x3b, 0xee, 0xa0, 0x84, 0x73, 0xa8,
-	0xa3, 0x4d, 0xb1, 0xc1, 0xac, 0x16, 0x21, 0x43, 0xc5, 0x67, 0xee, 0xba, 0xcf, 0x1c, 0x87, 0x4a,
-	0xbc, 0xe1, 0x44, 0x0d, 0x66, 0xd7, 0x95, 0x79, 0xee, 0xd4, 0x0a, 0x6d, 0xc0, 0xbc, 0x1d, 0xcd,
-	0xc2, 0x89, 0x4c, 0xfd, 0xa9, 0x98, 0x59, 0x6e, 0x55, 0x9d, 0x27, 0x93, 0xa9, 0x5c, 0x15, 0xe3,
-	0x8e, 0x27, 0x4c, 0xe7, 0xc3, 0xe0, 0x3e, 0xce, 0x87, 0x1d, 0x15, 0x9d, 0xc8, 0x5d, 0xc8, 0x2f,
-	0x15, 0x32, 0x00, 0x3d, 0x85, 0x22, 0x7e, 0x3e, 0x13, 0x8a, 0xc8, 0xdd, 0xc8, 0x37, 0x8a, 0xe9,
-	0xc0, 0xc1, 0xe3, 0x0e, 0xef, 0x67, 0x1c, 0xe1, 0x5f, 0x58, 0x20, 0xbf, 0xeb, 0x8c, 0x53, 0xdf,
-	0x20, 0x74, 0xca, 0xa0, 0x17, 0x61, 0x44, 0x6d, 0xa1, 0x67, 0x82, 0xb6, 0xcf, 0x43, 0x08, 0xcb,
-	0xfa, 0xc4, 0x02, 0xa7, 0xa0, 0x38, 0x83, 0x8d, 0x26, 0xa1, 0x46, 0xc7, 0x89, 0x3f, 0xca, 0x75,
-	0xad, 0xda, 0xa6, 0x4f, 0x2d, 0xcf, 0x8b, 0xa7, 0x34, 0x0e, 0x0a, 0xe0, 0xa4, 0xe7, 0xc4, 0x09,
-	0xeb, 0x01, 0xdd, 0x51, 0x1f, 0xb2, 0x8a, 0x04, 0x4b, 0xc6, 0x58, 0xc8, 0x12, 0xc2, 0x9d, 0xb4,
-	0xed, 0x7f, 0x5b, 0x81, 0xe1, 0x94, 0x64, 0x3c, 0xa0, 0x92, 0x7e, 0x0a, 0xaa, 0x52, 0x6f, 0x66,
-	0xcb, 0xe5, 0x28, 0xe5, 0xaa, 0x30, 0xa8, 0xd2, 0x5a, 0xd3, 0x5a, 0x35, 0x6b, 0x54, 0x18, 0x0a,
-	0x17, 0x9b, 0x78, 0x4c, 0x28, 0x27, 0x5e, 0x3c, 0xe3, 0xb9, 0xc4, 0x4f, 0x78, 0x37, 0x8b, 0x11,
-	0xca, 0xab, 0x0b, 0x2b, 0x26, 0x51, 0x2d, 0x94, 0x33, 0x00, 0x9c, 0x65, 0x8f, 0x3e, 0x65, 0xc1,
-	0xb0, 0x73, 0x3b, 0xd6, 0x85, 0x6d, 0x45, 0xd0, 0xe1, 0x11, 0x95, 0x54, 0xaa, 0x56, 0x2e, 0x77,
-	0xf9, 0xa6, 0x9a, 0x70, 0x9a, 0x29, 0x7a, 0xd3, 0x02, 0x44, 0xb6, 0x49, 0x5d, 0x86, 0x45, 0x8a,
-	0xbe, 0xf4, 0x17, 0xb1, 0xd3, 0xbc, 0xd8, 0x41, 0x97, 0x4b, 0xf5, 0xce, 0x76, 0x9c, 0xd3, 0x07,
-	0x74, 0x05, 0x50, 0xc3, 0x8d, 0x9d, 0x35, 0x8f, 0xcc, 0x04, 0x2d, 0x99, 0x40, 0x28, 0xce, 0x23,
-	0xcf, 0x89, 0x71, 0x46, 0xb3, 0x1d, 0x18, 0x38, 0xe7, 0x29, 0x36, 0xcb, 0xa2, 0x60, 0x7b, 0xe7,
-	0x7a, 0xe4, 0x31, 0x2d, 0x61, 0xce, 0x32, 0xd1, 0x8e, 0x15, 0x86, 0xfd, 0xcd, 0xb2, 0x5a, 0xca,
-	0x3a, 0x06, 0xd8, 0x31, 0x62, 0x11, 0xad, 0xc3, 0xc7, 0x22, 0xea, 0x58, 0x8a, 0xce, 0xb4, 0xd8,
-	0x54, 0x16, 0x5d, 0xe9, 0x3e, 0x65, 0xd1, 0xfd, 0xb4, 0x95, 0x2a, 0x49, 0x35, 0x78, 0xe1, 0xe5,
-	0x62, 0xe3, 0x8f, 0x27, 0x78, 0x9c, 0x47, 0x46, 0xaf, 0xa4, 0xc3, 0x7b, 0xa8, 0x1c, 0x37, 0xd0,
-	0x0e, 0x24, 0x87, 0xff, 0x7d, 0x19, 0x06, 0x0d, 0x1d, 0x9e, 0x6b, 0x90, 0x59, 0x0f, 0x98, 0x41,
-	0x56, 0x3a, 0x80, 0x41, 0xf6, 0x53, 0x50, 0xab, 0x4b, 0xfd, 0x52, 0x4c, 0x51, 0xe6, 0xac, 0xd6,
-	0xd2, 0x2a, 0x46, 0x35, 0x61, 0xcd, 0x13, 0xcd, 0xa5, 0x72, 0xaf, 0x84, 0x6e, 0xea, 0x63, 0xba,
-	0x29, 0x2f, 0x39, 0x4a, 0xe8, 0xa8, 0xce, 0x67, 0x58, 0xe5, 0xb2, 0xd0, 0x15, 0xef, 0x25, 0xb3,
-	0x04, 0x78, 0xe5, 0xb2, 0xe5, 0x79, 0xd9, 0x8c, 0x4d, 0x1c, 0xfb, 0xdb, 0x96, 0xfa, 0xb8, 0xf7,
-	0xa0, 0xc8, 0xc6, 0xad, 0x74, 0x91, 0x8d, 0x8b, 0x85, 0x0c, 0x73, 0x97, 0xea, 0x1a, 0xd7, 0x60,
-	0x60, 0x26, 0x68, 0xb5, 0x1c, 0xbf, 0x81, 0x7e, 0x08, 0x06, 0xea, 0xfc, 0xa7, 0x70, 0x29, 0xb1,
-	0x83, 0x49, 0x01, 0xc5, 0x12, 0x86, 0x1e, 0x81, 0x3e, 0x27, 0x6a, 0x4a, 0x37, 0x12, 0x0b, 0x0b,
-	0x9a, 0x8a, 0x9a, 0x31, 0x66, 0xad, 0xf6, 0x3f, 0xea, 0x03, 0x76, 0x9a, 0xee, 0x44, 0xa4, 0xb1,
-	0x1a, 0xb0, 0xa2, 0x90, 0xc7, 0x7a, 0x9c, 0xa7, 0xb7, 0x69, 0x0f, 0xf2, 0x91, 0x9e, 0x71, 0xac,
-	0x53, 0xbe, 0xc7, 0xc7, 0x3a, 0x5d, 0x4e, 0xea, 0xfa, 0x1e, 0xa0, 0x93, 0x3a, 0xfb, 0x73, 0x16,
-	0x20, 0x15, 0x82, 0xa1, 0x8f, 0xd2, 0x27, 0xa1, 0xa6, 0x82, 0x31, 0x84, 0x49, 0xa7, 0x45, 0x84,
-	0x04, 0x60, 0x8d, 0xd3, 0xc3, 0xde, 0xfc, 0x71, 0x29, 0xbf, 0xcb, 0xe9, 0x88, 0x62, 0x26, 0xf5,
-	0x85, 0x38, 0xb7, 0x7f, 0xab, 0x04, 0x0f, 0x71, 0x63, 0x60, 0xd1, 0xf1, 0x9d, 0x26, 0x69, 0xd1,
-	0x5e, 0xf5, 0x1a, 0x1c, 0x51, 0xa7, 0x9b, 0x42, 0x57, 0x46, 0x08, 0x1f, 0x75, 0xed, 0xf2, 0x35,
-	0xc7, 0x57, 0xd9, 0xbc, 0xef, 0x26, 0x98, 0x11, 0x47, 0x31, 0x54, 0xe5, 0x8d, 0x05, 0x42, 0x16,
-	0x17, 0xc4, 0x48, 0x89, 0x25, 0xa1, 0x37, 0x09, 0x56, 0x8c, 0xa8, 0x31, 0xe3, 0x05, 0xf5, 0x4d,
-	0x4c, 0xc2, 0x80, 0xc9, 0x5d, 0x23, 0x40, 0x73, 0x41, 0xb4, 0x63, 0x85, 0x61, 0xff, 0x96, 0x05,
-	0x59, 0x8d, 0x64, 0x54, 0xdf, 0xb3, 0xf6, 0xac, 0xbe, 0x77, 0x80, 0xfa, 0x75, 0x3f, 0x01, 0x83,
-	0x4e, 0x42, 0x8d, 0x08, 0xbe, 0xe1, 0x2f, 0x1f, 0xee, 0x40, 0x65, 0x31, 0x68, 0xb8, 0xeb, 0x2e,
-	0xdb, 0xe8, 0x9b, 0xe4, 0xec, 0xff, 0xd1, 0x07, 0x27, 0x3b, 0xf2, 0x69, 0xd0, 0xf3, 0x30, 0x54,
-	0x17, 0xd3, 0x23, 0x94, 0xae, 0xb4, 0x9a, 0x19, 0xd0, 0xa7, 0x61, 0x38, 0x85, 0xd9, 0xc3, 0x04,
-	0x9d, 0x87, 0x53, 0x11, 0x79, 0xad, 0x4d, 0xda, 0x64, 0x6a, 0x3d, 0x21, 0xd1, 0x0a, 0xa9, 0x07,
-	0x7e, 0x83, 0xd7, 0x88, 0x2c, 0x4f, 0x3f, 0x7c, 0x67, 0x77, 0xfc, 0x14, 0xee, 0x04, 0xe3, 0xbc,
-	0x67, 0x50, 0x08, 0xc3, 0x9e, 0x69, 0x03, 0x8a, 0xad, 0xc7, 0xa1, 0xcc, 0x47, 0x65, 0x23, 0xa4,
-	0x9a, 0x71, 0x9a, 0x41, 0xda, 0x90, 0xac, 0xdc, 0x27, 0x43, 0xf2, 0x93, 0xda, 0x90, 0xe4, 0x27,
-	0xff, 0x1f, 0x2c, 0x38, 0x9f, 0xea, 0xb8, 0x2d, 0xc9, 0x97, 0xa0, 0x2a, 0xa3, 0xa2, 0x7a, 0x8a,
-	0x26, 0x32, 0xe9, 0x74, 0x91, 0x68, 0x4f, 0xc0, 0x0f, 0x5e, 0x8c, 0x22, 0x63, 0x30, 0xaf, 0x05,
-	0xc9, 0x94, 0xe7, 0x05, 0xb7, 0xa9, 0x92, 0xbe, 0x1e, 0x13, 0xe1, 0xdb, 0xb1, 0xef, 0x96, 0x20,
-	0x67, 0x9b, 0x44, 0xd7, 0xa3, 0xb6, 0x0c, 0x52, 0xeb, 0xf1, 0x60, 0xd6, 0x01, 0xda, 0xe6, 0x91,
-	0x63, 0x5c, 0x07, 0x7e, 0xa0, 0xe8, 0x6d, 0x9e, 0x0e, 0x26, 0x53, 0x69, 0x20, 0x2a, 0xa0, 0xec,
-	0x02, 0x80, 0x36, 0xe8, 0x44, 0x90, 0xbf, 0x3a, 0x98, 0xd6, 0x76, 0x1f, 0x36, 0xb0, 0xe8, 0xae,
-	0xdf, 0xf5, 0xe3, 0xc4, 0xf1, 0xbc, 0xcb, 0xae, 0x9f, 0x08, 0xf7, 0xa5, 0x52, 0xf6, 0xf3, 0x1a,
-	0x84, 0x4d, 0xbc, 0x73, 0xef, 0x36, 0xbe, 0xdf, 0x41, 0xbe, 0xfb, 0x06, 0x9c, 0x9d, 0x73, 0x13,
-	0x95, 0x9a, 0xa2, 0xe6, 0x1b, 0xb5, 0xd7, 0x54, 0xaa, 0x95, 0xd5, 0x35, 0xd5, 0xca, 0x48, 0x0d,
-	0x29, 0xa5, 0x33, 0x59, 0xb2, 0xa9, 0x21, 0xf6, 0xf3, 0x70, 0x7a, 0xce, 0x4d, 0x2e, 0xb9, 0x1e,
-	0x39, 0x20, 0x13, 0xfb, 0x37, 0xfb, 0x61, 0xc8, 0x4c, 0xb2, 0x3c, 0x48, 0xb6, 0xd8, 0x17, 0xa8,
-	0x49, 0x26, 0xde, 0xce, 0x55, 0xc7, 0x7a, 0x37, 0x8f, 0x9c, 0xf1, 0x99, 0x3f, 0x62, 0x86, 0x55,
-	0xa6, 0x79, 0x62, 0xb3, 0x03, 0xe8, 0x36, 0x54, 0xd6, 0x59, 0xea, 0x42, 0xb9, 0x88, 0xd8, 0x87,
-	0xbc, 0x11, 0xd5, 0xcb, 0x91, 0x27, 0x3f, 0x70, 0x7e, 0x54, 0x93, 0x46, 0xe9, 0x7c, 0x38, 0x23,
-	0x5c, 0x56, 0x64, 0xc2, 0x29, 0x8c, 0x6e, 0x2a, 0xa1, 0x72, 0x08, 0x95, 0x90, 0x12, 0xd0, 0xfd,
-	0xf7, 0x49, 0x40, 0xb3, 0x34, 0x94, 0x64, 0x83, 0xd9, 0x79, 0x22, 0x3f, 0x60, 0x80, 0x0d, 0x82,
-	0x91, 0x86, 0x92, 0x02, 0xe3, 0x2c, 0x3e, 0xfa, 0x98, 0x12, 0xf1, 0xd5, 0x22, 0x3c, 0xbf, 0xe6,
-	0x8c, 0x3e, 0x6e, 0xe9, 0xfe, 0xb9, 0x12, 0x8c, 0xcc, 0xf9, 0xed, 0xe5, 0xb9, 0xe5, 0xf6, 0x9a,
-	0xe7, 0xd6, 0xaf, 0x92, 0x1d, 0x2a, 0xc2, 0x37, 0xc9, 0xce, 0xfc, 0xac, 0x58, 0x41, 0x6a, 0xce,
-	0x5c, 0xa5, 0x8d, 0x98, 0xc3, 0xa8, 0x30, 0x5a, 0x77, 0xfd, 0x26, 0x89, 0xc2, 0xc8, 0x15, 0x4e,
-	0x59, 0x43, 0x18, 0x5d, 0xd2, 0x20, 0x6c, 0xe2, 0x51, 0xda, 0xc1, 0x6d, 0x9f, 0x44, 0x59, 0x83,
-	0x77, 0x89, 0x36, 0x62, 0x0e, 0xa3, 0x48, 0x49, 0xd4, 0x8e, 0x13, 0x31, 0x19, 0x15, 0xd2, 0x2a,
-	0x6d, 0xc4, 0x1c, 0x46, 0x57, 0x7a, 0xdc, 0x5e, 0x63, 0xa1, 0x25, 0x99, 0x64, 0x84, 0x15, 0xde,
-	0x8c, 0x25, 0x9c, 0xa2, 0x6e, 0x92, 0x9d, 0x59, 0xba, 0x3b, 0xce, 0xe4, 0x24, 0x5d, 0xe5, 0xcd,
-	0x58, 0xc2, 0x59, 0x15, 0xcb, 0xf4, 0x70, 0x7c, 0xcf, 0x55, 0xb1, 0x4c, 0x77, 0xbf, 0xcb, 0x3e,
-	0xfb, 0x97, 0x2d, 0x18, 0x32, 0x03, 0xc2, 0x50, 0x33, 0x63, 0x0b, 0x2f, 0x75, 0x14, 0x41, 0x7e,
-	0x6f, 0xde, 0x05, 0x74, 0x4d, 0x37, 0x09, 0xc2, 0xf8, 0x69, 0xe2, 0x37, 0x5d, 0x9f, 0xb0, 0x73,
-	0x7e, 0x1e, 0x48, 0x96, 0x8a, 0x36, 0x9b, 0x09, 0x1a, 0xe4, 0x10, 0xc6, 0xb4, 0x7d, 0x13, 0x4e,
-	0x76, 0x24, 0xa2, 0xf5, 0x60, 0x82, 0xec, 0x9b, 0x06, 0x6c, 0x63, 0x18, 0xa4, 0x84, 0x65, 0x25,
-	0xa5, 0x19, 0x38, 0xc9, 0x17, 0x12, 0xe5, 0xb4, 0x52, 0xdf, 0x20, 0x2d, 0x95, 0x5c, 0xc8, 0x4e,
-	0x00, 0x6e, 0x64, 0x81, 0xb8, 0x13, 0xdf, 0xfe, 0xbc, 0x05, 0xc3, 0xa9, 0xdc, 0xc0, 0x82, 0x8c,
-	0x25, 0xb6, 0xd2, 0x02, 0x16, 0x9f, 0xc8, 0x82, 0xb4, 0xcb, 0x4c, 0x99, 0xea, 0x95, 0xa6, 0x41,
-	0xd8, 0xc4, 0xb3, 0xbf, 0x54, 0x82, 0xaa, 0x8c, 0xf1, 0xe8, 0xa1, 0x2b, 0x9f, 0xb5, 0x60, 0x58,
-	0x9d, 0xba, 0x30, 0xa7, 0x5a, 0xa9, 0x88, 0x44, 0x0c, 0xda, 0x03, 0xb5, 0x2d, 0xf7, 0xd7, 0x03,
-	0x6d, 0xb9, 0x63, 0x93, 0x19, 0x4e, 0xf3, 0x46, 0x37, 0x00, 0xe2, 0x9d, 0x38, 0x21, 0x2d, 0xc3,
-	0xbd, 0x67, 0x1b, 0x2b, 0x6e, 0xa2, 0x1e, 0x44, 0x84, 0xae, 0xaf, 0x6b, 0x41, 0x83, 0xac, 0x28,
-	0x4c, 0x6d, 0x42, 0xe9, 0x36, 0x6c, 0x50, 0xb2, 0xff, 0x41, 0x09, 0x4e, 0x64, 0xbb, 0x84, 0x3e,
-	0x08, 0x43, 0x92, 0xbb, 0x71, 0x97, 0x9e, 0x0c, 0x6c, 0x19, 0xc2, 0x06, 0xec, 0xee, 0xee, 0xf8,
-	0x78, 0xe7, 0x65, 0x86, 0x13, 0x26, 0x0a, 0x4e, 0x11, 0xe3, 0x47, 0x5f, 0xe2, 0x8c, 0x76, 0x7a,
-	0x67, 0x2a, 0x0c, 0xc5, 0xf9, 0x95, 0x71, 0xf4, 0x65, 0x42, 0x71, 0x06, 0x1b, 0x2d, 0xc3, 0x69,
-	0xa3, 0xe5, 0x1a, 0x71, 0x9b, 0x1b, 0x6b, 0x41, 0x24, 0x77, 0x60, 0x8f, 0xe8, 0xd0, 0xb3, 0x4e,
-	0x1c, 0x9c, 0xfb, 0x24, 0xd5, 0xf6, 0x75, 0x27, 0x74, 0xea, 0x6e, 0xb2, 0x23, 0xfc, 0x95, 0x4a,
-	0x36, 0xcd, 0x88, 0x76, 0xac, 0x30, 0xec, 0x45, 0xe8, 0xeb, 0x71, 0x06, 0xf5, 0x64, 0xf9, 0xbf,
-	0x04, 0x55, 0x4a, 0x4e, 0x9a, 0x77, 0x45, 0x90, 0x0c, 0xa0, 0x2a, 0xef, 0xb8, 0x41, 0x36, 0x94,
-	0x5d, 0x47, 0x9e, 0x2e, 0xaa, 0xd7, 0x9a, 0x8f, 0xe3, 0x36, 0xdb, 0x4c, 0x53, 0x20, 0x7a, 0x1c,
-	0xca, 0x64, 0x3b, 0xcc, 0x1e, 0x23, 0x5e, 0xdc, 0x0e, 0xdd, 0x88, 0xc4, 0x14, 0x89, 0x6c, 0x87,
-	0xe8, 0x1c, 0x94, 0xdc, 0x86, 0x50, 0x52, 0x20, 0x70, 0x4a, 0xf3, 0xb3, 0xb8, 0xe4, 0x36, 0xec,
-	0x6d, 0xa8, 0xa9, 0x4b, 0x75, 0xd0, 0xa6, 0x94, 0xdd, 0x56, 0x11, 0x41, 0x59, 0x92, 0x6e, 0x17,
-	0xa9, 0xdd, 0x06, 0xd0, 0x49, 0x92, 0x45, 0xc9, 0x97, 0xf3, 0xd0, 0x57, 0x0f, 0x44, 0x02, 0x77,
-	0x55, 0x93, 0x61, 0x42, 0x9b, 0x41, 0xec, 0x9b, 0x30, 0x72, 0xd5, 0x0f, 0x6e, 0xb3, 0x92, 0xfe,
-	0xac, 0x82, 0x1d, 0x25, 0xbc, 0x4e, 0x7f, 0x64, 0x4d, 0x04, 0x06, 0xc5, 0x1c, 0xa6, 0x6a, 0x6b,
-	0x95, 0xba, 0xd5, 0xd6, 0xb2, 0x3f, 0x6e, 0xc1, 0x90, 0xca, 0x96, 0x9a, 0xdb, 0xda, 0xa4, 0x74,
-	0x9b, 0x51, 0xd0, 0x0e, 0xb3, 0x74, 0xd9, 0xb5, 0x57, 0x98, 0xc3, 0xcc, 0x34, 0xc4, 0xd2, 0x3e,
-	0x69, 0x88, 0xe7, 0xa1, 0x6f, 0xd3, 0xf5, 0x1b, 0xd9, 0x7b, 0x5c, 0xae, 0xba, 0x7e, 0x03, 0x33,
-	0x88, 0xfd, 0x4d, 0x0b, 0x4e, 0xa8, 0x2e, 0x48, 0x85, 0xf0, 0x3c, 0x0c, 0xad, 0xb5, 0x5d, 0xaf,
-	0x21, 0x4b, 0xf3, 0x65, 0x3c, 0x2a, 0xd3, 0x06, 0x0c, 0xa7, 0x30, 0xe9, 0xbe, 0x6e, 0xcd, 0xf5,
-	0x9d, 0x68, 0x67, 0x59, 0x6b, 0x20, 0x25, 0x94, 0xa6, 0x15, 0x04, 0x1b, 0x58, 0x94, 0x5b, 0x4c,
-	0x12, 0x1d, 0x9e, 0xc9, 0x3f, 0x84, 0xe2, 0xb6, 0x62, 0xc0, 0x70, 0x0a, 0xd3, 0x7e, 0xa3, 0x0c,
-	0x23, 0xe9, 0x6c, 0xb3, 0x1e, 0x36, 0x66, 0x8f, 0x43, 0x85, 0x25, 0xa0, 0x65, 0x27, 0x05, 0xaf,
-	0x83, 0xc7, 0x61, 0x28, 0x86, 0x7e, 0x5e, 0xfa, 0xa2, 0x98, 0xdb, 0x93, 0x54, 0x27, 0x95, 0x07,
-	0x87, 0xc5, 0xca, 0x89, 0x6a, 0x1b, 0x82, 0x15, 0xfa, 0x94, 0x05, 0x03, 0x41, 0x68, 0x56, 0x73,
-	0xfa, 0x40, 0x91, 0x99, 0x78, 0x22, 0x11, 0x48, 0xd8, 0xd2, 0x6a, 0xd2, 0xc8, 0x0f, 0x29, 0x59,
-	0x9f, 0x7b, 0x0f, 0x0c, 0x99, 0x98, 0xfb, 0x99, 0xd3, 0x55, 0xd3, 0x9c, 0xfe, 0xac, 0x39, 0x9d,
-	0x44, 0xae, 0x61, 0x0f, 0x0b, 0xf5, 0x3a, 0x54, 0xea, 0x2a, 0xb8, 0xe1, 0x50, 0xa5, 0x60, 0x55,
-	0x2d, 0x0a, 0x76, 0xcc, 0xc4, 0xa9, 0xd9, 0xdf, 0xb6, 0x8c, 0xf9, 0x81, 0x49, 0x3c, 0xdf, 0x40,
-	0x11, 0x94, 0x9b, 0x5b, 0x9b, 0xc2, 0x88, 0xbd, 0x52, 0xd0, 0xf0, 0xce, 0x6d, 0x6d, 0xea, 0xf9,
-	0x6a, 0xb6, 0x62, 0xca, 0xac, 0x07, 0x37, 0x63, 0x2a, 0x25, 0xb5, 0xbc, 0x7f, 0x4a, 0xaa, 0xfd,
-	0x66, 0x09, 0x4e, 0x76, 0x4c, 0x2a, 0xf4, 0x3a, 0x54, 0x22, 0xfa, 0x96, 0xe2, 0xf5, 0x16, 0x0a,
-	0x4b, 0x22, 0x8d, 0xe7, 0x1b, 0x5a, 0x63, 0xa7, 0xdb, 0x31, 0x67, 0x89, 0xae, 0x00, 0xd2, 0x21,
-	0x38, 0xca, 0xc7, 0xc9, 0x5f, 0x59, 0x9d, 0xd3, 0x4f, 0x75, 0x60, 0xe0, 0x9c, 0xa7, 0xd0, 0x0b,
-	0x59, 0x57, 0x69, 0x39, 0x7d, 0x32, 0xba, 0x97, 0xd7, 0xd3, 0xfe, 0x67, 0x25, 0x18, 0x4e, 0x15,
-	0xd7, 0x42, 0x1e, 0x54, 0x89, 0xc7, 0x8e, 0x0d, 0xa4, 0x9a, 0x3a, 0x6a, 0xa9, 0x6c, 0xa5, 0x5a,
-	0x2f, 0x0a, 0xba, 0x58, 0x71, 0x78, 0x30, 0x8e, 0xef, 0x9f, 0x87, 0x21, 0xd9, 0xa1, 0x0f, 0x38,
-	0x2d, 0x4f, 0x0c, 0xa0, 0x9a, 0xa3, 0x17, 0x0d, 0x18, 0x4e, 0x61, 0xda, 0xbf, 0x5d, 0x86, 0x31,
-	0x7e, 0xce, 0xd2, 0x50, 0x33, 0x6f, 0x51, 0xee, 0xd4, 0xfe, 0xaa, 0x2e, 0x81, 0xc7, 0x07, 0x72,
-	0xed, 0xa8, 0x37, 0x53, 0xe4, 0x33, 0xea, 0x29, 0xea, 0xec, 0xab, 0x99, 0xa8, 0x33, 0x6e, 0xb0,
-	0x37, 0x8f, 0xa9, 0x47, 0xdf, 0x5b, 0x61, 0x68, 0x7f, 0xb7, 0x04, 0xa3, 0x99, 0x6b, 0x3f, 0xd0,
-	0x1b, 0xe9, 0x4a, 0xd1, 0x56, 0x11, 0xde, 0xf8, 0x3d, 0x6f, 0x82, 0x38, 0x58, 0xbd, 0xe8, 0xfb,
-	0xb4, 0x54, 0xec, 0x3f, 0x2c, 0xc1, 0x48, 0xfa, 0xbe, 0x92, 0x07, 0x70, 0xa4, 0xde, 0x09, 0x35,
-	0x56, 0x92, 0x9f, 0x5d, 0xe3, 0xca, 0x9d, 0xf9, 0xbc, 0xfa, 0xb9, 0x6c, 0xc4, 0x1a, 0xfe, 0x40,
-	0x94, 0xe1, 0xb6, 0xff, 0x9e, 0x05, 0x67, 0xf8, 0x5b, 0x66, 0xe7, 0xe1, 0xcf, 0xe5, 0x8d, 0xee,
-	0x2b, 0xc5, 0x76, 0x30, 0x53, 0xba, 0x71, 0xbf, 0xf1, 0x65, 0xd7, 0x47, 0x8a, 0xde, 0xa6, 0xa7,
-	0xc2, 0x03, 0xd8, 0xd9, 0x03, 0x4d, 0x06, 0xfb, 0x0f, 0xcb, 0xa0, 0x6f, 0xcc, 0x44, 0xae, 0xc8,
-	0xdf, 0x2c, 0xa4, 0x84, 0xe5, 0xca, 0x8e, 0x5f, 0xd7, 0x77, 0x73, 0x56, 0x33, 0xe9, 0x9b, 0x3f,
-	0x6b, 0xc1, 0xa0, 0xeb, 0xbb, 0x89, 0xeb, 0xb0, 0x0d, 0x78, 0x31, 0xb7, 0xf9, 0x29, 0x76, 0xf3,
-	0x9c, 0x72, 0x10, 0x99, 0x27, 0x40, 0x8a, 0x19, 0x36, 0x39, 0xa3, 0x0f, 0x8b, 0xc0, 0xf0, 0x72,
-	0x61, 0x99, 0xc7, 0xd5, 0x4c, 0x34, 0x78, 0x48, 0x0d, 0xaf, 0x24, 0x2a, 0x28, 0x61, 0x1f, 0x53,
-	0x52, 0xaa, 0x1a, 0xb2, 0xbe, 0xbb, 0x9c, 0x36, 0x63, 0xce, 0xc8, 0x8e, 0x01, 0x75, 0x8e, 0xc5,
-	0x01, 0x83, 0x6e, 0x27, 0xa1, 0xe6, 0xb4, 0x93, 0xa0, 0x45, 0x87, 0x49, 0x1c, 0x52, 0xe9, 0xb0,
-	0x62, 0x09, 0xc0, 0x1a, 0xc7, 0x7e, 0xa3, 0x02, 0x99, 0x84, 0x4a, 0xb4, 0x6d, 0xde, 0xf6, 0x6a,
-	0x15, 0x7b, 0xdb, 0xab, 0xea, 0x4c, 0xde, 0x8d, 0xaf, 0xa8, 0x09, 0x95, 0x70, 0xc3, 0x89, 0xa5,
-	0x59, 0xfd, 0x92, 0xda, 0xc7, 0xd1, 0xc6, 0xbb, 0xbb, 0xe3, 0x3f, 0xde, 0x9b, 0xbf, 0x96, 0xce,
-	0xd5, 0x49, 0x5e, 0x9a, 0x45, 0xb3, 0x66, 0x34, 0x30, 0xa7, 0x7f, 0x90, 0xfb, 0x0c, 0x3f, 0x21,
-	0xee, 0x1e, 0xc0, 0x24, 0x6e, 0x7b, 0x89, 0x98, 0x0d, 0x2f, 0x15, 0xb8, 0xca, 0x38, 0x61, 0x5d,
-	0x0a, 0x80, 0xff, 0xc7, 0x06, 0x53, 0xf4, 0x41, 0xa8, 0xc5, 0x89, 0x13, 0x25, 0x87, 0x4c, 0xde,
-	0x55, 0x83, 0xbe, 0x22, 0x89, 0x60, 0x4d, 0x0f, 0xbd, 0xcc, 0x2a, 0xfa, 0xba, 0xf1, 0xc6, 0x21,
-	0xf3, 0x39, 0x64, 0xf5, 0x5f, 0x41, 0x01, 0x1b, 0xd4, 0xd0, 0x05, 0x00, 0x36, 0xb7, 0x79, 0x28,
-	0x61, 0x95, 0xf9, 0xa7, 0x94, 0x28, 0xc4, 0x0a, 0x82, 0x0d, 0x2c, 0xfb, 0x47, 0x20, 0x5d, 0xcb,
-	0x02, 0x8d, 0xcb, 0xd2, 0x19, 0xdc, 0x7f, 0xcd, 0xf2, 0x32, 0x52, 0x55, 0x2e, 0x7e, 0xdd, 0x02,
-	0xb3, 0xe0, 0x06, 0x7a, 0x8d, 0x57, 0xf6, 0xb0, 0x8a, 0x38, 0x73, 0x34, 0xe8, 0x4e, 0x2c, 0x3a,
-	0x61, 0xe6, 0xf0, 0x5b, 0x96, 0xf7, 0x38, 0xf7, 0x6e, 0xa8, 0x4a, 0xe8, 0x81, 0x8c, 0xba, 0x8f,
-	0xc1, 0xa9, 0xec, 0x5d, 0xf8, 0xe2, 0xbc, 0x6a, 0x7f, 0xa7, 0x91, 0xf4, 0x04, 0x95, 0xba, 0x79,
-	0x82, 0x7a, 0xb8, 0xf3, 0xf7, 0x37, 0x2c, 0x38, 0xbf, 0xdf, 0x95, 0xfd, 0xe8, 0x11, 0xe8, 0xbb,
-	0xed, 0x44, 0xb2, 0xd4, 0x3a, 0x13, 0x94, 0x37, 0x9d, 0xc8, 0xc7, 0xac, 0x15, 0xed, 0x40, 0x3f,
-	0x8f, 0x37, 0x13, 0xd6, 0xfa, 0x11, 0xd7, 0x46, 0xce, 0x70, 0xe8, 0xed, 0x02, 0x8f, 0x75, 0xc3,
-	0x82, 0xa1, 0xfd, 0x1d, 0x0b, 0xd0, 0xd2, 0x16, 0x89, 0x22, 0xb7, 0x61, 0x44, 0xc8, 0xb1, 0x3b,
-	0x7c, 0x8c, 0xbb, 0x7a, 0xcc, 0xf4, 0xdd, 0xcc, 0x1d, 0x3e, 0xc6, 0xbf, 0xfc, 0x3b, 0x7c, 0x4a,
-	0x07, 0xbb, 0xc3, 0x07, 0x2d, 0xc1, 0x99, 0x16, 0xdf, 0x6e, 0xf0, 0x7b, 0x31, 0xf8, 0xde, 0x43,
-	0x25, 0xcb, 0x9d, 0xbd, 0xb3, 0x3b, 0x7e, 0x66, 0x31, 0x0f, 0x01, 0xe7, 0x3f, 0x67, 0xbf, 0x1b,
-	0x10, 0x0f, 0x8c, 0x9b, 0xc9, 0x8b, 0x72, 0xea, 0xea, 0x7e, 0xb1, 0xbf, 0x52, 0x81, 0xd1, 0x4c,
-	0x21, 0x5e, 0xba, 0xd5, 0xeb, 0x0c, 0xab, 0x3a, 0xb2, 0xfe, 0xee, 0xec, 0x5e, 0x4f, 0x81, 0x5a,
-	0x3e, 0x54, 0x5c, 0x3f, 0x6c, 0x27, 0xc5, 0xe4, 0xc7, 0xf2, 0x4e, 0xcc, 0x53, 0x82, 0x86, 0xa3,
-	0x99, 0xfe, 0xc5, 0x9c, 0x4d, 0x91, 0x61, 0x5f, 0x29, 0x63, 0xbc, 0xef, 0x3e, 0xb9, 0x03, 0x3e,
-	0xa1, 0x83, 0xb0, 0x2a, 0x45, 0x38, 0x16, 0x33, 0x93, 0xe5, 0xb8, 0x0f, 0xe9, 0x7f, 0xad, 0x04,
-	0x83, 0xc6, 0x47, 0x43, 0xbf, 0x94, 0x2e, 0x47, 0x65, 0x15, 0xf7, 0x4a, 0x8c, 0xfe, 0x84, 0x2e,
-	0x38, 0xc5, 0x5f, 0xe9, 0x89, 0xce, 0x4a, 0x54, 0x77, 0x77, 0xc7, 0x4f, 0x64, 0x6a, 0x4d, 0xa5,
-	0xaa, 0x53, 0x9d, 0xfb, 0x28, 0x8c, 0x66, 0xc8, 0xe4, 0xbc, 0xf2, 0xaa, 0xf9, 0xca, 0x47, 0x76,
-	0x4b, 0x99, 0x43, 0xf6, 0x0d, 0x3a, 0x64, 0x22, 0x45, 0x30, 0xf0, 0x48, 0x0f, 0x3e, 0xd8, 0x4c,
-	0x26, 0x70, 0xa9, 0xc7, 0x4c, 0xe0, 0x27, 0xa1, 0x1a, 0x06, 0x9e, 0x5b, 0x77, 0x55, 0xcd, 0x47,
-	0x96, 0x7b, 0xbc, 0x2c, 0xda, 0xb0, 0x82, 0xa2, 0xdb, 0x50, 0xbb, 0x75, 0x3b, 0xe1, 0xe7, 0x46,
-	0xc2, 0xbf, 0x5d, 0xd4, 0x71, 0x91, 0x32, 0x5a, 0xd4, 0xc1, 0x14, 0xd6, 0xbc, 0x90, 0x0d, 0xfd,
-	0x4c, 0x09, 0xca, 0xe4, 0x02, 0xe6, 0x7b, 0x67, 0xda, 0x31, 0xc6, 0x02, 0x62, 0x7f, 0xbd, 0x06,
-	0xa7, 0xf3, 0xaa, 0xa1, 0xa3, 0x8f, 0x40, 0x3f, 0xef, 0x63, 0x31, 0x17, 0x6e, 0xe4, 0xf1, 0x98,
-	0x63, 0x04, 0x45, 0xb7, 0xd8, 0x6f, 0x2c, 0x78, 0x0a, 0xee, 0x9e, 0xb3, 0x26, 0x66, 0xc8, 0xf1,
-	0x70, 0x5f, 0x70, 0x34, 0xf7, 0x05, 0x87, 0x73, 0xf7, 0x9c, 0x35, 0xb4, 0x0d, 0x95, 0xa6, 0x9b,
-	0x10, 0x47, 0x38, 0x11, 0x6e, 0x1e, 0x0b, 0x73, 0xe2, 0x70, 0x2b, 0x8d, 0xfd, 0xc4, 0x9c, 0x21,
-	0xfa, 0x9a, 0x05, 0xa3, 0x6b, 0xe9, 0xb4, 0x7f, 0x21, 0x3c, 0x9d, 0x63, 0xa8, 0x78, 0x9f, 0x66,
-	0xc4, 0x2f, 0xb1, 0xca, 0x34, 0xe2, 0x6c, 0x77, 0xd0, 0x27, 0x2d, 0x18, 0x58, 0x77, 0x3d, 0xa3,
-	0xe8, 0xf0, 0x31, 0x7c, 0x9c, 0x4b, 0x8c, 0x81, 0xde, 0x71, 0xf0, 0xff, 0x31, 0x96, 0x9c, 0xbb,
-	0x69, 0xaa, 0xfe, 0xa3, 0x6a, 0xaa, 0x81, 0xfb, 0xa4, 0xa9, 0x3e, 0x63, 0x41, 0x4d, 0x8d, 0xb4,
-	0x48, 0xe5, 0xfe, 0xe0, 0x31, 0x7e, 0x72, 0xee, 0x39, 0x51, 0x7f, 0xb1, 0x66, 0x8e, 0xbe, 0x68,
-	0xc1, 0xa0, 0xf3, 0x7a, 0x3b, 0x22, 0x0d, 0xb2, 0x15, 0x84, 0xb1, 0xb8, 0x01, 0xf3, 0x95, 0xe2,
-	0x3b, 0x33, 0x45, 0x99, 0xcc, 0x92, 0xad, 0xa5, 0x30, 0x16, 0x89, 0x4f, 0xba, 0x01, 0x9b, 0x5d,
-	0xb0, 0x77, 0x4b, 0x30, 0xbe, 0x0f, 0x05, 0xf4, 0x3c, 0x0c, 0x05, 0x51, 0xd3, 0xf1, 0xdd, 0xd7,
-	0xcd, 0x3a, 0x1e, 0xca, 0xca, 0x5a, 0x32, 0x60, 0x38, 0x85, 0x69, 0x26, 0x9b, 0x97, 0xf6, 0x49,
-	0x36, 0x3f, 0x0f, 0x7d, 0x11, 0x09, 0x83, 0xec, 0x66, 0x81, 0x25, 0x1d, 0x30, 0x08, 0x7a, 0x14,
-	0xca, 0x4e, 0xe8, 0x8a, 0x10, 0x36, 0xb5, 0x07, 0x9a, 0x5a, 0x9e, 0xc7, 0xb4, 0x3d, 0x55, 0xfb,
-	0xa2, 0x72, 0x4f, 0x6a, 0x5f, 0x50, 0x35, 0x20, 0xce, 0x2e, 0xfa, 0xb5, 0x1a, 0x48, 0x9f, 0x29,
-	0xd8, 0x6f, 0x96, 0xe1, 0xd1, 0x3d, 0xe7, 0x8b, 0x8e, 0xe0, 0xb3, 0xf6, 0x88, 0xe0, 0x93, 0xc3,
-	0x53, 0xda, 0x6f, 0x78, 0xca, 0x5d, 0x86, 0xe7, 0x93, 0x74, 0x19, 0xc8, 0xfa, 0x27, 0xc5, 0xdc,
-	0x61, 0xd8, 0xad, 0x9c, 0x8a, 0x58, 0x01, 0x12, 0x8a, 0x35, 0x5f, 0xba, 0x07, 0x48, 0x25, 0x5a,
-	0x57, 0x8a, 0x50, 0x03, 0x5d, 0xeb, 0xa1, 0xf0, 0xb9, 0xdf, 0x2d, 0x7b, 0xdb, 0xfe, 0xf9, 0x12,
-	0x3c, 0xde, 0x83, 0xf4, 0x36, 0x67, 0xb1, 0xd5, 0xe3, 0x2c, 0xfe, 0xde, 0xfe, 0x4c, 0xf6, 0x5f,
-	0xb3, 0xe0, 0x5c, 0x77, 0xe5, 0x81, 0x9e, 0x81, 0xc1, 0xb5, 0xc8, 0xf1, 0xeb, 0x1b, 0xec, 0x5e,
-	0x56, 0x39, 0x28, 0x6c, 0xac, 0x75, 0x33, 0x36, 0x71, 0xe8, 0xf6, 0x96, 0xc7, 0x24, 0x18, 0x18,
-	0x32, 0x3d, 0x95, 0x6e, 0x6f, 0x57, 0xb3, 0x40, 0xdc, 0x89, 0x6f, 0xff, 0x79, 0x29, 0xbf, 0x5b,
-	0xdc, 0xc8, 0x38, 0xc8, 0x77, 0x12, 0x5f, 0xa1, 0xd4, 0x83, 0x2c, 0x29, 0xdf, 0x6b, 0x59, 0xd2,
-	0xd7, 0x4d, 0x96, 0xa0, 0x59, 0x38, 0x61, 0x5c, 0x9c, 0xc3, 0x53, 0x8e, 0x79, 0xa8, 0xae, 0xaa,
-	0x00, 0xb2, 0x9c, 0x81, 0xe3, 0x8e, 0x27, 0xd0, 0x53, 0x50, 0x75, 0xfd, 0x98, 0xd4, 0xdb, 0x11,
-	0x0f, 0x11, 0x37, 0xd2, 0xbc, 0xe6, 0x45, 0x3b, 0x56, 0x18, 0xf6, 0x2f, 0x97, 0xe0, 0x6c, 0x57,
-	0x3b, 0xeb, 0x1e, 0xc9, 0x2e, 0xf3, 0x73, 0xf4, 0xdd, 0x9b, 0xcf, 0x61, 0x0e, 0x52, 0x65, 0xdf,
-	0x41, 0xfa, 0xa3, 0xee, 0x13, 0x93, 0xda, 0xdc, 0xdf, 0xb7, 0xa3, 0xf4, 0x02, 0x0c, 0x3b, 0x61,
-	0xc8, 0xf1, 0x58, 0xa4, 0x67, 0xa6, 0x02, 0xd0, 0x94, 0x09, 0xc4, 0x69, 0xdc, 0x9e, 0xb4, 0xe7,
-	0x9f, 0x58, 0x50, 0xc3, 0x64, 0x9d, 0x4b, 0x07, 0x74, 0x4b, 0x0c, 0x91, 0x55, 0x44, 0xad, 0x50,
-	0x3a, 0xb0, 0xb1, 0xcb, 0x6a, 0x68, 0xe6, 0x0d, 0x76, 0xe7, 0xc5, 0x46, 0xa5, 0x03, 0x5d, 0x6c,
-	0xa4, 0xae, 0xb6, 0x29, 0x77, 0xbf, 0xda, 0xc6, 0xfe, 0xc6, 0x00, 0x7d, 0xbd, 0x30, 0x98, 0x89,
-	0x48, 0x23, 0xa6, 0xdf, 0xb7, 0x1d, 0x79, 0x62, 0x92, 0xa8, 0xef, 0x7b, 0x1d, 0x2f, 0x60, 0xda,
-	0x9e, 0x3a, 0x8a, 0x29, 0x1d, 0xa8, 0xfe, 0x49, 0x79, 0xdf, 0xfa, 0x27, 0x2f, 0xc0, 0x70, 0x1c,
-	0x6f, 0x2c, 0x47, 0xee, 0x96, 0x93, 0x90, 0xab, 0x64, 0x47, 0x58, 0x59, 0xba, 0x72, 0xc0, 0xca,
-	0x65, 0x0d, 0xc4, 0x69, 0x5c, 0x34, 0x07, 0x27, 0x75, 0x15, 0x12, 0x12, 0x25, 0x2c, 0x2f, 0x80,
-	0xcf, 0x04, 0x95, 0x26, 0xac, 0xeb, 0x96, 0x08, 0x04, 0xdc, 0xf9, 0x0c, 0x95, 0x6f, 0xa9, 0x46,
-	0xda, 0x91, 0xfe, 0xb4, 0x7c, 0x4b, 0xd1, 0xa1, 0x7d, 0xe9, 0x78, 0x02, 0x2d, 0xc2, 0x29, 0x3e,
-	0x31, 0xa6, 0xc2, 0xd0, 0x78, 0xa3, 0x81, 0x74, 0x8d, 0xc6, 0xb9, 0x4e, 0x14, 0x9c, 0xf7, 0x1c,
-	0x7a, 0x0e, 0x06, 0x55, 0xf3, 0xfc, 0xac, 0x38, 0x45, 0x50, 0x5e, 0x0c, 0x45, 0x66, 0xbe, 0x81,
-	0x4d, 0x3c, 0xf4, 0x01, 0x78, 0x58, 0xff, 0xe5, 0xc9, 0x63, 0xfc, 0x68, 0x6d, 0x56, 0x14, 0x78,
-	0x52, 0x17, 0xa9, 0xcc, 0xe5, 0xa2, 0x35, 0x70, 0xb7, 0xe7, 0xd1, 0x1a, 0x9c, 0x53, 0xa0, 0x8b,
-	0x7e, 0xc2, 0x32, 0x41, 0x62, 0x32, 0xed, 0xc4, 0xe4, 0x7a, 0xe4, 0x89, 0xfb, 0x07, 0xd4, 0x5d,
-	0x9b, 0x73, 0x6e, 0x72, 0x39, 0x0f, 0x13, 0x2f, 0xe0, 0x3d, 0xa8, 0xa0, 0x49, 0xa8, 0x11, 0xdf,
-	0x59, 0xf3, 0xc8, 0xd2, 0xcc, 0xbc, 0xb8, 0x91, 0x40, 0x47, 0xf6, 0x4a, 0x00, 0xd6, 0x38, 0x2a,
-	0x36, 0x75, 0xa8, 0xeb, 0xbd, 0xaf, 0xcb, 0x70, 0xba, 0x59, 0x0f, 0xa9, 0xed, 0xe1, 0xd6, 0xc9,
-	0x54, 0x9d, 0x05, 0xd4, 0xd1, 0x0f, 0xc3, 0x8b, 0x67, 0xaa, 0xc0, 0xeb, 0xb9, 0x99, 0xe5, 0x0e,
-	0x1c, 0x9c, 0xfb, 0x24, 0x0b, 0xbc, 0x8c, 0x82, 0xed, 0x9d, 0xb1, 0x53, 0x99, 0xc0, 0x4b, 0xda,
-	0x88, 0x39, 0x0c, 0x5d, 0x01, 0xc4, 0xa2, 0xf8, 0x2f, 0x27, 0x49, 0xa8, 0x8c, 0x9d, 0xb1, 0xd3,
-	0xe9, 0x72, 0x2f, 0x97, 0x3a, 0x30, 0x70, 0xce, 0x53, 0xf6, 0x7f, 0xb0, 0x60, 0x58, 0xad, 0xd7,
-	0x7b, 0x90, 0xc7, 0xe2, 0xa5, 0xf3, 0x58, 0xe6, 0x8e, 0x2e, 0xf1, 0x58, 0xcf, 0xbb, 0x04, 0x43,
-	0x7f, 0x7a, 0x10, 0x40, 0x4b, 0x45, 0xa5, 0x90, 0xac, 0xae, 0x0a, 0xe9, 0x81, 0x95, 0x48, 0x79,
-	0xb5, 0x59, 0x2a, 0xf7, 0xb7, 0x36, 0xcb, 0x0a, 0x9c, 0x91, 0xe6, 0x02, 0x3f, 0x2b, 0xba, 0x1c,
-	0xc4, 0x4a, 0xc0, 0x55, 0xa7, 0x1f, 0x15, 0x84, 0xce, 0xcc, 0xe7, 0x21, 0xe1, 0xfc, 0x67, 0x53,
-	0x56, 0xca, 0xc0, 0x7e, 0x56, 0x8a, 0x5e, 0xd3, 0x0b, 0xeb, 0xf2, 0xc6, 0x94, 0xcc, 0x9a, 0x5e,
-	0xb8, 0xb4, 0x82, 0x35, 0x4e, 0xbe, 0x60, 0xaf, 0x15, 0x24, 0xd8, 0xe1, 0xc0, 0x82, 0x5d, 0x8a,
-	0x98, 0xc1, 0xae, 0x22, 0x46, 0xfa, 0xa4, 0x87, 0xba, 0xfa, 0xa4, 0x5f, 0x84, 0x11, 0xd7, 0xdf,
-	0x20, 0x91, 0x9b, 0x90, 0x06, 0x5b, 0x0b, 0x4c, 0xfc, 0x18, 0x57, 0xbd, 0xcc, 0xa7, 0xa0, 0x38,
-	0x83, 0x9d, 0x96, 0x8b, 0x23, 0x3d, 0xc8, 0xc5, 0x2e, 0xda, 0x68, 0xb4, 0x18, 0x6d, 0x74, 0xe2,
-	0xe8, 0xda, 0xe8, 0xe4, 0xb1, 0x6a, 0x23, 0x54, 0x88, 0x36, 0xea, 0x49, 0xd0, 0x1b, 0xdb, 0xbf,
-	0xd3, 0xfb, 0x6c, 0xff, 0xba, 0xa9, 0xa2, 0x33, 0x87, 0x56, 0x45, 0xf9, 0x5a, 0xe6, 0xa1, 0x43,
-	0x69, 0x99, 0xcf, 0x94, 0xe0, 0x8c, 0x96, 0xc3, 0x74, 0xf6, 0xbb, 0xeb, 0x54, 0x12, 0xb1, 0x4b,
-	0xb7, 0xf8, 0xb9, 0x8d, 0x91, 0x56, 0xa5, 0x33, 0xb4, 0x14, 0x04, 0x1b, 0x58, 0x2c, 0x3b, 0x89,
-	0x44, 0xac, 0x44, 0x70, 0x56, 0x48, 0xcf, 0x88, 0x76, 0xac, 0x30, 0xe8, 0xfc, 0xa2, 0xbf, 0x45,
-	0xc6, 0x67, 0xb6, 0x10, 0xde, 0x8c, 0x06, 0x61, 0x13, 0x0f, 0x3d, 0xc9, 0x99, 0x30, 0x01, 0x41,
-	0x05, 0xf5, 0x90, 0xb8, 0x0d, 0x58, 0xca, 0x04, 0x05, 0x95, 0xdd, 0x61, 0x69, 0x68, 0x95, 0xce,
-	0xee, 0xb0, 0x10, 0x28, 0x85, 0x61, 0xff, 0x4f, 0x0b, 0xce, 0xe6, 0x0e, 0xc5, 0x3d, 0x50, 0xbe,
-	0xdb, 0x69, 0xe5, 0xbb, 0x52, 0xd4, 0x76, 0xc3, 0x78, 0x8b, 0x2e, 0x8a, 0xf8, 0xdf, 0x59, 0x30,
-	0xa2, 0xf1, 0xef, 0xc1, 0xab, 0xba, 0xe9, 0x57, 0x2d, 0x6e, 0x67, 0x55, 0xeb, 0x78, 0xb7, 0xdf,
-	0x2e, 0x81, 0x2a, 0x4e, 0x39, 0x55, 0x97, 0xa5, 0x7f, 0xf7, 0x39, 0x49, 0xdc, 0x81, 0x7e, 0x76,
-	0x10, 0x1a, 0x17, 0x13, 0xe4, 0x91, 0xe6, 0xcf, 0x0e, 0x55, 0xf5, 0x21, 0x33, 0xfb, 0x1b, 0x63,
-	0xc1, 0x90, 0x15, 0xb0, 0xe6, 0x75, 0xff, 0x1a, 0x22, 0x8f, 0x48, 0x17, 0xb0, 0x16, 0xed, 0x58,
-	0x61, 0x50, 0xf5, 0xe0, 0xd6, 0x03, 0x7f, 0xc6, 0x73, 0x62, 0x79, 0xd3, 0xa4, 0x52, 0x0f, 0xf3,
-	0x12, 0x80, 0x35, 0x0e, 0x3b, 0x23, 0x75, 0xe3, 0xd0, 0x73, 0x76, 0x8c, 0xfd, 0xb3, 0x51, 0xd9,
-	0x40, 0x81, 0xb0, 0x89, 0x67, 0xb7, 0x60, 0x2c, 0xfd, 0x12, 0xb3, 0x64, 0x9d, 0x05, 0x28, 0xf6,
-	0x34, 0x9c, 0x93, 0x50, 0x73, 0xd8, 0x53, 0x0b, 0x6d, 0x27, 0x7b, 0x51, 0xfd, 0x94, 0x04, 0x60,
-	0x8d, 0x63, 0xff, 0xaa, 0x05, 0xa7, 0x72, 0x06, 0xad, 0xc0, 0x84, 0xb9, 0x44, 0x4b, 0x9b, 0x3c,
-	0xc5, 0xfe, 0xc3, 0x30, 0xd0, 0x20, 0xeb, 0x8e, 0x0c, 0x81, 0x33, 0x64, 0xfb, 0x2c, 0x6f, 0xc6,
-	0x12, 0x6e, 0xff, 0x77, 0x0b, 0x46, 0xd3, 0x7d, 0x8d, 0x59, 0x2a, 0x09, 0x1f, 0x26, 0x37, 0xae,
-	0x07, 0x5b, 0x24, 0xda, 0xa1, 0x6f, 0x6e, 0x65, 0x52, 0x49, 0x3a, 0x30, 0x70, 0xce, 0x53, 0xac,
-	0x34, 0x6d, 0x43, 0x8d, 0xb6, 0x9c, 0x91, 0x37, 0x8a, 0x9c, 0x91, 0xfa, 0x63, 0x9a, 0xc7, 0xe5,
-	0x8a, 0x25, 0x36, 0xf9, 0xdb, 0xdf, 0xe9, 0x03, 0x95, 0x51, 0xcb, 0xe2, 0x8f, 0x0a, 0x8a, 0xde,
-	0x3a, 0x68, 0x06, 0x91, 0x9a, 0x0c, 0x7d, 0x7b, 0x05, 0x04, 0x70, 0x2f, 0x89, 0xe9, 0xba, 0x54,
-	0x6f, 0xb8, 0xaa, 0x41, 0xd8, 0xc4, 0xa3, 0x3d, 0xf1, 0xdc, 0x2d, 0xc2, 0x1f, 0xea, 0x4f, 0xf7,
-	0x64, 0x41, 0x02, 0xb0, 0xc6, 0xa1, 0x3d, 0x69, 0xb8, 0xeb, 0xeb, 0x62, 0xcb, 0xaf, 0x7a, 0x42,
-	0x47, 0x07, 0x33, 0x08, 0xaf, 0x36, 0x1e, 0x6c, 0x0a, 0x2b, 0xd8, 0xa8, 0x36, 0x1e, 0x6c, 0x62,
-	0x06, 0xa1, 0x76, 0x9b, 0x1f, 0x44, 0x2d, 0xc7, 0x73, 0x5f, 0x27, 0x0d, 0xc5, 0x45, 0x58, 0xbf,
-	0xca, 0x6e, 0xbb, 0xd6, 0x89, 0x82, 0xf3, 0x9e, 0xa3, 0x33, 0x30, 0x8c, 0x48, 0xc3, 0xad, 0x27,
-	0x26, 0x35, 0x48, 0xcf, 0xc0, 0xe5, 0x0e, 0x0c, 0x9c, 0xf3, 0x14, 0x9a, 0x82, 0x51, 0x99, 0x11,
-	0x2d, 0xeb, 0xdd, 0x0c, 0xa6, 0xeb, 0x6b, 0xe0, 0x34, 0x18, 0x67, 0xf1, 0xa9, 0x54, 0x6b, 0x89,
-	0x92, 0x58, 0xcc, 0x58, 0x36, 0xa4, 0x9a, 0x2c, 0x95, 0x85, 0x15, 0x86, 0xfd, 0x89, 0x32, 0xd5,
-	0xc2, 0x5d, 0x4a, 0xc1, 0xdd, 0xb3, 0x68, 0xc1, 0xf4, 0x8c, 0xec, 0xeb, 0x61, 0x46, 0x3e, 0x0b,
-	0x43, 0xb7, 0xe2, 0xc0, 0x57, 0x91, 0x78, 0x95, 0xae, 0x91, 0x78, 0x06, 0x56, 0x7e, 0x24, 0x5e,
-	0x7f, 0x51, 0x91, 0x78, 0x03, 0x87, 0x8c, 0xc4, 0xfb, 0x9d, 0x0a, 0xa8, 0x6b, 0x4f, 0xae, 0x91,
-	0xe4, 0x76, 0x10, 0x6d, 0xba, 0x7e, 0x93, 0x65, 0x92, 0x7f, 0xcd, 0x82, 0x21, 0xbe, 0x5e, 0x16,
-	0xcc, 0x4c, 0xaa, 0xf5, 0x82, 0xee, 0xd3, 0x48, 0x31, 0x9b, 0x58, 0x35, 0x18, 0x65, 0x2e, 0x3a,
-	0x35, 0x41, 0x38, 0xd5, 0x23, 0xf4, 0x51, 0x00, 0xe9, 0x1f, 0x5d, 0x97, 0x22, 0x73, 0xbe, 0x98,
-	0xfe, 0x61, 0xb2, 0xae, 0x6d, 0xe0, 0x55, 0xc5, 0x04, 0x1b, 0x0c, 0xd1, 0x67, 0x74, 0x96, 0x19,
-	0x0f, 0xd9, 0xff, 0xf0, 0xb1, 0x8c, 0x4d, 0x2f, 0x39, 0x66, 0x18, 0x06, 0x5c, 0xbf, 0x49, 0xe7,
-	0x89, 0x88, 0x58, 0x7a, 0x47, 0x5e, 0x15, 0x86, 0x85, 0xc0, 0x69, 0x4c, 0x3b, 0x9e, 0xe3, 0xd7,
-	0x49, 0x34, 0xcf, 0xd1, 0xcd, 0xeb, 0xbd, 0x59, 0x03, 0x96, 0x84, 0x3a, 0x2e, 0x8c, 0xa9, 0xf4,
-	0x72, 0x61, 0xcc, 0xb9, 0xf7, 0xc1, 0xc9, 0x8e, 0x8f, 0x79, 0xa0, 0x94, 0xb2, 0xc3, 0x67, 0xa3,
-	0xd9, 0xbf, 0x5b, 0xd3, 0x4a, 0xeb, 0x5a, 0xd0, 0xe0, 0xd7, 0x96, 0x44, 0xfa, 0x8b, 0x0a, 0x1b,
-	0xb7, 0xc0, 0x29, 0x62, 0x5c, 0x11, 0xae, 0x1a, 0xb1, 0xc9, 0x92, 0xce, 0xd1, 0xd0, 0x89, 0x88,
-	0x7f, 0xdc, 0x73, 0x74, 0x59, 0x31, 0xc1, 0x06, 0x43, 0xb4, 0x91, 0xca, 0x29, 0xb9, 0x74, 0xf4,
-	0x9c, 0x12, 0x56, 0x9f, 0x2a, 0xef, 0xa6, 0x81, 0x2f, 0x5a, 0x30, 0xe2, 0xa7, 0x66, 0x6e, 0x31,
-	0x61, 0xa4, 0xf9, 0xab, 0x82, 0xdf, 0x9a, 0x95, 0x6e, 0xc3, 0x19, 0xfe, 0x79, 0x2a, 0xad, 0x72,
-	0x40, 0x95, 0xa6, 0xef, 0x3f, 0xea, 0xef, 0x76, 0xff, 0x11, 0xf2, 0xd5, 0x05, 0x70, 0x03, 0x85,
-	0x5f, 0x00, 0x07, 0x39, 0x97, 0xbf, 0xdd, 0x84, 0x5a, 0x3d, 0x22, 0x4e, 0x72, 0xc8, 0xbb, 0xc0,
-	0xd8, 0x01, 0xfd, 0x8c, 0x24, 0x80, 0x35, 0x2d, 0xf4, 0x31, 0x25, 0xcf, 0x6a, 0x45, 0x9a, 0x9f,
-	0x74, 0x29, 0xf6, 0x24, 0xc5, 0xbe, 0x94, 0xc9, 0x94, 0x85, 0x22, 0x12, 0x1a, 0x53, 0xbd, 0xf8,
-	0xde, 0xca, 0x8e, 0xfd, 0x3f, 0x7d, 0x70, 0x42, 0x76, 0x5f, 0xa6, 0x04, 0x50, 0x7b, 0x85, 0xcf,
-	0x03, 0xbd, 0xd9, 0x50, 0xf6, 0xca, 0x65, 0x09, 0xc0, 0x1a, 0x87, 0xda, 0xc7, 0xed, 0x98, 0x2c,
-	0x85, 0xc4, 0x5f, 0x70, 0xd7, 0x62, 0x71, 0xee, 0xac, 0xde, 0xfb, 0xba, 0x06, 0x61, 0x13, 0x8f,
-	0x6e, 0x8e, 0xf8, 0x3e, 0x25, 0xce, 0xa6, 0x13, 0x89, 0xfd, 0x0f, 0x96, 0x70, 0xf4, 0x0b, 0xb9,
-	0xb5, 0x82, 0x8b, 0x49, 0xa4, 0xeb, 0xc8, 0x84, 0x38, 0xe0, 0x75, 0x9e, 0x7f, 0xdb, 0x82, 0x33,
-	0xbc, 0x55, 0x8e, 0xe4, 0xf5, 0xb0, 0xe1, 0x24, 0x24, 0x2e, 0xe6, 0xd6, 0x80, 0x9c, 0xfe, 0x69,
-	0xa7, 0x7b, 0x1e, 0x5b, 0x9c, 0xdf, 0x1b, 0xf4, 0x86, 0x05, 0xa3, 0x9b, 0xa9, 0xea, 0x2d, 0x52,
-	0x95, 0x1f, 0xb5, 0x3c, 0x42, 0x8a, 0xa8, 0x16, 0x7d, 0xe9, 0xf6, 0x18, 0x67, 0xb9, 0xdb, 0x7f,
-	0x6e, 0x81, 0xa9, 0xd6, 0xee, 0x7d, 0xd1, 0x97, 0x83, 0x9b, 0xe6, 0xd2, 0xda, 0xaf, 0x74, 0xb5,
-	0xf6, 0x1f, 0x85, 0x72, 0xdb, 0x6d, 0x88, 0xfd, 0x9e, 0x3e, 0x0d, 0x9f, 0x9f, 0xc5, 0xb4, 0xdd,
-	0xfe, 0xa7, 0x15, 0xed, 0x47, 0x12, 0x79, 0x6a, 0xdf, 0x17, 0xaf, 0xbd, 0xae, 0xca, 0xc6, 0xf1,
-	0x37, 0xbf, 0xd6, 0x51, 0x36, 0xee, 0xc7, 0x0e, 0x9e, 0x86, 0xc8, 0x07, 0xa8, 0x5b, 0xd5, 0xb8,
-	0x81, 0x7d, 0x72, 0x10, 0x6f, 0x41, 0x95, 0x6e, 0x89, 0x99, 0x43, 0xb8, 0x9a, 0xea, 0x54, 0xf5,
-	0xb2, 0x68, 0xbf, 0xbb, 0x3b, 0xfe, 0x9e, 0x83, 0x77, 0x4b, 0x3e, 0x8d, 0x15, 0x7d, 0x14, 0x43,
-	0x8d, 0xfe, 0x66, 0xe9, 0x92, 0x62, 0xb3, 0x7d, 0x5d, 0xc9, 0x4c, 0x09, 0x28, 0x24, 0x17, 0x53,
-	0xf3, 0x41, 0x3e, 0xd4, 0xd8, 0xcd, 0xc7, 0x8c, 0x29, 0xdf, 0x93, 0x2f, 0xab, 0xa4, 0x45, 0x09,
-	0xb8, 0xbb, 0x3b, 0xfe, 0xc2, 0xc1, 0x99, 0xaa, 0xc7, 0xb1, 0x66, 0x61, 0x7f, 0xa9, 0x4f, 0xcf,
-	0x5d, 0x51, 0x2d, 0xf0, 0xfb, 0x62, 0xee, 0x3e, 0x9f, 0x99, 0xbb, 0xe7, 0x3b, 0xe6, 0xee, 0x88,
-	0xbe, 0xa1, 0x37, 0x35, 0x1b, 0xef, 0xb5, 0x61, 0xb6, 0xbf, 0xff, 0x87, 0x59, 0xa4, 0xaf, 0xb5,
-	0xdd, 0x88, 0xc4, 0xcb, 0x51, 0xdb, 0x77, 0xfd, 0x26, 0x9b, 0x8e, 0x55, 0xd3, 0x22, 0x4d, 0x81,
-	0x71, 0x16, 0x1f, 0x3d, 0x05, 0x55, 0xfa, 0xcd, 0x6f, 0x3a, 0x5b, 0x7c, 0x56, 0x19, 0x05, 0xd4,
-	0x56, 0x44, 0x3b, 0x56, 0x18, 0xf6, 0x37, 0x58, 0x6c, 0x81, 0x91, 0xa7, 0x4d, 0xe7, 0x84, 0xc7,
-	0xae, 0x9a, 0xe6, 0xd5, 0xd7, 0xd4, 0x9c, 0xe0, 0xf7, 0x4b, 0x73, 0x18, 0xba, 0x0d, 0x03, 0x6b,
-	0xfc, 0xae, 0xc5, 0x62, 0x2a, 0xd2, 0x8b, 0x8b, 0x1b, 0xd9, 0x8d, 0x3a, 0xf2, 0x16, 0xc7, 0xbb,
-	0xfa, 0x27, 0x96, 0xdc, 0xec, 0x3f, 0xa8, 0xc0, 0x68, 0xe6, 0x32, 0xe2, 0x54, 0xdd, 0xdb, 0xd2,
-	0xbe, 0x75, 0x6f, 0x3f, 0x04, 0xd0, 0x20, 0xa1, 0x17, 0xec, 0x30, 0xf3, 0xb8, 0xef, 0xc0, 0xe6,
-	0xb1, 0xda, 0x51, 0xcd, 0x2a, 0x2a, 0xd8, 0xa0, 0x28, 0x4a, 0xce, 0xf1, 0x32, 0xba, 0x99, 0x92,
-	0x73, 0xc6, 0xbd, 0x15, 0xfd, 0xf7, 0xf6, 0xde, 0x0a, 0x17, 0x46, 0x79, 0x17, 0x55, 0x36, 0xf4,
-	0x21, 0x92, 0x9e, 0x59, 0x3e, 0xc9, 0x6c, 0x9a, 0x0c, 0xce, 0xd2, 0xbd, 0x9f, 0x77, 0x8d, 0xa3,
-	0x77, 0x42, 0x4d, 0x7e, 0x67, 0xbe, 0x47, 0x11, 0x15, 0x25, 0xe4, 0x34, 0x60, 0x77, 0x80, 0x8b,
-	0x9f, 0x1d, 0x85, 0x1d, 0xe0, 0x7e, 0x15, 0x76, 0xb0, 0xbf, 0x50, 0xa2, 0x76, 0x3c, 0xef, 0x97,
-	0xaa, 0x51, 0xf4, 0x04, 0xf4, 0x3b, 0xed, 0x64, 0x23, 0xe8, 0xb8, 0x39, 0x72, 0x8a, 0xb5, 0x62,
-	0x01, 0x45, 0x0b, 0xd0, 0xd7, 0xd0, 0x75, 0x67, 0x0e, 0xf2, 0x3d, 0xb5, 0x8b, 0xda, 0x49, 0x08,
-	0x66, 0x54, 0xd0, 0x23, 0xd0, 0x97, 0x38, 0x4d, 0x99, 0x02, 0xc7, 0xd2, 0x9e, 0x57, 0x9d, 0x66,
-	0x8c, 0x59, 0xab, 0xa9, 0xbe, 0xfb, 0xf6, 0x51, 0xdf, 0x2f, 0xc0, 0x70, 0xec, 0x36, 0x7d, 0x27,
-	0x69, 0x47, 0xc4, 0x38, 0x76, 0xd5, 0x91, 0x34, 0x26, 0x10, 0xa7, 0x71, 0xed, 0xdf, 0x1c, 0x82,
-	0xd3, 0x2b, 0x33, 0x8b, 0xb2, 0x0e, 0xfb, 0xb1, 0x65, 0xb1, 0xe5, 0xf1, 0xb8, 0x77, 0x59, 0x6c,
-	0x5d, 0xb8, 0x7b, 0x46, 0x16, 0x9b, 0x67, 0x64, 0xb1, 0xa5, 0x53, 0x8a, 0xca, 0x45, 0xa4, 0x14,
-	0xe5, 0xf5, 0xa0, 0x97, 0x94, 0xa2, 0x63, 0x4b, 0x6b, 0xdb, 0xb3, 0x43, 0x07, 0x4a, 0x6b, 0x53,
-	0x39, 0x7f, 0x85, 0x24, 0x7b, 0x74, 0xf9, 0x54, 0xb9, 0x39, 0x7f, 0x2a, 0xdf, 0x8a, 0x27, 0x32,
-	0x09, 0x51, 0xff, 0x4a, 0xf1, 0x1d, 0xe8, 0x21, 0xdf, 0x4a, 0xe4, 0x52, 0x99, 0x39, 0x7e, 0x03,
-	0x45, 0xe4, 0xf8, 0xe5, 0x75, 0x67, 0xdf, 0x1c, 0xbf, 0x17, 0x60, 0xb8, 0xee, 0x05, 0x3e, 0x59,
-	0x8e, 0x82, 0x24, 0xa8, 0x07, 0xf2, 0xee, 0x3a, 0x25, 0x12, 0x66, 0x4c, 0x20, 0x4e, 0xe3, 0x76,
-	0x4b, 0x10, 0xac, 0x1d, 0x35, 0x41, 0x10, 0xee, 0x53, 0x82, 0xe0, 0xcf, 0xe8, 0x54, 0xf6, 0x41,
-	0xf6, 0x45, 0x3e, 0x54, 0xfc, 0x17, 0xe9, 0x25, 0x9f, 0x1d, 0xbd, 0xc9, 0xaf, 0x6e, 0xa4, 0x86,
-	0xf1, 0x4c, 0xd0, 0xa2, 0x86, 0xdf, 0x10, 0x1b, 0x92, 0x57, 0x8f, 0x61, 0xc2, 0xde, 0x5c, 0xd1,
-	0x6c, 0xd4, 0x75, 0x8e, 0xba, 0x09, 0xa7, 0x3b, 0x72, 0x94, 0x54, 0xfb, 0xaf, 0x94, 0xe0, 0x07,
-	0xf6, 0xed, 0x02, 0xba, 0x0d, 0x90, 0x38, 0x4d, 0x31, 0x51, 0xc5, 0x01, 0xd6, 0x11, 0xc3, 0x5d,
-	0x57, 0x25, 0x3d, 0x5e, 0x23, 0x46, 0xfd, 0x65, 0x47, 0x43, 0xf2, 0x37, 0x8b, 0x72, 0x0d, 0xbc,
-	0x8e, 0x52, 0x9a, 0x38, 0xf0, 0x08, 0x66, 0x10, 0xaa, 0xfe, 0x23, 0xd2, 0xd4, 0x77, 0x8d, 0xab,
-	0xcf, 0x87, 0x59, 0x2b, 0x16, 0x50, 0xf4, 0x1c, 0x0c, 0x3a, 0x9e, 0xc7, 0xf3, 0x95, 0x48, 0x2c,
-	0x6e, 0x50, 0xd2, 0x5e, 0x4b, 0x0d, 0xc2, 0x26, 0x9e, 0xfd, 0x67, 0x25, 0x18, 0xdf, 0x47, 0xa6,
-	0x74, 0x64, 0x60, 0x56, 0x7a, 0xce, 0xc0, 0x14, 0x39, 0x23, 0xfd, 0x5d, 0x72, 0x46, 0x9e, 0x83,
-	0xc1, 0x84, 0x38, 0x2d, 0x11, 0x20, 0x27, 0x3c, 0x01, 0xfa, 0x44, 0x5e, 0x83, 0xb0, 0x89, 0x47,
-	0xa5, 0xd8, 0x88, 0x53, 0xaf, 0x93, 0x38, 0x96, 0x49, 0x21, 0xc2, 0xbb, 0x5d, 0x58, 0xc6, 0x09,
-	0x3b, 0x34, 0x98, 0x4a, 0xb1, 0xc0, 0x19, 0x96, 0xd9, 0x01, 0xaf, 0xf5, 0x38, 0xe0, 0x5f, 0x2f,
-	0xc1, 0xa3, 0x7b, 0x6a, 0xb7, 0x9e, 0xf3, 0x75, 0xda, 0x31, 0x89, 0xb2, 0x13, 0xe7, 0x7a, 0x4c,
-	0x22, 0xcc, 0x20, 0x7c, 0x94, 0xc2, 0xd0, 0xb8, 0xcb, 0xbd, 0xe8, 0x64, 0x32, 0x3e, 0x4a, 0x29,
-	0x16, 0x38, 0xc3, 0xf2, 0xb0, 0xd3, 0xf2, 0xef, 0x97, 0xe0, 0xf1, 0x1e, 0x6c, 0x80, 0x02, 0x93,
-	0xee, 0xd2, 0xa9, 0x8f, 0xe5, 0xfb, 0x94, 0xa1, 0x7a, 0xc8, 0xe1, 0xfa, 0x46, 0x09, 0xce, 0x75,
-	0x57, 0xc5, 0xe8, 0xbd, 0x30, 0x1a, 0xa9, 0xa8, 0x38, 0x33, 0x6b, 0xf2, 0x14, 0xf7, 0x24, 0xa4,
-	0x40, 0x38, 0x8b, 0x8b, 0x26, 0x00, 0x42, 0x27, 0xd9, 0x88, 0x2f, 0x6e, 0xbb, 0x71, 0x22, 0xaa,
-	0x02, 0x8d, 0xf0, 0xb3, 0x44, 0xd9, 0x8a, 0x0d, 0x0c, 0xca, 0x8e, 0xfd, 0x9b, 0x0d, 0xae, 0x05,
-	0x09, 0x7f, 0x88, 0x6f, 0x23, 0x4e, 0xc9, 0xdb, 0x57, 0x0c, 0x10, 0xce, 0xe2, 0x52, 0x76, 0xec,
-	0x9c, 0x87, 0x77, 0x94, 0xef, 0x2f, 0x18, 0xbb, 0x05, 0xd5, 0x8a, 0x0d, 0x8c, 0x6c, 0x3e, 0x68,
-	0x65, 0xff, 0x7c, 0x50, 0xfb, 0x9f, 0x94, 0xe0, 0x6c, 0x57, 0x53, 0xae, 0xb7, 0x05, 0xf8, 0xe0,
-	0xe5, 0x70, 0x1e, 0x6e, 0xee, 0x1c, 0x30, 0xd7, 0xf0, 0x4f, 0xba, 0xcc, 0x34, 0x91, 0x6b, 0x78,
-	0xf8, 0x64, 0xfd, 0x07, 0x6f, 0x3c, 0x3b, 0xd2, 0x0b, 0xfb, 0x0e, 0x90, 0x5e, 0x98, 0xf9, 0x18,
-	0x95, 0x1e, 0x17, 0xf2, 0x5f, 0x94, 0xbb, 0x0e, 0x2f, 0xdd, 0xfa, 0xf5, 0xe4, 0xa7, 0x9d, 0x85,
-	0x13, 0xae, 0xcf, 0x6e, 0xe2, 0x5a, 0x69, 0xaf, 0x89, 0x42, 0x31, 0xa5, 0xf4, 0x4d, 0xfd, 0xf3,
-	0x19, 0x38, 0xee, 0x78, 0xe2, 0x01, 0x4c, 0xf7, 0x3c, 0xdc, 0x90, 0x1e, 0x2c, 0xe1, 0x18, 0x2d,
-	0xc1, 0x19, 0x39, 0x14, 0x1b, 0x4e, 0x44, 0x1a, 0x42, 0x8d, 0xc4, 0x22, 0xc1, 0xe5, 0x2c, 0x4f,
-	0x92, 0xc9, 0x41, 0xc0, 0xf9, 0xcf, 0xb1, 0xcb, 0x8f, 0x82, 0xd0, 0xad, 0x8b, 0x4d, 0x8e, 0xbe,
-	0xfc, 0x88, 0x36, 0x62, 0x0e, 0xb3, 0x3f, 0x04, 0x35, 0xf5, 0xfe, 0x3c, 0xcc, 0x5e, 0x4d, 0xba,
-	0x8e, 0x30, 0x7b, 0x35, 0xe3, 0x0c, 0x2c, 0xfa, 0xb5, 0xa8, 0x49, 0x9c, 0x59, 0x3d, 0x57, 0xc9,
-	0x0e, 0xb3, 0x8f, 0xed, 0x77, 0xc1, 0x90, 0xf2, 0xb3, 0xf4, 0x7a, 0x25, 0x94, 0xfd, 0x73, 0x03,
-	0x30, 0x9c, 0x2a, 0xd6, 0x98, 0x72, 0xb0, 0x5a, 0xfb, 0x3a, 0x58, 0x59, 0xda, 0x44, 0xdb, 0x97,
-	0xf7, 0xc5, 0x19, 0x69, 0x13, 0x6d, 0x9f, 0x60, 0x0e, 0xa3, 0xe6, 0x6d, 0x23, 0xda, 0xc1, 0x6d,
-	0x5f, 0x84, 0x37, 0x2b, 0xf3, 0x76, 0x96, 0xb5, 0x62, 0x01, 0x45, 0x1f, 0xb7, 0x60, 0x28, 0x66,
-	0xde, 0x7b, 0xee, 0x9e, 0x16, 0x93, 0xee, 0xca, 0xd1, 0x6b, 0x51, 0xaa, 0xc2, 0xa4, 0x2c, 0x62,
-	0xc9, 0x6c, 0xc1, 0x29, 0x8e, 0xe8, 0x53, 0x16, 0xd4, 0xd4, 0xb5, 0x36, 0xe2, 0xf2, 0xc7, 0x95,
-	0x62, 0x6b, 0x61, 0x72, 0xbf, 0xa6, 0x3a, 0x08, 0x51, 0x45, 0x09, 0xb1, 0x66, 0x8c, 0x62, 0xe5,
-	0x3b, 0x1e, 0x38, 0x1e, 0xdf, 0x31, 0xe4, 0xf8, 0x8d, 0xdf, 0x09, 0xb5, 0x96, 0xe3, 0xbb, 0xeb,
-	0x24, 0x4e, 0xb8, 0x3b, 0x57, 0x96, 0xe8, 0x95, 0x8d, 0x58, 0xc3, 0xa9, 0x42, 0x8e, 0xd9, 0x8b,
-	0x25, 0x86, 0xff, 0x95, 0x29, 0xe4, 0x15, 0xdd, 0x8c, 0x4d, 0x1c, 0xd3, 0x59, 0x0c, 0xf7, 0xd5,
-	0x59, 0x3c, 0xb8, 0x8f, 0xb3, 0xf8, 0xbd, 0x30, 0x5a, 0xdf, 0x70, 0xfc, 0x26, 0x51, 0xd0, 0xb1,
-	0x21, 0x6d, 0xdb, 0xcc, 0xa4, 0x41, 0x38, 0x8b, 0x8b, 0x5e, 0x84, 0x91, 0x74, 0x93, 0xc8, 0x3f,
-	0x55, 0x09, 0x60, 0x69, 0x0a, 0x38, 0x83, 0x6d, 0xff, 0x43, 0x0b, 0xce, 0xe4, 0x4e, 0x9a, 0x07,
-	0x37, 0x0e, 0xd6, 0xfe, 0x72, 0x05, 0x4e, 0xe5, 0x14, 0x7d, 0x45, 0x3b, 0xe6, 0x72, 0xb2, 0x8a,
-	0x08, 0x61, 0x48, 0x9f, 0xc8, 0xcb, 0xaf, 0x98, 0xb3, 0x86, 0x0e, 0x76, 0x52, 0xa4, 0x4f, 0x6b,
-	0xca, 0xf7, 0xf6, 0xb4, 0xc6, 0x58, 0x15, 0x7d, 0xf7, 0x75, 0x55, 0x54, 0xf6, 0x59, 0x15, 0xbf,
-	0x66, 0xc1, 0x58, 0xab, 0xcb, 0x4d, 0x03, 0xc2, 0xef, 0x79, 0xe3, 0x78, 0xee, 0x31, 0x98, 0x7e,
-	0xe4, 0xce, 0xee, 0x78, 0xd7, 0x0b, 0x1e, 0x70, 0xd7, 0x5e, 0xd9, 0xdf, 0x29, 0x03, 0xab, 0x38,
-	0xcc, 0x0a, 0xfb, 0xed, 0xa0, 0x8f, 0x99, 0xb5, 0xa3, 0xad, 0xa2, 0xea, 0x1c, 0x73, 0xe2, 0xaa,
-	0xf6, 0x34, 0x1f, 0xc1, 0xbc, 0x52, 0xd4, 0x59, 0x99, 0x59, 0xea, 0x41, 0x66, 0x7a, 0xb2, 0x48,
-	0x77, 0xb9, 0xf8, 0x22, 0xdd, 0xb5, 0x6c, 0x81, 0xee, 0xbd, 0x3f, 0x71, 0xdf, 0x03, 0xf9, 0x89,
-	0x7f, 0xd1, 0xe2, 0x82, 0x27, 0xf3, 0x15, 0xb4, 0x61, 0x62, 0xed, 0x61, 0x98, 0x3c, 0x05, 0xd5,
-	0x98, 0x78, 0xeb, 0x97, 0x89, 0xe3, 0x09, 0x03, 0x46, 0x1f, 0x9f, 0x8b, 0x76, 0xac, 0x30, 0xd8,
-	0xfd, 0xbf, 0x9e, 0x17, 0xdc, 0xbe, 0xd8, 0x0a, 0x93, 0x1d, 0x61, 0xca, 0xe8, 0xfb, 0x7f, 0x15,
-	0x04, 0x1b, 0x58, 0xf6, 0xdf, 0x2a, 0xf1, 0x19, 0x28, 0x62, 0x30, 0x9e, 0xcf, 0xdc, 0xd8, 0xd8,
-	0x7b, 0xf8, 0xc2, 0x47, 0x00, 0xea, 0x41, 0x2b, 0xa4, 0x66, 0xe7, 0x6a, 0x20, 0x8e, 0xa4, 0x2e,
-	0x1f, 0xf9, 0xc2, 0x76, 0x41, 0x4f, 0xbf, 0x86, 0x6e, 0xc3, 0x06, 0xbf, 0x94, 0x2c, 0x2d, 0xef,
-	0x2b, 0x4b, 0x53, 0x62, 0xa5, 0x6f, 0x6f, 0xb1, 0x62, 0xff, 0x99, 0x05, 0x29, 0x83, 0x0c, 0x85,
-	0x50, 0xa1, 0xdd, 0xdd, 0x11, 0x2b, 0x74, 0xa9, 0x38, 0xeb, 0x8f, 0x8a, 0x46, 0x31, 0xed, 0xd9,
-	0x4f, 0xcc, 0x19, 0x21, 0x4f, 0x84, 0x6a, 0xf0, 0x51, 0xbd, 0x56, 0x1c, 0xc3, 0xcb, 0x41, 0xb0,
-	0xc9, 0xcf, 0x55, 0x75, 0xd8, 0x87, 0xfd, 0x3c, 0x9c, 0xec, 0xe8, 0x14, 0xbb, 0x9c, 0x2d, 0xa0,
-	0xda, 0x27, 0x33, 0x5d, 0x59, 0x3e, 0x2f, 0xe6, 0x30, 0xfb, 0x1b, 0x16, 0x9c, 0xc8, 0x92, 0x47,
-	0x6f, 0x5a, 0x70, 0x32, 0xce, 0xd2, 0x3b, 0xae, 0xb1, 0x53, 0xe1, 0x96, 0x1d, 0x20, 0xdc, 0xd9,
-	0x09, 0xfb, 0xff, 0x8a, 0xc9, 0x7f, 0xd3, 0xf5, 0x1b, 0xc1, 0x6d, 0x65, 0x98, 0x58, 0x5d, 0x0d,
-	0x13, 0xba, 0x1e, 0xeb, 0x1b, 0xa4, 0xd1, 0xf6, 0x3a, 0x12, 0x89, 0x57, 0x44, 0x3b, 0x56, 0x18,
-	0x2c, 0x6f, 0xb2, 0x2d, 0xaa, 0xf8, 0x67, 0x26, 0xe5, 0xac, 0x68, 0xc7, 0x0a, 0x03, 0x3d, 0x0b,
-	0x43, 0xc6, 0x4b, 0xca, 0x79, 0xc9, 0xf6, 0x03, 0x86, 0xca, 0x8c, 0x71, 0x0a, 0x0b, 0x4d, 0x00,
-	0x28, 0x23, 0x47, 0xaa, 0x48, 0xe6, 0xa7, 0x52, 0x92, 0x28, 0xc6, 0x06, 0x06, 0xcb, 0x52, 0xf6,
-	0xda, 0x31, 0x3b, 0x62, 0xe8, 0xd7, 0x95, 0x65, 0x67, 0x44, 0x1b, 0x56, 0x50, 0x2a, 0x4d, 0x5a,
-	0x8e, 0xdf, 0x76, 0x3c, 0x3a, 0x42, 0x62, 0xe7, 0xa9, 0x96, 0xe1, 0xa2, 0x82, 0x60, 0x03, 0x8b,
-	0xbe, 0x71, 0xe2, 0xb6, 0xc8, 0xcb, 0x81, 0x2f, 0xc3, 0xe4, 0xf4, 0xa9, 0x93, 0x68, 0xc7, 0x0a,
-	0xc3, 0xfe, 0xaf, 0x16, 0x8c, 0xea, 0x9a, 0x07, 0xfc, 0x1a, 0x76, 0x73, 0xa3, 0x6c, 0xed, 0xbb,
-	0x51, 0x4e, 0x27, 0x83, 0x97, 0x7a, 0x4a, 0x06, 0x37, 0xf3, 0xb4, 0xcb, 0x7b, 0xe6, 0x69, 0xff,
-	0x90, 0xbe, 0xe2, 0x97, 0x27, 0x74, 0x0f, 0xe6, 0x5d, 0xef, 0x8b, 0x6c, 0xe8, 0xaf, 0x3b, 0xaa,
-	0xe0, 0xcf, 0x10, 0xdf, 0xba, 0xcc, 0x4c, 0x31, 0x24, 0x01, 0xb1, 0x97, 0xa0, 0xa6, 0x0e, 0x5f,
-	0xe4, 0x3e, 0xd9, 0xca, 0xdf, 0x27, 0xf7, 0x94, 0x2f, 0x3a, 0xbd, 0xf6, 0xad, 0xef, 0x3e, 0xf6,
-	0xb6, 0xdf, 0xff, 0xee, 0x63, 0x6f, 0xfb, 0xe3, 0xef, 0x3e, 0xf6, 0xb6, 0x8f, 0xdf, 0x79, 0xcc,
-	0xfa, 0xd6, 0x9d, 0xc7, 0xac, 0xdf, 0xbf, 0xf3, 0x98, 0xf5, 0xc7, 0x77, 0x1e, 0xb3, 0xbe, 0x73,
-	0xe7, 0x31, 0xeb, 0x8b, 0xff, 0xe9, 0xb1, 0xb7, 0xbd, 0x9c, 0x1b, 0x27, 0x49, 0x7f, 0x3c, 0x5d,
-	0x6f, 0x4c, 0x6e, 0x5d, 0x60, 0xa1, 0x7a, 0x74, 0x79, 0x4d, 0x1a, 0x73, 0x6a, 0x52, 0x2e, 0xaf,
-	0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x99, 0x15, 0x93, 0xb9, 0x1e, 0xe6, 0x00, 0x00,
+	// 13104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x25, 0xd9,
+	0x59, 0x18, 0xee, 0xbe, 0x57, 0x57, 0xba, 0xf7, 0x48, 0xa3, 0x99, 0xe9, 0x9d, 0xd9, 0xbd, 0x3b,
+	0xde, 0x5d, 0x0d, 0xbd, 0xc6, 0xf6, 0x0f, 0x6c, 0x0d, 0x5e, 0x6c, 0xb3, 0x3f, 0x8c, 0x0d, 0x7a,
+	0xcc, 0x43, 0x33, 0xd2, 0x8c, 0xf6, 0x93, 0x76, 0xc6, 0x0f, 0xec, 0x75, 0xeb, 0xde, 0xa3, 0xab,
+	0x1e, 0xf5, 0xed, 0xbe, 0xdb, 0xdd, 0x57, 0x33, 0x5a, 0x8c, 0x1f, 0xbc, 0x4c, 0x30, 0x18, 0x63,
+	0x5e, 0x86, 0xc4, 0x04, 0x02, 0xa1, 0x92, 0x4a, 0x5c, 0x21, 0x95, 0x4a, 0x42, 0x08, 0x29, 0x2a,
+	0x90, 0xa2, 0x48, 0x80, 0x82, 0x24, 0x14, 0x81, 0x84, 0x4c, 0x60, 0xf2, 0x20, 0x95, 0xaa, 0x50,
+	0x45, 0x42, 0x52, 0xc9, 0x54, 0xfe, 0x48, 0x7d, 0xe7, 0x7d, 0xfa, 0xf6, 0x95, 0xae, 0xa4, 0xd6,
+	0xcc, 0x98, 0xec, 0x5f, 0xd2, 0x3d, 0xdf, 0xd7, 0xdf, 0x77, 0xfa, 0xf4, 0x79, 0x7c, 0xe7, 0x7b,
+	0x92, 0xe5, 0x4e, 0x90, 0x6d, 0xf5, 0x37, 0x66, 0x5b, 0x71, 0xf7, 0x82, 0x9f, 0x74, 0xe2, 0x5e,
+	0x12, 0xdf, 0x66, 0xff, 0xbc, 0xbd, 0xd5, 0xbe, 0xb0, 0xf3, 0xc2, 0x85, 0xde, 0x76, 0xe7, 0x82,
+	0xdf, 0x0b, 0xd2, 0x0b, 0x7e, 0xaf, 0x17, 0x06, 0x2d, 0x3f, 0x0b, 0xe2, 0xe8, 0xc2, 0xce, 0x3b,
+	0xfc, 0xb0, 0xb7, 0xe5, 0xbf, 0xe3, 0x42, 0x87, 0x46, 0x34, 0xf1, 0x33, 0xda, 0x9e, 0xed, 0x25,
+	0x71, 0x16, 0xbb, 0xdf, 0xa0, 0xa9, 0xcd, 0x4a, 0x6a, 0xec, 0x9f, 0x57, 0x5a, 0xed, 0xd9, 0x9d,
+	0x17, 0x66, 0x7b, 0xdb, 0x9d, 0x59, 0xa4, 0x36, 0x6b, 0x50, 0x9b, 0x95, 0xd4, 0xce, 0xbd, 0xdd,
+	0xe8, 0x4b, 0x27, 0xee, 0xc4, 0x17, 0x18, 0xd1, 0x8d, 0xfe, 0x26, 0xfb, 0xc5, 0x7e, 0xb0, 0xff,
+	0x38, 0xb3, 0x73, 0xde, 0xf6, 0x8b, 0xe9, 0x6c, 0x10, 0x63, 0xf7, 0x2e, 0xb4, 0xe2, 0x84, 0x5e,
+	0xd8, 0x19, 0xe8, 0xd0, 0xb9, 0x2b, 0x1a, 0x87, 0xde, 0xcd, 0x68, 0x94, 0x06, 0x71, 0x94, 0xbe,
+	0x1d, 0xbb, 0x40, 0x93, 0x1d, 0x9a, 0x98, 0xaf, 0x67, 0x20, 0x14, 0x51, 0x7a, 0xa7, 0xa6, 0xd4,
+	0xf5, 0x5b, 0x5b, 0x41, 0x44, 0x93, 0x5d, 0xfd, 0x78, 0x97, 0x66, 0x7e, 0xd1, 0x53, 0x17, 0x86,
+	0x3d, 0x95, 0xf4, 0xa3, 0x2c, 0xe8, 0xd2, 0x81, 0x07, 0xde, 0xbd, 0xdf, 0x03, 0x69, 0x6b, 0x8b,
+	0x76, 0xfd, 0x81, 0xe7, 0xbe, 0x76, 0xd8, 0x73, 0xfd, 0x2c, 0x08, 0x2f, 0x04, 0x51, 0x96, 0x66,
+	0x49, 0xfe, 0x21, 0xef, 0x2f, 0x39, 0xe4, 0xc4, 0xdc, 0xad, 0xb5, 0xb9, 0x7e, 0xb6, 0xb5, 0x10,
+	0x47, 0x9b, 0x41, 0xc7, 0x7d, 0x17, 0x99, 0x6c, 0x85, 0xfd, 0x34, 0xa3, 0xc9, 0x75, 0xbf, 0x4b,
+	0x9b, 0xce, 0x79, 0xe7, 0xad, 0x8d, 0xf9, 0x27, 0x7e, 0xed, 0xde, 0xcc, 0x1b, 0xee, 0xdf, 0x9b,
+	0x99, 0x5c, 0xd0, 0x20, 0x30, 0xf1, 0xdc, 0xff, 0x8f, 0x4c, 0x24, 0x71, 0x48, 0xe7, 0xe0, 0x7a,
+	0xb3, 0xc2, 0x1e, 0x39, 0x29, 0x1e, 0x99, 0x00, 0xde, 0x0c, 0x12, 0x8e, 0xa8, 0xbd, 0x24, 0xde,
+	0x0c, 0x42, 0xda, 0xac, 0xda, 0xa8, 0xab, 0xbc, 0x19, 0x24, 0xdc, 0xfb, 0xdd, 0x0a, 0x21, 0x73,
+	0xbd, 0xde, 0x6a, 0x12, 0xdf, 0xa6, 0xad, 0xcc, 0xfd, 0x28, 0xa9, 0xe3, 0x30, 0xb7, 0xfd, 0xcc,
+	0x67, 0x1d, 0x9b, 0x7c, 0xe1, 0x6b, 0x66, 0xf9, 0x5b, 0xcf, 0x9a, 0x6f, 0xad, 0x27, 0x19, 0x62,
+	0xcf, 0xee, 0xbc, 0x63, 0xf6, 0xc6, 0x06, 0x3e, 0xbf, 0x42, 0x33, 0x7f, 0xde, 0x15, 0xcc, 0x88,
+	0x6e, 0x03, 0x45, 0xd5, 0x8d, 0xc8, 0x58, 0xda, 0xa3, 0x2d, 0xf6, 0x0e, 0x93, 0x2f, 0x2c, 0xcf,
+	0x1e, 0x65, 0x36, 0xcf, 0xea, 0x9e, 0xaf, 0xf5, 0x68, 0x6b, 0x7e, 0x4a, 0x70, 0x1e, 0xc3, 0x5f,
+	0xc0, 0xf8, 0xb8, 0x3b, 0x64, 0x3c, 0xcd, 0xfc, 0xac, 0x9f, 0xb2, 0xa1, 0x98, 0x7c, 0xe1, 0x7a,
+	0x69, 0x1c, 0x19, 0xd5, 0xf9, 0x69, 0xc1, 0x73, 0x9c, 0xff, 0x06, 0xc1, 0xcd, 0xfb, 0xb7, 0x0e,
+	0x99, 0xd6, 0xc8, 0xcb, 0x41, 0x9a, 0xb9, 0xdf, 0x3c, 0x30, 0xb8, 0xb3, 0xa3, 0x0d, 0x2e, 0x3e,
+	0xcd, 0x86, 0xf6, 0x94, 0x60, 0x56, 0x97, 0x2d, 0xc6, 0xc0, 0x76, 0x49, 0x2d, 0xc8, 0x68, 0x37,
+	0x6d, 0x56, 0xce, 0x57, 0xdf, 0x3a, 0xf9, 0xc2, 0x95, 0xb2, 0xde, 0x73, 0xfe, 0x84, 0x60, 0x5a,
+	0x5b, 0x42, 0xf2, 0xc0, 0xb9, 0x78, 0x9f, 0x3e, 0x69, 0xbe, 0x1f, 0x0e, 0xb8, 0xfb, 0x0e, 0x32,
+	0x99, 0xc6, 0xfd, 0xa4, 0x45, 0x81, 0xf6, 0xe2, 0xb4, 0xe9, 0x9c, 0xaf, 0xe2, 0xd4, 0xc3, 0x49,
+	0xbd, 0xa6, 0x9b, 0xc1, 0xc4, 0x71, 0x3f, 0xeb, 0x90, 0xa9, 0x36, 0x4d, 0xb3, 0x20, 0x62, 0xfc,
+	0x65, 0xe7, 0xd7, 0x8f, 0xdc, 0x79, 0xd9, 0xb8, 0xa8, 0x89, 0xcf, 0x9f, 0x11, 0x2f, 0x32, 0x65,
+	0x34, 0xa6, 0x60, 0xf1, 0xc7, 0xc5, 0xd9, 0xa6, 0x69, 0x2b, 0x09, 0x7a, 0xf8, 0xbb, 0x59, 0xb5,
+	0x17, 0xe7, 0xa2, 0x06, 0x81, 0x89, 0xe7, 0x46, 0xa4, 0x86, 0x8b, 0x2f, 0x6d, 0x8e, 0xb1, 0xfe,
+	0x2f, 0x1d, 0xad, 0xff, 0x62, 0x50, 0x71, 0x5d, 0xeb, 0xd1, 0xc7, 0x5f, 0x29, 0x70, 0x36, 0xee,
+	0xf7, 0x39, 0xa4, 0x29, 0x36, 0x07, 0xa0, 0x7c, 0x40, 0x6f, 0x6d, 0x05, 0x19, 0x0d, 0x83, 0x34,
+	0x6b, 0xd6, 0x58, 0x1f, 0x2e, 0x8c, 0x36, 0xb7, 0x2e, 0x27, 0x71, 0xbf, 0x77, 0x2d, 0x88, 0xda,
+	0xf3, 0xe7, 0x05, 0xa7, 0xe6, 0xc2, 0x10, 0xc2, 0x30, 0x94, 0xa5, 0xfb, 0x83, 0x0e, 0x39, 0x17,
+	0xf9, 0x5d, 0x9a, 0xf6, 0xfc, 0x16, 0x95, 0xe0, 0xf9, 0xd0, 0x6f, 0x6d, 0xb3, 0x1e, 0x8d, 0x1f,
+	0xae, 0x47, 0x9e, 0xe8, 0xd1, 0xb9, 0xeb, 0x43, 0x49, 0xc3, 0x1e, 0x6c, 0xdd, 0x9f, 0x76, 0xc8,
+	0xe9, 0x38, 0xe9, 0x6d, 0xf9, 0x11, 0x6d, 0x4b, 0x68, 0xda, 0x9c, 0x60, 0x4b, 0xef, 0x23, 0x47,
+	0xfb, 0x44, 0x37, 0xf2, 0x64, 0x57, 0xe2, 0x28, 0xc8, 0xe2, 0x64, 0x8d, 0x66, 0x59, 0x10, 0x75,
+	0xd2, 0xf9, 0xb3, 0xf7, 0xef, 0xcd, 0x9c, 0x1e, 0xc0, 0x82, 0xc1, 0xfe, 0xb8, 0xdf, 0x42, 0x26,
+	0xd3, 0xdd, 0xa8, 0x75, 0x2b, 0x88, 0xda, 0xf1, 0x9d, 0xb4, 0x59, 0x2f, 0x63, 0xf9, 0xae, 0x29,
+	0x82, 0x62, 0x01, 0x6a, 0x06, 0x60, 0x72, 0x2b, 0xfe, 0x70, 0x7a, 0x2a, 0x35, 0xca, 0xfe, 0x70,
+	0x7a, 0x32, 0xed, 0xc1, 0xd6, 0xfd, 0xb4, 0x43, 0x4e, 0xa4, 0x41, 0x27, 0xf2, 0xb3, 0x7e, 0x42,
+	0xaf, 0xd1, 0xdd, 0xb4, 0x49, 0x58, 0x47, 0xae, 0x1e, 0x71, 0x54, 0x0c, 0x92, 0xf3, 0x67, 0x45,
+	0x1f, 0x4f, 0x98, 0xad, 0x29, 0xd8, 0x7c, 0x8b, 0x16, 0x9a, 0x9e, 0xd6, 0x93, 0xe5, 0x2e, 0x34,
+	0x3d, 0xa9, 0x87, 0xb2, 0x74, 0xbf, 0x89, 0x9c, 0xe2, 0x4d, 0x6a, 0x64, 0xd3, 0xe6, 0x14, 0xdb,
+	0x68, 0xcf, 0xdc, 0xbf, 0x37, 0x73, 0x6a, 0x2d, 0x07, 0x83, 0x01, 0x6c, 0xf7, 0x55, 0x32, 0xd3,
+	0xa3, 0x49, 0x37, 0xc8, 0x6e, 0x44, 0xe1, 0xae, 0xdc, 0xbe, 0x5b, 0x71, 0x8f, 0xb6, 0x45, 0x77,
+	0xd2, 0xe6, 0x89, 0xf3, 0xce, 0x5b, 0xeb, 0xf3, 0x6f, 0x11, 0xdd, 0x9c, 0x59, 0xdd, 0x1b, 0x1d,
+	0xf6, 0xa3, 0xe7, 0x7e, 0xde, 0x21, 0xa7, 0xba, 0x7e, 0x14, 0x6c, 0xd2, 0x34, 0x5b, 0x8d, 0xc3,
+	0xa0, 0x15, 0xd0, 0xb4, 0x39, 0x7d, 0xbe, 0x7a, 0x74, 0x01, 0x60, 0xc5, 0xa4, 0xba, 0x3b, 0xdf,
+	0x14, 0x5d, 0x3e, 0xb5, 0x92, 0xe3, 0x06, 0x03, 0xfc, 0xdd, 0x5f, 0x75, 0xc8, 0x39, 0x63, 0xeb,
+	0x5f, 0xa3, 0xc9, 0x4e, 0xd0, 0xa2, 0x73, 0xad, 0x56, 0xdc, 0x8f, 0xb2, 0xb4, 0x79, 0x92, 0x75,
+	0x6f, 0xe3, 0x38, 0x0e, 0x22, 0x9b, 0x95, 0x5e, 0x2c, 0x43, 0x51, 0x52, 0xd8, 0xa3, 0xa7, 0xde,
+	0x3f, 0xad, 0x90, 0x53, 0x79, 0xb1, 0xc4, 0xfd, 0x59, 0x87, 0x9c, 0xbc, 0x7d, 0x27, 0x5b, 0x8f,
+	0xb7, 0x69, 0x94, 0xce, 0xef, 0xe2, 0xe1, 0xc1, 0x0e, 0xe4, 0xc9, 0x17, 0x5a, 0xe5, 0x0a, 0x40,
+	0xb3, 0x57, 0x6d, 0x2e, 0x17, 0xa3, 0x2c, 0xd9, 0x9d, 0x7f, 0x4a, 0xbc, 0xd3, 0xc9, 0xab, 0xb7,
+	0xd6, 0x4d, 0x28, 0xe4, 0x3b, 0x75, 0xee, 0x33, 0x0e, 0x39, 0x53, 0x44, 0xc2, 0x3d, 0x45, 0xaa,
+	0xdb, 0x74, 0x97, 0x8b, 0xc7, 0x80, 0xff, 0xba, 0x1f, 0x26, 0xb5, 0x1d, 0x3f, 0xec, 0x53, 0x21,
+	0x3b, 0x5e, 0x3e, 0xda, 0x8b, 0xa8, 0x9e, 0x01, 0xa7, 0xfa, 0xf5, 0x95, 0x17, 0x1d, 0xef, 0xb7,
+	0xaa, 0x64, 0xd2, 0xf8, 0x68, 0x0f, 0x41, 0x1e, 0x8e, 0x2d, 0x79, 0x78, 0xa5, 0xb4, 0xf9, 0x36,
+	0x54, 0x20, 0xbe, 0x93, 0x13, 0x88, 0x6f, 0x94, 0xc7, 0x72, 0x4f, 0x89, 0xd8, 0xcd, 0x48, 0x23,
+	0xee, 0xd1, 0x84, 0xa1, 0x36, 0xc7, 0xca, 0xf8, 0x84, 0x37, 0x24, 0xb9, 0xf9, 0x13, 0xf7, 0xef,
+	0xcd, 0x34, 0xd4, 0x4f, 0xd0, 0x8c, 0xbc, 0x7f, 0xe5, 0x90, 0x33, 0x46, 0x1f, 0x17, 0xe2, 0xa8,
+	0x1d, 0xb0, 0x4f, 0x7b, 0x9e, 0x8c, 0x65, 0xbb, 0x3d, 0x79, 0xff, 0x52, 0x23, 0xb5, 0xbe, 0xdb,
+	0xa3, 0xc0, 0x20, 0x78, 0x8d, 0xea, 0xd2, 0x34, 0xf5, 0x3b, 0x34, 0x7f, 0xe3, 0x5a, 0xe1, 0xcd,
+	0x20, 0xe1, 0x6e, 0x42, 0xdc, 0xd0, 0x4f, 0xb3, 0xf5, 0xc4, 0x8f, 0x52, 0x46, 0x7e, 0x3d, 0xe8,
+	0x52, 0x31, 0xc0, 0x5f, 0x35, 0xda, 0x8c, 0xc1, 0x27, 0xe6, 0x9f, 0xbc, 0x7f, 0x6f, 0xc6, 0x5d,
+	0x1e, 0xa0, 0x04, 0x05, 0xd4, 0xbd, 0x1f, 0x74, 0xc8, 0x93, 0xc5, 0x1b, 0x8c, 0xfb, 0x66, 0x32,
+	0xce, 0x2f, 0xdf, 0xe2, 0xed, 0xf4, 0x27, 0x61, 0xad, 0x20, 0xa0, 0xee, 0x05, 0xd2, 0x50, 0xa7,
+	0xb0, 0x78, 0xc7, 0xd3, 0x02, 0xb5, 0xa1, 0x8f, 0x6e, 0x8d, 0x83, 0x83, 0x16, 0xf9, 0xe2, 0xcd,
+	0x8c, 0x41, 0x43, 0x5c, 0x60, 0x10, 0xef, 0x77, 0x1c, 0xf2, 0xa6, 0x51, 0xb6, 0xbd, 0xe3, 0xeb,
+	0xe3, 0x1a, 0x39, 0xdb, 0xa6, 0x9b, 0x7e, 0x3f, 0xcc, 0x6c, 0x8e, 0xa2, 0xd3, 0xcf, 0x8a, 0x87,
+	0xcf, 0x2e, 0x16, 0x21, 0x41, 0xf1, 0xb3, 0xde, 0xef, 0x57, 0xc9, 0x33, 0x43, 0x5e, 0x8b, 0xcf,
+	0xee, 0xcf, 0x38, 0xec, 0xe6, 0x20, 0x5b, 0xc5, 0x6e, 0x71, 0x3c, 0x17, 0x19, 0xf3, 0x3e, 0x22,
+	0x1b, 0xc1, 0xe4, 0xee, 0xbe, 0xa8, 0x16, 0x39, 0x1f, 0xb1, 0xf3, 0xf6, 0x9a, 0x7c, 0x70, 0x6f,
+	0x66, 0x1a, 0x45, 0x42, 0xfe, 0x6b, 0x21, 0x6e, 0x53, 0xb5, 0x4a, 0x13, 0x32, 0xbe, 0x45, 0xfd,
+	0x30, 0xdb, 0x12, 0xb3, 0xf7, 0x88, 0x22, 0xd7, 0x15, 0x46, 0x2b, 0xbf, 0x33, 0xf0, 0x56, 0x10,
+	0x9c, 0xdc, 0x6f, 0x25, 0x8d, 0x44, 0x89, 0xe7, 0x63, 0x65, 0xc8, 0x05, 0x52, 0x70, 0x12, 0x8c,
+	0xd5, 0x84, 0xd1, 0x82, 0xb8, 0xe6, 0xe8, 0xfd, 0x3b, 0x87, 0x9c, 0x34, 0x46, 0xfa, 0x21, 0xdc,
+	0xd5, 0x23, 0xfb, 0xae, 0xbe, 0x54, 0xda, 0x2c, 0x19, 0x72, 0x59, 0xff, 0x3e, 0x87, 0x9c, 0x33,
+	0xb0, 0x56, 0xfc, 0xac, 0xb5, 0x75, 0xf1, 0x6e, 0x2f, 0xa1, 0x69, 0x8a, 0xb3, 0xe5, 0x59, 0xe3,
+	0xa8, 0x9d, 0x9f, 0x14, 0x14, 0xaa, 0xd7, 0xe8, 0x2e, 0x3f, 0x77, 0xdf, 0x46, 0xea, 0x7c, 0x3f,
+	0x8d, 0x13, 0x31, 0x9d, 0xd4, 0xbb, 0xdd, 0x10, 0xed, 0xa0, 0x30, 0x5c, 0x8f, 0x8c, 0xb3, 0xf3,
+	0x14, 0xcf, 0x17, 0x94, 0x4b, 0x09, 0x7e, 0xf0, 0x9b, 0xac, 0x05, 0x04, 0xc4, 0x4b, 0xad, 0xee,
+	0xac, 0x26, 0x94, 0xad, 0xf5, 0xf6, 0xa5, 0x80, 0x86, 0xed, 0x14, 0xf5, 0x08, 0x7e, 0x14, 0xc5,
+	0x99, 0x50, 0x09, 0x18, 0x7a, 0x84, 0x39, 0xdd, 0x0c, 0x26, 0x0e, 0x32, 0x0d, 0xfd, 0x0d, 0x1a,
+	0xf2, 0x11, 0x15, 0x4c, 0x97, 0x59, 0x0b, 0x08, 0x88, 0x77, 0xbf, 0x42, 0xa6, 0x0d, 0xae, 0x6b,
+	0xf4, 0x61, 0xa8, 0xbb, 0x12, 0xeb, 0x78, 0x5f, 0x2d, 0xef, 0xac, 0xa5, 0xc3, 0x55, 0x5e, 0xaf,
+	0xe5, 0x4e, 0x78, 0x28, 0x95, 0xeb, 0xde, 0x6a, 0xaf, 0x4f, 0x56, 0xc9, 0x8c, 0xfd, 0xc0, 0x80,
+	0x80, 0x80, 0x3a, 0x16, 0x83, 0x51, 0x5e, 0x01, 0x6a, 0xe0, 0x83, 0x89, 0x37, 0xe4, 0x8c, 0xad,
+	0x1c, 0xe7, 0x19, 0x6b, 0x8a, 0x00, 0xd5, 0x7d, 0x44, 0x80, 0x37, 0xab, 0x51, 0x1f, 0xcb, 0x9d,
+	0x67, 0xb6, 0x18, 0x74, 0x9e, 0x8c, 0xa5, 0x19, 0xed, 0x35, 0x6b, 0xf6, 0x11, 0xba, 0x96, 0xd1,
+	0x1e, 0x30, 0x88, 0xfb, 0x5e, 0x72, 0x32, 0xf3, 0x93, 0x0e, 0xcd, 0x12, 0xba, 0x13, 0x30, 0x65,
+	0x39, 0x53, 0xa0, 0x34, 0xe6, 0x9f, 0x40, 0x89, 0x7a, 0x9d, 0x81, 0x40, 0x82, 0x20, 0x8f, 0xeb,
+	0xfd, 0x97, 0x0a, 0x79, 0xca, 0xfe, 0x04, 0x5a, 0xe8, 0xf9, 0x46, 0x4b, 0xe8, 0xf9, 0x6a, 0x53,
+	0xe8, 0x79, 0x70, 0x6f, 0xe6, 0x8d, 0x43, 0x1e, 0xfb, 0xb2, 0x91, 0x89, 0xdc, 0xcb, 0xb9, 0x8f,
+	0x70, 0x61, 0xe0, 0xdc, 0x7b, 0x76, 0xc8, 0x3b, 0xe6, 0xbe, 0xd2, 0x9b, 0xc9, 0x78, 0x42, 0xfd,
+	0x34, 0x8e, 0x9a, 0x35, 0xfb, 0x6b, 0x02, 0x6b, 0x05, 0x01, 0xf5, 0xfe, 0x45, 0x23, 0x3f, 0xd8,
+	0x97, 0xb9, 0x01, 0x20, 0x4e, 0xdc, 0x80, 0x8c, 0x31, 0x35, 0x01, 0xdf, 0x59, 0xae, 0x1d, 0x6d,
+	0x15, 0xe2, 0x29, 0xa2, 0x48, 0xcf, 0xd7, 0xf1, 0xab, 0x61, 0x13, 0x30, 0x16, 0xee, 0x5d, 0x52,
+	0x6f, 0xc9, 0xdb, 0x7b, 0xa5, 0x0c, 0x3d, 0xb7, 0xb8, 0xbb, 0x6b, 0x8e, 0x53, 0xb8, 0xdd, 0xab,
+	0x2b, 0xbf, 0xe2, 0xe6, 0x52, 0x52, 0xed, 0x04, 0x59, 0x39, 0xc2, 0xc2, 0xe5, 0xc0, 0x78, 0xc5,
+	0x09, 0x3c, 0x83, 0x2e, 0x07, 0x19, 0x20, 0x7d, 0xf7, 0x3b, 0x1d, 0x32, 0x99, 0xb6, 0xba, 0xab,
+	0x49, 0xbc, 0x13, 0xb4, 0x69, 0xd2, 0x1c, 0x2b, 0x63, 0x67, 0x5b, 0x5b, 0x58, 0x91, 0x04, 0x35,
+	0x5f, 0xae, 0x2f, 0xd3, 0x10, 0x30, 0xf9, 0xe2, 0xbd, 0xfa, 0x29, 0xf1, 0xee, 0x8b, 0xb4, 0xc5,
+	0x56, 0x9c, 0x94, 0x29, 0x9a, 0xb5, 0x32, 0xee, 0x53, 0x8b, 0xfd, 0xd6, 0x36, 0xae, 0x37, 0xdd,
+	0xa1, 0x37, 0xde, 0xbf, 0x37, 0xf3, 0xd4, 0x42, 0x31, 0x4f, 0x18, 0xd6, 0x19, 0x36, 0x60, 0xbd,
+	0x7e, 0x18, 0x02, 0x7d, 0xb5, 0x4f, 0x99, 0x0a, 0xb6, 0x84, 0x01, 0x5b, 0xd5, 0x04, 0x73, 0x03,
+	0x66, 0x40, 0xc0, 0xe4, 0xeb, 0xbe, 0x4a, 0xc6, 0xbb, 0x7e, 0x96, 0x04, 0x77, 0x9b, 0x13, 0x65,
+	0xdc, 0x70, 0x57, 0x18, 0x2d, 0xcd, 0x9c, 0x1d, 0xf4, 0xbc, 0x11, 0x04, 0x23, 0xb4, 0x84, 0x74,
+	0x69, 0xd2, 0xa1, 0xcd, 0x7a, 0x19, 0x36, 0xa6, 0x15, 0x24, 0xa5, 0x19, 0x36, 0x50, 0xb8, 0x62,
+	0x6d, 0xc0, 0xb9, 0xb8, 0x1f, 0x26, 0xf5, 0x94, 0x86, 0xb4, 0x85, 0xe2, 0x51, 0x83, 0x71, 0xfc,
+	0xda, 0x11, 0x45, 0x45, 0x94, 0x4b, 0xd6, 0xc4, 0xa3, 0x7c, 0x81, 0xc9, 0x5f, 0xa0, 0x48, 0xe2,
+	0x00, 0xf6, 0xc2, 0x7e, 0x27, 0x88, 0x9a, 0xa4, 0x8c, 0x01, 0x5c, 0x65, 0xb4, 0x72, 0x03, 0xc8,
+	0x1b, 0x41, 0x30, 0xf2, 0xfe, 0xa3, 0x43, 0x5c, 0x7b, 0x53, 0x7b, 0x08, 0x32, 0xf1, 0xab, 0xb6,
+	0x4c, 0xbc, 0x5c, 0xa6, 0xd0, 0x32, 0x44, 0x2c, 0xfe, 0x87, 0x0d, 0x92, 0x3b, 0x0e, 0xae, 0xd3,
+	0x34, 0xa3, 0xed, 0xd7, 0xb7, 0xf0, 0xd7, 0xb7, 0xf0, 0xd7, 0xb7, 0x70, 0xf9, 0xc3, 0xdd, 0xc8,
+	0x6d, 0xe1, 0xef, 0x33, 0x56, 0xbd, 0x76, 0xe8, 0x78, 0x45, 0x79, 0x7c, 0x98, 0x3d, 0x30, 0x10,
+	0x70, 0x27, 0xb8, 0xba, 0x76, 0xe3, 0x7a, 0xe1, 0x9e, 0xfd, 0x8a, 0xbd, 0x67, 0x1f, 0x95, 0xc5,
+	0xff, 0x0b, 0xbb, 0xf4, 0xaf, 0x3a, 0xe4, 0x2d, 0xf6, 0xee, 0x25, 0x67, 0xce, 0x52, 0x27, 0x8a,
+	0x13, 0xba, 0x18, 0x6c, 0x6e, 0xd2, 0x84, 0x46, 0x68, 0xf4, 0x91, 0x7a, 0x3b, 0x67, 0x98, 0xde,
+	0xce, 0x7d, 0x27, 0x99, 0xba, 0x9d, 0xc6, 0xd1, 0x6a, 0x1c, 0x44, 0x62, 0x0b, 0xc2, 0x1b, 0xc7,
+	0x29, 0x34, 0x97, 0xe3, 0x88, 0xca, 0x76, 0xb0, 0xb0, 0xdc, 0x05, 0x72, 0xfa, 0xf6, 0xab, 0xab,
+	0x7e, 0x66, 0x68, 0x13, 0xe4, 0xbd, 0x9f, 0x19, 0x40, 0xaf, 0xbe, 0x94, 0x03, 0xc2, 0x20, 0xbe,
+	0xf7, 0x17, 0x2b, 0xe4, 0xe9, 0xdc, 0x8b, 0xc4, 0x61, 0x18, 0xf7, 0x33, 0xbc, 0x13, 0xb9, 0x3f,
+	0xc1, 0x8c, 0x47, 0x96, 0xc2, 0x22, 0x15, 0xa6, 0x8c, 0xf7, 0x97, 0x76, 0x46, 0xe4, 0x34, 0x22,
+	0xa6, 0x21, 0xc9, 0xe6, 0x0c, 0x03, 0x7d, 0x71, 0x3f, 0x4c, 0x1a, 0x5d, 0xff, 0xee, 0xcb, 0xbd,
+	0xb6, 0x9f, 0xc9, 0xeb, 0xe8, 0x70, 0x2d, 0x42, 0x3f, 0x0b, 0xc2, 0x59, 0xee, 0x2a, 0x34, 0xbb,
+	0x14, 0x65, 0x37, 0x92, 0xb5, 0x2c, 0x09, 0xa2, 0x0e, 0x57, 0x60, 0xaf, 0x48, 0x32, 0xa0, 0x29,
+	0x7a, 0x5f, 0x74, 0xc8, 0xb3, 0x43, 0x46, 0x27, 0xf1, 0x33, 0xda, 0xd9, 0x75, 0x3f, 0x46, 0x6a,
+	0x78, 0x6f, 0x94, 0xa3, 0x72, 0xab, 0xcc, 0x93, 0xd3, 0xf8, 0x12, 0xfa, 0x10, 0xc5, 0x5f, 0x29,
+	0x70, 0xa6, 0xde, 0x4f, 0x34, 0xf2, 0xc2, 0x02, 0x73, 0x06, 0x79, 0x81, 0x90, 0x4e, 0xbc, 0x4e,
+	0xbb, 0xbd, 0xd0, 0xcf, 0xf8, 0xbc, 0xab, 0x6b, 0x55, 0xc9, 0x65, 0x05, 0x01, 0x03, 0xcb, 0xfd,
+	0x0b, 0x0e, 0x21, 0x1d, 0x39, 0xe7, 0xa5, 0x20, 0xf0, 0x72, 0x99, 0xaf, 0xa3, 0x57, 0x94, 0xee,
+	0x8b, 0x62, 0x08, 0x06, 0x73, 0xf7, 0xdb, 0x1c, 0x52, 0xcf, 0x64, 0xf7, 0xab, 0x25, 0x2b, 0x73,
+	0xd7, 0x68, 0x26, 0x5f, 0x5a, 0xcb, 0x44, 0x6a, 0x48, 0x14, 0x5f, 0xf7, 0xbb, 0x1c, 0x42, 0xd0,
+	0x5a, 0xcf, 0xcd, 0x9b, 0xe2, 0xc4, 0xbc, 0x59, 0xaa, 0x3a, 0x47, 0x51, 0x9f, 0x9f, 0xc6, 0xd1,
+	0xd0, 0xbf, 0xc1, 0xe0, 0xec, 0x7e, 0x9c, 0xd4, 0x53, 0x31, 0xdd, 0x9a, 0xb5, 0xf2, 0x07, 0x43,
+	0x4e, 0x65, 0xb1, 0xbd, 0x8a, 0x5f, 0xa0, 0x78, 0xba, 0x3f, 0xea, 0x90, 0x93, 0x3d, 0x5b, 0x4d,
+	0x28, 0x8e, 0xc3, 0xf2, 0xf6, 0x80, 0x9c, 0x1a, 0x92, 0x6b, 0x5b, 0x72, 0x8d, 0x90, 0xef, 0x05,
+	0xee, 0x80, 0x7a, 0x06, 0xdf, 0xe8, 0x71, 0x95, 0xe5, 0x84, 0xde, 0x01, 0x2f, 0xe7, 0x81, 0x30,
+	0x88, 0xef, 0xae, 0x92, 0x33, 0xd8, 0xbb, 0x5d, 0x2e, 0x7e, 0xca, 0xe3, 0x25, 0x65, 0x87, 0x61,
+	0x7d, 0xfe, 0x19, 0x31, 0x43, 0xce, 0xcc, 0x15, 0xe0, 0x40, 0xe1, 0x93, 0xee, 0x6f, 0x39, 0xe4,
+	0x99, 0x80, 0x1d, 0x03, 0xa6, 0x0d, 0x41, 0x9f, 0x08, 0xc2, 0xb3, 0x83, 0x96, 0xba, 0x57, 0x0c,
+	0x3b, 0x7e, 0xe6, 0xdf, 0x24, 0xde, 0xe0, 0x99, 0xa5, 0x3d, 0xba, 0x04, 0x7b, 0x76, 0xd8, 0xfd,
+	0x3a, 0x72, 0x42, 0xae, 0x8b, 0x55, 0xdc, 0x82, 0xd9, 0x41, 0xdb, 0x98, 0x3f, 0x8d, 0x2e, 0x1c,
+	0xeb, 0x26, 0x00, 0x6c, 0x3c, 0xef, 0x9f, 0x55, 0xc9, 0x99, 0xfc, 0x74, 0x63, 0x3a, 0x1e, 0xdc,
+	0x6e, 0x5a, 0x52, 0xff, 0x23, 0x77, 0xcf, 0x52, 0xb7, 0x1b, 0xa5, 0x5d, 0xd2, 0xdb, 0x8d, 0x6a,
+	0x4a, 0xc1, 0x60, 0x8e, 0x42, 0xe9, 0x69, 0x3f, 0xaf, 0x29, 0x15, 0x3b, 0xe0, 0x87, 0xcb, 0xec,
+	0xd2, 0xa0, 0xbd, 0xf6, 0x69, 0xd1, 0xb5, 0xd3, 0x03, 0x20, 0x18, 0xec, 0x92, 0x6d, 0xab, 0xa9,
+	0x3e, 0x74, 0x5b, 0xcd, 0xaf, 0xdb, 0x46, 0x4f, 0x63, 0xef, 0x18, 0xc1, 0xa0, 0xfb, 0x59, 0x87,
+	0x4c, 0x26, 0x71, 0x18, 0x06, 0x51, 0x07, 0xf7, 0x39, 0x71, 0x58, 0x7f, 0xe8, 0x58, 0xce, 0x4b,
+	0xb1, 0xa1, 0x31, 0xc9, 0x1a, 0x34, 0x4f, 0x30, 0x3b, 0x80, 0x4e, 0xa2, 0xcd, 0x61, 0xfb, 0xb1,
+	0x4b, 0xc9, 0x1b, 0xe5, 0x66, 0xa3, 0x86, 0xe2, 0x46, 0xb4, 0x48, 0x43, 0xaa, 0xd4, 0xe6, 0xf5,
+	0xf9, 0xe7, 0xc5, 0x6b, 0xbe, 0x71, 0x75, 0x38, 0x2a, 0xec, 0x45, 0xc7, 0xfd, 0x20, 0x39, 0x65,
+	0xbc, 0x57, 0xaa, 0x06, 0xa6, 0x31, 0x3f, 0x8b, 0x02, 0xd0, 0x5c, 0x0e, 0xf6, 0xe0, 0xde, 0xcc,
+	0x93, 0xf9, 0x36, 0x71, 0x60, 0x0c, 0xd0, 0xf1, 0x7e, 0xa6, 0x92, 0xff, 0x5a, 0xea, 0xac, 0xff,
+	0x82, 0x33, 0xa0, 0x4d, 0x78, 0xff, 0x71, 0x9c, 0xaf, 0x4c, 0xef, 0xa0, 0x5c, 0x6c, 0x86, 0xe3,
+	0x3c, 0x42, 0x97, 0x0c, 0xef, 0x37, 0xc6, 0xc8, 0x1e, 0x3d, 0x1b, 0x41, 0x78, 0x3f, 0xb0, 0x8d,
+	0xfc, 0x7b, 0x1d, 0x65, 0x30, 0xe3, 0x6b, 0xb8, 0x7d, 0x5c, 0x63, 0xcf, 0xef, 0x4f, 0x29, 0x77,
+	0x0b, 0x52, 0x5a, 0x74, 0xdb, 0x34, 0xe7, 0xfe, 0xa4, 0x63, 0x9b, 0xfc, 0xb8, 0x0d, 0x38, 0x38,
+	0xb6, 0x3e, 0x19, 0x76, 0x44, 0xde, 0x31, 0x6d, 0x7d, 0x1a, 0x66, 0x61, 0x9c, 0x25, 0x64, 0x33,
+	0x88, 0xfc, 0x30, 0x78, 0x0d, 0x6f, 0x47, 0x35, 0x76, 0xc0, 0x33, 0x89, 0xe9, 0x92, 0x6a, 0x05,
+	0x03, 0xe3, 0xdc, 0xff, 0x4f, 0x26, 0x8d, 0x37, 0x2f, 0xf0, 0x66, 0x3a, 0x63, 0x7a, 0x33, 0x35,
+	0x0c, 0x27, 0xa4, 0x73, 0xef, 0x23, 0xa7, 0xf2, 0x1d, 0x3c, 0xc8, 0xf3, 0xde, 0xff, 0x9a, 0xc8,
+	0xdb, 0xe0, 0xd6, 0x69, 0xd2, 0xc5, 0xae, 0xbd, 0xae, 0xd8, 0x7a, 0x5d, 0xb1, 0xf5, 0xba, 0x62,
+	0xcb, 0xb4, 0x4d, 0x08, 0xa5, 0xcd, 0xc4, 0x43, 0x52, 0xda, 0x58, 0x6a, 0xa8, 0x7a, 0xe9, 0x6a,
+	0x28, 0xef, 0x3b, 0x07, 0x34, 0xf7, 0xeb, 0x09, 0xa5, 0x6e, 0x4c, 0x6a, 0x51, 0xdc, 0xa6, 0x52,
+	0xc6, 0xbd, 0x5a, 0x8e, 0xc0, 0x76, 0x3d, 0x6e, 0x1b, 0xf1, 0x09, 0xf8, 0x2b, 0x05, 0xce, 0xc7,
+	0xbb, 0x5f, 0x23, 0x96, 0x38, 0xc9, 0xbf, 0x3b, 0x86, 0x30, 0xd1, 0x5e, 0xfc, 0x32, 0x2c, 0x37,
+	0x1d, 0xdb, 0x78, 0x0c, 0xbc, 0x19, 0x24, 0x1c, 0xcf, 0xbc, 0x9e, 0x9f, 0x6d, 0x35, 0x2b, 0xf6,
+	0x99, 0x87, 0xaa, 0x23, 0x60, 0x10, 0xf7, 0x7d, 0x64, 0x3a, 0xb3, 0x4c, 0xe1, 0xc2, 0xe4, 0xfb,
+	0xa4, 0xc0, 0x9d, 0xb6, 0x0d, 0xe5, 0x90, 0xc3, 0x76, 0x5f, 0x25, 0x63, 0x5b, 0x34, 0xec, 0x8a,
+	0x4f, 0xbf, 0x56, 0xde, 0x59, 0xc3, 0xde, 0xf5, 0x0a, 0x0d, 0xbb, 0x7c, 0x27, 0xc4, 0xff, 0x80,
+	0xb1, 0xc2, 0x79, 0xdf, 0xd8, 0xee, 0xa7, 0x59, 0xdc, 0x0d, 0x5e, 0x93, 0x9a, 0xce, 0xf7, 0x97,
+	0xcc, 0xf8, 0x9a, 0xa4, 0xcf, 0x55, 0x4a, 0xea, 0x27, 0x68, 0xce, 0xac, 0x1f, 0xed, 0x20, 0x61,
+	0x53, 0x66, 0xb7, 0x49, 0x8e, 0xa5, 0x1f, 0x8b, 0x92, 0x3e, 0xef, 0x87, 0xfa, 0x09, 0x9a, 0xb3,
+	0xbb, 0xab, 0xd6, 0xdf, 0xe4, 0x79, 0xa7, 0xdc, 0xbb, 0x17, 0xeb, 0x03, 0x5f, 0x7b, 0x85, 0xeb,
+	0xf0, 0x79, 0x52, 0x6b, 0x6d, 0xf9, 0x49, 0xd6, 0x9c, 0x62, 0x93, 0x46, 0xcd, 0xe2, 0x05, 0x6c,
+	0x04, 0x0e, 0x43, 0xbf, 0xa8, 0x84, 0x6e, 0x36, 0x4f, 0xd8, 0x7e, 0x51, 0x40, 0x37, 0x01, 0xdb,
+	0xbd, 0x9f, 0xaa, 0x90, 0x73, 0x03, 0x3c, 0xd5, 0x8b, 0xf2, 0xd9, 0xde, 0xea, 0x27, 0xa9, 0x54,
+	0x7f, 0x19, 0xb3, 0x9d, 0x35, 0x83, 0x84, 0xbb, 0x9f, 0x72, 0xc8, 0x04, 0xea, 0x55, 0x23, 0x9a,
+	0x35, 0x2b, 0x65, 0x2b, 0x79, 0x58, 0xb7, 0xae, 0x72, 0xea, 0xba, 0x0f, 0xa2, 0x01, 0x24, 0x5f,
+	0xec, 0x2e, 0xbd, 0xdb, 0x0a, 0xfb, 0xed, 0x01, 0x57, 0x97, 0x8b, 0xbc, 0x19, 0x24, 0x1c, 0x51,
+	0x83, 0x88, 0xa3, 0x8e, 0xd9, 0xa8, 0x4b, 0x91, 0x40, 0x15, 0x70, 0xef, 0x87, 0xc7, 0xc9, 0xd9,
+	0xc2, 0xc5, 0x81, 0x02, 0x15, 0x13, 0x59, 0x2e, 0x05, 0x21, 0x95, 0x4e, 0x5e, 0x4c, 0xa0, 0xba,
+	0xa9, 0x5a, 0xc1, 0xc0, 0x70, 0x3f, 0x41, 0x48, 0xcf, 0x4f, 0xfc, 0x2e, 0x55, 0xea, 0xe9, 0x23,
+	0xcb, 0x2d, 0xd8, 0x8f, 0x55, 0x49, 0x53, 0x5f, 0xd1, 0x55, 0x53, 0x0a, 0x06, 0x4b, 0x74, 0x5b,
+	0x4a, 0x68, 0x48, 0xfd, 0x94, 0x45, 0x53, 0xe4, 0x43, 0xc3, 0x40, 0x83, 0xc0, 0xc4, 0x43, 0x4f,
+	0x12, 0xe1, 0x0f, 0x97, 0xf3, 0x0b, 0xb2, 0x7d, 0xe2, 0xdc, 0xef, 0x77, 0xc8, 0x34, 0x86, 0x64,
+	0x6a, 0xee, 0x22, 0x90, 0xeb, 0xc6, 0xd1, 0x5f, 0xf2, 0x92, 0x49, 0x57, 0xef, 0x90, 0x56, 0x73,
+	0x0a, 0x39, 0xf6, 0xf8, 0x99, 0x77, 0x68, 0xc2, 0xb6, 0xd6, 0x71, 0xfb, 0x33, 0xdf, 0xe4, 0xcd,
+	0x20, 0xe1, 0xee, 0x1c, 0x39, 0xd9, 0xf3, 0xd3, 0x74, 0x21, 0xa1, 0x6d, 0x1a, 0x65, 0x81, 0x1f,
+	0xf2, 0x30, 0xab, 0xba, 0x0e, 0x04, 0x58, 0xb5, 0xc1, 0x90, 0xc7, 0x77, 0x3f, 0x40, 0x9e, 0xe2,
+	0xfa, 0x9f, 0x95, 0x20, 0x4d, 0x83, 0xa8, 0xa3, 0xa7, 0x81, 0x50, 0x83, 0xcd, 0x08, 0x52, 0x4f,
+	0x2d, 0x15, 0xa3, 0xc1, 0xb0, 0xe7, 0xd1, 0x81, 0x31, 0xdd, 0x0e, 0x7a, 0x0b, 0x49, 0x3b, 0x65,
+	0xb6, 0x9f, 0xba, 0x56, 0xba, 0xae, 0x89, 0x76, 0x50, 0x18, 0x6e, 0x8b, 0x4c, 0xf1, 0x4f, 0xc2,
+	0x1d, 0xfa, 0xc4, 0xfe, 0xf8, 0xf6, 0xa1, 0xc7, 0xb4, 0x88, 0x1a, 0x9e, 0x05, 0xff, 0xce, 0x45,
+	0x69, 0x89, 0xe2, 0x86, 0x93, 0x9b, 0x06, 0x19, 0xb0, 0x88, 0x7a, 0x3f, 0x56, 0x21, 0xcd, 0x81,
+	0x75, 0x21, 0xd6, 0xa4, 0x9b, 0xe2, 0x52, 0xcc, 0x6e, 0xfa, 0x89, 0x3c, 0xb0, 0x8f, 0x18, 0x0d,
+	0x26, 0xe8, 0xde, 0xf4, 0x13, 0x73, 0x51, 0x33, 0x06, 0x20, 0x39, 0xb9, 0xb7, 0xc9, 0x58, 0x16,
+	0xfa, 0x25, 0x85, 0x8f, 0x1a, 0x1c, 0xb5, 0x22, 0x66, 0x79, 0x2e, 0x05, 0xc6, 0xc3, 0x7d, 0x06,
+	0x6f, 0x1f, 0x1b, 0xd2, 0x52, 0x24, 0x2e, 0x0c, 0x1b, 0x29, 0xb0, 0x56, 0xef, 0x17, 0x26, 0x0b,
+	0xf6, 0x55, 0x75, 0x90, 0xa1, 0x65, 0x01, 0x2f, 0xb2, 0xab, 0x09, 0xdd, 0x0c, 0xee, 0x0a, 0x41,
+	0x42, 0xad, 0xdd, 0xeb, 0x0a, 0x02, 0x06, 0x96, 0x7c, 0x66, 0xad, 0xbf, 0x89, 0xcf, 0x54, 0x06,
+	0x9f, 0xe1, 0x10, 0x30, 0xb0, 0xdc, 0x77, 0x92, 0xf1, 0xa0, 0xeb, 0x77, 0x94, 0x23, 0xeb, 0x33,
+	0xb8, 0x68, 0x97, 0x58, 0x0b, 0xfa, 0x4f, 0xab, 0x0e, 0xb1, 0x26, 0x10, 0xb8, 0xee, 0xcf, 0x38,
+	0x64, 0xaa, 0x15, 0x77, 0xbb, 0x71, 0xc4, 0xaf, 0x7f, 0xe2, 0x2e, 0x7b, 0xfb, 0xb8, 0x8e, 0xf9,
+	0xd9, 0x05, 0x83, 0x19, 0xbf, 0xcc, 0xaa, 0x38, 0x57, 0x13, 0x04, 0x56, 0xaf, 0xcc, 0xb5, 0x5d,
+	0xdb, 0x67, 0x6d, 0xff, 0xbc, 0x43, 0x4e, 0xf3, 0x67, 0x8d, 0x5b, 0xa9, 0x08, 0xe9, 0x8c, 0x8f,
+	0xf9, 0xb5, 0x06, 0x2e, 0xea, 0x4a, 0x59, 0x39, 0x00, 0x87, 0xc1, 0x4e, 0xba, 0x97, 0xc9, 0xe9,
+	0xcd, 0x38, 0x69, 0x51, 0x73, 0x20, 0xc4, 0xc6, 0xa4, 0x08, 0x5d, 0xca, 0x23, 0xc0, 0xe0, 0x33,
+	0xee, 0x4d, 0xf2, 0xa4, 0xd1, 0x68, 0x8e, 0x03, 0xdf, 0x9b, 0x9e, 0x13, 0xd4, 0x9e, 0xbc, 0x54,
+	0x88, 0x05, 0x43, 0x9e, 0x46, 0x21, 0x96, 0x41, 0x94, 0x92, 0x46, 0xec, 0x4f, 0x7a, 0x8b, 0xb6,
+	0xa0, 0x90, 0xc3, 0xb6, 0x15, 0x3f, 0x64, 0x04, 0xc5, 0xcf, 0x2b, 0xe4, 0xe9, 0xd6, 0xe0, 0xc8,
+	0xee, 0xa4, 0xfd, 0x0d, 0x16, 0xcf, 0x88, 0xbc, 0xbf, 0x42, 0x10, 0x78, 0x7a, 0x61, 0x18, 0x22,
+	0x0c, 0xa7, 0xe1, 0x7e, 0x8c, 0xd4, 0x13, 0xca, 0xbe, 0x2a, 0x0f, 0x4c, 0x3c, 0xf2, 0x6d, 0x5f,
+	0x4b, 0xb0, 0x9c, 0xac, 0xde, 0xbb, 0x45, 0x43, 0x0a, 0x8a, 0xa3, 0x7b, 0x87, 0x4c, 0xf4, 0x50,
+	0xe9, 0x4f, 0x31, 0x88, 0xb1, 0x04, 0xdd, 0xb4, 0x62, 0xce, 0x4c, 0x09, 0x46, 0x1e, 0x05, 0xce,
+	0x04, 0x24, 0x37, 0x94, 0x66, 0x5a, 0x71, 0xb7, 0x17, 0x47, 0x34, 0xca, 0x78, 0x6c, 0xa3, 0x90,
+	0x66, 0x16, 0x54, 0x2b, 0x18, 0x18, 0x68, 0xf1, 0x61, 0xba, 0xaf, 0x5b, 0x41, 0xb6, 0x85, 0xfa,
+	0x62, 0x79, 0x27, 0x3c, 0x69, 0x5b, 0x7c, 0x96, 0x0b, 0x70, 0xa0, 0xf0, 0xc9, 0x73, 0xdf, 0x48,
+	0x4e, 0x0f, 0x6c, 0x05, 0x07, 0x52, 0x3b, 0x2d, 0x92, 0x27, 0x8b, 0x17, 0xdd, 0x81, 0x94, 0x4f,
+	0x7f, 0x27, 0xe7, 0x7d, 0x6c, 0x08, 0xe2, 0x23, 0x28, 0x32, 0x7d, 0x52, 0xa5, 0xd1, 0x8e, 0x38,
+	0x83, 0x2e, 0x1d, 0xed, 0xdb, 0x5d, 0x8c, 0x76, 0xf8, 0x9e, 0xc1, 0xb4, 0x35, 0x17, 0xa3, 0x1d,
+	0x40, 0xda, 0x18, 0x8c, 0x6a, 0x0a, 0x92, 0x5c, 0xfd, 0xf9, 0x91, 0x63, 0xb9, 0x79, 0x8c, 0x2c,
+	0x5b, 0x7a, 0xbf, 0x59, 0x21, 0xe7, 0xf7, 0x23, 0x32, 0xc2, 0xf0, 0x3d, 0x8f, 0xee, 0xcf, 0x49,
+	0x10, 0x75, 0xc4, 0xa6, 0x3e, 0x89, 0x73, 0x95, 0x7b, 0x18, 0xbc, 0x02, 0x02, 0xe4, 0x86, 0xa4,
+	0xda, 0xf5, 0x7b, 0x42, 0x2b, 0xb6, 0x74, 0xd4, 0x08, 0x3c, 0xfc, 0xed, 0x87, 0x2b, 0x7e, 0x8f,
+	0xeb, 0x5a, 0x8c, 0x06, 0x40, 0x36, 0x6e, 0x46, 0x6a, 0x7e, 0x92, 0xf8, 0xd2, 0x78, 0x7d, 0xad,
+	0x1c, 0x7e, 0x73, 0x48, 0x92, 0xdb, 0xfe, 0xac, 0x26, 0xe0, 0xcc, 0xbc, 0xef, 0x20, 0x56, 0x48,
+	0x0f, 0xf3, 0x48, 0x48, 0xc9, 0xb8, 0x50, 0x86, 0x39, 0x65, 0x07, 0x3e, 0x32, 0xb2, 0xfc, 0x9e,
+	0xc9, 0xff, 0x07, 0xc1, 0x6a, 0x20, 0x2c, 0xac, 0xf2, 0x48, 0xc3, 0xc2, 0x78, 0x62, 0x18, 0x26,
+	0xd5, 0x0e, 0x26, 0x86, 0xc1, 0x66, 0x90, 0x70, 0xf7, 0x6e, 0x81, 0xe7, 0x41, 0x09, 0x49, 0x09,
+	0x46, 0xf0, 0x35, 0xf8, 0x49, 0x87, 0x9c, 0x0e, 0xf2, 0x26, 0xe4, 0x66, 0xad, 0x0c, 0xdf, 0x96,
+	0xe1, 0x16, 0x6a, 0x25, 0x0e, 0x0c, 0x80, 0x60, 0xb0, 0x33, 0x6e, 0x9b, 0x8c, 0x05, 0xd1, 0x66,
+	0x2c, 0x84, 0xa0, 0xf9, 0xa3, 0x75, 0x6a, 0x29, 0xda, 0x8c, 0xf5, 0x6a, 0xc6, 0x5f, 0xc0, 0xa8,
+	0xbb, 0xcb, 0xe4, 0x8c, 0x8c, 0xea, 0xb8, 0x12, 0xa4, 0xa8, 0x53, 0x58, 0x0e, 0xba, 0x41, 0xc6,
+	0x04, 0x98, 0xea, 0x7c, 0x13, 0xcf, 0x07, 0x28, 0x80, 0x43, 0xe1, 0x53, 0xee, 0x6b, 0x64, 0x42,
+	0x9a, 0x6d, 0xeb, 0x65, 0xdc, 0x2b, 0x07, 0xe7, 0xbf, 0x9a, 0x4c, 0xfc, 0x77, 0x0a, 0x92, 0xa1,
+	0x7b, 0x95, 0xb8, 0xbd, 0x24, 0xee, 0x30, 0x97, 0xa9, 0xa8, 0x83, 0x91, 0x1a, 0x71, 0x3f, 0x63,
+	0xa2, 0x4e, 0x63, 0xfe, 0x9c, 0x78, 0xca, 0x5d, 0x1d, 0xc0, 0x80, 0x82, 0xa7, 0x06, 0x53, 0xc6,
+	0x90, 0x47, 0x9c, 0x32, 0xe6, 0x0b, 0x0e, 0x71, 0x8d, 0x06, 0x61, 0x01, 0x6e, 0x4e, 0x96, 0x11,
+	0xf1, 0xb5, 0x38, 0x40, 0x97, 0x87, 0xc3, 0x0c, 0xb6, 0x43, 0x41, 0x1f, 0xbc, 0x7f, 0x79, 0x8a,
+	0x9c, 0x9e, 0xdb, 0xdb, 0x84, 0xef, 0x3c, 0x6c, 0x13, 0x3e, 0x5e, 0x34, 0x53, 0x6d, 0x7d, 0x2f,
+	0x61, 0x4f, 0x11, 0x5c, 0xb5, 0x65, 0x15, 0xed, 0xec, 0x8c, 0xc7, 0x23, 0x89, 0x66, 0xbd, 0x4b,
+	0x26, 0xb6, 0xf8, 0xc2, 0x13, 0x77, 0xbf, 0x95, 0xa3, 0x0e, 0xae, 0xb5, 0x9a, 0xf5, 0x32, 0x13,
+	0x0d, 0x20, 0xd9, 0x31, 0x77, 0x31, 0xc3, 0xa1, 0x85, 0x6f, 0x99, 0xe5, 0x45, 0xff, 0x8d, 0xee,
+	0xcd, 0xf2, 0x51, 0x32, 0x95, 0xd0, 0x56, 0x1c, 0xb5, 0x82, 0x90, 0xb6, 0xe7, 0xa4, 0x81, 0xe7,
+	0x20, 0x41, 0x5f, 0x4c, 0x7f, 0x02, 0x06, 0x0d, 0xb0, 0x28, 0xba, 0xdf, 0xed, 0x90, 0x69, 0x15,
+	0xe4, 0x8f, 0x1f, 0x84, 0x0a, 0x45, 0xfe, 0x72, 0x49, 0x29, 0x05, 0x18, 0xcd, 0x79, 0x17, 0xef,
+	0x60, 0x76, 0x1b, 0xe4, 0xf8, 0xba, 0x1f, 0x24, 0x24, 0xde, 0xe0, 0x3e, 0x61, 0x73, 0x59, 0xb3,
+	0x7e, 0xe0, 0x57, 0x9d, 0xe6, 0xc1, 0xa3, 0x92, 0x02, 0x18, 0xd4, 0xdc, 0x6b, 0x84, 0xf0, 0x65,
+	0x83, 0x66, 0xb7, 0x66, 0xc3, 0x8a, 0xda, 0x23, 0x6b, 0x0a, 0xf2, 0xe0, 0xde, 0xcc, 0xa0, 0x96,
+	0x15, 0x01, 0x60, 0x3c, 0xee, 0x7e, 0x0b, 0x99, 0x48, 0xfb, 0xdd, 0xae, 0xaf, 0x74, 0xfe, 0x25,
+	0x86, 0xa3, 0x72, 0xba, 0xc6, 0x11, 0xc0, 0x1b, 0x40, 0x72, 0x74, 0x6f, 0xe3, 0x61, 0x96, 0x0a,
+	0xf5, 0x2f, 0x5b, 0x45, 0xec, 0x7f, 0xb6, 0x4d, 0x36, 0xe6, 0xdf, 0x2d, 0x2f, 0x3c, 0x50, 0x80,
+	0x83, 0x2e, 0x27, 0x76, 0xfb, 0x72, 0xcc, 0xd9, 0x42, 0x21, 0x4d, 0xf7, 0x2a, 0x99, 0xd4, 0xaf,
+	0x2d, 0xf3, 0xe3, 0xbc, 0x55, 0x27, 0x22, 0x63, 0xcd, 0xc3, 0xc7, 0xcc, 0x7c, 0xd8, 0x5d, 0x21,
+	0x4f, 0xb4, 0xe2, 0x28, 0x4b, 0xe2, 0x30, 0xe4, 0x89, 0xf8, 0xf8, 0x5d, 0x9b, 0xdb, 0x04, 0xde,
+	0x28, 0xba, 0xfd, 0xc4, 0xc2, 0x20, 0x0a, 0x14, 0x3d, 0xe7, 0xee, 0x90, 0x3a, 0x36, 0x6d, 0xf8,
+	0xad, 0xed, 0xe6, 0x74, 0x19, 0x13, 0x16, 0x04, 0x35, 0xb9, 0x09, 0xb2, 0x8b, 0xb1, 0x68, 0x03,
+	0xc5, 0x0b, 0xdd, 0x59, 0x27, 0xbb, 0x7e, 0x10, 0x65, 0x34, 0xf2, 0xa3, 0x16, 0x65, 0xf7, 0xcc,
+	0x23, 0x8b, 0x00, 0x2b, 0x9a, 0xa0, 0x60, 0xcf, 0xee, 0x00, 0x46, 0x33, 0x98, 0x4c, 0x31, 0x99,
+	0x92, 0x5e, 0x3c, 0x2f, 0xf5, 0x69, 0x9f, 0x36, 0x4f, 0x95, 0xb1, 0x43, 0x32, 0x52, 0x6d, 0x9d,
+	0x0d, 0x44, 0x69, 0x4f, 0x6e, 0x58, 0xcc, 0x20, 0xc7, 0xdc, 0xfd, 0x62, 0x61, 0x7e, 0xb0, 0xd3,
+	0x65, 0xc8, 0x13, 0xf9, 0xcc, 0x5f, 0x79, 0x5f, 0xbb, 0x91, 0x32, 0x83, 0xfd, 0x50, 0x5e, 0xd4,
+	0x71, 0x59, 0xd7, 0x3e, 0x78, 0x2c, 0x49, 0x89, 0x78, 0x07, 0x47, 0x10, 0x78, 0xbc, 0xc8, 0xb6,
+	0x31, 0x8b, 0x05, 0xfe, 0x4e, 0x32, 0x85, 0x81, 0x18, 0x49, 0xe4, 0x87, 0x2f, 0xc3, 0xb2, 0xb4,
+	0xe8, 0xb0, 0x7d, 0xfc, 0xa2, 0xd1, 0x0e, 0x16, 0x16, 0x06, 0xee, 0x0b, 0x25, 0xab, 0x11, 0xb8,
+	0xcf, 0x95, 0xac, 0x52, 0xa5, 0xea, 0xfd, 0xef, 0x8a, 0x75, 0x99, 0x7b, 0x24, 0x16, 0x6d, 0x96,
+	0x92, 0x4c, 0x7e, 0x21, 0x06, 0x68, 0x56, 0x4a, 0xe7, 0xac, 0x52, 0x92, 0xdd, 0x30, 0x19, 0x81,
+	0xcd, 0xd7, 0xdd, 0x26, 0xb5, 0xad, 0x38, 0xcd, 0xa4, 0xea, 0xe2, 0x88, 0x5a, 0x92, 0x2b, 0x71,
+	0x9a, 0xb1, 0x1b, 0x88, 0x7a, 0x6d, 0x6c, 0x49, 0x81, 0xf3, 0xf0, 0xfe, 0xd8, 0xb1, 0xec, 0x77,
+	0xb7, 0x58, 0xf0, 0xc3, 0x0e, 0x8d, 0xf0, 0x68, 0x32, 0xdd, 0x2d, 0xbf, 0x2e, 0x17, 0x4a, 0xfe,
+	0x96, 0x61, 0xb9, 0x52, 0xef, 0x20, 0x85, 0x59, 0x46, 0xc2, 0xf0, 0xcc, 0xfc, 0xa4, 0x63, 0xe7,
+	0x04, 0xa8, 0x94, 0xa1, 0x9c, 0x30, 0xfa, 0xbd, 0x7f, 0x7a, 0x01, 0xef, 0xd3, 0x0e, 0x39, 0x3d,
+	0xd7, 0xcf, 0xe2, 0xae, 0x9f, 0xd1, 0xb6, 0xdc, 0x41, 0xd1, 0x7a, 0x77, 0x87, 0xa5, 0xca, 0xcb,
+	0x67, 0xa9, 0xe1, 0x09, 0xf4, 0x40, 0x40, 0x87, 0xdc, 0x70, 0x2a, 0x87, 0xb9, 0xe1, 0x78, 0x7f,
+	0xcf, 0x21, 0x13, 0xf3, 0x7e, 0x6b, 0x3b, 0xde, 0xdc, 0x44, 0xd3, 0x55, 0xbb, 0x9f, 0x98, 0x89,
+	0x12, 0x94, 0xfa, 0x73, 0x51, 0xb4, 0x83, 0xc2, 0xc0, 0xd5, 0xb4, 0xe9, 0xb7, 0x64, 0x9e, 0x8e,
+	0x2a, 0x5f, 0x4d, 0x97, 0x58, 0x0b, 0x08, 0x08, 0x9a, 0x31, 0xbb, 0xfe, 0x5d, 0xf9, 0x70, 0xde,
+	0x8c, 0xb9, 0xa2, 0x41, 0x60, 0xe2, 0x21, 0xe9, 0xdb, 0x41, 0x96, 0x09, 0xf7, 0x26, 0x41, 0xfa,
+	0x2a, 0x6b, 0x01, 0x01, 0xf1, 0xfe, 0x89, 0x43, 0x9a, 0xf3, 0x7e, 0x1a, 0xb4, 0x30, 0xdb, 0xed,
+	0x7c, 0x90, 0x6d, 0xf4, 0x5b, 0xdb, 0x34, 0xe3, 0xf9, 0x7c, 0xf0, 0x4d, 0xfa, 0x29, 0x4d, 0x0c,
+	0x0d, 0x96, 0x7a, 0x93, 0x97, 0x45, 0x3b, 0x28, 0x0c, 0xf7, 0x35, 0x32, 0x89, 0x06, 0xc2, 0x3b,
+	0x71, 0xd2, 0x06, 0xba, 0x59, 0x4e, 0xc6, 0xaf, 0x35, 0xda, 0x4a, 0x68, 0x06, 0x74, 0x53, 0xb8,
+	0x05, 0x69, 0xfa, 0x60, 0x32, 0xf3, 0x3e, 0xeb, 0x90, 0xa7, 0xe7, 0xa9, 0x9f, 0xd0, 0x84, 0x25,
+	0x08, 0x53, 0x2f, 0xb2, 0x10, 0xc6, 0xfd, 0xb6, 0xfb, 0x2a, 0xa9, 0x67, 0xd8, 0x8c, 0xdd, 0x72,
+	0xca, 0xed, 0x16, 0x3b, 0xbc, 0xd7, 0x05, 0x71, 0x50, 0x6c, 0xbc, 0x1f, 0x77, 0xc8, 0x14, 0x73,
+	0x4c, 0x58, 0xa4, 0x99, 0x1f, 0x84, 0x03, 0x59, 0x4a, 0x9d, 0x11, 0xb3, 0x94, 0x9e, 0x27, 0x63,
+	0x5b, 0x71, 0x97, 0xe6, 0x9d, 0x6a, 0xae, 0xc4, 0xa8, 0x40, 0x44, 0x08, 0xa6, 0x5e, 0x61, 0x07,
+	0xb6, 0x8f, 0x0b, 0x56, 0x1a, 0xbe, 0xf4, 0xa1, 0xce, 0x9b, 0xc1, 0xc4, 0xf1, 0xfe, 0x71, 0x83,
+	0x4c, 0x08, 0x0f, 0xb0, 0x91, 0x73, 0x3a, 0x49, 0x4d, 0x66, 0x65, 0xa8, 0x26, 0x33, 0x25, 0xe3,
+	0x2d, 0x96, 0x2e, 0xb9, 0x59, 0x2d, 0x43, 0x6f, 0x28, 0x3a, 0xc8, 0x33, 0x30, 0xeb, 0x6e, 0xf1,
+	0xdf, 0x20, 0x58, 0xb9, 0x9f, 0x73, 0xc8, 0xc9, 0x56, 0x1c, 0x45, 0xb4, 0xa5, 0x6f, 0x15, 0x63,
+	0x65, 0x78, 0x86, 0x2d, 0xd8, 0x44, 0xb5, 0x55, 0x3c, 0x07, 0x80, 0x3c, 0x7b, 0xf7, 0x3d, 0xe4,
+	0x04, 0x1f, 0xb3, 0x9b, 0x96, 0xb5, 0x4e, 0x27, 0xaf, 0x34, 0x81, 0x60, 0xe3, 0xa2, 0x51, 0x22,
+	0xd2, 0x69, 0x22, 0xc7, 0xb5, 0x51, 0xc2, 0x48, 0x10, 0x69, 0x60, 0x60, 0xc6, 0x8e, 0x84, 0x6e,
+	0x26, 0x34, 0xdd, 0x12, 0x1e, 0x72, 0xec, 0x46, 0x33, 0x71, 0xb8, 0x8c, 0x1d, 0x30, 0x40, 0x09,
+	0x0a, 0xa8, 0xbb, 0xdb, 0x42, 0x95, 0x56, 0x2f, 0x63, 0xc7, 0x17, 0x9f, 0x79, 0xa8, 0x46, 0x6d,
+	0x86, 0xd4, 0xd2, 0x2d, 0x3f, 0x69, 0xb3, 0x9b, 0x54, 0x95, 0x47, 0x89, 0xae, 0x61, 0x03, 0xf0,
+	0x76, 0x77, 0x91, 0x9c, 0xca, 0xa5, 0xde, 0x4c, 0xd9, 0x5d, 0xa9, 0xae, 0x23, 0x02, 0x73, 0x49,
+	0x3b, 0x53, 0x18, 0x78, 0xc2, 0x54, 0xb3, 0x4e, 0xee, 0xa3, 0x66, 0xdd, 0x55, 0x7e, 0xd8, 0xdc,
+	0x58, 0xf6, 0x52, 0x29, 0x03, 0x30, 0x92, 0xd3, 0xf5, 0xf7, 0xe5, 0x9c, 0xae, 0xb9, 0xc1, 0xec,
+	0x66, 0x39, 0x1d, 0x38, 0xb8, 0x87, 0xf5, 0xa3, 0xf4, 0x98, 0xfe, 0x33, 0x87, 0xc8, 0xef, 0xba,
+	0xe0, 0xb7, 0xb6, 0x28, 0x4e, 0x19, 0xb4, 0xcd, 0x2a, 0xa5, 0xd5, 0x02, 0x4b, 0x20, 0xe7, 0xb0,
+	0x59, 0xa3, 0x6e, 0x17, 0x60, 0x41, 0x21, 0x87, 0x8d, 0xb6, 0x59, 0x1c, 0x27, 0xfe, 0x28, 0x3f,
+	0x8f, 0x95, 0x62, 0x6c, 0x6e, 0x75, 0x49, 0x3c, 0xa5, 0x71, 0xdc, 0x98, 0x9c, 0x0e, 0xfd, 0x34,
+	0x63, 0x3d, 0x40, 0x1d, 0xd6, 0x21, 0xf3, 0xe5, 0xb0, 0xb0, 0xb3, 0xe5, 0x3c, 0x21, 0x18, 0xa4,
+	0xed, 0xfd, 0xf3, 0x1a, 0x39, 0x61, 0xed, 0x8c, 0x07, 0x3c, 0xa4, 0xdf, 0x46, 0xea, 0xf2, 0xdc,
+	0xcc, 0x27, 0x06, 0x53, 0x87, 0xab, 0xc2, 0xc0, 0x43, 0x6b, 0x43, 0x9f, 0xaa, 0x79, 0xc1, 0xc3,
+	0x38, 0x70, 0xc1, 0xc4, 0x63, 0x9b, 0x72, 0x16, 0xa6, 0x0b, 0x61, 0x40, 0xa3, 0x8c, 0x77, 0xb3,
+	0x9c, 0x4d, 0x79, 0x7d, 0x79, 0xcd, 0x24, 0xaa, 0x37, 0xe5, 0x1c, 0x00, 0xf2, 0xec, 0xdd, 0xef,
+	0x70, 0xc8, 0x09, 0xff, 0x4e, 0xaa, 0x73, 0xfa, 0x37, 0x6b, 0x65, 0x1c, 0x52, 0x56, 0x99, 0x00,
+	0x6e, 0xdc, 0xb2, 0x9a, 0xc0, 0x66, 0xca, 0x14, 0xcf, 0xf4, 0x2e, 0x6d, 0x49, 0x07, 0x70, 0xd1,
+	0x97, 0xf1, 0x32, 0x74, 0x3b, 0x17, 0x07, 0xe8, 0xf2, 0x5d, 0x7d, 0xb0, 0x1d, 0x0a, 0xfa, 0x80,
+	0xe2, 0x70, 0x3b, 0x48, 0xfd, 0x8d, 0x10, 0x7d, 0x1e, 0x64, 0xa8, 0xb4, 0xf0, 0xbc, 0x50, 0xe2,
+	0xf0, 0xe2, 0x00, 0x06, 0x14, 0x3c, 0xc5, 0x66, 0x59, 0x12, 0xdf, 0xdd, 0x7d, 0x39, 0x09, 0x9b,
+	0xf5, 0xdc, 0x2c, 0x13, 0xed, 0xa0, 0x30, 0xbc, 0x5f, 0xa8, 0xaa, 0xa5, 0xac, 0xa3, 0x1d, 0x7c,
+	0xc3, 0xeb, 0xda, 0x39, 0xbc, 0xd7, 0xb5, 0xe2, 0x5b, 0x90, 0x00, 0xc0, 0x8a, 0x17, 0xae, 0x3c,
+	0xa2, 0x78, 0xe1, 0x6f, 0x73, 0xac, 0xe4, 0x7b, 0x47, 0x56, 0x15, 0xe4, 0x07, 0x72, 0x96, 0x7b,
+	0xb4, 0xe5, 0xce, 0x15, 0xdb, 0x91, 0x11, 0xf7, 0x71, 0x03, 0xed, 0x40, 0xfb, 0xf0, 0xbf, 0xae,
+	0x92, 0x49, 0xe3, 0x0c, 0x2f, 0x14, 0xc8, 0x9c, 0xc7, 0x4c, 0x20, 0xab, 0x1c, 0x40, 0x20, 0xfb,
+	0x04, 0x69, 0xb4, 0xe4, 0xf9, 0x52, 0x4e, 0x3d, 0x8a, 0xfc, 0xa9, 0xa5, 0x8f, 0x18, 0xd5, 0x04,
+	0x9a, 0x27, 0x3a, 0x44, 0x19, 0x64, 0xc4, 0xd9, 0xc4, 0x2f, 0x74, 0x45, 0x61, 0xa0, 0xe2, 0x8c,
+	0x1a, 0x7c, 0x86, 0xe5, 0x68, 0xec, 0x05, 0xe2, 0xbd, 0x64, 0x3c, 0x14, 0xcf, 0xd1, 0xb8, 0xba,
+	0x24, 0x9b, 0xc1, 0xc4, 0xc1, 0x4c, 0xbc, 0xf2, 0xe3, 0x3e, 0x84, 0x74, 0x42, 0xb7, 0xed, 0x74,
+	0x42, 0x17, 0x4b, 0x19, 0xe6, 0x21, 0x79, 0x84, 0xae, 0x93, 0x09, 0xf4, 0x9c, 0xf1, 0xa3, 0xb6,
+	0xfb, 0x95, 0x64, 0xa2, 0xc5, 0xff, 0x15, 0x0a, 0x30, 0xe6, 0x82, 0x21, 0xa0, 0x20, 0x61, 0xe8,
+	0x00, 0xe9, 0x27, 0x1d, 0xa9, 0xf4, 0x62, 0x0e, 0x90, 0x73, 0x49, 0x27, 0x05, 0xd6, 0xea, 0xfd,
+	0x4a, 0x8d, 0x30, 0xbf, 0x21, 0x3f, 0xa1, 0xed, 0xf5, 0x98, 0x65, 0x6c, 0x3e, 0x56, 0xc7, 0x05,
+	0x7d, 0x4d, 0x7b, 0x9c, 0x9d, 0x17, 0x0c, 0x03, 0x76, 0xf5, 0x61, 0x1b, 0xb0, 0x8b, 0x7d, 0x12,
+	0xc6, 0x1e, 0x27, 0x9f, 0x84, 0x01, 0xbb, 0x78, 0xed, 0xd1, 0xda, 0xc5, 0xbd, 0xef, 0x75, 0x88,
+	0xab, 0xbc, 0xdf, 0xb4, 0x17, 0xd3, 0x05, 0xd2, 0x50, 0x7e, 0x70, 0x42, 0xc6, 0xd4, 0x7b, 0x96,
+	0x04, 0x80, 0xc6, 0x19, 0x41, 0x59, 0xf0, 0xbc, 0x3c, 0x50, 0xaa, 0x76, 0x30, 0x07, 0x3b, 0x86,
+	0xc4, 0xf9, 0xe2, 0xfd, 0x72, 0x85, 0x3c, 0xc9, 0xa5, 0x93, 0x15, 0x3f, 0xf2, 0x3b, 0xb4, 0x8b,
+	0xbd, 0x1a, 0xd5, 0x2f, 0xad, 0x85, 0xb7, 0xd4, 0x40, 0x06, 0x67, 0x1c, 0x75, 0x33, 0xe1, 0x9b,
+	0x00, 0x5f, 0xf6, 0x4b, 0x51, 0x90, 0x01, 0x23, 0xee, 0xa6, 0xa4, 0x2e, 0xab, 0x47, 0x35, 0xab,
+	0x65, 0x32, 0x52, 0xfb, 0xa4, 0x38, 0xc8, 0x29, 0x28, 0x46, 0x28, 0x5d, 0x85, 0x71, 0x6b, 0x1b,
+	0x68, 0x2f, 0x6e, 0x8e, 0xd9, 0xbe, 0xf1, 0xcb, 0xa2, 0x1d, 0x14, 0x86, 0xf7, 0xcb, 0x0e, 0xc9,
+	0x1f, 0x91, 0x46, 0xe2, 0x53, 0x67, 0xcf, 0xc4, 0xa7, 0x07, 0x48, 0x1d, 0xfa, 0xcd, 0x64, 0xd2,
+	0xcf, 0x50, 0xaa, 0xe1, 0x1a, 0x88, 0xea, 0xe1, 0x6c, 0xaa, 0x2b, 0x71, 0x3b, 0xd8, 0x0c, 0x90,
+	0x02, 0x98, 0xe4, 0x30, 0x71, 0xfa, 0x89, 0x85, 0x58, 0x28, 0xb0, 0x96, 0xe3, 0x4e, 0xca, 0xe7,
+	0xa4, 0x68, 0x18, 0x9c, 0x93, 0x02, 0x00, 0x1a, 0x07, 0x67, 0x4c, 0x18, 0x77, 0xd2, 0xfc, 0x9c,
+	0x44, 0x62, 0x30, 0x16, 0x0a, 0x92, 0x59, 0xd2, 0x8f, 0x5a, 0x7e, 0x46, 0xdb, 0xec, 0x05, 0xea,
+	0x9a, 0xe4, 0xba, 0x04, 0x80, 0xc6, 0xc1, 0xf9, 0x39, 0xb9, 0x78, 0x03, 0xe6, 0x56, 0x68, 0x96,
+	0x04, 0xad, 0x74, 0x64, 0xcd, 0x33, 0xd3, 0x05, 0xf6, 0xc2, 0x78, 0xb7, 0xcb, 0x5c, 0x4f, 0xf9,
+	0x45, 0xd3, 0xd8, 0x4d, 0x15, 0x08, 0x4c, 0x3c, 0x4c, 0x32, 0x1b, 0x52, 0xbf, 0x8d, 0xa3, 0xb5,
+	0x46, 0xd1, 0x74, 0xcf, 0xb3, 0x05, 0x57, 0x79, 0xda, 0x93, 0x65, 0x1b, 0x04, 0x79, 0x5c, 0xe6,
+	0x59, 0xed, 0xa3, 0x2d, 0xde, 0x60, 0x90, 0x17, 0x24, 0x2e, 0xe5, 0x11, 0x60, 0xf0, 0x19, 0x74,
+	0x72, 0xca, 0x82, 0x2e, 0x5d, 0x8f, 0x81, 0xa6, 0x59, 0x9c, 0xa8, 0xce, 0xd4, 0xb4, 0x93, 0xd3,
+	0x7a, 0x01, 0x1c, 0x0a, 0x9f, 0xf2, 0xfe, 0xe7, 0x18, 0x79, 0xda, 0xd8, 0x92, 0x6c, 0xcb, 0xfd,
+	0x63, 0x96, 0xa3, 0xbd, 0x43, 0x6a, 0xbd, 0x2d, 0x3f, 0x95, 0xab, 0xe1, 0x25, 0xb9, 0x6b, 0xad,
+	0x62, 0xe3, 0x83, 0x7b, 0x33, 0xdf, 0x54, 0x54, 0x36, 0xb0, 0x13, 0x64, 0x71, 0x2f, 0x7d, 0x3b,
+	0x8d, 0x3a, 0x41, 0x44, 0x99, 0x41, 0x05, 0xfd, 0x5c, 0x2e, 0x70, 0x7f, 0x6c, 0xed, 0xbd, 0xc0,
+	0x68, 0x00, 0xa7, 0x7f, 0x90, 0x24, 0xc6, 0x9f, 0x12, 0x09, 0x87, 0x80, 0xa6, 0xfd, 0x30, 0x13,
+	0xf7, 0xec, 0x97, 0x8e, 0xee, 0xa2, 0xa3, 0x3a, 0xc6, 0x09, 0x6b, 0xff, 0x3f, 0xfe, 0x1b, 0x0c,
+	0xa6, 0xee, 0x2d, 0xd2, 0x48, 0x33, 0x3f, 0xe1, 0x4b, 0xbf, 0x76, 0xe0, 0xa5, 0xcf, 0xc2, 0x0d,
+	0xd7, 0x24, 0x01, 0xd0, 0xb4, 0xd0, 0x51, 0x63, 0x33, 0x88, 0x82, 0x74, 0xeb, 0x90, 0x3e, 0x29,
+	0x32, 0xdc, 0x5f, 0x50, 0x00, 0x83, 0x9a, 0xf7, 0x03, 0x0e, 0x29, 0x70, 0xca, 0x72, 0xaf, 0x1a,
+	0x79, 0x93, 0xf8, 0x32, 0x9e, 0x55, 0x77, 0x48, 0xd1, 0xfe, 0xc0, 0xae, 0xfc, 0x92, 0x4b, 0x20,
+	0x62, 0xe4, 0x40, 0xba, 0x40, 0x1a, 0x1b, 0x68, 0x3c, 0x5b, 0x0b, 0x5e, 0xe3, 0x73, 0xc6, 0xd0,
+	0x27, 0xcd, 0x4b, 0x00, 0x68, 0x1c, 0xef, 0x33, 0x63, 0x64, 0x72, 0x31, 0x09, 0x36, 0x33, 0xa0,
+	0x2d, 0x54, 0xc0, 0x3c, 0x4f, 0x6a, 0x9d, 0x24, 0xee, 0xf7, 0x9a, 0x8e, 0x7d, 0x4c, 0xb2, 0xca,
+	0x44, 0xc0, 0x61, 0xb8, 0xb3, 0x6d, 0x07, 0x51, 0x3b, 0xbf, 0xb3, 0x61, 0xe1, 0x22, 0x60, 0x10,
+	0x3b, 0xe6, 0xa0, 0x7a, 0x80, 0xa2, 0x11, 0x63, 0x43, 0x8f, 0xd7, 0xb7, 0x61, 0xd0, 0x80, 0x88,
+	0xe2, 0xad, 0xd9, 0x57, 0x7c, 0x15, 0xbf, 0xab, 0x30, 0xdc, 0x8f, 0x10, 0xd2, 0xa6, 0x19, 0x6d,
+	0x65, 0x87, 0xfc, 0x8e, 0xca, 0x7b, 0x69, 0x51, 0x51, 0x01, 0x83, 0x22, 0xce, 0x93, 0x84, 0xa6,
+	0x71, 0xb8, 0x73, 0x48, 0xf5, 0x37, 0x9b, 0x27, 0xa0, 0x28, 0x80, 0x41, 0x8d, 0x59, 0xe8, 0x78,
+	0xfa, 0xaa, 0xba, 0xb6, 0x77, 0x8b, 0xdc, 0x52, 0x02, 0xc2, 0xd6, 0x2b, 0x13, 0x51, 0x92, 0x66,
+	0x23, 0xb7, 0x5e, 0x79, 0x33, 0x48, 0x38, 0xa2, 0xb6, 0x69, 0x48, 0xf1, 0x8c, 0x21, 0x76, 0x8c,
+	0xe9, 0x22, 0x6f, 0x06, 0x09, 0xf7, 0xfe, 0xdb, 0x18, 0x39, 0x3d, 0x10, 0xc0, 0xef, 0xbe, 0x88,
+	0xd1, 0x4a, 0x5c, 0x28, 0xea, 0x49, 0x8b, 0x56, 0xc3, 0x8c, 0x20, 0xd2, 0x30, 0xb0, 0x30, 0x47,
+	0x10, 0xcb, 0x96, 0xc8, 0x13, 0x09, 0x6a, 0xfa, 0xfb, 0x74, 0x6e, 0x33, 0xa3, 0x89, 0x7d, 0xcc,
+	0x3c, 0x85, 0x6e, 0x33, 0x30, 0x08, 0x86, 0xa2, 0x67, 0xdc, 0x1e, 0x39, 0x11, 0x9a, 0xaa, 0x98,
+	0xe6, 0xd8, 0xe1, 0xb5, 0x38, 0xea, 0xaa, 0x6e, 0x35, 0x83, 0xcd, 0xc0, 0xd6, 0xe7, 0xd4, 0x1e,
+	0x91, 0x3e, 0xe7, 0xdb, 0xb5, 0x3e, 0x87, 0xbb, 0x1a, 0x7f, 0xa8, 0xe4, 0x04, 0x0e, 0xc7, 0xad,
+	0xd0, 0x79, 0x89, 0xd4, 0x65, 0x18, 0xc6, 0x48, 0xe1, 0x0b, 0x26, 0x9d, 0x21, 0x72, 0xfc, 0x9b,
+	0xc9, 0x9b, 0x2e, 0x26, 0x89, 0x31, 0x98, 0xd7, 0xe3, 0x6c, 0x2e, 0x0c, 0xe3, 0x3b, 0x78, 0x57,
+	0x7e, 0x39, 0xa5, 0xc2, 0xc4, 0xe2, 0x3d, 0xa8, 0x90, 0x02, 0x6d, 0x25, 0xae, 0x18, 0x7d, 0x41,
+	0xb7, 0x16, 0xd7, 0xc1, 0x2e, 0xe9, 0xee, 0x5d, 0x1e, 0xaa, 0xc2, 0xaf, 0xa2, 0x1f, 0x28, 0x5b,
+	0xdb, 0xaa, 0xa3, 0x57, 0x54, 0xdc, 0xb9, 0x8a, 0x60, 0x79, 0x81, 0x10, 0xad, 0x57, 0x11, 0xbb,
+	0xaa, 0xda, 0xd3, 0xb4, 0xfa, 0x05, 0x0c, 0x2c, 0x94, 0x12, 0x83, 0x28, 0xcd, 0xfc, 0x30, 0xbc,
+	0x12, 0x44, 0x99, 0xd8, 0x64, 0x95, 0x8c, 0xb2, 0xa4, 0x41, 0x60, 0xe2, 0x9d, 0x7b, 0xb7, 0xf1,
+	0xfd, 0x0e, 0xf2, 0xdd, 0xb7, 0xc8, 0xd3, 0x97, 0x83, 0x4c, 0xc5, 0xc2, 0xab, 0xf9, 0x86, 0x6a,
+	0x13, 0x95, 0xdb, 0xc1, 0x19, 0x9a, 0xdb, 0xc1, 0x88, 0x45, 0xaf, 0xd8, 0xdb, 0x5a, 0x3e, 0x16,
+	0xdd, 0x7b, 0x91, 0x9c, 0xb9, 0x1c, 0x64, 0x18, 0xe7, 0x7b, 0x40, 0x26, 0xde, 0x2f, 0x8d, 0x93,
+	0x29, 0x33, 0xab, 0xcb, 0x41, 0xd2, 0x53, 0x60, 0x26, 0x31, 0x99, 0xc7, 0x20, 0x50, 0xbe, 0x40,
+	0xb7, 0x8e, 0x9c, 0x62, 0xa6, 0x78, 0xc4, 0x0c, 0x61, 0x52, 0xf3, 0x04, 0xb3, 0x03, 0xee, 0x1d,
+	0x52, 0xdb, 0x64, 0xb1, 0xd2, 0xd5, 0x32, 0x9c, 0x7e, 0x8b, 0x46, 0x54, 0x2f, 0x47, 0x1e, 0x6d,
+	0xcd, 0xf9, 0x59, 0x47, 0xf7, 0xd8, 0xbe, 0x47, 0xf7, 0x90, 0x23, 0xa1, 0x76, 0x88, 0x23, 0xc1,
+	0xda, 0xa0, 0xc7, 0x1f, 0xd1, 0x06, 0xcd, 0xe2, 0xde, 0xb3, 0x2d, 0xa6, 0xdd, 0x10, 0x01, 0xc9,
+	0x13, 0x6c, 0x10, 0x8c, 0xb8, 0x77, 0x0b, 0x0c, 0x79, 0x7c, 0xf7, 0xe3, 0x6a, 0x8b, 0xaf, 0x97,
+	0x61, 0x80, 0x35, 0x67, 0xf4, 0x71, 0xef, 0xee, 0xdf, 0x5b, 0x21, 0xd3, 0x97, 0xa3, 0xfe, 0xea,
+	0xe5, 0xd5, 0xfe, 0x46, 0x18, 0xb4, 0xae, 0xd1, 0x5d, 0xdc, 0xc2, 0xb7, 0xe9, 0xee, 0xd2, 0x62,
+	0x5e, 0xc6, 0xbc, 0x86, 0x8d, 0xc0, 0x61, 0xb8, 0x19, 0x6d, 0x06, 0x51, 0x87, 0x26, 0xbd, 0x24,
+	0x88, 0xa4, 0x97, 0x94, 0x9a, 0xe3, 0x97, 0x34, 0x08, 0x4c, 0x3c, 0xa4, 0x1d, 0xdf, 0xc1, 0x1b,
+	0x7a, 0x4e, 0xcd, 0x73, 0x03, 0x1b, 0x81, 0xc3, 0x10, 0x29, 0x4b, 0xfa, 0x69, 0xd6, 0x1c, 0xb3,
+	0x91, 0xd6, 0xb1, 0x11, 0x38, 0x0c, 0x57, 0x7a, 0xda, 0xdf, 0x60, 0x3e, 0xd5, 0xb9, 0xe8, 0xe7,
+	0x35, 0xde, 0x0c, 0x12, 0x8e, 0xa8, 0xdb, 0x74, 0x77, 0x11, 0x95, 0xd4, 0xb9, 0x24, 0x08, 0xd7,
+	0x78, 0x33, 0x48, 0x38, 0x4b, 0x9b, 0x6f, 0x0f, 0xc7, 0x97, 0x5d, 0xda, 0x7c, 0xbb, 0xfb, 0x43,
+	0xd4, 0xdd, 0x3f, 0x52, 0x21, 0x53, 0x66, 0x24, 0x84, 0xdb, 0xc9, 0x69, 0x80, 0x6e, 0x0c, 0x54,
+	0x5d, 0x79, 0xef, 0x68, 0x77, 0x59, 0x1e, 0x41, 0x61, 0x85, 0x59, 0x58, 0xc5, 0xc9, 0x1e, 0xf3,
+	0x8a, 0x7c, 0xb7, 0xc8, 0xe9, 0x81, 0x6c, 0x1b, 0x23, 0x88, 0x3d, 0xfb, 0xe6, 0x3a, 0xf2, 0x80,
+	0x4c, 0x22, 0x61, 0x99, 0x2e, 0x76, 0x81, 0x9c, 0xe6, 0x8b, 0x17, 0x39, 0xad, 0x61, 0xb1, 0x7a,
+	0x95, 0x41, 0x85, 0x19, 0xff, 0x6f, 0xe6, 0x81, 0x30, 0x88, 0x8f, 0x35, 0xc1, 0x4e, 0x58, 0x09,
+	0x50, 0x4a, 0x12, 0xd0, 0xd8, 0xea, 0x8e, 0x99, 0xcb, 0x33, 0x8b, 0x44, 0xe5, 0xba, 0x2f, 0xbd,
+	0xba, 0x35, 0x08, 0x4c, 0x3c, 0xef, 0x3b, 0xc6, 0x49, 0xfd, 0x4a, 0x1c, 0x6f, 0x33, 0x85, 0xdc,
+	0xe3, 0x7b, 0x55, 0xbd, 0x4d, 0xea, 0x5b, 0x71, 0xbc, 0x6d, 0xec, 0x1d, 0xd7, 0xe5, 0xea, 0xbc,
+	0x22, 0xda, 0x1f, 0xdc, 0x9b, 0xf9, 0xfa, 0x83, 0xeb, 0x6e, 0xe4, 0xd3, 0xa0, 0xe8, 0xbb, 0x11,
+	0x69, 0x20, 0x06, 0x53, 0xe6, 0x88, 0xdd, 0x67, 0x55, 0x76, 0x7f, 0x4d, 0x02, 0x1e, 0xdc, 0x9b,
+	0x79, 0xcf, 0xc1, 0xb9, 0xa9, 0xc7, 0x41, 0xb3, 0x70, 0x77, 0x88, 0x6b, 0xc6, 0xb6, 0x24, 0x87,
+	0xf5, 0xff, 0x52, 0x16, 0xfe, 0x1b, 0x03, 0xd4, 0xa0, 0x80, 0x03, 0xf3, 0x97, 0x8a, 0xdb, 0x2c,
+	0xab, 0x4e, 0x3d, 0xe7, 0x2f, 0xc5, 0x9b, 0x41, 0xc2, 0x31, 0x0b, 0x90, 0xd2, 0xc2, 0xca, 0x24,
+	0xc6, 0xd7, 0x8e, 0x6c, 0x0b, 0xd6, 0xaa, 0x60, 0x2b, 0xb4, 0x49, 0xb0, 0x01, 0x83, 0x25, 0x2a,
+	0x1f, 0x5a, 0x7e, 0x2f, 0xeb, 0x27, 0x6c, 0x6c, 0xc8, 0xe1, 0x95, 0x0f, 0x0b, 0x8a, 0x0a, 0x18,
+	0x14, 0xbd, 0xcf, 0x57, 0x48, 0x5d, 0xfa, 0x64, 0x8f, 0xb0, 0x22, 0x3f, 0xe3, 0x90, 0x13, 0xca,
+	0xef, 0x08, 0x9f, 0x11, 0xe7, 0xc0, 0xf5, 0xa3, 0x7b, 0x85, 0x2b, 0xc3, 0x14, 0x9a, 0x95, 0xd5,
+	0xa5, 0x19, 0x4c, 0x66, 0x60, 0xf3, 0x76, 0x6f, 0xa2, 0xf6, 0x30, 0xcd, 0x68, 0xd7, 0x30, 0x70,
+	0x7b, 0xc6, 0xe0, 0xcc, 0xb6, 0xe2, 0x84, 0xe2, 0x50, 0xa0, 0x27, 0xfb, 0x9a, 0xc2, 0xd4, 0x83,
+	0xa2, 0xdb, 0xc0, 0xa0, 0xe4, 0xfd, 0xad, 0x0a, 0x39, 0x95, 0xef, 0x92, 0xfb, 0x21, 0x0c, 0x32,
+	0xd3, 0xe5, 0xad, 0x73, 0x8e, 0xe8, 0x53, 0x60, 0xc0, 0x1e, 0xdc, 0x9b, 0x99, 0xd1, 0x0e, 0xe9,
+	0x17, 0xb0, 0x17, 0x17, 0x76, 0x0c, 0xe7, 0x7b, 0x1c, 0x4f, 0x8b, 0x18, 0x77, 0xfe, 0x12, 0x5e,
+	0x8a, 0xf3, 0xbb, 0x73, 0xbd, 0x5e, 0xb3, 0x92, 0x77, 0xfe, 0x32, 0xa1, 0x90, 0xc3, 0xc6, 0xfc,
+	0x0e, 0x46, 0xcb, 0x75, 0x1a, 0x74, 0xb6, 0x36, 0xe2, 0x44, 0x2a, 0x3f, 0x9e, 0xd1, 0xe1, 0x4e,
+	0x83, 0x38, 0x50, 0xf8, 0x24, 0x0a, 0xda, 0x2d, 0xbf, 0xe7, 0xb7, 0x82, 0x6c, 0x57, 0x28, 0xda,
+	0x95, 0x58, 0xb0, 0x20, 0xda, 0x41, 0x61, 0x78, 0x2b, 0x64, 0x6c, 0xc4, 0x19, 0x34, 0xd2, 0xa5,
+	0xfb, 0x25, 0x52, 0x47, 0x72, 0xf2, 0x66, 0x55, 0x06, 0xc9, 0x98, 0xd4, 0x65, 0x09, 0x66, 0xd7,
+	0x23, 0xd5, 0xc0, 0x97, 0xfe, 0x75, 0xea, 0xb5, 0x96, 0xd2, 0xb4, 0xcf, 0xd6, 0x07, 0x02, 0xdd,
+	0xe7, 0x49, 0x95, 0xde, 0xed, 0xe5, 0x15, 0x9f, 0x17, 0xef, 0xf6, 0x82, 0x84, 0xa6, 0x88, 0x44,
+	0xef, 0xf6, 0xdc, 0x73, 0xa4, 0x12, 0xb4, 0xc5, 0x4e, 0x4f, 0x04, 0x4e, 0x65, 0x69, 0x11, 0x2a,
+	0x41, 0xdb, 0xbb, 0x4b, 0x1a, 0x92, 0x21, 0x0b, 0xa2, 0xe0, 0x62, 0x93, 0x53, 0x46, 0x10, 0x85,
+	0xa4, 0x3b, 0x44, 0x60, 0xea, 0x13, 0xa2, 0x13, 0x22, 0x95, 0x75, 0xcc, 0x9e, 0x27, 0x63, 0xad,
+	0x58, 0x24, 0x6b, 0xab, 0x6b, 0x32, 0x4c, 0x5e, 0x62, 0x10, 0xef, 0x16, 0x99, 0xbe, 0x16, 0xc5,
+	0x77, 0x58, 0xf9, 0x3e, 0xa6, 0x62, 0x44, 0xc2, 0x4c, 0xc7, 0x98, 0x3f, 0x56, 0x19, 0x14, 0x38,
+	0x4c, 0xe5, 0xd1, 0xae, 0x0c, 0xcb, 0xa3, 0xed, 0x7d, 0xd2, 0x21, 0x53, 0x2a, 0x33, 0xca, 0xe5,
+	0x9d, 0xed, 0xd1, 0x8e, 0x6b, 0x23, 0xe5, 0x50, 0x65, 0x9f, 0x94, 0x43, 0xf2, 0x64, 0xaf, 0x0e,
+	0x3b, 0xd9, 0xbd, 0x5f, 0x70, 0xc8, 0x29, 0xd5, 0x05, 0x29, 0x17, 0xbd, 0x48, 0xa6, 0x36, 0xfa,
+	0x41, 0xd8, 0x16, 0xbf, 0xf3, 0xca, 0xcc, 0x79, 0x03, 0x06, 0x16, 0x26, 0xaa, 0x54, 0x36, 0x82,
+	0xc8, 0x4f, 0x76, 0x57, 0xb5, 0x20, 0xa6, 0x36, 0xa5, 0x79, 0x05, 0x01, 0x03, 0x0b, 0xb9, 0xa5,
+	0x34, 0xbb, 0x6e, 0xc9, 0x17, 0x75, 0xcd, 0x6d, 0xcd, 0x80, 0x81, 0x85, 0xe9, 0x7d, 0x7f, 0x95,
+	0x4c, 0xdb, 0x99, 0x65, 0x46, 0xd0, 0x89, 0x3c, 0x4f, 0x6a, 0x2c, 0xd9, 0x4c, 0x7e, 0x52, 0xb0,
+	0xe7, 0x81, 0xc3, 0xd0, 0x6d, 0x9e, 0xa7, 0xb9, 0x2c, 0xa7, 0xb8, 0xb7, 0xea, 0xa4, 0x52, 0x9e,
+	0x32, 0x7d, 0xb5, 0xc8, 0xac, 0x29, 0x58, 0xa1, 0x3b, 0xe4, 0x44, 0xdc, 0x33, 0x33, 0x37, 0x7f,
+	0xa0, 0xcc, 0xac, 0x3b, 0x22, 0xe9, 0x87, 0xb8, 0xc6, 0xaa, 0x49, 0x23, 0x3f, 0xa4, 0x64, 0x7d,
+	0xee, 0xeb, 0xc9, 0x94, 0x89, 0xb9, 0xdf, 0x4d, 0xb6, 0x6e, 0xde, 0x64, 0x3f, 0x63, 0x4e, 0x27,
+	0x91, 0x57, 0x68, 0x84, 0x85, 0xfa, 0x32, 0xa9, 0xb5, 0x94, 0x7b, 0xef, 0xa1, 0xca, 0xbe, 0xa8,
+	0xbc, 0x93, 0x48, 0x06, 0x38, 0x35, 0xf4, 0x94, 0x9a, 0x36, 0x7a, 0x93, 0x2e, 0xb5, 0xdd, 0x84,
+	0x54, 0x3b, 0x3b, 0xdb, 0xe2, 0xfe, 0x78, 0xb5, 0xa4, 0xe1, 0xbd, 0xbc, 0xb3, 0xad, 0xe7, 0xab,
+	0xd9, 0x0a, 0xc8, 0x6c, 0x04, 0x0d, 0xff, 0x41, 0xe5, 0x6b, 0xef, 0x0b, 0x15, 0x72, 0x7a, 0x60,
+	0x52, 0xb9, 0xaf, 0x91, 0x5a, 0x82, 0x6f, 0x29, 0x5e, 0x6f, 0xb9, 0xb4, 0x84, 0x51, 0xe9, 0x52,
+	0x5b, 0x9f, 0xd8, 0x76, 0x3b, 0x70, 0x96, 0xe8, 0xa9, 0xaa, 0x9d, 0xd0, 0x95, 0x79, 0x21, 0x17,
+	0xb8, 0x35, 0x37, 0x80, 0x01, 0x05, 0x4f, 0xa1, 0x6f, 0xa0, 0x6d, 0xa5, 0xa8, 0xda, 0xbe, 0x81,
+	0x7b, 0x19, 0x1c, 0xbc, 0x5f, 0xac, 0x90, 0x13, 0x56, 0x22, 0x6d, 0x37, 0x24, 0x75, 0x1a, 0x52,
+	0x6e, 0x5a, 0xe7, 0xc7, 0xd4, 0x51, 0xcb, 0x62, 0xa9, 0xa3, 0xf5, 0xa2, 0xa0, 0x0b, 0x8a, 0xc3,
+	0xe3, 0xe1, 0xc0, 0xfa, 0x22, 0x99, 0x92, 0x1d, 0xfa, 0x80, 0xdf, 0x0d, 0xc5, 0x00, 0xaa, 0x39,
+	0x7a, 0xd1, 0x80, 0x81, 0x85, 0xe9, 0xfd, 0x6c, 0x85, 0x9c, 0x1e, 0x88, 0x47, 0x36, 0xca, 0xb8,
+	0x3a, 0x7b, 0x95, 0x71, 0xc5, 0x89, 0x2c, 0xec, 0xc4, 0xf3, 0xbb, 0xf9, 0x04, 0xfa, 0x6b, 0x12,
+	0x00, 0x1a, 0xc7, 0xfd, 0x90, 0x69, 0xa4, 0x3e, 0xb8, 0x56, 0x21, 0x4f, 0xdc, 0x36, 0x54, 0x7f,
+	0x88, 0x34, 0xa8, 0x94, 0x6b, 0x9a, 0x63, 0x87, 0x27, 0xae, 0x85, 0x23, 0x4d, 0xcf, 0xfb, 0x95,
+	0x2a, 0x69, 0x72, 0x3b, 0x62, 0x5b, 0x2d, 0xd1, 0x15, 0xa9, 0x4d, 0xfa, 0x1e, 0x5d, 0x17, 0x80,
+	0xcf, 0xb8, 0x8d, 0xa3, 0x46, 0x88, 0x17, 0x33, 0x1a, 0x29, 0x40, 0xe5, 0x27, 0x72, 0x01, 0x2a,
+	0xfc, 0x66, 0xd3, 0x39, 0xa6, 0x1e, 0x7d, 0x79, 0x45, 0xac, 0xfc, 0xa7, 0x0a, 0x99, 0x5e, 0xf1,
+	0xa3, 0x60, 0x93, 0xa6, 0x99, 0xc8, 0x9b, 0xb4, 0xff, 0x71, 0x95, 0x0b, 0x1b, 0xac, 0x8c, 0x18,
+	0x36, 0xb8, 0x4e, 0x6a, 0x28, 0x73, 0x49, 0xdb, 0xc2, 0x85, 0xd1, 0xa6, 0x22, 0x93, 0xfd, 0x50,
+	0x62, 0x33, 0x94, 0xc0, 0x48, 0x05, 0x38, 0x31, 0x14, 0xb9, 0xa8, 0xaa, 0xa2, 0x96, 0xb7, 0x62,
+	0xe9, 0xfa, 0x6a, 0x60, 0x60, 0x99, 0xfa, 0xbf, 0xda, 0x3e, 0xfa, 0xbf, 0x4b, 0xe8, 0xf2, 0xbf,
+	0x43, 0x13, 0xbc, 0x2e, 0x71, 0xd5, 0xc9, 0x57, 0x69, 0xef, 0x7d, 0xde, 0x8e, 0x79, 0x25, 0xec,
+	0x31, 0x94, 0x10, 0x50, 0xcf, 0x7a, 0x7f, 0xad, 0x42, 0x4e, 0xe6, 0x8a, 0xce, 0x62, 0xaa, 0x5e,
+	0xb3, 0x4e, 0x99, 0x53, 0x86, 0x69, 0x76, 0xcf, 0x3a, 0xa4, 0x07, 0xab, 0x56, 0xf6, 0x88, 0x36,
+	0x6f, 0xef, 0x77, 0x70, 0x4e, 0x5a, 0xd5, 0x72, 0x1f, 0xc3, 0x91, 0xfa, 0x6a, 0xd2, 0x60, 0x05,
+	0x21, 0xaf, 0xd1, 0x5d, 0x69, 0xd9, 0xe5, 0xb5, 0xf7, 0x64, 0x23, 0x68, 0xf8, 0x63, 0x51, 0x04,
+	0xce, 0xfb, 0x1b, 0x0e, 0x39, 0xcb, 0xdf, 0x32, 0x3f, 0x0f, 0x7f, 0xa0, 0x68, 0x74, 0x3f, 0x5c,
+	0x6e, 0x07, 0x73, 0x85, 0x43, 0xf6, 0x1b, 0x5f, 0x94, 0x5d, 0xcf, 0x88, 0xde, 0xda, 0x53, 0xe1,
+	0x31, 0xec, 0xec, 0x81, 0x26, 0x83, 0xf7, 0x3b, 0x55, 0xd2, 0x50, 0x0a, 0x4d, 0x2c, 0xa0, 0xc2,
+	0xb2, 0x58, 0x95, 0x52, 0x40, 0xc5, 0x72, 0x91, 0xe3, 0x9e, 0x06, 0x46, 0x12, 0xab, 0x4f, 0x3b,
+	0x68, 0xbc, 0x0f, 0xb2, 0xc0, 0xd7, 0xf2, 0xc9, 0x91, 0x03, 0xbc, 0x14, 0xbb, 0x25, 0x4e, 0x39,
+	0x4e, 0x4c, 0x77, 0x00, 0xc5, 0x0c, 0x4c, 0xce, 0xee, 0x47, 0x45, 0xb0, 0x6e, 0xb5, 0xb4, 0xbc,
+	0x77, 0xf5, 0x5c, 0x84, 0x6e, 0x0f, 0xaf, 0x02, 0x59, 0x52, 0x52, 0xba, 0x48, 0x40, 0x52, 0xaa,
+	0x16, 0x97, 0x3a, 0x87, 0x58, 0x33, 0x70, 0x46, 0x5e, 0x4a, 0xdc, 0xc1, 0xb1, 0x38, 0x60, 0x20,
+	0x24, 0x86, 0x7a, 0xca, 0xd4, 0x11, 0xc2, 0x63, 0x41, 0x87, 0x7a, 0x4a, 0x00, 0x68, 0x1c, 0xef,
+	0x32, 0x39, 0x63, 0xe7, 0xa6, 0x11, 0x67, 0xf8, 0x05, 0x56, 0x4b, 0x74, 0x99, 0x46, 0x1d, 0xa1,
+	0x0b, 0x30, 0x54, 0x5d, 0x2b, 0x12, 0x00, 0x1a, 0xc7, 0xfb, 0xd2, 0x04, 0xc9, 0xe5, 0xa7, 0x72,
+	0xef, 0x92, 0x86, 0xd2, 0xb1, 0x97, 0x93, 0xa1, 0x40, 0x4f, 0x4d, 0xd5, 0x19, 0xd5, 0x04, 0x9a,
+	0xd9, 0xeb, 0x1e, 0xad, 0x43, 0x3c, 0x5a, 0xad, 0xcb, 0xc2, 0x78, 0xc9, 0x97, 0x05, 0xdb, 0xab,
+	0x75, 0xa2, 0x4c, 0xaf, 0x56, 0x94, 0xd1, 0xd8, 0x22, 0xe1, 0x71, 0x62, 0x75, 0x36, 0x1f, 0xd5,
+	0x9e, 0x0a, 0x0a, 0x02, 0x06, 0x16, 0x4b, 0x94, 0xc3, 0x7e, 0xce, 0x71, 0x97, 0x7b, 0x69, 0xa5,
+	0xb9, 0x5a, 0xc2, 0x52, 0x16, 0x24, 0x4d, 0x6b, 0x84, 0xc1, 0x08, 0x6c, 0xbe, 0x78, 0xe2, 0x14,
+	0x65, 0x8a, 0xbc, 0x55, 0x5a, 0x4a, 0xc6, 0x5c, 0xb2, 0xb8, 0x51, 0x82, 0x62, 0xbe, 0x86, 0xd8,
+	0x09, 0x6b, 0x31, 0x25, 0x01, 0xcf, 0x8f, 0xcb, 0xed, 0xb7, 0x2c, 0x25, 0x81, 0x95, 0xca, 0xf6,
+	0xe7, 0x1d, 0x62, 0x66, 0xd5, 0x75, 0x5f, 0xe5, 0xe9, 0x7b, 0x9d, 0x32, 0xfc, 0x7c, 0x0c, 0xba,
+	0xb3, 0x2b, 0x7e, 0x2f, 0xe7, 0x70, 0x26, 0x73, 0xf8, 0xa2, 0x17, 0x98, 0x84, 0x1e, 0xe8, 0x92,
+	0xf2, 0xb3, 0x15, 0xf2, 0x64, 0x3e, 0xd1, 0xd5, 0x1c, 0x8b, 0x1c, 0x71, 0x17, 0xc9, 0xb8, 0xdf,
+	0x32, 0x92, 0x97, 0xbc, 0x4d, 0xde, 0x01, 0x39, 0x1c, 0x9d, 0xa2, 0x8b, 0x9f, 0x64, 0x6a, 0x68,
+	0xf1, 0xac, 0xd6, 0x3b, 0x57, 0x46, 0x30, 0x13, 0x0f, 0x55, 0x26, 0x8f, 0x60, 0xf5, 0x7d, 0x17,
+	0x99, 0xec, 0x24, 0x7e, 0x8b, 0xae, 0xd2, 0x24, 0x88, 0xdb, 0x79, 0xf7, 0xb9, 0xcb, 0x1a, 0x04,
+	0x26, 0x1e, 0xaa, 0x1f, 0xda, 0xc9, 0x2e, 0xf4, 0x79, 0x01, 0x8d, 0xba, 0xbe, 0xe9, 0x2e, 0xb2,
+	0x56, 0x10, 0x50, 0xef, 0xe3, 0xe4, 0x89, 0xfc, 0xdb, 0x0a, 0x67, 0x9a, 0x32, 0xac, 0xe0, 0xf2,
+	0xf5, 0xaa, 0xc3, 0x5e, 0xcf, 0xfb, 0xd1, 0xb1, 0xc1, 0x0f, 0x25, 0x34, 0x28, 0x8f, 0xaf, 0x25,
+	0x5e, 0x4f, 0xa1, 0xda, 0x11, 0xa6, 0x50, 0x47, 0xa7, 0xf5, 0x5a, 0x0b, 0x30, 0x33, 0xde, 0xc1,
+	0xf7, 0xe7, 0x81, 0xb4, 0x5d, 0x8c, 0x10, 0xd8, 0x74, 0x5d, 0x1f, 0x8b, 0xc4, 0x6d, 0xd1, 0x76,
+	0x3f, 0x3c, 0xe4, 0x46, 0xad, 0xa6, 0xdb, 0x9a, 0x26, 0x03, 0x26, 0x4d, 0x63, 0xba, 0xd5, 0xf7,
+	0x9a, 0x6e, 0xe6, 0xf1, 0xd9, 0xd8, 0xfb, 0xf8, 0x44, 0xad, 0xe4, 0xf9, 0xfc, 0x28, 0xa6, 0x2b,
+	0x71, 0x14, 0x64, 0x71, 0xb2, 0x46, 0xb3, 0x2c, 0x88, 0x3a, 0xac, 0x9c, 0xc7, 0x1d, 0x3f, 0x91,
+	0x25, 0x49, 0x99, 0x48, 0x77, 0xcb, 0x4f, 0x22, 0x60, 0xad, 0x98, 0xe2, 0x84, 0x47, 0x2b, 0x0a,
+	0x05, 0xce, 0x4b, 0xe5, 0x66, 0xd6, 0x43, 0x3f, 0x25, 0xf5, 0xa2, 0x3c, 0x52, 0x12, 0x04, 0x43,
+	0xf7, 0x13, 0x64, 0x82, 0x7f, 0x66, 0xa9, 0xbb, 0x28, 0x39, 0xab, 0x1f, 0x9f, 0x4f, 0x7a, 0xf8,
+	0xf8, 0xef, 0x14, 0x24, 0x57, 0xef, 0x0f, 0x1d, 0xe2, 0xde, 0xd8, 0xa1, 0x49, 0x12, 0xb4, 0x8d,
+	0x00, 0x4f, 0x56, 0x6c, 0xdf, 0x28, 0xaa, 0x6f, 0xe6, 0xca, 0xcb, 0x15, 0xdb, 0x37, 0x7e, 0x15,
+	0x17, 0xdb, 0xaf, 0x1c, 0xac, 0xd8, 0xbe, 0x7b, 0x83, 0x9c, 0xe5, 0xc1, 0x03, 0xa2, 0x80, 0xb5,
+	0x08, 0x29, 0x90, 0xb9, 0x9e, 0x9e, 0xbe, 0x7f, 0x6f, 0xe6, 0xec, 0x4a, 0x11, 0x02, 0x14, 0x3f,
+	0xe7, 0xbd, 0x9b, 0xb8, 0x3c, 0x8c, 0x72, 0xa1, 0x28, 0x3a, 0x60, 0xa8, 0x32, 0xca, 0xfb, 0x62,
+	0x8d, 0x9c, 0xcc, 0x55, 0xcc, 0x43, 0xf5, 0xe3, 0x60, 0x38, 0xc2, 0x91, 0xaf, 0x3a, 0x83, 0xdd,
+	0x1b, 0x29, 0xc0, 0x21, 0x22, 0xb5, 0x20, 0xea, 0xf5, 0xb3, 0x72, 0x92, 0xd1, 0xf1, 0x4e, 0x2c,
+	0x21, 0x41, 0xc3, 0x4a, 0x8c, 0x3f, 0x81, 0xb3, 0x29, 0x33, 0x5c, 0xc2, 0xd2, 0x5b, 0x8c, 0x3d,
+	0x22, 0x5d, 0xfe, 0xa7, 0x74, 0xf0, 0x42, 0xad, 0x0c, 0xab, 0x60, 0x6e, 0xb2, 0x1c, 0xb7, 0x73,
+	0xeb, 0xcf, 0x55, 0xc8, 0xa4, 0xf1, 0xd1, 0xdc, 0x9f, 0xb2, 0xeb, 0x46, 0x38, 0xe5, 0xbd, 0x12,
+	0xa3, 0x3f, 0xab, 0x2b, 0x43, 0xf0, 0x57, 0x7a, 0xf3, 0x60, 0xc9, 0x88, 0x07, 0xf7, 0x66, 0x4e,
+	0xe5, 0x8a, 0x42, 0x58, 0x65, 0x24, 0xce, 0x7d, 0x2b, 0x39, 0x99, 0x23, 0x53, 0xf0, 0xca, 0xeb,
+	0xe6, 0x2b, 0x1f, 0xd9, 0xa6, 0x64, 0x0e, 0xd9, 0x97, 0x70, 0xc8, 0x44, 0x86, 0xab, 0x38, 0xa4,
+	0xc7, 0xa7, 0x91, 0x7e, 0x2b, 0xa9, 0xf7, 0xf0, 0xc2, 0x1c, 0xa8, 0xe2, 0x4c, 0x2c, 0x75, 0xde,
+	0xaa, 0x68, 0x03, 0x05, 0x75, 0xef, 0x90, 0xc6, 0xed, 0x3b, 0x19, 0x77, 0xfa, 0x68, 0x8e, 0x95,
+	0xea, 0xeb, 0xa1, 0xe4, 0x17, 0xd9, 0x92, 0x82, 0xe6, 0x85, 0x81, 0x5e, 0x4c, 0x36, 0x92, 0xb9,
+	0x31, 0x98, 0xe1, 0x9c, 0x09, 0x4d, 0x29, 0x08, 0x88, 0xf7, 0xd3, 0x0d, 0x72, 0xa6, 0xa8, 0x6c,
+	0xa9, 0xfb, 0x31, 0x32, 0xce, 0xfb, 0x58, 0x4e, 0x65, 0xec, 0x22, 0x1e, 0x97, 0x19, 0x41, 0xd1,
+	0x2d, 0xf6, 0x3f, 0x08, 0x9e, 0x82, 0x7b, 0xe8, 0x6f, 0x34, 0x2b, 0xc7, 0xc8, 0x7d, 0xd9, 0xd7,
+	0xdc, 0x97, 0x7d, 0xce, 0x3d, 0xf4, 0x37, 0xdc, 0xbb, 0xa4, 0xd6, 0x09, 0x32, 0xea, 0x0b, 0x7d,
+	0xeb, 0xad, 0x63, 0x61, 0x4e, 0x7d, 0x7e, 0xd3, 0x62, 0xff, 0x02, 0x67, 0x88, 0x49, 0x1e, 0x4e,
+	0x6e, 0xd8, 0x59, 0x2b, 0xc5, 0xe6, 0xe9, 0x97, 0xdf, 0x89, 0x5c, 0x7a, 0x4c, 0x1e, 0x76, 0x9d,
+	0x6b, 0x84, 0x7c, 0x77, 0x30, 0x20, 0x6c, 0x62, 0x33, 0x08, 0x8d, 0xea, 0x80, 0xc7, 0xf0, 0x71,
+	0x2e, 0x31, 0x06, 0x5a, 0xaa, 0xe1, 0xbf, 0x53, 0x90, 0x9c, 0x87, 0x9d, 0x54, 0xe3, 0x47, 0x3d,
+	0xa9, 0x26, 0x1e, 0xd1, 0x49, 0xf5, 0xdd, 0x0e, 0x69, 0xa8, 0x91, 0x16, 0x99, 0x08, 0x3f, 0x74,
+	0x8c, 0x9f, 0x9c, 0x2b, 0x99, 0xd5, 0x4f, 0xd0, 0xcc, 0x31, 0xe3, 0xd1, 0xa4, 0xff, 0x1a, 0xfa,
+	0x82, 0xd2, 0x9d, 0xb8, 0xc7, 0xcb, 0x15, 0x1e, 0x59, 0x4d, 0x5e, 0xd4, 0x99, 0x39, 0x64, 0xb2,
+	0x48, 0x77, 0x6e, 0xf4, 0x44, 0xd6, 0x6e, 0xa3, 0x01, 0xcc, 0x2e, 0x78, 0xf7, 0x2a, 0x64, 0x66,
+	0x1f, 0x0a, 0x68, 0xb7, 0x8f, 0x93, 0x8e, 0x1f, 0x05, 0xaf, 0x99, 0xa9, 0x6a, 0x95, 0x94, 0x75,
+	0xc3, 0x80, 0x81, 0x85, 0x69, 0xe6, 0x4a, 0xac, 0xec, 0x93, 0x2b, 0xf1, 0x3c, 0x19, 0x4b, 0x68,
+	0x2f, 0xce, 0xdf, 0x63, 0x59, 0x8a, 0x0a, 0x06, 0xc1, 0x82, 0xad, 0x7e, 0x2f, 0x10, 0x77, 0x46,
+	0xa5, 0xc7, 0x98, 0x5b, 0x5d, 0x02, 0x6c, 0xb7, 0x52, 0xb7, 0xd6, 0x1e, 0x4a, 0xea, 0x56, 0x3c,
+	0x06, 0x84, 0x3d, 0x7d, 0x5c, 0x1f, 0x03, 0xb6, 0x9d, 0xdb, 0xfb, 0x42, 0x95, 0x3c, 0xbb, 0xe7,
+	0x7c, 0xd1, 0x91, 0x2f, 0xce, 0x1e, 0x91, 0x2f, 0x72, 0x78, 0x2a, 0xfb, 0x0d, 0x4f, 0x75, 0xc8,
+	0xf0, 0x7c, 0x3b, 0x2e, 0x03, 0x99, 0xbe, 0x57, 0xec, 0x7c, 0x47, 0x8c, 0x46, 0x1a, 0x96, 0x0d,
+	0x58, 0xac, 0x00, 0x09, 0x05, 0xcd, 0x17, 0xef, 0x00, 0x56, 0x9e, 0xc0, 0x5a, 0x19, 0xc7, 0xc0,
+	0xd0, 0x74, 0xbe, 0x7c, 0xee, 0x0f, 0x4b, 0x3e, 0xe8, 0xfd, 0x68, 0x85, 0x3c, 0x3f, 0xc2, 0xee,
+	0x6d, 0xce, 0x62, 0x67, 0xc4, 0x59, 0xfc, 0xe5, 0xfd, 0x99, 0xbc, 0x1f, 0x72, 0xc8, 0xb9, 0xe1,
+	0x87, 0x07, 0xe6, 0x07, 0xdb, 0x48, 0xfc, 0xa8, 0xb5, 0xb5, 0xc2, 0x7c, 0x16, 0xc5, 0xa0, 0xb0,
+	0xb1, 0xd6, 0xcd, 0x60, 0xe2, 0xe0, 0xf5, 0x96, 0x3b, 0x14, 0x1a, 0x18, 0x32, 0xbb, 0x1a, 0x5e,
+	0x6f, 0xd7, 0xf3, 0x40, 0x18, 0xc4, 0xf7, 0xfe, 0xb4, 0x52, 0xdc, 0x2d, 0x2e, 0x64, 0x1c, 0xe4,
+	0x3b, 0x89, 0xaf, 0x50, 0x19, 0x61, 0x2f, 0xa9, 0x3e, 0xec, 0xbd, 0x64, 0x6c, 0xd8, 0x5e, 0x82,
+	0x09, 0x6c, 0x8d, 0x0a, 0xf7, 0x3c, 0x63, 0x1e, 0x57, 0x8f, 0xa9, 0x04, 0xb6, 0xab, 0x39, 0x38,
+	0x0c, 0x3c, 0x81, 0xd6, 0xaf, 0x20, 0x4a, 0x69, 0xab, 0x9f, 0x50, 0xa1, 0xb9, 0xd4, 0x4e, 0xd9,
+	0xa2, 0x1d, 0x14, 0x86, 0xf7, 0x57, 0x2a, 0xe4, 0xe9, 0xa1, 0x72, 0xd6, 0x43, 0xda, 0xbb, 0xcc,
+	0xcf, 0x31, 0xf6, 0x70, 0x3e, 0x87, 0x39, 0x48, 0xb5, 0x7d, 0x07, 0xe9, 0x77, 0x87, 0x4f, 0x4c,
+	0x94, 0xb9, 0xff, 0xdc, 0x8e, 0xd2, 0x7b, 0xc8, 0x09, 0xbf, 0xd7, 0xe3, 0x78, 0x2c, 0x4c, 0x23,
+	0x97, 0xc0, 0x7a, 0xce, 0x04, 0x82, 0x8d, 0x3b, 0xd2, 0xe9, 0xf9, 0xa9, 0x0a, 0x39, 0x99, 0x2b,
+	0x00, 0x22, 0xc2, 0x00, 0x9c, 0xa2, 0x30, 0x00, 0xdb, 0x3c, 0x5a, 0x79, 0x98, 0xe6, 0xd1, 0xf7,
+	0x93, 0x3a, 0x13, 0x80, 0x0f, 0xe7, 0x32, 0xa8, 0x26, 0xd7, 0x4b, 0x82, 0x06, 0x28, 0x6a, 0xde,
+	0x1f, 0x38, 0xa4, 0x01, 0x74, 0x93, 0xef, 0x90, 0x58, 0x60, 0x8b, 0x4d, 0x13, 0xa7, 0x8c, 0x02,
+	0x5b, 0x38, 0xb9, 0xd2, 0x80, 0x15, 0x9e, 0x2a, 0x9a, 0x70, 0xef, 0x23, 0xd3, 0x7c, 0x13, 0x96,
+	0xc1, 0xde, 0x62, 0x72, 0x2a, 0xaf, 0xdb, 0x75, 0x0b, 0x0a, 0x39, 0x6c, 0x5d, 0x87, 0xbf, 0x3a,
+	0xbc, 0x0e, 0x3f, 0x1a, 0xb9, 0x1b, 0xc8, 0x13, 0xcb, 0x85, 0xa7, 0x38, 0xc7, 0xfb, 0x49, 0xd8,
+	0x74, 0xec, 0x39, 0x8e, 0xe1, 0xf9, 0xd8, 0x6e, 0x59, 0xee, 0x2b, 0x07, 0x4a, 0x61, 0x5c, 0xdd,
+	0x37, 0x85, 0x31, 0x26, 0xff, 0x4c, 0xb7, 0x56, 0x93, 0x60, 0xc7, 0xcf, 0x50, 0xf1, 0xdc, 0x1c,
+	0xb3, 0x27, 0xf3, 0xda, 0xda, 0x15, 0x0d, 0x04, 0x1b, 0x17, 0x53, 0x66, 0xe9, 0x44, 0xc2, 0x34,
+	0xc9, 0x58, 0x4c, 0x31, 0x5f, 0x0d, 0x2a, 0x65, 0x96, 0x4e, 0x3d, 0x2c, 0x10, 0x60, 0xf0, 0x19,
+	0xdc, 0xe3, 0xad, 0x46, 0xec, 0xc8, 0xb8, 0xbd, 0xc7, 0x5b, 0x74, 0xb0, 0x2f, 0x03, 0x4f, 0x60,
+	0x61, 0x23, 0x3e, 0x31, 0xe6, 0x7a, 0x3d, 0xe3, 0x8d, 0x26, 0xec, 0xc2, 0x46, 0x97, 0x07, 0x51,
+	0xa0, 0xe8, 0x39, 0x66, 0x21, 0x93, 0xcd, 0x4b, 0x8b, 0xc2, 0x56, 0xac, 0x2d, 0x64, 0x0a, 0x84,
+	0x16, 0x32, 0x8d, 0x87, 0x55, 0xdf, 0xf5, 0x4f, 0x9e, 0x78, 0x82, 0x7b, 0x62, 0x2c, 0x8a, 0x1c,
+	0xed, 0xaa, 0xea, 0xfb, 0xe5, 0x42, 0xb4, 0x36, 0x0c, 0x7b, 0xde, 0xdd, 0x20, 0xe7, 0x14, 0xe8,
+	0x62, 0x94, 0xb1, 0x28, 0xf2, 0x94, 0xce, 0xfb, 0x29, 0xc5, 0x4c, 0xc2, 0xbc, 0x58, 0xb2, 0x27,
+	0xa8, 0x9f, 0xbb, 0x1c, 0x64, 0x57, 0x8a, 0x30, 0x61, 0x19, 0xf6, 0xa0, 0x82, 0x66, 0x2d, 0x1a,
+	0xf9, 0x1b, 0x21, 0xbd, 0xb1, 0xb0, 0x24, 0xca, 0x27, 0x6b, 0xef, 0x5b, 0x09, 0x00, 0x8d, 0xa3,
+	0x82, 0x6b, 0xa6, 0x86, 0x05, 0xd7, 0x60, 0xe4, 0x58, 0xa7, 0xd5, 0x43, 0xf9, 0x2b, 0x40, 0x03,
+	0x03, 0x8b, 0x08, 0xc0, 0x0f, 0xc3, 0x2b, 0x4e, 0xa9, 0xc8, 0xb1, 0xcb, 0x0b, 0xab, 0x03, 0x38,
+	0x50, 0xf8, 0x24, 0x8b, 0x1c, 0xc1, 0xf4, 0xc8, 0xcd, 0x27, 0x72, 0x91, 0x23, 0xd8, 0x08, 0x1c,
+	0x86, 0x7e, 0xf0, 0x2c, 0x1a, 0xf7, 0x4a, 0x96, 0xf5, 0x94, 0xc0, 0xd7, 0x3c, 0x63, 0x67, 0x6c,
+	0xbe, 0x34, 0x80, 0x01, 0x05, 0x4f, 0x79, 0xff, 0xc6, 0x21, 0x27, 0xd4, 0x7a, 0x7d, 0x08, 0x31,
+	0xf0, 0xa1, 0x1d, 0x03, 0x7f, 0xf9, 0xe8, 0x3b, 0x1e, 0xeb, 0xf9, 0x90, 0x68, 0xae, 0xef, 0x9c,
+	0x24, 0x44, 0xef, 0x8a, 0xea, 0x50, 0x76, 0x86, 0x1e, 0xca, 0x8f, 0xed, 0x8e, 0x54, 0x94, 0x5e,
+	0xb9, 0xf6, 0x68, 0xd3, 0x2b, 0xaf, 0x91, 0xb3, 0x52, 0x64, 0xe2, 0xf6, 0x32, 0x0c, 0xfb, 0x94,
+	0x1b, 0x5c, 0x7d, 0xfe, 0x59, 0x41, 0xe8, 0xec, 0x52, 0x11, 0x12, 0x14, 0x3f, 0x6b, 0x49, 0x6a,
+	0x13, 0xfb, 0x49, 0x6a, 0x7a, 0x4d, 0x2f, 0x6f, 0xca, 0xf2, 0xee, 0xb9, 0x35, 0xbd, 0x7c, 0x69,
+	0x0d, 0x34, 0x4e, 0xf1, 0xc6, 0xde, 0x28, 0x69, 0x63, 0x27, 0x07, 0xde, 0xd8, 0xe5, 0x16, 0x33,
+	0x39, 0x74, 0x8b, 0x91, 0x7a, 0xf9, 0xa9, 0xa1, 0x7a, 0xf9, 0xf7, 0x91, 0xe9, 0x20, 0xda, 0xa2,
+	0x49, 0x90, 0xd1, 0x36, 0x5b, 0x0b, 0xcd, 0x13, 0x76, 0x5d, 0xfa, 0x25, 0x0b, 0x0a, 0x39, 0x6c,
+	0x7b, 0x5f, 0x9c, 0x1e, 0x61, 0x5f, 0x1c, 0x72, 0x1a, 0x9d, 0x2c, 0xe7, 0x34, 0x3a, 0x75, 0xf4,
+	0xd3, 0xe8, 0xf4, 0xb1, 0x9e, 0x46, 0x6e, 0x29, 0xa7, 0xd1, 0x48, 0x1b, 0xbd, 0x71, 0x05, 0x3e,
+	0xb3, 0xcf, 0x15, 0x78, 0xd8, 0x51, 0x74, 0xf6, 0xd0, 0x47, 0x51, 0xf1, 0x29, 0xf3, 0xe4, 0xa1,
+	0x4e, 0x99, 0xef, 0xae, 0x90, 0xb3, 0x7a, 0x1f, 0xc6, 0xd9, 0x1f, 0x6c, 0xe2, 0x4e, 0x44, 0xd1,
+	0x6d, 0x8d, 0xdb, 0xae, 0x8c, 0xb8, 0x70, 0x1d, 0x62, 0xae, 0x20, 0x60, 0x60, 0xb1, 0xf0, 0x6a,
+	0x9a, 0xb0, 0x9a, 0x64, 0xf9, 0x4d, 0x7a, 0x41, 0xb4, 0x83, 0xc2, 0xc0, 0xf9, 0x85, 0xff, 0x8b,
+	0x6c, 0x31, 0xf9, 0x5a, 0x16, 0x0b, 0x1a, 0x04, 0x26, 0x1e, 0xda, 0xad, 0x5a, 0x72, 0x83, 0xc0,
+	0x8d, 0x7a, 0x8a, 0x5f, 0x9b, 0xd4, 0x9e, 0xa0, 0xa0, 0xb2, 0x3b, 0x2c, 0x8e, 0xbe, 0x36, 0xd8,
+	0x1d, 0x6c, 0x07, 0x85, 0xe1, 0xfd, 0x77, 0x87, 0x3c, 0x5d, 0x38, 0x14, 0x0f, 0xe1, 0xf0, 0xbd,
+	0x6b, 0x1f, 0xbe, 0x6b, 0x65, 0x5d, 0x37, 0x8c, 0xb7, 0x18, 0x72, 0x10, 0xff, 0xbe, 0x43, 0xa6,
+	0x35, 0xfe, 0x43, 0x78, 0xd5, 0xc0, 0x7e, 0xd5, 0xf2, 0x6e, 0x56, 0x8d, 0x81, 0x77, 0xfb, 0x95,
+	0x0a, 0x99, 0xce, 0xb9, 0xcc, 0xed, 0x6f, 0x4d, 0xdd, 0x25, 0xe3, 0xcc, 0x18, 0x9c, 0x96, 0xe3,
+	0x69, 0x63, 0xf3, 0x67, 0x86, 0x65, 0x6d, 0x68, 0x67, 0x3f, 0x53, 0x10, 0x0c, 0x59, 0x9d, 0x3a,
+	0x5e, 0xba, 0x43, 0x66, 0x3b, 0xd6, 0x75, 0xea, 0x44, 0x3b, 0x28, 0x0c, 0x3c, 0x1e, 0x82, 0x56,
+	0x1c, 0x2d, 0x84, 0x7e, 0x9a, 0x0a, 0x89, 0x45, 0x1d, 0x0f, 0x4b, 0x12, 0x00, 0x1a, 0x87, 0xd9,
+	0x89, 0x83, 0xb4, 0x17, 0xfa, 0xbb, 0x86, 0x0e, 0xc1, 0xc8, 0x8a, 0xa6, 0x40, 0x60, 0xe2, 0x79,
+	0x5d, 0xd2, 0xb4, 0x5f, 0x62, 0x91, 0x6e, 0x32, 0x7f, 0xf6, 0x91, 0x86, 0x13, 0xbd, 0xba, 0xd9,
+	0x53, 0xcb, 0x7d, 0x3f, 0x1f, 0x14, 0x38, 0x27, 0x01, 0xa0, 0x71, 0xbc, 0xbf, 0xee, 0x90, 0x27,
+	0x0a, 0x06, 0xad, 0xc4, 0x88, 0xff, 0x4c, 0xef, 0x36, 0x45, 0x07, 0x3b, 0x4b, 0x07, 0xba, 0xe9,
+	0x4b, 0x47, 0xe7, 0x86, 0x99, 0x0e, 0x94, 0x35, 0x83, 0x84, 0x7b, 0xff, 0xd5, 0x21, 0x27, 0xed,
+	0xbe, 0xb2, 0x32, 0xed, 0xfc, 0x65, 0x16, 0x83, 0xb4, 0x15, 0xef, 0xd0, 0x64, 0x17, 0xdf, 0xdc,
+	0xc9, 0xc5, 0xc2, 0x0e, 0x60, 0x40, 0xc1, 0x53, 0xac, 0xba, 0x54, 0x5b, 0x8d, 0xb6, 0x9c, 0x91,
+	0x37, 0xcb, 0x9c, 0x91, 0xfa, 0x63, 0x1a, 0x53, 0x41, 0xb3, 0x04, 0x93, 0xbf, 0xf7, 0x87, 0x63,
+	0x44, 0xa5, 0x04, 0x61, 0x3e, 0x58, 0x8f, 0xaf, 0x63, 0xe3, 0xbb, 0xc8, 0x24, 0xd7, 0x92, 0x98,
+	0xea, 0x5b, 0xf5, 0x86, 0xeb, 0x1a, 0x04, 0x26, 0x1e, 0xf6, 0x24, 0x0c, 0x76, 0x28, 0x7f, 0x68,
+	0xdc, 0xee, 0xc9, 0xb2, 0x04, 0x80, 0xc6, 0xc1, 0x9e, 0xb4, 0x83, 0xcd, 0xcd, 0xe6, 0x84, 0xdd,
+	0x13, 0x1c, 0x1d, 0x60, 0x10, 0x5e, 0x30, 0x30, 0xde, 0x16, 0x52, 0xb0, 0x51, 0x30, 0x30, 0xde,
+	0x06, 0x06, 0x41, 0xb9, 0x2d, 0x8a, 0x93, 0xae, 0x1f, 0x06, 0xaf, 0xd1, 0xb6, 0xe2, 0xd2, 0x6c,
+	0xd8, 0x72, 0xdb, 0xf5, 0x41, 0x14, 0x28, 0x7a, 0x8e, 0x97, 0xd1, 0xa4, 0xed, 0xa0, 0x95, 0x99,
+	0xd4, 0x48, 0xbe, 0x8c, 0x66, 0x1e, 0x03, 0x0a, 0x9e, 0xc2, 0xdc, 0x7c, 0x32, 0xa5, 0x8b, 0xcc,
+	0x95, 0x39, 0x69, 0xe7, 0xe6, 0x03, 0x1b, 0x0c, 0x79, 0x7c, 0xdc, 0xd5, 0xba, 0x22, 0x89, 0x7c,
+	0x73, 0xca, 0xde, 0xd5, 0x64, 0x72, 0x79, 0x50, 0x18, 0xde, 0xa7, 0xaa, 0x78, 0x0a, 0x0f, 0xa9,
+	0xe6, 0xf0, 0xd0, 0x9c, 0x79, 0xed, 0x19, 0x39, 0x36, 0xc2, 0x8c, 0x44, 0x6f, 0xc4, 0x34, 0x8e,
+	0x94, 0x37, 0x62, 0x6d, 0xa8, 0x37, 0xa2, 0x81, 0x55, 0xec, 0x8d, 0x38, 0x5e, 0x96, 0x37, 0xe2,
+	0xc4, 0x21, 0xbd, 0x11, 0x7f, 0xbd, 0x46, 0x54, 0xad, 0xf0, 0xeb, 0x34, 0xbb, 0x13, 0x27, 0xdb,
+	0x41, 0xd4, 0x61, 0xa9, 0x70, 0x7e, 0xd2, 0x21, 0x53, 0x7c, 0xbd, 0x2c, 0x9b, 0x11, 0xce, 0x9b,
+	0x25, 0x15, 0xf0, 0xb5, 0x98, 0xcd, 0xae, 0x1b, 0x8c, 0xb8, 0x3f, 0x97, 0x32, 0x86, 0x9b, 0x20,
+	0xb0, 0x7a, 0xe4, 0x7e, 0x2b, 0x21, 0x52, 0x3f, 0xba, 0x29, 0xb7, 0xcc, 0xa5, 0x72, 0xfa, 0x87,
+	0x3a, 0x7a, 0x25, 0x03, 0xaf, 0x2b, 0x26, 0x60, 0x30, 0x44, 0x3f, 0x08, 0xa9, 0x6f, 0xe7, 0xee,
+	0xb2, 0x1f, 0x3d, 0x96, 0xb1, 0x19, 0x25, 0xf6, 0x1b, 0xc8, 0x44, 0x10, 0xb1, 0xc2, 0xb8, 0xc2,
+	0x6b, 0xeb, 0x2d, 0x45, 0x69, 0xa4, 0x96, 0x63, 0xbf, 0x3d, 0xef, 0x87, 0x7e, 0xd4, 0xc2, 0x82,
+	0x51, 0x0c, 0x5d, 0x1f, 0x79, 0xa2, 0x01, 0x24, 0xa1, 0x81, 0x0a, 0xd5, 0xb5, 0x51, 0x2a, 0x54,
+	0x9f, 0xfb, 0x46, 0x72, 0x7a, 0xe0, 0x63, 0x1e, 0x28, 0xd4, 0xfb, 0xf0, 0x51, 0xe2, 0xde, 0x6f,
+	0x36, 0xf4, 0xa1, 0x85, 0x29, 0xb3, 0x58, 0x9d, 0xe4, 0x44, 0x7f, 0x51, 0x21, 0xe3, 0x96, 0x38,
+	0x45, 0xd4, 0x31, 0x63, 0x34, 0x82, 0xc9, 0x12, 0xe7, 0x68, 0xcf, 0x4f, 0x68, 0x74, 0xdc, 0x73,
+	0x74, 0x55, 0x31, 0x01, 0x83, 0xa1, 0xbb, 0x65, 0x85, 0x20, 0x5e, 0x3a, 0x7a, 0x08, 0x22, 0xcb,
+	0x6d, 0x5b, 0x54, 0x2c, 0xf4, 0x73, 0x0e, 0x99, 0x8e, 0xac, 0x99, 0x5b, 0x8e, 0x2b, 0x6d, 0xf1,
+	0xaa, 0x98, 0x77, 0x51, 0xad, 0x62, 0xb7, 0x41, 0x8e, 0x7f, 0xd1, 0x91, 0x56, 0x3b, 0xe0, 0x91,
+	0xa6, 0x0b, 0xae, 0x8f, 0x0f, 0x2b, 0xb8, 0xee, 0x46, 0x64, 0x9c, 0x67, 0xe2, 0x14, 0x1e, 0x59,
+	0x47, 0x0c, 0xdd, 0x32, 0xd3, 0x79, 0x72, 0x7e, 0xbc, 0x05, 0x04, 0x17, 0xac, 0xf8, 0xd0, 0x4a,
+	0xa8, 0xcf, 0xe3, 0xe3, 0xea, 0x87, 0xab, 0xf8, 0xb0, 0x20, 0x09, 0x80, 0xa6, 0x85, 0xb9, 0x75,
+	0xc5, 0x7e, 0xd6, 0x28, 0x53, 0xfc, 0xc4, 0xa5, 0x38, 0xd2, 0x2e, 0xf6, 0xf9, 0x5c, 0x06, 0x0b,
+	0x52, 0x46, 0xfc, 0xbb, 0xd5, 0x8b, 0x2f, 0xaf, 0xac, 0x15, 0x7f, 0x77, 0x9c, 0x9c, 0x92, 0xdd,
+	0x97, 0x61, 0x11, 0x28, 0xaf, 0xf0, 0x79, 0xa0, 0x2f, 0x1b, 0x4a, 0x5e, 0xb9, 0x22, 0x01, 0xa0,
+	0x71, 0x50, 0x3e, 0xee, 0xa7, 0xf4, 0x46, 0x8f, 0x46, 0xcb, 0xc1, 0x46, 0x2a, 0x6c, 0xef, 0xea,
+	0xbd, 0x5f, 0xd6, 0x20, 0x30, 0xf1, 0x34, 0x9f, 0x85, 0x8b, 0xcb, 0xcd, 0x89, 0x22, 0x3e, 0x0b,
+	0x17, 0x97, 0x41, 0xe3, 0xa0, 0x6e, 0x9d, 0xff, 0xb8, 0xc6, 0xe7, 0xae, 0x10, 0x83, 0x95, 0x6e,
+	0xfd, 0x8a, 0x09, 0x04, 0x1b, 0x77, 0x48, 0x41, 0xf8, 0xc6, 0x61, 0x0a, 0xc2, 0xe3, 0xb5, 0x4e,
+	0x87, 0xb1, 0x58, 0xd7, 0xba, 0x7c, 0xc0, 0x89, 0xfb, 0x63, 0x85, 0x85, 0xca, 0xca, 0x89, 0x18,
+	0x1f, 0x88, 0x63, 0x39, 0x60, 0x85, 0xb2, 0xbf, 0xea, 0x90, 0xb3, 0xbc, 0x55, 0xce, 0x81, 0x97,
+	0x7b, 0x6d, 0x3f, 0xa3, 0x69, 0x73, 0xfc, 0x98, 0xfa, 0xa7, 0xcd, 0x05, 0x45, 0x6c, 0xa1, 0xb8,
+	0x37, 0x98, 0xb4, 0xe2, 0xe4, 0xb6, 0x95, 0x38, 0x4f, 0x0a, 0x21, 0x47, 0xcd, 0x4c, 0x65, 0x11,
+	0xd5, 0x9b, 0xb6, 0xdd, 0x9e, 0x42, 0x9e, 0xbb, 0xf7, 0xa7, 0x0e, 0x31, 0x0f, 0xe4, 0x87, 0x9f,
+	0x6f, 0xef, 0xe0, 0x97, 0x0a, 0x79, 0x4f, 0xa9, 0x0d, 0xbd, 0xa7, 0xa0, 0x1d, 0x3f, 0x68, 0x37,
+	0xc7, 0x73, 0x76, 0xfc, 0xa5, 0x45, 0xc0, 0x76, 0xef, 0x8f, 0x6b, 0x5a, 0x03, 0x26, 0xe2, 0xa8,
+	0xff, 0x5c, 0xbc, 0xf6, 0xa6, 0x4a, 0x96, 0x3d, 0x6e, 0xa5, 0x0f, 0xd6, 0xc9, 0xb2, 0xbf, 0xe1,
+	0xe0, 0x61, 0xf2, 0x7c, 0x80, 0x86, 0xe5, 0xca, 0x9e, 0xd8, 0x27, 0x46, 0xde, 0xcc, 0x69, 0x5c,
+	0x3f, 0xe6, 0x9c, 0xc6, 0x29, 0x69, 0xe0, 0xff, 0x3c, 0xa7, 0x31, 0xdf, 0x0e, 0x5f, 0x56, 0xbb,
+	0xb0, 0x04, 0x94, 0x92, 0x2b, 0x40, 0xf3, 0xb1, 0x13, 0x29, 0x93, 0xe3, 0x4f, 0xa4, 0xcc, 0x8a,
+	0x22, 0xf4, 0x42, 0xbf, 0x45, 0xdb, 0xcd, 0x49, 0x5b, 0x6f, 0x00, 0xa2, 0x1d, 0x14, 0x86, 0xf7,
+	0xf9, 0x31, 0x3d, 0xd3, 0x0f, 0x12, 0x75, 0xfb, 0xb8, 0xcf, 0xf4, 0x17, 0x73, 0x33, 0xfd, 0xfc,
+	0xc0, 0x4c, 0x9f, 0xc6, 0xd1, 0x2b, 0xc8, 0xf3, 0xfe, 0xb0, 0x05, 0xd0, 0xfd, 0xf5, 0x5c, 0x4c,
+	0xf2, 0x7e, 0xb5, 0x1f, 0x24, 0x34, 0x5d, 0x4d, 0xfa, 0x11, 0x26, 0x36, 0x6f, 0x30, 0x64, 0x43,
+	0xf2, 0xb6, 0xc0, 0x90, 0xc7, 0xc7, 0x49, 0x81, 0x33, 0xe4, 0x96, 0xbf, 0xc3, 0xe7, 0xa0, 0x91,
+	0xe9, 0x76, 0x4d, 0xb4, 0x83, 0xc2, 0xf0, 0xfe, 0x87, 0x83, 0x57, 0x3f, 0x9d, 0xce, 0x80, 0x09,
+	0x01, 0xfc, 0x5f, 0x91, 0x18, 0x44, 0x0b, 0x01, 0xbc, 0x19, 0x26, 0x7c, 0x8d, 0x3a, 0x6a, 0x9a,
+	0x7c, 0x56, 0xa6, 0xd0, 0x8f, 0xd2, 0x80, 0x46, 0xd9, 0x60, 0x99, 0x42, 0x01, 0x00, 0x8d, 0x83,
+	0xf9, 0xaf, 0x8d, 0x74, 0x13, 0x63, 0x87, 0xcf, 0x7f, 0x3d, 0xa4, 0x90, 0xda, 0x7f, 0x60, 0xbe,
+	0x23, 0x46, 0xda, 0x16, 0x5c, 0x0b, 0x61, 0xd0, 0x0d, 0xe4, 0x6b, 0xab, 0xb5, 0xb0, 0x8c, 0x8d,
+	0xc0, 0x61, 0xee, 0x1d, 0x32, 0xb1, 0xe1, 0xb7, 0xb6, 0xe3, 0xcd, 0xcd, 0x72, 0x6a, 0x74, 0xce,
+	0x73, 0x62, 0x2c, 0xfd, 0xff, 0x84, 0xf8, 0xf1, 0x40, 0xff, 0x0b, 0x92, 0x9b, 0xfb, 0x2e, 0x32,
+	0xce, 0x82, 0xcd, 0x76, 0xc5, 0xda, 0x7a, 0x56, 0x19, 0x48, 0x58, 0xeb, 0x03, 0x76, 0x95, 0xce,
+	0x92, 0x5d, 0xfe, 0x13, 0x04, 0xb2, 0xf7, 0x3d, 0x13, 0xe4, 0xa4, 0x74, 0x82, 0xbb, 0x12, 0xa4,
+	0xcc, 0x93, 0xc4, 0x2c, 0xa5, 0x52, 0x19, 0xad, 0x0a, 0x1a, 0xd6, 0x51, 0x3c, 0xea, 0x87, 0x58,
+	0x54, 0x54, 0xc0, 0xa0, 0x28, 0x7c, 0x28, 0x79, 0x65, 0x96, 0xbc, 0x0f, 0xa5, 0xae, 0x48, 0x3c,
+	0xfe, 0x70, 0x2b, 0x12, 0x07, 0xe4, 0x24, 0xef, 0xe2, 0x51, 0xd2, 0xd3, 0xb3, 0x50, 0xab, 0x45,
+	0x9b, 0x0c, 0xe4, 0xe9, 0x9a, 0xe5, 0x86, 0xeb, 0x0f, 0xbb, 0xdc, 0xf0, 0x57, 0x93, 0x86, 0xfc,
+	0xce, 0xfc, 0xea, 0x2a, 0xf2, 0x52, 0xc9, 0x69, 0x90, 0x82, 0x86, 0x0f, 0xa4, 0x87, 0x22, 0x8f,
+	0x2c, 0x3d, 0xd4, 0x2d, 0x79, 0xc3, 0xda, 0x9d, 0xcb, 0x9a, 0x93, 0x07, 0xfe, 0x2e, 0x27, 0xf4,
+	0x4d, 0x6c, 0x17, 0xef, 0xf1, 0x8a, 0x96, 0xfb, 0x61, 0x32, 0x89, 0xc7, 0x6d, 0x90, 0xf1, 0x4f,
+	0x3e, 0x75, 0x60, 0xd2, 0x2c, 0xf2, 0x60, 0x41, 0x93, 0x00, 0x93, 0x9e, 0xf7, 0xd9, 0x0a, 0x5e,
+	0x4b, 0xf9, 0x78, 0xaa, 0x54, 0x98, 0x6f, 0x26, 0xe3, 0x7e, 0x3f, 0xdb, 0x8a, 0x93, 0x7c, 0xea,
+	0xd0, 0x39, 0xd6, 0x0a, 0x02, 0xea, 0x2e, 0x93, 0xb1, 0xb6, 0xce, 0xba, 0x77, 0x90, 0x4e, 0x69,
+	0x8b, 0x8b, 0x9f, 0x51, 0x60, 0x54, 0x30, 0x95, 0x42, 0xe6, 0x77, 0x64, 0x54, 0x2b, 0x4b, 0xa5,
+	0xb0, 0xee, 0x63, 0xcd, 0x31, 0x6c, 0x35, 0x37, 0xf6, 0xb1, 0x7d, 0x36, 0x76, 0x74, 0x0c, 0x0b,
+	0x3a, 0x91, 0x9f, 0xa1, 0x37, 0x94, 0xf6, 0x22, 0xd0, 0x8e, 0x61, 0x26, 0x10, 0x6c, 0x5c, 0x0c,
+	0xe4, 0x9d, 0x86, 0x38, 0x0c, 0x71, 0x93, 0x13, 0x12, 0x89, 0xb9, 0x39, 0x39, 0xfb, 0x6e, 0x4e,
+	0xd6, 0x04, 0xae, 0xec, 0x33, 0x81, 0xf1, 0x62, 0xce, 0xb7, 0xc0, 0xa5, 0x45, 0x11, 0xee, 0xae,
+	0x2f, 0xe6, 0x12, 0x00, 0x1a, 0xc7, 0x6d, 0x93, 0xa9, 0x24, 0x0e, 0x43, 0xda, 0xc6, 0xdd, 0xf8,
+	0x50, 0x9b, 0x9f, 0x52, 0xc6, 0x83, 0x41, 0x07, 0x2c, 0xaa, 0x07, 0x48, 0x36, 0xe9, 0xfd, 0xd2,
+	0x14, 0x39, 0xb3, 0xb6, 0xb0, 0x22, 0x4b, 0xb8, 0x1d, 0x5b, 0x20, 0x6f, 0x11, 0x8f, 0x87, 0x17,
+	0xc8, 0x3b, 0x84, 0x7b, 0x68, 0x04, 0xf2, 0x86, 0x46, 0x20, 0xaf, 0x1d, 0x55, 0x59, 0x2d, 0x23,
+	0xaa, 0xb2, 0xa8, 0x07, 0xa3, 0x44, 0x55, 0x1e, 0x5b, 0x64, 0xef, 0x9e, 0x1d, 0x3a, 0x50, 0x64,
+	0xaf, 0x0a, 0x7b, 0x2e, 0x25, 0xde, 0x6d, 0xc8, 0xa7, 0x2a, 0x0c, 0x7b, 0x56, 0x21, 0xa7, 0x3c,
+	0x96, 0xb3, 0x39, 0x5e, 0x46, 0xc8, 0x69, 0x51, 0x07, 0x46, 0x08, 0x39, 0xe5, 0x3f, 0xac, 0x30,
+	0xe7, 0x89, 0x32, 0xc2, 0x9c, 0x8b, 0xba, 0xb3, 0x6f, 0x98, 0xf3, 0x7b, 0xc8, 0x89, 0x56, 0x18,
+	0x47, 0x58, 0x51, 0x32, 0x8b, 0x5b, 0x71, 0xd8, 0xac, 0xdb, 0x5b, 0xe8, 0x82, 0x09, 0x04, 0x1b,
+	0x77, 0x58, 0x8c, 0x74, 0xe3, 0xa8, 0x31, 0xd2, 0xe4, 0x11, 0xc5, 0x48, 0x7f, 0x97, 0xce, 0xe6,
+	0x31, 0xc9, 0xbe, 0xc8, 0x47, 0xca, 0xff, 0x22, 0xa3, 0xa4, 0xf4, 0x70, 0xbf, 0xe0, 0x90, 0x13,
+	0xfe, 0x1d, 0x76, 0x5f, 0xe4, 0x07, 0xba, 0x10, 0x07, 0x5e, 0x39, 0x86, 0x09, 0x7b, 0x6b, 0x4d,
+	0xb3, 0x99, 0x3f, 0xcd, 0xc2, 0x95, 0xcc, 0x26, 0xb0, 0x3b, 0x72, 0x94, 0x6c, 0x23, 0x5f, 0xac,
+	0x90, 0xaf, 0xd8, 0xb7, 0x0b, 0xee, 0x1d, 0xb4, 0x0f, 0x77, 0xc4, 0x44, 0x6d, 0x3a, 0x65, 0x78,
+	0xbb, 0xaf, 0x4b, 0x7a, 0x3c, 0x11, 0xa0, 0xfa, 0xc9, 0x2c, 0xc3, 0xf2, 0x7f, 0xe6, 0xe4, 0x1e,
+	0x87, 0x03, 0xa5, 0x00, 0x20, 0x0e, 0x29, 0x30, 0x08, 0xcf, 0xb4, 0xde, 0x41, 0xe9, 0xa0, 0x9a,
+	0xcf, 0xb4, 0xde, 0x09, 0x78, 0xa6, 0xf5, 0x8e, 0x28, 0x44, 0xea, 0x87, 0x21, 0x0f, 0xd9, 0xa4,
+	0xdc, 0xf9, 0xcb, 0x50, 0xde, 0xcf, 0x69, 0x10, 0x98, 0x78, 0xde, 0x9f, 0x54, 0xc8, 0xcc, 0x3e,
+	0x7b, 0xca, 0x40, 0x10, 0x7a, 0x6d, 0xe4, 0x20, 0x74, 0x11, 0x36, 0x37, 0x3e, 0x24, 0x6c, 0x0e,
+	0x1d, 0x72, 0x28, 0x16, 0x6c, 0xe4, 0x6e, 0xb3, 0x13, 0x39, 0x87, 0x1c, 0x0d, 0x02, 0x13, 0x0f,
+	0x77, 0xb1, 0x69, 0xbf, 0xd5, 0xa2, 0x69, 0x2a, 0xe3, 0xe2, 0x84, 0x71, 0xab, 0xb4, 0xa0, 0x3b,
+	0x66, 0x33, 0x9c, 0xb3, 0x58, 0x40, 0x8e, 0x65, 0x7e, 0xc0, 0x1b, 0x23, 0x0e, 0xf8, 0x4f, 0x57,
+	0xc8, 0xb3, 0x7b, 0x9e, 0x6e, 0x23, 0x87, 0x2c, 0x62, 0x64, 0x43, 0x7e, 0xe2, 0x60, 0xdc, 0x03,
+	0x30, 0x08, 0x1f, 0xa5, 0x5e, 0x4f, 0xc5, 0x36, 0x94, 0x1f, 0x4f, 0xcb, 0x47, 0xc9, 0x62, 0x01,
+	0x39, 0x96, 0x87, 0x9d, 0x96, 0x7f, 0xb3, 0x42, 0x9e, 0x1f, 0x41, 0x06, 0x28, 0x31, 0xee, 0xd8,
+	0x8e, 0xfe, 0xae, 0x3e, 0xa2, 0x20, 0xfd, 0x43, 0x0e, 0xd7, 0x97, 0x2a, 0xe4, 0xdc, 0xf0, 0xa3,
+	0xd8, 0x7d, 0x2f, 0x2a, 0xd9, 0xa4, 0x53, 0xac, 0x19, 0x38, 0xfe, 0x04, 0x57, 0xb0, 0x59, 0x20,
+	0xc8, 0xe3, 0xba, 0xb3, 0xe8, 0x99, 0x90, 0x6d, 0xa5, 0x17, 0xef, 0x06, 0x69, 0x26, 0x6e, 0x1d,
+	0xd3, 0xdc, 0x95, 0x40, 0xb6, 0x82, 0x81, 0x81, 0xec, 0xd8, 0xaf, 0xc5, 0xf8, 0x7a, 0x9c, 0xf1,
+	0x87, 0xf8, 0xb5, 0xeb, 0x09, 0x59, 0xb8, 0xd5, 0x00, 0x41, 0x1e, 0x17, 0xd9, 0x31, 0x33, 0x2f,
+	0xef, 0x28, 0xbf, 0x8f, 0x31, 0x76, 0xcb, 0xaa, 0x15, 0x0c, 0x8c, 0x7c, 0x48, 0x7c, 0x6d, 0xff,
+	0x90, 0x78, 0xef, 0xef, 0x57, 0xc8, 0xd3, 0x43, 0x45, 0xb9, 0xd1, 0x16, 0xe0, 0xe3, 0x17, 0xc6,
+	0x7e, 0xb8, 0xb9, 0x73, 0xc0, 0x70, 0xeb, 0x3f, 0x18, 0x32, 0xd3, 0x44, 0xb8, 0xf5, 0xe1, 0xf3,
+	0x95, 0x3c, 0x7e, 0xe3, 0x39, 0x10, 0x61, 0x3d, 0x76, 0x80, 0x08, 0xeb, 0xdc, 0xc7, 0xa8, 0x8d,
+	0xb8, 0x90, 0xff, 0xac, 0x3a, 0x74, 0x78, 0xf1, 0xea, 0x37, 0x92, 0xf9, 0x62, 0x91, 0x9c, 0x0a,
+	0x22, 0x56, 0xc4, 0x7b, 0xad, 0xbf, 0x21, 0x72, 0x65, 0xf1, 0xdc, 0xd9, 0x2a, 0xda, 0x69, 0x29,
+	0x07, 0x87, 0x81, 0x27, 0x1e, 0xc3, 0x88, 0xf7, 0xc3, 0x0d, 0xe9, 0xc1, 0x72, 0x2e, 0xa0, 0xe3,
+	0xa4, 0x1c, 0x8a, 0x2d, 0x3f, 0xa1, 0x6d, 0x71, 0x8c, 0xa4, 0x22, 0xbe, 0xed, 0x69, 0x1e, 0x23,
+	0x57, 0x80, 0x00, 0xc5, 0xcf, 0xe1, 0x27, 0xcb, 0xe2, 0x5e, 0xd0, 0x6a, 0xd6, 0xed, 0x4f, 0xb6,
+	0x8e, 0x8d, 0xc0, 0x61, 0xde, 0x47, 0x48, 0x43, 0xbd, 0x3f, 0x8f, 0xb2, 0x51, 0x93, 0x6e, 0x20,
+	0xca, 0x46, 0xcd, 0x38, 0x03, 0xcb, 0x7d, 0x96, 0x8b, 0xc4, 0xb9, 0xd5, 0x83, 0xf1, 0x42, 0xd8,
+	0xee, 0x7d, 0x2d, 0x99, 0x52, 0x7a, 0xa9, 0x51, 0xab, 0x49, 0x7b, 0xbf, 0x51, 0x21, 0xa7, 0xb8,
+	0x92, 0x4a, 0x97, 0xe3, 0x75, 0x5f, 0x14, 0xbe, 0xf2, 0xfc, 0xc1, 0x37, 0x99, 0xbe, 0xf2, 0x0f,
+	0xee, 0xcd, 0x9c, 0xc9, 0xe3, 0xdb, 0xc1, 0x71, 0x9b, 0x49, 0xdc, 0xcd, 0x4b, 0x2c, 0x97, 0x92,
+	0xb8, 0x0b, 0x0c, 0x82, 0x9a, 0xf1, 0x2c, 0xce, 0x17, 0x19, 0x5c, 0x8f, 0xa1, 0x92, 0xc5, 0x46,
+	0xc1, 0xa1, 0xb1, 0x3d, 0x0b, 0x0e, 0x99, 0xea, 0xb4, 0xda, 0xbe, 0xea, 0xb4, 0xdb, 0x64, 0x3a,
+	0x53, 0x7d, 0x3d, 0x64, 0x16, 0x71, 0x1d, 0x91, 0x6f, 0x51, 0x82, 0x1c, 0x65, 0xef, 0x17, 0x27,
+	0xc8, 0x09, 0x2b, 0xc1, 0xf9, 0x01, 0x55, 0x7f, 0x2c, 0x08, 0xad, 0x1f, 0xc9, 0xca, 0xfd, 0x46,
+	0x10, 0x5a, 0x3f, 0xc2, 0x04, 0xee, 0xf8, 0xc7, 0xc8, 0x54, 0x5b, 0xdd, 0x33, 0x53, 0x2d, 0x56,
+	0x1a, 0x4c, 0x99, 0x8d, 0x90, 0x1b, 0x83, 0x9a, 0x63, 0x65, 0xd8, 0x03, 0xd7, 0x0c, 0x8a, 0xdc,
+	0xff, 0xd3, 0x6c, 0x01, 0x8b, 0x23, 0x56, 0xb7, 0x6b, 0xa8, 0x2a, 0xa7, 0xcd, 0xf1, 0x32, 0x82,
+	0x9c, 0xf2, 0xf9, 0xe3, 0xb9, 0x39, 0x40, 0xe9, 0x3c, 0x65, 0x0b, 0x53, 0x92, 0x8a, 0x7f, 0xb1,
+	0xb2, 0x1f, 0xff, 0x57, 0x18, 0x3c, 0x4a, 0xb7, 0x46, 0x90, 0x02, 0x73, 0x0b, 0xd6, 0xc7, 0x10,
+	0x05, 0x72, 0xb8, 0x15, 0x44, 0xd6, 0xc7, 0x90, 0x8d, 0xa0, 0xe1, 0x28, 0xdf, 0xa4, 0xec, 0xc5,
+	0x32, 0xc3, 0x6c, 0xc1, 0xe4, 0x9b, 0x35, 0xdd, 0x0c, 0x26, 0x8e, 0x69, 0x63, 0x21, 0x8f, 0xd4,
+	0xc6, 0x32, 0xb9, 0x8f, 0x8a, 0xfa, 0xbd, 0xe4, 0x64, 0x6b, 0xcb, 0x8f, 0x3a, 0x54, 0x41, 0x9b,
+	0x53, 0x5a, 0x54, 0x5c, 0xb0, 0x41, 0x90, 0xc7, 0xc5, 0x70, 0x5a, 0xbb, 0x49, 0x44, 0xf3, 0xab,
+	0x35, 0x69, 0x53, 0x80, 0x1c, 0x36, 0x5e, 0x1f, 0x32, 0xe1, 0x41, 0x36, 0x6d, 0x5f, 0x1f, 0xa4,
+	0xdb, 0x98, 0x84, 0x7b, 0x7f, 0xdb, 0x21, 0x67, 0x0b, 0xe7, 0xd7, 0xe3, 0x1b, 0x80, 0xe0, 0xfd,
+	0xa3, 0x3a, 0x79, 0xa2, 0xa0, 0xa6, 0x82, 0xbb, 0x6b, 0xae, 0x3c, 0xa7, 0x0c, 0x0f, 0x2c, 0xdb,
+	0xa1, 0x48, 0x7e, 0xf0, 0x82, 0xe5, 0x76, 0x30, 0x5b, 0xac, 0xb6, 0x87, 0x56, 0x1f, 0xae, 0x3d,
+	0xd4, 0x58, 0x40, 0x63, 0x8f, 0x74, 0x01, 0xd5, 0xf6, 0x59, 0x40, 0x3f, 0xe7, 0x90, 0x66, 0x77,
+	0x48, 0xe9, 0xb5, 0xe6, 0x78, 0x19, 0xb7, 0xd8, 0x61, 0x85, 0xdd, 0xe6, 0x9f, 0xb9, 0x7f, 0x6f,
+	0x66, 0x68, 0xc5, 0x3b, 0x18, 0xda, 0x2b, 0xf7, 0x55, 0x52, 0xbb, 0xe3, 0xef, 0x50, 0xa9, 0x81,
+	0x5e, 0x3e, 0xfa, 0x9e, 0xcf, 0x5c, 0x3c, 0xc4, 0xcc, 0x93, 0xab, 0x0f, 0xdb, 0x52, 0xe0, 0x9c,
+	0xf0, 0x72, 0x7f, 0xaa, 0xd5, 0x4f, 0x12, 0x1a, 0x65, 0xd8, 0xce, 0xfd, 0x95, 0xb8, 0x40, 0x76,
+	0x4b, 0xca, 0xc4, 0x0b, 0x39, 0xf8, 0x51, 0xdd, 0x96, 0x06, 0x18, 0xb2, 0xe0, 0x66, 0xdd, 0x26,
+	0x54, 0xd6, 0x3a, 0xb8, 0x59, 0x83, 0xc0, 0xc4, 0x73, 0xef, 0x92, 0x33, 0xc6, 0x4f, 0x6d, 0xa0,
+	0x3f, 0x78, 0x8d, 0xf4, 0x26, 0x46, 0x95, 0x2f, 0x14, 0xd0, 0x82, 0x42, 0x0e, 0xde, 0xe7, 0x2a,
+	0x84, 0xb9, 0xd0, 0xac, 0x86, 0x7e, 0x74, 0x9c, 0x86, 0xca, 0x4f, 0x98, 0xfb, 0x51, 0xb5, 0x8c,
+	0x3a, 0xeb, 0xb2, 0xd7, 0x23, 0x0a, 0x01, 0x6f, 0x25, 0xf5, 0x4d, 0x3f, 0x08, 0xfb, 0x09, 0x95,
+	0x39, 0xe0, 0xd8, 0x25, 0xe4, 0x92, 0x68, 0x03, 0x05, 0xf5, 0x7e, 0xb8, 0x46, 0x4e, 0xe5, 0x89,
+	0xff, 0xb9, 0xf1, 0x2a, 0x13, 0x65, 0x1f, 0x72, 0x5e, 0x65, 0xaa, 0xec, 0xc3, 0xb4, 0x7c, 0x71,
+	0xde, 0xa2, 0x4a, 0x3d, 0x58, 0x5e, 0x80, 0x13, 0x0f, 0xc5, 0x0b, 0x50, 0x39, 0x7c, 0xd5, 0xf7,
+	0x73, 0xf8, 0xb2, 0x9c, 0x30, 0x1b, 0xc7, 0xec, 0x84, 0xa9, 0x6a, 0x49, 0x93, 0x3d, 0x6a, 0x49,
+	0x1b, 0xf6, 0xef, 0xc9, 0x7d, 0x9c, 0x0d, 0xcc, 0x79, 0x39, 0xb5, 0xe7, 0xbc, 0xfc, 0xf1, 0x71,
+	0xc2, 0xaa, 0x24, 0x89, 0x7a, 0x57, 0x1f, 0x37, 0x0b, 0x67, 0x39, 0x65, 0xd5, 0x66, 0xe2, 0xc4,
+	0x55, 0xe1, 0x2d, 0xbe, 0xa2, 0x8b, 0xea, 0x70, 0xe5, 0x65, 0xd6, 0xca, 0x08, 0x32, 0x6b, 0x28,
+	0x2b, 0x94, 0x55, 0xcb, 0xaf, 0x50, 0xd6, 0xc8, 0x57, 0x27, 0xdb, 0xfb, 0xdc, 0x1c, 0x7b, 0x2c,
+	0xcf, 0x4d, 0x43, 0x58, 0xad, 0xed, 0x2d, 0xac, 0xba, 0x1f, 0x23, 0x0d, 0x39, 0xff, 0xd3, 0x72,
+	0x5c, 0xc9, 0xe4, 0xc2, 0x4a, 0x75, 0xd5, 0x37, 0xb9, 0xbc, 0x59, 0x33, 0x68, 0x86, 0xee, 0x67,
+	0x1d, 0x32, 0x1d, 0x5b, 0x55, 0xd8, 0xc4, 0xdd, 0x0a, 0x4a, 0xf2, 0x9d, 0x32, 0x2a, 0xbb, 0x71,
+	0x23, 0x84, 0x0d, 0x81, 0x1c, 0x77, 0xcc, 0x36, 0xfb, 0x44, 0xc1, 0xfc, 0xd5, 0x57, 0x6a, 0x67,
+	0x8f, 0x2b, 0x35, 0xee, 0x36, 0x34, 0xdc, 0x44, 0xff, 0x29, 0x71, 0xf5, 0xd6, 0xbb, 0x8d, 0x68,
+	0x07, 0x85, 0x81, 0xca, 0x1b, 0x3f, 0x0c, 0xe3, 0x3b, 0x17, 0xbb, 0xbd, 0x6c, 0x57, 0x5c, 0xc2,
+	0x95, 0xf2, 0x66, 0x4e, 0x41, 0xc0, 0xc0, 0x72, 0x77, 0x49, 0x3d, 0x11, 0x4e, 0x41, 0xcd, 0xb1,
+	0x32, 0x3e, 0x96, 0x5e, 0x92, 0x82, 0x2c, 0xdf, 0x36, 0xe4, 0x2f, 0x50, 0xec, 0xbc, 0xbf, 0x5c,
+	0xe1, 0xdb, 0x86, 0x70, 0x46, 0xd2, 0x9e, 0xc5, 0xce, 0x01, 0x3d, 0x8b, 0x3f, 0x46, 0x48, 0x2b,
+	0xee, 0xf6, 0x50, 0xf5, 0xb5, 0x1e, 0x0b, 0xb7, 0x98, 0x2b, 0x47, 0x4d, 0x76, 0x25, 0xe9, 0xe9,
+	0x11, 0xd4, 0x6d, 0x60, 0xf0, 0xb3, 0x64, 0x93, 0xea, 0xc1, 0x64, 0x93, 0xb1, 0xbd, 0x65, 0x13,
+	0xef, 0x4f, 0x1c, 0x62, 0x69, 0x31, 0xb0, 0x92, 0x22, 0x76, 0x77, 0xb7, 0xe9, 0x94, 0xf1, 0xa9,
+	0x4c, 0xd2, 0x78, 0x49, 0x10, 0x7b, 0x15, 0xfb, 0x17, 0x38, 0x23, 0x37, 0x14, 0x5e, 0xd4, 0x7c,
+	0x54, 0xaf, 0x97, 0xc7, 0x10, 0x4f, 0x30, 0xee, 0x0b, 0xa7, 0x3d, 0xb2, 0xbd, 0x17, 0xc9, 0xe9,
+	0x81, 0x4e, 0xe1, 0x4a, 0x61, 0x99, 0x82, 0xf2, 0x2b, 0x85, 0xa5, 0x14, 0x02, 0x0e, 0xf3, 0xbe,
+	0xe4, 0x90, 0x53, 0xe6, 0xa3, 0x48, 0x14, 0xdd, 0x0a, 0x4e, 0xa7, 0x79, 0x7a, 0xc7, 0x35, 0x76,
+	0x2a, 0x6e, 0x6a, 0x00, 0x04, 0x83, 0x9d, 0xf0, 0xfe, 0x81, 0x43, 0xa6, 0xed, 0xeb, 0x03, 0x8a,
+	0x49, 0x78, 0x63, 0x10, 0x2e, 0xd1, 0x4a, 0x4c, 0x62, 0x18, 0x0c, 0x82, 0x92, 0x97, 0x96, 0x55,
+	0x2b, 0xb6, 0x53, 0x5d, 0xa1, 0x6c, 0x89, 0xfe, 0xe5, 0xd8, 0x7b, 0x91, 0xc0, 0xc5, 0xf4, 0x2f,
+	0xe7, 0xcd, 0x20, 0xe1, 0x88, 0x2a, 0x7c, 0x33, 0x9b, 0x63, 0x36, 0xaa, 0xf0, 0xde, 0x04, 0x09,
+	0xf7, 0x7e, 0xc4, 0xe1, 0x9f, 0xc9, 0xda, 0x93, 0x51, 0x1f, 0xd2, 0xf5, 0xef, 0x2e, 0xc4, 0x11,
+	0x17, 0xe7, 0x5b, 0xbb, 0xe2, 0x45, 0x94, 0x3e, 0x64, 0xc5, 0x82, 0x42, 0x0e, 0x1b, 0xbd, 0xf1,
+	0x39, 0x83, 0xf5, 0xad, 0x84, 0xa6, 0x5b, 0x71, 0xd8, 0x16, 0xaf, 0xa8, 0xbc, 0xf1, 0xaf, 0xd8,
+	0x60, 0xc8, 0xe3, 0x7b, 0xff, 0x47, 0xec, 0x28, 0xb7, 0x82, 0xa8, 0x1d, 0xdf, 0x51, 0xa2, 0xac,
+	0x33, 0x54, 0x94, 0xc5, 0xfd, 0x55, 0xd4, 0xda, 0xca, 0x6b, 0x04, 0x64, 0x41, 0x2e, 0x50, 0x18,
+	0x88, 0xdd, 0xee, 0x8b, 0x24, 0xb3, 0xb9, 0x95, 0xbe, 0x28, 0xda, 0x41, 0x61, 0x60, 0x64, 0xba,
+	0x31, 0x73, 0xe4, 0x62, 0x67, 0x9a, 0x49, 0xe3, 0x46, 0x9e, 0x82, 0x85, 0x85, 0x06, 0x48, 0x25,
+	0x38, 0xcb, 0x1b, 0x38, 0x33, 0x40, 0xaa, 0x33, 0x39, 0x05, 0x03, 0x83, 0x65, 0x9f, 0x0a, 0xfb,
+	0x29, 0xf3, 0x1d, 0x19, 0xd7, 0x52, 0xda, 0x82, 0x68, 0x03, 0x05, 0xc5, 0xd3, 0xa1, 0xeb, 0x47,
+	0x7d, 0x3f, 0xc4, 0x11, 0x12, 0x26, 0x05, 0xb5, 0xb7, 0xad, 0x28, 0x08, 0x18, 0x58, 0xf8, 0xc6,
+	0x78, 0xac, 0x7f, 0x30, 0x8e, 0xe4, 0x95, 0x55, 0xbb, 0x13, 0x89, 0x76, 0x50, 0x18, 0xde, 0x7f,
+	0x76, 0xc8, 0x49, 0x9d, 0xcb, 0x8e, 0x95, 0x5d, 0xb2, 0x2c, 0x20, 0xce, 0xbe, 0x16, 0x10, 0x3b,
+	0xc9, 0x57, 0x65, 0xa4, 0x24, 0x5f, 0x66, 0xfe, 0xad, 0xea, 0x9e, 0xf9, 0xb7, 0xbe, 0x92, 0x4c,
+	0x6c, 0xd3, 0x5d, 0x23, 0x51, 0xd7, 0x24, 0x4e, 0xef, 0x6b, 0xbc, 0x09, 0x24, 0x0c, 0xa3, 0xa9,
+	0x5b, 0xbe, 0x4a, 0xe4, 0x3a, 0xc5, 0x95, 0xa8, 0x0b, 0x73, 0x0c, 0x49, 0x40, 0xbc, 0x1b, 0xa4,
+	0xa1, 0xbc, 0x6a, 0xa4, 0x01, 0xc4, 0x29, 0x36, 0x80, 0x8c, 0x94, 0x07, 0x68, 0x7e, 0xe3, 0xd7,
+	0xfe, 0xe8, 0xb9, 0x37, 0xfc, 0xf6, 0x1f, 0x3d, 0xf7, 0x86, 0xdf, 0xfb, 0xa3, 0xe7, 0xde, 0xf0,
+	0xc9, 0xfb, 0xcf, 0x39, 0xbf, 0x76, 0xff, 0x39, 0xe7, 0xb7, 0xef, 0x3f, 0xe7, 0xfc, 0xde, 0xfd,
+	0xe7, 0x9c, 0x3f, 0xbc, 0xff, 0x9c, 0xf3, 0xb9, 0x7f, 0xff, 0xdc, 0x1b, 0x3e, 0x58, 0x18, 0x45,
+	0x86, 0xff, 0xbc, 0xbd, 0xd5, 0xbe, 0xb0, 0xf3, 0x02, 0xbb, 0x26, 0xe0, 0x9e, 0x75, 0xc1, 0x98,
+	0x53, 0x17, 0xe4, 0x9e, 0xf5, 0x7f, 0x07, 0x00, 0x6d, 0x5f, 0xcc, 0x47, 0xb4, 0x10, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5371,6 +6126,34 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DestinationServiceAccounts) > 0 {
+		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationServiceAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ManifestPolicies) > 0 {
+		for iNdEx := len(m.ManifestPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManifestPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	i--
 	if m.PermitOnlyProjectScopedClusters {
 		dAtA[i] = 1
@@ -5729,6 +6512,106 @@ func (m *ApplicationDestination) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDestinationServiceAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDestinationServiceAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDestinationServiceAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.DefaultServiceAccount)
+	copy(dAtA[i:], m.DefaultServiceAccount)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DefaultServiceAccount)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Server)
+	copy(dAtA[i:], m.Server)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Server)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationDestinationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDestinationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDestinationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7687,6 +8570,37 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DestinationRollout != nil {
+		{
+			size, err := m.DestinationRollout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	i -= len(m.ProgressingTimeout)
+	copy(dAtA[i:], m.ProgressingTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProgressingTimeout)))
+	i--
+	dAtA[i] = 0x4a
 	if len(m.Sources) > 0 {
 		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7796,6 +8710,78 @@ func (m *ApplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.OrphanedResources) > 0 {
+		for iNdEx := len(m.OrphanedResources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrphanedResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OperationQueue) > 0 {
+		for iNdEx := len(m.OperationQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperationQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Maintenance != nil {
+		{
+			size, err := m.Maintenance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Rollback != nil {
+		{
+			size, err := m.Rollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	i -= len(m.ControllerNamespace)
 	copy(dAtA[i:], m.ControllerNamespace)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ControllerNamespace)))
@@ -8075,6 +9061,39 @@ func (m *ApplicationWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutomatedRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutomatedRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutomatedRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ProgressingTimeout)
+	copy(dAtA[i:], m.ProgressingTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProgressingTimeout)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Window)
+	copy(dAtA[i:], m.Window)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Window)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8095,6 +9114,11 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Jitter != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Jitter))
+		i--
+		dAtA[i] = 0x20
+	}
 	i -= len(m.MaxDuration)
 	copy(dAtA[i:], m.MaxDuration)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
@@ -8746,6 +9770,20 @@ func (m *ComparedTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.IgnoreDifferences) > 0 {
 		for iNdEx := len(m.IgnoreDifferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8938,6 +9976,293 @@ func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerLogs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerLogs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Truncated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Logs)
+	copy(dAtA[i:], m.Logs)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Logs)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DORAMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DORAMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DORAMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeToRestoreSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeToRestoreSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FailedDeployments))
+	i--
+	dAtA[i] = 0x20
+	if m.LeadTimeSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.LeadTimeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Deployments))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Window)
+	copy(dAtA[i:], m.Window)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Window)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DestinationOperationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DestinationOperationState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DestinationOperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SyncResult != nil {
+		{
+			size, err := m.SyncResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DestinationRollout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DestinationRollout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DestinationRollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.BatchSize))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DriftRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DriftRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DriftRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Deleted {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	i -= len(m.Manager)
+	copy(dAtA[i:], m.Manager)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Manager)))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ResolvedAt != nil {
+		{
+			size, err := m.ResolvedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.DetectedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DuckTypeGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9436,6 +10761,18 @@ func (m *HealthStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTransitionTime != nil {
+		{
+			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.Message)
 	copy(dAtA[i:], m.Message)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
@@ -9555,6 +10892,98 @@ func (m *HelmParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HookLogs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookLogs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CapturedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.PodName)
+	copy(dAtA[i:], m.PodName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodName)))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.OperationStartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.SyncPhase)
+	copy(dAtA[i:], m.SyncPhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.HookType)
+	copy(dAtA[i:], m.HookType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HookType)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10182,6 +11611,59 @@ func (m *ListGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaintenanceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.StartedBy)
+	copy(dAtA[i:], m.StartedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StartedBy)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ManagedNamespaceMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10253,6 +11735,68 @@ func (m *ManagedNamespaceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ManifestPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Severity)
+	copy(dAtA[i:], m.Severity)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Severity)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Kinds) > 0 {
+		for iNdEx := len(m.Kinds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kinds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Description)
+	copy(dAtA[i:], m.Description)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MatrixGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10544,6 +12088,32 @@ func (m *OperationInitiator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OperationQueuePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationQueuePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationQueuePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxLength))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *OperationState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10564,6 +12134,34 @@ func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RetryAttempts) > 0 {
+		for iNdEx := len(m.RetryAttempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryAttempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
 	i--
 	dAtA[i] = 0x40
@@ -10703,6 +12301,62 @@ func (m *OptionalMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OrphanedResourceAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourceAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrphanedResourceAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.GracePeriod)
+	copy(dAtA[i:], m.GracePeriod)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GracePeriod)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OrphanedResourceKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10741,6 +12395,87 @@ func (m *OrphanedResourceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OrphanedResourceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrphanedResourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x4a
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	{
+		size, err := m.ScheduledAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.OrphanedSince.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OrphanedResourcesMonitorSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10761,6 +12496,20 @@ func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Ignore) > 0 {
 		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -11591,6 +13340,54 @@ func (m *PullRequestGeneratorGithub) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueuedOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QueuedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RefTarget) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12719,6 +14516,24 @@ func (m *ResourceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ProgressingTimeout)
+	copy(dAtA[i:], m.ProgressingTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProgressingTimeout)))
+	i--
+	dAtA[i] = 0x4a
+	i--
+	if m.HealthKStatus {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.HealthCEL)
+	copy(dAtA[i:], m.HealthCEL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthCEL)))
+	i--
+	dAtA[i] = 0x3a
 	{
 		size, err := m.IgnoreResourceUpdates.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -12847,6 +14662,14 @@ func (m *ResourceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Replaced {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
 	i -= len(m.SyncPhase)
 	copy(dAtA[i:], m.SyncPhase)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
@@ -12984,6 +14807,55 @@ func (m *ResourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i--
+	if m.Transient {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Attempt))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13004,6 +14876,11 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0x1a
 	if m.Backoff != nil {
 		{
 			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
@@ -13042,6 +14919,30 @@ func (m *RevisionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommittedAt != nil {
+		{
+			size, err := m.CommittedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.HealthyAt != nil {
+		{
+			size, err := m.HealthyAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
 		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -13175,6 +15076,61 @@ func (m *RevisionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RollbackStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.RolledBackAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.HistoryID))
+	i--
+	dAtA[i] = 0x18
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SCMProviderGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13885,6 +15841,64 @@ func (m *SignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StatusTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransitionedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.To)
+	copy(dAtA[i:], m.To)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.To)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.From)
+	copy(dAtA[i:], m.From)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.From)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13905,6 +15919,11 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x72
 	i -= len(m.ChangeRevision)
 	copy(dAtA[i:], m.ChangeRevision)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChangeRevision)))
@@ -14085,6 +16104,40 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CurrentWaveStartedAt != nil {
+		{
+			size, err := m.CurrentWaveStartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentWave))
+	i--
+	dAtA[i] = 0x48
+	i -= len(m.CurrentWavePhase)
+	copy(dAtA[i:], m.CurrentWavePhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentWavePhase)))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -14152,6 +16205,151 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Failures[iNdEx])
+			copy(dAtA[i:], m.Failures[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Failures[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncPlanResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPlanResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPlanResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Failures[iNdEx])
+			copy(dAtA[i:], m.Failures[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Failures[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x5a
+	i -= len(m.Patch)
+	copy(dAtA[i:], m.Patch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Patch)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.HookType)
+	copy(dAtA[i:], m.HookType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HookType)))
+	i--
+	dAtA[i] = 0x4a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncWave))
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.SyncPhase)
+	copy(dAtA[i:], m.SyncPhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14172,6 +16370,35 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OperationQueue != nil {
+		{
+			size, err := m.OperationQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SyncWaves != nil {
+		{
+			size, err := m.SyncWaves.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x2a
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -14240,6 +16467,18 @@ func (m *SyncPolicyAutomated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rollback != nil {
+		{
+			size, err := m.Rollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i--
 	if m.AllowEmpty {
 		dAtA[i] = 1
@@ -14430,6 +16669,70 @@ func (m *SyncStrategyHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWaveResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWaveResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWaveResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Healthy))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Applied))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Resources))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Wave))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWavesStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWavesStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWavesStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.HealthThreshold))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxConcurrency))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14729,6 +17032,18 @@ func (m *AppProjectSpec) Size() (n int) {
 		}
 	}
 	n += 2
+	if len(m.ManifestPolicies) > 0 {
+		for _, e := range m.ManifestPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DestinationServiceAccounts) > 0 {
+		for _, e := range m.DestinationServiceAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -14801,6 +17116,42 @@ func (m *ApplicationDestination) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDestinationServiceAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DefaultServiceAccount)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationDestinationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Destination.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Health.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ApplicationList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15565,6 +17916,18 @@ func (m *ApplicationSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.ProgressingTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.DestinationRollout != nil {
+		l = m.DestinationRollout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15622,6 +17985,32 @@ func (m *ApplicationStatus) Size() (n int) {
 	}
 	l = len(m.ControllerNamespace)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Rollback != nil {
+		l = m.Rollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Maintenance != nil {
+		l = m.Maintenance.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.OperationQueue) > 0 {
+		for _, e := range m.OperationQueue {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.OrphanedResources) > 0 {
+		for _, e := range m.OrphanedResources {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -15686,6 +18075,19 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	return n
 }
 
+func (m *AutomatedRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Window)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ProgressingTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15699,6 +18101,9 @@ func (m *Backoff) Size() (n int) {
 	}
 	l = len(m.MaxDuration)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Jitter != nil {
+		n += 1 + sovGenerated(uint64(*m.Jitter))
+	}
 	return n
 }
 
@@ -15948,6 +18353,12 @@ func (m *ComparedTo) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16001,6 +18412,112 @@ func (m *ConnectionState) Size() (n int) {
 	return n
 }
 
+func (m *ContainerLogs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Logs)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *DORAMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Window)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Deployments))
+	if m.LeadTimeSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.LeadTimeSeconds))
+	}
+	n += 1 + sovGenerated(uint64(m.FailedDeployments))
+	if m.TimeToRestoreSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeToRestoreSeconds))
+	}
+	return n
+}
+
+func (m *DestinationOperationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Destination.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SyncResult != nil {
+		l = m.SyncResult.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *DestinationRollout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.BatchSize))
+	return n
+}
+
+func (m *DriftRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.DetectedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ResolvedAt != nil {
+		l = m.ResolvedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Manager)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *DuckTypeGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16191,6 +18708,10 @@ func (m *HealthStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16236,6 +18757,39 @@ func (m *HelmParameter) Size() (n int) {
 	return n
 }
 
+func (m *HookLogs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HookType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SyncPhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.OperationStartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PodName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.CapturedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HostInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16466,6 +19020,23 @@ func (m *ListGenerator) Size() (n int) {
 	return n
 }
 
+func (m *MaintenanceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StartedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ExpiresAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ManagedNamespaceMetadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16491,6 +19062,31 @@ func (m *ManagedNamespaceMetadata) Size() (n int) {
 	return n
 }
 
+func (m *ManifestPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Kinds) > 0 {
+		for _, e := range m.Kinds {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Severity)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *MatrixGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16602,6 +19198,16 @@ func (m *OperationInitiator) Size() (n int) {
 	return n
 }
 
+func (m *OperationQueuePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxLength))
+	return n
+}
+
 func (m *OperationState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16625,6 +19231,18 @@ func (m *OperationState) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 1 + sovGenerated(uint64(m.RetryCount))
+	if len(m.RetryAttempts) > 0 {
+		for _, e := range m.RetryAttempts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16660,6 +19278,26 @@ func (m *OptionalMap) Size() (n int) {
 	return n
 }
 
+func (m *OrphanedResourceAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GracePeriod)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *OrphanedResourceKey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16675,6 +19313,32 @@ func (m *OrphanedResourceKey) Size() (n int) {
 	return n
 }
 
+func (m *OrphanedResourceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.OrphanedSince.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ScheduledAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16690,6 +19354,12 @@ func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17009,6 +19679,21 @@ func (m *PullRequestGeneratorGithub) Size() (n int) {
 	return n
 }
 
+func (m *QueuedOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Operation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.QueuedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RefTarget) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17434,6 +20119,11 @@ func (m *ResourceOverride) Size() (n int) {
 	n += 2
 	l = m.IgnoreResourceUpdates.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthCEL)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.ProgressingTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17484,6 +20174,7 @@ func (m *ResourceResult) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.SyncPhase)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -17515,6 +20206,21 @@ func (m *ResourceStatus) Size() (n int) {
 	return n
 }
 
+func (m *RetryAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Attempt))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17526,6 +20232,8 @@ func (m *RetryStrategy) Size() (n int) {
 		l = m.Backoff.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17560,6 +20268,14 @@ func (m *RevisionHistory) Size() (n int) {
 	}
 	l = m.InitiatedBy.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.HealthyAt != nil {
+		l = m.HealthyAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CommittedAt != nil {
+		l = m.CommittedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17586,6 +20302,28 @@ func (m *RevisionMetadata) Size() (n int) {
 	return n
 }
 
+func (m *RollbackStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.HistoryID))
+	l = m.RolledBackAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SCMProviderGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17841,6 +20579,27 @@ func (m *SignatureKey) Size() (n int) {
 	return n
 }
 
+func (m *StatusTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.From)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.To)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TransitionedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SyncOperation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17897,6 +20656,8 @@ func (m *SyncOperation) Size() (n int) {
 	}
 	l = len(m.ChangeRevision)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17949,6 +20710,84 @@ func (m *SyncOperationResult) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.CurrentWavePhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.CurrentWave))
+	if m.CurrentWaveStartedAt != nil {
+		l = m.CurrentWaveStartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Failures) > 0 {
+		for _, s := range m.Failures {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncPlanResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SyncPhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SyncWave))
+	l = len(m.HookType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Patch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Failures) > 0 {
+		for _, s := range m.Failures {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17976,6 +20815,16 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SyncWaves != nil {
+		l = m.SyncWaves.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OperationQueue != nil {
+		l = m.OperationQueue.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17988,6 +20837,10 @@ func (m *SyncPolicyAutomated) Size() (n int) {
 	n += 2
 	n += 2
 	n += 2
+	if m.Rollback != nil {
+		l = m.Rollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18050,6 +20903,30 @@ func (m *SyncStrategyHook) Size() (n int) {
 	return n
 }
 
+func (m *SyncWaveResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Wave))
+	n += 1 + sovGenerated(uint64(m.Resources))
+	n += 1 + sovGenerated(uint64(m.Applied))
+	n += 1 + sovGenerated(uint64(m.Healthy))
+	return n
+}
+
+func (m *SyncWavesStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxConcurrency))
+	n += 1 + sovGenerated(uint64(m.HealthThreshold))
+	return n
+}
+
 func (m *SyncWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18213,6 +21090,16 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForClusterResourceBlacklist += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForClusterResourceBlacklist += "}"
+	repeatedStringForManifestPolicies := "[]ManifestPolicy{"
+	for _, f := range this.ManifestPolicies {
+		repeatedStringForManifestPolicies += strings.Replace(strings.Replace(f.String(), "ManifestPolicy", "ManifestPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForManifestPolicies += "}"
+	repeatedStringForDestinationServiceAccounts := "[]ApplicationDestinationServiceAccount{"
+	for _, f := range this.DestinationServiceAccounts {
+		repeatedStringForDestinationServiceAccounts += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDestinationServiceAccounts += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -18227,6 +21114,8 @@ func (this *AppProjectSpec) String() string {
 		`ClusterResourceBlacklist:` + repeatedStringForClusterResourceBlacklist + `,`,
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
+		`ManifestPolicies:` + repeatedStringForManifestPolicies + `,`,
+		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18288,6 +21177,36 @@ func (this *ApplicationDestination) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDestinationServiceAccount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDestinationServiceAccount{`,
+		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`DefaultServiceAccount:` + fmt.Sprintf("%v", this.DefaultServiceAccount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationDestinationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResources := "[]ResourceStatus{"
+	for _, f := range this.Resources {
+		repeatedStringForResources += strings.Replace(strings.Replace(f.String(), "ResourceStatus", "ResourceStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResources += "}"
+	s := strings.Join([]string{`&ApplicationDestinationStatus{`,
+		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Health:` + strings.Replace(strings.Replace(this.Health.String(), "HealthStatus", "HealthStatus", 1), `&`, ``, 1) + `,`,
+		`Resources:` + repeatedStringForResources + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationList) String() string {
 	if this == nil {
 		return "nil"
@@ -18810,6 +21729,11 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForDestinations := "[]ApplicationDestination{"
+	for _, f := range this.Destinations {
+		repeatedStringForDestinations += strings.Replace(strings.Replace(f.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDestinations += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -18819,6 +21743,9 @@ func (this *ApplicationSpec) String() string {
 		`Info:` + repeatedStringForInfo + `,`,
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
+		`ProgressingTimeout:` + fmt.Sprintf("%v", this.ProgressingTimeout) + `,`,
+		`Destinations:` + repeatedStringForDestinations + `,`,
+		`DestinationRollout:` + strings.Replace(this.DestinationRollout.String(), "DestinationRollout", "DestinationRollout", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18842,6 +21769,21 @@ func (this *ApplicationStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "ApplicationCondition", "ApplicationCondition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForOperationQueue := "[]QueuedOperation{"
+	for _, f := range this.OperationQueue {
+		repeatedStringForOperationQueue += strings.Replace(strings.Replace(f.String(), "QueuedOperation", "QueuedOperation", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOperationQueue += "}"
+	repeatedStringForOrphanedResources := "[]OrphanedResourceStatus{"
+	for _, f := range this.OrphanedResources {
+		repeatedStringForOrphanedResources += strings.Replace(strings.Replace(f.String(), "OrphanedResourceStatus", "OrphanedResourceStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOrphanedResources += "}"
+	repeatedStringForDestinations := "[]ApplicationDestinationStatus{"
+	for _, f := range this.Destinations {
+		repeatedStringForDestinations += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationStatus", "ApplicationDestinationStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDestinations += "}"
 	s := strings.Join([]string{`&ApplicationStatus{`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`Sync:` + strings.Replace(strings.Replace(this.Sync.String(), "SyncStatus", "SyncStatus", 1), `&`, ``, 1) + `,`,
@@ -18856,6 +21798,11 @@ func (this *ApplicationStatus) String() string {
 		`ResourceHealthSource:` + fmt.Sprintf("%v", this.ResourceHealthSource) + `,`,
 		`SourceTypes:` + fmt.Sprintf("%v", this.SourceTypes) + `,`,
 		`ControllerNamespace:` + fmt.Sprintf("%v", this.ControllerNamespace) + `,`,
+		`Rollback:` + strings.Replace(this.Rollback.String(), "RollbackStatus", "RollbackStatus", 1) + `,`,
+		`Maintenance:` + strings.Replace(this.Maintenance.String(), "MaintenanceStatus", "MaintenanceStatus", 1) + `,`,
+		`OperationQueue:` + repeatedStringForOperationQueue + `,`,
+		`OrphanedResources:` + repeatedStringForOrphanedResources + `,`,
+		`Destinations:` + repeatedStringForDestinations + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18909,6 +21856,17 @@ func (this *ApplicationWatchEvent) String() string {
 	}, "")
 	return s
 }
+func (this *AutomatedRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutomatedRollback{`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`ProgressingTimeout:` + fmt.Sprintf("%v", this.ProgressingTimeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Backoff) String() string {
 	if this == nil {
 		return "nil"
@@ -18917,6 +21875,7 @@ func (this *Backoff) String() string {
 		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
 		`Factor:` + valueToStringGenerated(this.Factor) + `,`,
 		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`Jitter:` + valueToStringGenerated(this.Jitter) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19102,11 +22061,17 @@ func (this *ComparedTo) String() string {
 		repeatedStringForIgnoreDifferences += strings.Replace(strings.Replace(f.String(), "ResourceIgnoreDifferences", "ResourceIgnoreDifferences", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForIgnoreDifferences += "}"
+	repeatedStringForDestinations := "[]ApplicationDestination{"
+	for _, f := range this.Destinations {
+		repeatedStringForDestinations += strings.Replace(strings.Replace(f.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDestinations += "}"
 	s := strings.Join([]string{`&ComparedTo{`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`IgnoreDifferences:` + repeatedStringForIgnoreDifferences + `,`,
+		`Destinations:` + repeatedStringForDestinations + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19148,6 +22113,77 @@ func (this *ConnectionState) String() string {
 	}, "")
 	return s
 }
+func (this *ContainerLogs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerLogs{`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`Logs:` + fmt.Sprintf("%v", this.Logs) + `,`,
+		`Truncated:` + fmt.Sprintf("%v", this.Truncated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DORAMetrics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DORAMetrics{`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`Deployments:` + fmt.Sprintf("%v", this.Deployments) + `,`,
+		`LeadTimeSeconds:` + valueToStringGenerated(this.LeadTimeSeconds) + `,`,
+		`FailedDeployments:` + fmt.Sprintf("%v", this.FailedDeployments) + `,`,
+		`TimeToRestoreSeconds:` + valueToStringGenerated(this.TimeToRestoreSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DestinationOperationState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DestinationOperationState{`,
+		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`SyncResult:` + strings.Replace(this.SyncResult.String(), "SyncOperationResult", "SyncOperationResult", 1) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DestinationRollout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DestinationRollout{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DriftRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DriftRecord{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`DetectedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DetectedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ResolvedAt:` + strings.Replace(fmt.Sprintf("%v", this.ResolvedAt), "Time", "v1.Time", 1) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`Manager:` + fmt.Sprintf("%v", this.Manager) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DuckTypeGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -19313,6 +22349,7 @@ func (this *HealthStatus) String() string {
 	s := strings.Join([]string{`&HealthStatus{`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19350,6 +22387,30 @@ func (this *HelmParameter) String() string {
 	}, "")
 	return s
 }
+func (this *HookLogs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainers := "[]ContainerLogs{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(strings.Replace(f.String(), "ContainerLogs", "ContainerLogs", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForContainers += "}"
+	s := strings.Join([]string{`&HookLogs{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
+		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
+		`OperationStartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OperationStartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`CapturedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CapturedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HostInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -19551,6 +22612,19 @@ func (this *ListGenerator) String() string {
 	}, "")
 	return s
 }
+func (this *MaintenanceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceStatus{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`StartedBy:` + fmt.Sprintf("%v", this.StartedBy) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ManagedNamespaceMetadata) String() string {
 	if this == nil {
 		return "nil"
@@ -19582,6 +22656,26 @@ func (this *ManagedNamespaceMetadata) String() string {
 	}, "")
 	return s
 }
+func (this *ManifestPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForKinds := "[]GroupKind{"
+	for _, f := range this.Kinds {
+		repeatedStringForKinds += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForKinds += "}"
+	s := strings.Join([]string{`&ManifestPolicy{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Kinds:` + repeatedStringForKinds + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Severity:` + fmt.Sprintf("%v", this.Severity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MatrixGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -19675,10 +22769,30 @@ func (this *OperationInitiator) String() string {
 	}, "")
 	return s
 }
+func (this *OperationQueuePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationQueuePolicy{`,
+		`MaxLength:` + fmt.Sprintf("%v", this.MaxLength) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OperationState) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRetryAttempts := "[]RetryAttempt{"
+	for _, f := range this.RetryAttempts {
+		repeatedStringForRetryAttempts += strings.Replace(strings.Replace(f.String(), "RetryAttempt", "RetryAttempt", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRetryAttempts += "}"
+	repeatedStringForDestinations := "[]DestinationOperationState{"
+	for _, f := range this.Destinations {
+		repeatedStringForDestinations += strings.Replace(strings.Replace(f.String(), "DestinationOperationState", "DestinationOperationState", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDestinations += "}"
 	s := strings.Join([]string{`&OperationState{`,
 		`Operation:` + strings.Replace(strings.Replace(this.Operation.String(), "Operation", "Operation", 1), `&`, ``, 1) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
@@ -19687,6 +22801,8 @@ func (this *OperationState) String() string {
 		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
 		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`RetryAttempts:` + repeatedStringForRetryAttempts + `,`,
+		`Destinations:` + repeatedStringForDestinations + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19721,6 +22837,21 @@ func (this *OptionalMap) String() string {
 	}, "")
 	return s
 }
+func (this *OrphanedResourceAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResourceAction{`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`GracePeriod:` + fmt.Sprintf("%v", this.GracePeriod) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OrphanedResourceKey) String() string {
 	if this == nil {
 		return "nil"
@@ -19733,6 +22864,24 @@ func (this *OrphanedResourceKey) String() string {
 	}, "")
 	return s
 }
+func (this *OrphanedResourceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResourceStatus{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`OrphanedSince:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrphanedSince), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ScheduledAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ScheduledAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OrphanedResourcesMonitorSettings) String() string {
 	if this == nil {
 		return "nil"
@@ -19742,9 +22891,15 @@ func (this *OrphanedResourcesMonitorSettings) String() string {
 		repeatedStringForIgnore += strings.Replace(strings.Replace(f.String(), "OrphanedResourceKey", "OrphanedResourceKey", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForIgnore += "}"
+	repeatedStringForActions := "[]OrphanedResourceAction{"
+	for _, f := range this.Actions {
+		repeatedStringForActions += strings.Replace(strings.Replace(f.String(), "OrphanedResourceAction", "OrphanedResourceAction", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForActions += "}"
 	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
 		`Warn:` + valueToStringGenerated(this.Warn) + `,`,
 		`Ignore:` + repeatedStringForIgnore + `,`,
+		`Actions:` + repeatedStringForActions + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19954,6 +23109,18 @@ func (this *PullRequestGeneratorGithub) String() string {
 	}, "")
 	return s
 }
+func (this *QueuedOperation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueuedOperation{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Operation:` + strings.Replace(strings.Replace(this.Operation.String(), "Operation", "Operation", 1), `&`, ``, 1) + `,`,
+		`QueuedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.QueuedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RefTarget) String() string {
 	if this == nil {
 		return "nil"
@@ -20288,6 +23455,9 @@ func (this *ResourceOverride) String() string {
 		`KnownTypeFields:` + repeatedStringForKnownTypeFields + `,`,
 		`UseOpenLibs:` + fmt.Sprintf("%v", this.UseOpenLibs) + `,`,
 		`IgnoreResourceUpdates:` + strings.Replace(strings.Replace(this.IgnoreResourceUpdates.String(), "OverrideIgnoreDiff", "OverrideIgnoreDiff", 1), `&`, ``, 1) + `,`,
+		`HealthCEL:` + fmt.Sprintf("%v", this.HealthCEL) + `,`,
+		`HealthKStatus:` + fmt.Sprintf("%v", this.HealthKStatus) + `,`,
+		`ProgressingTimeout:` + fmt.Sprintf("%v", this.ProgressingTimeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20322,6 +23492,7 @@ func (this *ResourceResult) String() string {
 		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
 		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
 		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
+		`Replaced:` + fmt.Sprintf("%v", this.Replaced) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20345,6 +23516,19 @@ func (this *ResourceStatus) String() string {
 	}, "")
 	return s
 }
+func (this *RetryAttempt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryAttempt{`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Transient:` + fmt.Sprintf("%v", this.Transient) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -20352,6 +23536,7 @@ func (this *RetryStrategy) String() string {
 	s := strings.Join([]string{`&RetryStrategy{`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20374,6 +23559,8 @@ func (this *RevisionHistory) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`HealthyAt:` + strings.Replace(fmt.Sprintf("%v", this.HealthyAt), "Time", "v1.Time", 1) + `,`,
+		`CommittedAt:` + strings.Replace(fmt.Sprintf("%v", this.CommittedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20392,6 +23579,20 @@ func (this *RevisionMetadata) String() string {
 	}, "")
 	return s
 }
+func (this *RollbackStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollbackStatus{`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`HistoryID:` + fmt.Sprintf("%v", this.HistoryID) + `,`,
+		`RolledBackAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RolledBackAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SCMProviderGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -20566,6 +23767,21 @@ func (this *SignatureKey) String() string {
 	}, "")
 	return s
 }
+func (this *StatusTransition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatusTransition{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`TransitionedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TransitionedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncOperation) String() string {
 	if this == nil {
 		return "nil"
@@ -20593,6 +23809,7 @@ func (this *SyncOperation) String() string {
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`ChangeRevisions:` + fmt.Sprintf("%v", this.ChangeRevisions) + `,`,
 		`ChangeRevision:` + fmt.Sprintf("%v", this.ChangeRevision) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20624,6 +23841,11 @@ func (this *SyncOperationResult) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForWaves := "[]SyncWaveResult{"
+	for _, f := range this.Waves {
+		repeatedStringForWaves += strings.Replace(strings.Replace(f.String(), "SyncWaveResult", "SyncWaveResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaves += "}"
 	s := strings.Join([]string{`&SyncOperationResult{`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
@@ -20631,6 +23853,49 @@ func (this *SyncOperationResult) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Waves:` + repeatedStringForWaves + `,`,
+		`CurrentWavePhase:` + fmt.Sprintf("%v", this.CurrentWavePhase) + `,`,
+		`CurrentWave:` + fmt.Sprintf("%v", this.CurrentWave) + `,`,
+		`CurrentWaveStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.CurrentWaveStartedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPlan) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResources := "[]SyncPlanResource{"
+	for _, f := range this.Resources {
+		repeatedStringForResources += strings.Replace(strings.Replace(f.String(), "SyncPlanResource", "SyncPlanResource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResources += "}"
+	s := strings.Join([]string{`&SyncPlan{`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`Resources:` + repeatedStringForResources + `,`,
+		`Failures:` + fmt.Sprintf("%v", this.Failures) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPlanResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPlanResource{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
+		`SyncWave:` + fmt.Sprintf("%v", this.SyncWave) + `,`,
+		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
+		`Patch:` + fmt.Sprintf("%v", this.Patch) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Failures:` + fmt.Sprintf("%v", this.Failures) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20644,6 +23909,9 @@ func (this *SyncPolicy) String() string {
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`SyncWaves:` + strings.Replace(this.SyncWaves.String(), "SyncWavesStrategy", "SyncWavesStrategy", 1) + `,`,
+		`OperationQueue:` + strings.Replace(this.OperationQueue.String(), "OperationQueuePolicy", "OperationQueuePolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20656,6 +23924,7 @@ func (this *SyncPolicyAutomated) String() string {
 		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
 		`SelfHeal:` + fmt.Sprintf("%v", this.SelfHeal) + `,`,
 		`AllowEmpty:` + fmt.Sprintf("%v", this.AllowEmpty) + `,`,
+		`Rollback:` + strings.Replace(this.Rollback.String(), "AutomatedRollback", "AutomatedRollback", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20704,6 +23973,30 @@ func (this *SyncStrategyHook) String() string {
 	}, "")
 	return s
 }
+func (this *SyncWaveResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWaveResult{`,
+		`Wave:` + fmt.Sprintf("%v", this.Wave) + `,`,
+		`Resources:` + fmt.Sprintf("%v", this.Resources) + `,`,
+		`Applied:` + fmt.Sprintf("%v", this.Applied) + `,`,
+		`Healthy:` + fmt.Sprintf("%v", this.Healthy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWavesStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWavesStrategy{`,
+		`MaxConcurrency:` + fmt.Sprintf("%v", this.MaxConcurrency) + `,`,
+		`HealthThreshold:` + fmt.Sprintf("%v", this.HealthThreshold) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -21619,6 +24912,74 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.PermitOnlyProjectScopedClusters = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestPolicies = append(m.ManifestPolicies, ManifestPolicy{})
+			if err := m.ManifestPolicies[len(m.ManifestPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationServiceAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationServiceAccounts = append(m.DestinationServiceAccounts, ApplicationDestinationServiceAccount{})
+			if err := m.DestinationServiceAccounts[len(m.DestinationServiceAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22300,7 +25661,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22323,17 +25684,17 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22343,30 +25704,29 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22376,24 +25736,353 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDestinationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDestinationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDestinationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -28550,6 +32239,108 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressingTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressingTimeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, ApplicationDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRollout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DestinationRollout == nil {
+				m.DestinationRollout = &DestinationRollout{}
+			}
+			if err := m.DestinationRollout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29037,6 +32828,180 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rollback == nil {
+				m.Rollback = &RollbackStatus{}
+			}
+			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Maintenance == nil {
+				m.Maintenance = &MaintenanceStatus{}
+			}
+			if err := m.Maintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationQueue = append(m.OperationQueue, QueuedOperation{})
+			if err := m.OperationQueue[len(m.OperationQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrphanedResources = append(m.OrphanedResources, OrphanedResourceStatus{})
+			if err := m.OrphanedResources[len(m.OrphanedResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, ApplicationDestinationStatus{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29439,7 +33404,121 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *AutomatedRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutomatedRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutomatedRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressingTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressingTimeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29552,6 +33631,26 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			}
 			m.MaxDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jitter = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31825,93 +35924,11 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Component = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31921,55 +35938,25 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Destinations = append(m.Destinations, ApplicationDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31992,7 +35979,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32015,15 +36002,15 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32051,13 +36038,13 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Component = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32067,33 +36054,29 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Init == nil {
-				m.Init = &Command{}
-			}
-			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32103,45 +36086,24 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.LockRepo = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32163,7 +36125,7 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32186,15 +36148,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32222,13 +36184,13 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32238,27 +36200,31 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Init == nil {
+				m.Init = &Command{}
+			}
+			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32285,13 +36251,30 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ModifiedAt == nil {
-				m.ModifiedAt = &v1.Time{}
-			}
-			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LockRepo = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32313,7 +36296,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32336,15 +36319,15 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32372,11 +36355,11 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32404,31 +36387,11 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequeueAfterSeconds = &v
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32455,170 +36418,13 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32640,7 +36446,7 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvEntry) Unmarshal(dAtA []byte) error {
+func (m *ContainerLogs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32663,15 +36469,15 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerLogs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerLogs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32699,11 +36505,11 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Container = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32731,8 +36537,28 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Logs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32754,7 +36580,7 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ErrApplicationNotAllowedToUseProject) Unmarshal(dAtA []byte) error {
+func (m *DORAMetrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32777,12 +36603,122 @@ func (m *ErrApplicationNotAllowedToUseProject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ErrApplicationNotAllowedToUseProject: wiretype end group for non-group")
+			return fmt.Errorf("proto: DORAMetrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ErrApplicationNotAllowedToUseProject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DORAMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployments", wireType)
+			}
+			m.Deployments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deployments |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeadTimeSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeadTimeSeconds = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeployments", wireType)
+			}
+			m.FailedDeployments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedDeployments |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToRestoreSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeToRestoreSeconds = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32804,7 +36740,7 @@ func (m *ErrApplicationNotAllowedToUseProject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
+func (m *DestinationOperationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32827,15 +36763,48 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DestinationOperationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DestinationOperationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32863,11 +36832,11 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32895,11 +36864,11 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32926,109 +36895,18 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Env[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33038,29 +36916,33 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33070,23 +36952,27 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallHint = string(dAtA[iNdEx:postIndex])
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33109,7 +36995,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *DestinationRollout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33132,15 +37018,15 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: DestinationRollout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DestinationRollout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33168,13 +37054,13 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Strategy = DestinationRolloutStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
 			}
-			var v int
+			m.BatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33184,12 +37070,11 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BatchSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33211,7 +37096,7 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *DriftRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33234,15 +37119,15 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: DriftRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DriftRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33270,61 +37155,107 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GitGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33352,11 +37283,11 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33383,14 +37314,13 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
-			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DetectedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33417,14 +37347,16 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Files = append(m.Files, GitFileGeneratorItem{})
-			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ResolvedAt == nil {
+				m.ResolvedAt = &v1.Time{}
+			}
+			if err := m.ResolvedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33452,9 +37384,175 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
@@ -33474,9 +37572,9 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequeueAfterSeconds = &v
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33503,15 +37601,15 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathParamPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33521,25 +37619,26 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathParamPrefix = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
@@ -33687,7 +37786,7 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
+func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33710,15 +37809,15 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33746,11 +37845,11 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33778,136 +37877,58 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trust = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrApplicationNotAllowedToUseProject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.KeyData = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrApplicationNotAllowedToUseProject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrApplicationNotAllowedToUseProject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33929,7 +37950,7 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
+func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33952,17 +37973,17 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33972,30 +37993,29 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Command = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34005,79 +38025,154 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, GnuPGPublicKey{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Env == nil {
+				m.Env = make(map[string]string)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34105,11 +38200,11 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34137,7 +38232,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.InstallHint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34160,7 +38255,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
+func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34183,15 +38278,15 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34219,13 +38314,13 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34235,24 +38330,12 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34274,7 +38357,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmOptions) Unmarshal(dAtA []byte) error {
+func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34297,15 +38380,15 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34333,7 +38416,7 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34356,7 +38439,7 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34379,15 +38462,15 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34415,13 +38498,13 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34431,29 +38514,31 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
+			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34463,65 +38548,29 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceString = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Files = append(m.Files, GitFileGeneratorItem{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34549,13 +38598,13 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34565,29 +38614,15 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			m.RequeueAfterSeconds = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34614,10 +38649,169 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathParamPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathParamPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34639,7 +38833,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34662,15 +38856,15 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34698,13 +38892,13 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
-			m.RequestedByApp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34714,16 +38908,29 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestedByApp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.RequestedByNeighbors = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34733,16 +38940,29 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestedByNeighbors |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
 			}
-			m.Capacity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34752,11 +38972,88 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34778,7 +39075,7 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34801,17 +39098,17 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34821,29 +39118,30 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34853,23 +39151,25 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, GnuPGPublicKey{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34892,7 +39192,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34915,15 +39215,15 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34951,11 +39251,11 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34983,7 +39283,43 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35006,7 +39342,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35029,17 +39365,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35049,33 +39385,27 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35103,7 +39433,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35126,7 +39456,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35149,17 +39479,17 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35169,25 +39499,23 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35210,7 +39538,7 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35233,10 +39561,10 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -35305,7 +39633,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -35322,7 +39650,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Code = bool(v != 0)
+			m.ForceString = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35344,7 +39672,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *HookLogs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35367,15 +39695,15 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: HookLogs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HookLogs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35403,11 +39731,11 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35435,61 +39763,75 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35517,11 +39859,11 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35549,11 +39891,44 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationStartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperationStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35581,7 +39956,74 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, ContainerLogs{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CapturedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35604,7 +40046,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35627,15 +40069,15 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35663,13 +40105,13 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35679,29 +40121,31 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetNamespace", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35711,12 +40155,25 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SetNamespace = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35738,7 +40195,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
+func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35761,15 +40218,15 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35797,13 +40254,13 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
 			}
-			var stringLen uint64
+			m.RequestedByApp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35813,29 +40270,16 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestedByApp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
 			}
-			var msglen int
+			m.RequestedByNeighbors = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35845,33 +40289,16 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RequestedByNeighbors |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Target == nil {
-				m.Target = &KustomizeSelector{}
-			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			var msglen int
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35881,107 +40308,11 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Capacity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Options == nil {
-				m.Options = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Options[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36003,7 +40334,7 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36026,10 +40357,10 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -36066,9 +40397,9 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36078,24 +40409,23 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36118,7 +40448,7 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36141,46 +40471,13 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -36212,9 +40509,9 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36242,7 +40539,7 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36265,7 +40562,7 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36288,17 +40585,17 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var msglen int
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36308,30 +40605,16 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IssuedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36341,27 +40624,14 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36389,7 +40659,7 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36412,7 +40682,7 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListGenerator) Unmarshal(dAtA []byte) error {
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36435,15 +40705,15 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36470,76 +40740,11 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Elements = append(m.Elements, v11.JSON{})
-			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36561,7 +40766,7 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36584,17 +40789,17 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36604,124 +40809,29 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36731,119 +40841,44 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36865,7 +40900,7 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36888,17 +40923,17 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36908,31 +40943,29 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36942,24 +40975,23 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36982,7 +41014,7 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37005,17 +41037,17 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37025,29 +41057,27 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37075,13 +41105,13 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37091,24 +41121,23 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37131,7 +41160,7 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37154,17 +41183,17 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37174,81 +41203,29 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37258,31 +41235,29 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetNamespace", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37292,24 +41267,12 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.SetNamespace = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37331,7 +41294,7 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37354,17 +41317,17 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37374,33 +41337,29 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
-			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37410,28 +41369,27 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37458,14 +41416,16 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, &Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Target == nil {
+				m.Target = &KustomizeSelector{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37492,9 +41452,91 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Options == nil {
+				m.Options = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Options[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37517,7 +41559,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
+func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37540,15 +41582,15 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37576,13 +41618,13 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37592,12 +41634,25 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Automated = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37619,7 +41674,7 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationState) Unmarshal(dAtA []byte) error {
+func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37642,15 +41697,15 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37677,13 +41732,13 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37711,11 +41766,11 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37743,11 +41798,61 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37774,16 +41879,127 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
-			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37810,13 +42026,14 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Elements = append(m.Elements, v11.JSON{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37843,18 +42060,15 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
 			}
-			m.RetryCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37864,11 +42078,24 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RetryCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37890,7 +42117,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OptionalArray) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37913,15 +42140,15 @@ func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalArray: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalArray: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37949,7 +42176,105 @@ func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37972,7 +42297,7 @@ func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OptionalMap) Unmarshal(dAtA []byte) error {
+func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37995,15 +42320,15 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38030,8 +42355,8 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Map == nil {
-				m.Map = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -38126,30 +42451,157 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Map[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
+func (m *ManifestPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38172,15 +42624,15 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38208,11 +42660,11 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38240,11 +42692,45 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kinds = append(m.Kinds, v1.GroupKind{})
+			if err := m.Kinds[len(m.Kinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38272,7 +42758,71 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severity = ManifestPolicySeverity(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38295,7 +42845,7 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
+func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38318,17 +42868,17 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38338,16 +42888,29 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Warn = &b
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38374,8 +42937,7 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
-			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -38400,7 +42962,7 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
+func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38423,17 +42985,17 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38443,27 +43005,29 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38491,13 +43055,13 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38507,23 +43071,24 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38546,7 +43111,7 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
+func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38569,17 +43134,17 @@ func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginConfigMapRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginConfigMapRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38589,23 +43154,25 @@ func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38628,7 +43195,7 @@ func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
+func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38651,15 +43218,15 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38686,13 +43253,96 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38719,15 +43369,18 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38737,15 +43390,28 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequeueAfterSeconds = &v
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38772,13 +43438,14 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Info = append(m.Info, &Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38805,103 +43472,9 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38924,7 +43497,7 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginInput) Unmarshal(dAtA []byte) error {
+func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38947,17 +43520,17 @@ func (m *PluginInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38967,121 +43540,44 @@ func (m *PluginInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parameters == nil {
-				m.Parameters = make(PluginParameters)
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
-			var mapkey string
-			mapvalue := &v11.JSON{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v11.JSON{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Parameters[mapkey] = *mapvalue
-			iNdEx = postIndex
+			m.Automated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39103,7 +43599,7 @@ func (m *PluginInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+func (m *OperationQueuePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39126,147 +43622,17 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationQueuePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationQueuePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JWTTokens = append(m.JWTTokens, JWTToken{})
-			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLength", wireType)
 			}
-			var stringLen uint64
+			m.MaxLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39276,24 +43642,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxLength |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39315,7 +43668,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
+func (m *OperationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39338,15 +43691,15 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39373,18 +43726,15 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Github == nil {
-				m.Github = &PullRequestGeneratorGithub{}
-			}
-			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitLab", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39394,33 +43744,29 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GitLab == nil {
-				m.GitLab = &PullRequestGeneratorGitLab{}
-			}
-			if err := m.GitLab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39430,31 +43776,27 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitea == nil {
-				m.Gitea = &PullRequestGeneratorGitea{}
-			}
-			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39481,16 +43823,16 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BitbucketServer == nil {
-				m.BitbucketServer = &PullRequestGeneratorBitbucketServer{}
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
 			}
-			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39517,34 +43859,13 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, PullRequestGeneratorFilter{})
-			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequeueAfterSeconds = &v
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39571,13 +43892,35 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAttempts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39604,16 +43947,14 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Bitbucket == nil {
-				m.Bitbucket = &PullRequestGeneratorBitbucket{}
-			}
-			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RetryAttempts = append(m.RetryAttempts, RetryAttempt{})
+			if err := m.RetryAttempts[len(m.RetryAttempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39640,10 +43981,8 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AzureDevOps == nil {
-				m.AzureDevOps = &PullRequestGeneratorAzureDevOps{}
-			}
-			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Destinations = append(m.Destinations, DestinationOperationState{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39668,7 +44007,7 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
+func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39691,15 +44030,15 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: wiretype end group for non-group")
+			return fmt.Errorf("proto: OptionalArray: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OptionalArray: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39727,107 +44066,61 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Project = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptionalMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39854,44 +44147,103 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			if m.Map == nil {
+				m.Map = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.Map[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39914,7 +44266,7 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourceAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39937,15 +44289,15 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourceAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39973,11 +44325,11 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Action = OrphanedResourceActionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40005,11 +44357,11 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40037,13 +44389,13 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40053,33 +44405,29 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
-			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40089,28 +44437,44 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BearerToken == nil {
-				m.BearerToken = &BearerTokenBitbucketCloud{}
+			m.GracePeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40132,7 +44496,7 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40155,15 +44519,15 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40191,11 +44555,11 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40223,11 +44587,11 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40255,43 +44619,7 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
-			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40314,7 +44642,7 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40337,15 +44665,15 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40373,12 +44701,11 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BranchMatch = &s
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40406,62 +44733,11 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TargetBranchMatch = &s
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitLab: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitLab: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40489,11 +44765,11 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40521,13 +44797,13 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40537,33 +44813,29 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = OrphanedResourceActionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedSince", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40573,29 +44845,30 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			if err := m.OrphanedSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40605,27 +44878,28 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullRequestState = string(dAtA[iNdEx:postIndex])
+			if err := m.ScheduledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -40642,7 +44916,39 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
+			m.DryRun = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40664,7 +44970,7 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40687,17 +44993,17 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitea: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40707,29 +45013,18 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Warn = &b
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40739,59 +45034,29 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40818,33 +45083,11 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Actions = append(m.Actions, OrphanedResourceAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40866,7 +45109,7 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
+func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40889,15 +45132,15 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorGithub: wiretype end group for non-group")
+			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40925,11 +45168,11 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40957,111 +45200,11 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AppSecretName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41089,7 +45232,7 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41112,7 +45255,7 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefTarget) Unmarshal(dAtA []byte) error {
+func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41135,80 +45278,15 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefTarget: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginConfigMapRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginConfigMapRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41236,7 +45314,7 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chart = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41259,7 +45337,7 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoCreds) Unmarshal(dAtA []byte) error {
+func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41282,17 +45360,17 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41302,29 +45380,30 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41334,29 +45413,30 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41366,29 +45446,17 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.RequeueAfterSeconds = &v
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41398,29 +45466,30 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41430,129 +45499,351 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+			if m.Values == nil {
+				m.Values = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppPrivateKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
+			if m.Parameters == nil {
+				m.Parameters = make(PluginParameters)
 			}
-			m.GithubAppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &v11.JSON{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GithubAppId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.JSON{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
+			m.Parameters[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GithubAppInstallationId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GithubAppInstallationId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 10:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41580,31 +45871,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableOCI = bool(v != 0)
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41632,11 +45903,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41664,13 +45935,13 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 19:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41680,29 +45951,31 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proxy = string(dAtA[iNdEx:postIndex])
+			m.JWTTokens = append(m.JWTTokens, JWTToken{})
+			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41712,12 +45985,24 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceHttpBasicAuth = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41739,7 +46024,7 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41762,15 +46047,15 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoCredsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoCredsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41797,13 +46082,16 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Github == nil {
+				m.Github = &PullRequestGeneratorGithub{}
+			}
+			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitLab", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41830,66 +46118,18 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepoCreds{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.GitLab == nil {
+				m.GitLab = &PullRequestGeneratorGitLab{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.GitLab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Repository) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41899,29 +46139,33 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.Gitea == nil {
+				m.Gitea = &PullRequestGeneratorGitea{}
+			}
+			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41931,29 +46175,33 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.BitbucketServer == nil {
+				m.BitbucketServer = &PullRequestGeneratorBitbucketServer{}
+			}
+			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41963,29 +46211,31 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Filters = append(m.Filters, PullRequestGeneratorFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41995,27 +46245,15 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.RequeueAfterSeconds = &v
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42042,15 +46280,15 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42060,17 +46298,33 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bitbucket == nil {
+				m.Bitbucket = &PullRequestGeneratorBitbucket{}
+			}
+			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42080,131 +46334,81 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableLFS = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+			if m.AzureDevOps == nil {
+				m.AzureDevOps = &PullRequestGeneratorAzureDevOps{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42232,51 +46436,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InheritedCreds", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InheritedCreds = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableOCI = bool(v != 0)
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42304,49 +46468,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
-			}
-			m.GithubAppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GithubAppId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
-			}
-			m.GithubAppInstallationId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GithubAppInstallationId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 18:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42374,11 +46500,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42406,13 +46532,13 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proxy = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42422,27 +46548,31 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 21:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42470,28 +46600,8 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForceHttpBasicAuth = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42513,7 +46623,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42536,15 +46646,15 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42572,11 +46682,11 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerName = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42604,11 +46714,11 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertType = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42636,13 +46746,13 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertSubType = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42652,31 +46762,33 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertData == nil {
-				m.CertData = []byte{}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42686,23 +46798,27 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertInfo = string(dAtA[iNdEx:postIndex])
+			if m.BearerToken == nil {
+				m.BearerToken = &BearerTokenBitbucketCloud{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42725,7 +46841,7 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42748,17 +46864,17 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42768,28 +46884,91 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42816,8 +46995,10 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepositoryCertificate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -42842,7 +47023,7 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42865,17 +47046,17 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42885,30 +47066,30 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BranchMatch = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42918,25 +47099,24 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Repository{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TargetBranchMatch = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42959,7 +47139,7 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42982,15 +47162,15 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorGitLab: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorGitLab: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43018,13 +47198,13 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43034,31 +47214,29 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, ResourceActionParam{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43068,15 +47246,31 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Disabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43104,11 +47298,11 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IconClass = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43136,8 +47330,28 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.PullRequestState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -43159,7 +47373,7 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43182,15 +47396,15 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorGitea: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43218,11 +47432,11 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43250,8 +47464,96 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -43273,7 +47575,7 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43296,15 +47598,15 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorGithub: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43332,11 +47634,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43364,11 +47666,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43396,13 +47698,13 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43412,77 +47714,31 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Default = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43510,13 +47766,13 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			m.AppSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43526,25 +47782,23 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43567,7 +47821,7 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+func (m *QueuedOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43590,15 +47844,15 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueuedOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueuedOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43626,13 +47880,13 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43642,29 +47896,30 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43674,29 +47929,80 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.QueuedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43706,27 +48012,28 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43754,11 +48061,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43786,11 +48093,61 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
+			m.Chart = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoCreds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43818,31 +48175,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Diff = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Hook = bool(v != 0)
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43870,11 +48207,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43902,11 +48239,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43934,13 +48271,13 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43950,65 +48287,27 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Modified = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44036,11 +48335,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppPrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44068,13 +48367,13 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
 			}
-			var stringLen uint64
+			m.GithubAppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44084,27 +48383,33 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GithubAppId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.GithubAppInstallationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GithubAppInstallationId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44132,11 +48437,31 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableOCI = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44164,11 +48489,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44196,11 +48521,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44228,8 +48553,28 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
+			m.Proxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceHttpBasicAuth = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44251,7 +48596,7 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44274,15 +48619,15 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoCredsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoCredsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44309,107 +48654,13 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetLabels == nil {
-				m.TargetLabels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TargetLabels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44436,22 +48687,3144 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
-			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, RepoCreds{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Repository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableLFS = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InheritedCreds = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableOCI = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppPrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
+			}
+			m.GithubAppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GithubAppId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
+			}
+			m.GithubAppInstallationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GithubAppInstallationId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceHttpBasicAuth = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertSubType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertData == nil {
+				m.CertData = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, RepositoryCertificate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Repository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, ResourceActionParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IconClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Default = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hook = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Modified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetLabels == nil {
+				m.TargetLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TargetLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
+			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = append(m.Ingress, v12.LoadBalancerIngress{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
+			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, InfoItem{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetworkingInfo == nil {
+				m.NetworkingInfo = &ResourceNetworkingInfo{}
+			}
+			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
@@ -44566,11 +51939,339 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
+			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseOpenLibs = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44597,14 +52298,13 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, v12.LoadBalancerIngress{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCEL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44632,7 +52332,59 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			m.HealthCEL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthKStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HealthKStatus = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressingTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressingTimeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44655,7 +52407,7 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44678,17 +52430,17 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44698,30 +52450,29 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44731,31 +52482,29 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
-			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44765,31 +52514,29 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, InfoItem{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44799,31 +52546,27 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkingInfo == nil {
-				m.NetworkingInfo = &ResourceNetworkingInfo{}
-			}
-			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44851,11 +52594,11 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44883,13 +52626,63 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44899,33 +52692,61 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44935,33 +52756,61 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &v1.Time{}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44971,124 +52820,157 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45098,119 +52980,44 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replaced", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Replaced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45232,7 +53039,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45255,15 +53062,15 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45291,13 +53098,13 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45307,28 +53114,27 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45356,13 +53162,13 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45372,31 +53178,29 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
-			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45406,15 +53210,59 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseOpenLibs = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45441,10 +53289,72 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hook = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresPruning = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
+			}
+			m.SyncWave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncWave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45466,7 +53376,7 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceRef) Unmarshal(dAtA []byte) error {
+func (m *RetryAttempt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45489,17 +53399,17 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryAttempt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			var stringLen uint64
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45509,27 +53419,14 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Attempt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45557,13 +53454,13 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transient", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45573,29 +53470,17 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Transient = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45605,29 +53490,99 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45637,27 +53592,31 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45685,7 +53644,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = string(dAtA[iNdEx:postIndex])
+			m.Policy = RetryPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -45708,7 +53667,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceResult) Unmarshal(dAtA []byte) error {
+func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45731,47 +53690,15 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45799,13 +53726,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45815,29 +53742,49 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45847,29 +53794,30 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45879,29 +53827,33 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.DeployStartedAt == nil {
+				m.DeployStartedAt = &v1.Time{}
+			}
+			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45911,27 +53863,29 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45959,13 +53913,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45975,29 +53929,30 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46007,29 +53962,33 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			if m.HealthyAt == nil {
+				m.HealthyAt = &v1.Time{}
+			}
+			if err := m.HealthyAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46039,23 +53998,27 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			if m.CommittedAt == nil {
+				m.CommittedAt = &v1.Time{}
+			}
+			if err := m.CommittedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46078,7 +54041,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
+func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46101,15 +54064,15 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46137,13 +54100,13 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46153,27 +54116,28 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46201,11 +54165,11 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46233,11 +54197,11 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureInfo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46265,11 +54229,61 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46297,13 +54311,13 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46313,33 +54327,29 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryID", wireType)
 			}
-			var v int
+			m.HistoryID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46349,17 +54359,16 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.HistoryID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hook = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBackAt", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46369,17 +54378,30 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiresPruning = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RolledBackAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SyncWave = 0
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46389,11 +54411,24 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SyncWave |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46415,7 +54450,7 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46438,17 +54473,17 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46458,14 +54493,31 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Github == nil {
+				m.Github = &SCMProviderGeneratorGithub{}
+			}
+			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46492,68 +54544,18 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
+			if m.Gitlab == nil {
+				m.Gitlab = &SCMProviderGeneratorGitlab{}
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Gitlab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46563,27 +54565,31 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.Bitbucket == nil {
+				m.Bitbucket = &SCMProviderGeneratorBitbucket{}
+			}
+			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46610,15 +54616,18 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BitbucketServer == nil {
+				m.BitbucketServer = &SCMProviderGeneratorBitbucketServer{}
+			}
+			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46628,14 +54637,31 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gitea == nil {
+				m.Gitea = &SCMProviderGeneratorGitea{}
+			}
+			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46662,13 +54688,16 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AzureDevOps == nil {
+				m.AzureDevOps = &SCMProviderGeneratorAzureDevOps{}
+			}
+			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46695,16 +54724,66 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeployStartedAt == nil {
-				m.DeployStartedAt = &v1.Time{}
-			}
-			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filters = append(m.Filters, SCMProviderGeneratorFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloneProtocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CloneProtocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46731,16 +54810,15 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46750,27 +54828,122 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSCodeCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46797,7 +54970,10 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AWSCodeCommit == nil {
+				m.AWSCodeCommit = &SCMProviderGeneratorAWSCodeCommit{}
+			}
+			if err := m.AWSCodeCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -46822,7 +54998,7 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46845,47 +55021,15 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Author = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagFilters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46912,13 +55056,14 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TagFilters = append(m.TagFilters, &TagFilter{})
+			if err := m.TagFilters[len(m.TagFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46946,11 +55091,11 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46978,13 +55123,13 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureInfo", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46994,24 +55139,12 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47033,7 +55166,7 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47056,17 +55189,81 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamProject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47076,31 +55273,27 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Github == nil {
-				m.Github = &SCMProviderGeneratorGithub{}
-			}
-			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TeamProject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47127,18 +55320,18 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitlab == nil {
-				m.Gitlab = &SCMProviderGeneratorGitlab{}
+			if m.AccessTokenRef == nil {
+				m.AccessTokenRef = &SecretRef{}
 			}
-			if err := m.Gitlab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47148,33 +55341,67 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.AllBranches = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Bitbucket == nil {
-				m.Bitbucket = &SCMProviderGeneratorBitbucket{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47184,33 +55411,29 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BitbucketServer == nil {
-				m.BitbucketServer = &SCMProviderGeneratorBitbucketServer{}
-			}
-			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47220,31 +55443,27 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitea == nil {
-				m.Gitea = &SCMProviderGeneratorGitea{}
-			}
-			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47271,18 +55490,18 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AzureDevOps == nil {
-				m.AzureDevOps = &SCMProviderGeneratorAzureDevOps{}
+			if m.AppPasswordRef == nil {
+				m.AppPasswordRef = &SecretRef{}
 			}
-			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47292,29 +55511,65 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.AllBranches = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, SCMProviderGeneratorFilter{})
-			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloneProtocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47342,33 +55597,13 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CloneProtocol = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequeueAfterSeconds = &v
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47378,28 +55613,27 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47426,109 +55660,18 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSCodeCommit", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47538,28 +55681,12 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AWSCodeCommit == nil {
-				m.AWSCodeCommit = &SCMProviderGeneratorAWSCodeCommit{}
-			}
-			if err := m.AWSCodeCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47581,7 +55708,7 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47604,17 +55731,17 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TagFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47624,29 +55751,28 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TagFilters = append(m.TagFilters, &TagFilter{})
-			if err := m.TagFilters[len(m.TagFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RepositoryMatch = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47674,11 +55800,11 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47706,13 +55832,13 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47722,12 +55848,58 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.LabelMatch = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BranchMatch = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47749,7 +55921,7 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47772,15 +55944,15 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 5:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47808,9 +55980,9 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
@@ -47840,43 +56012,11 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamProject", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TeamProject = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47903,14 +56043,14 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessTokenRef == nil {
-				m.AccessTokenRef = &SecretRef{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -47930,6 +56070,26 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllBranches = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47951,7 +56111,7 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47974,15 +56134,15 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48010,11 +56170,11 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48042,11 +56202,11 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48073,14 +56233,46 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AppPasswordRef == nil {
-				m.AppPasswordRef = &SecretRef{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -48121,7 +56313,7 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48144,15 +56336,15 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48180,9 +56372,29 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSubgroups = bool(v != 0)
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
@@ -48214,9 +56426,9 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			}
 			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48243,14 +56455,14 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -48270,6 +56482,79 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllBranches = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.IncludeSharedProjects = &b
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48291,7 +56576,7 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
+func (m *SecretRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48314,15 +56599,15 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48350,12 +56635,11 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RepositoryMatch = &s
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48383,76 +56667,61 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.LabelMatch = &s
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48480,8 +56749,7 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BranchMatch = &s
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48504,7 +56772,7 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
+func (m *StatusTransition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48527,15 +56795,15 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusTransition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusTransition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48563,11 +56831,11 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Type = StatusTransitionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48595,13 +56863,13 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48611,33 +56879,29 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48647,17 +56911,29 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48667,12 +56943,57 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransitionedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransitionedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48694,7 +57015,7 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
+func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48717,15 +57038,15 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48753,13 +57074,13 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48769,27 +57090,35 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.Prune = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.DryRun = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48816,18 +57145,18 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48837,29 +57166,31 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48869,65 +57200,31 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48955,31 +57252,11 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeSubgroups = bool(v != 0)
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49007,11 +57284,11 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49038,18 +57315,16 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49059,17 +57334,29 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRevisions", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49079,17 +57366,29 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeRevisions = append(m.ChangeRevisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRevision", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49099,16 +57398,27 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.IncludeSharedProjects = &b
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49136,7 +57446,7 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49159,7 +57469,7 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretRef) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49182,15 +57492,15 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49218,11 +57528,11 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretName = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49250,61 +57560,43 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignatureKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49332,7 +57624,7 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49355,7 +57647,7 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49378,17 +57670,17 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49398,29 +57690,31 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49430,17 +57724,29 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49450,15 +57756,28 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49485,16 +57804,46 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
-			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49521,14 +57870,16 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49555,16 +57906,14 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Waves = append(m.Waves, SyncWaveResult{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWavePhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49592,13 +57941,32 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			m.CurrentWavePhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWave", wireType)
+			}
+			m.CurrentWave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentWave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWaveStartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49608,29 +57976,83 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			if m.CurrentWaveStartedAt == nil {
+				m.CurrentWaveStartedAt = &v1.Time{}
+			}
+			if err := m.CurrentWaveStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49640,27 +58062,25 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
@@ -49692,11 +58112,11 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRevisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49706,27 +58126,29 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChangeRevisions = append(m.ChangeRevisions, string(dAtA[iNdEx:postIndex]))
+			m.Resources = append(m.Resources, SyncPlanResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49754,7 +58176,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChangeRevision = string(dAtA[iNdEx:postIndex])
+			m.Failures = append(m.Failures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49777,7 +58199,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncPlanResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49800,10 +58222,10 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPlanResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPlanResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -49840,7 +58262,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49868,11 +58290,11 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49900,7 +58322,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -49934,61 +58356,43 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49998,29 +58402,27 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = SyncPlanAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50048,13 +58450,32 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
+			}
+			m.SyncWave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncWave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50064,30 +58485,29 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50097,29 +58517,27 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50147,13 +58565,13 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50163,27 +58581,23 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Failures = append(m.Failures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50368,10 +58782,114 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWaves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncWaves == nil {
+				m.SyncWaves = &SyncWavesStrategy{}
 			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncWaves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationQueue == nil {
+				m.OperationQueue = &OperationQueuePolicy{}
+			}
+			if err := m.OperationQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -50485,6 +59003,42 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowEmpty = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rollback == nil {
+				m.Rollback = &AutomatedRollback{}
+			}
+			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50960,6 +59514,220 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncWaveResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWaveResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWaveResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wave", wireType)
+			}
+			m.Wave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			m.Resources = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resources |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			m.Applied = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Applied |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			m.Healthy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Healthy |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWavesStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWavesStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWavesStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrency", wireType)
+			}
+			m.MaxConcurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConcurrency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthThreshold", wireType)
+			}
+			m.HealthThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HealthThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,7 +3,9 @@ package v1alpha1
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"math"
+	"math/rand"
 	"net"
 	"net/http"
 	"net/url"
@@ -39,6 +41,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/util/collections"
 	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	utilhttp "github.com/argoproj/argo-cd/v2/util/http"
 	"github.com/argoproj/argo-cd/v2/util/security"
@@ -66,7 +69,7 @@ type ApplicationSpec struct {
 	// Source is a reference to the location of the application's manifests or chart
 	Source *ApplicationSource `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	// Destination is a reference to the target Kubernetes server and namespace
-	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
+	Destination ApplicationDestination `json:"destination,omitempty" protobuf:"bytes,2,name=destination"`
 	// Project is a reference to the project this application belongs to.
 	// The empty string means that application belongs to the 'default' project.
 	Project string `json:"project" protobuf:"bytes,3,name=project"`
@@ -85,6 +88,26 @@ type ApplicationSpec struct {
 
 	// Sources is a reference to the location of the application's manifests or chart
 	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+
+	// ProgressingTimeout is the maximum amount of time a resource of the application may remain Progressing, after
+	// which it is reported Degraded. Takes precedence over the progressing timeouts of the resource customizations.
+	// Resources may remain Progressing forever if not set.
+	ProgressingTimeout string `json:"progressingTimeout,omitempty" protobuf:"bytes,9,opt,name=progressingTimeout"`
+
+	// Destinations is a list of target Kubernetes servers and namespaces the application is deployed to.
+	// Destination is ignored if Destinations is set.
+	Destinations ApplicationDestinations `json:"destinations,omitempty" protobuf:"bytes,10,rep,name=destinations"`
+	// DestinationRollout controls the order in which a sync operation is performed on the destinations
+	DestinationRollout *DestinationRollout `json:"destinationRollout,omitempty" protobuf:"bytes,11,opt,name=destinationRollout"`
+}
+
+// GetProgressingTimeout returns the maximum amount of time a resource of the application may remain Progressing.
+// Returns zero if not set.
+func (spec *ApplicationSpec) GetProgressingTimeout() (time.Duration, error) {
+	if spec.ProgressingTimeout == "" {
+		return 0, nil
+	}
+	return ParseDuration(spec.ProgressingTimeout)
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -237,6 +260,20 @@ func (a *ApplicationSpec) HasMultipleSources() bool {
 	return a.Sources != nil && len(a.Sources) > 0
 }
 
+// HasMultipleDestinations returns whether the application is deployed to the list of destinations of its
+// Destinations field instead of its Destination field
+func (a *ApplicationSpec) HasMultipleDestinations() bool {
+	return len(a.Destinations) > 0
+}
+
+// GetDestinations returns the destinations the application is deployed to
+func (a *ApplicationSpec) GetDestinations() ApplicationDestinations {
+	if a.HasMultipleDestinations() {
+		return a.Destinations
+	}
+	return ApplicationDestinations{a.Destination}
+}
+
 func (a *ApplicationSpec) GetSourcePtrByPosition(sourcePosition int) *ApplicationSource {
 	// if Application has multiple sources, return the first source in sources
 	return a.GetSourcePtrByIndex(sourcePosition - 1)
@@ -942,6 +979,86 @@ type ApplicationDestination struct {
 	isServerInferred bool `json:"-"`
 }
 
+// ApplicationDestinations contains list of the destinations of an application
+type ApplicationDestinations []ApplicationDestination
+
+// Equals compares two instances of ApplicationDestinations and returns true if instances are equal.
+func (d ApplicationDestinations) Equals(other ApplicationDestinations) bool {
+	if len(d) != len(other) {
+		return false
+	}
+	for i := range d {
+		if !d[i].Equals(other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DestinationRolloutStrategy is the order in which a sync operation is performed on the destinations of an application
+type DestinationRolloutStrategy string
+
+const (
+	// DestinationRolloutStrategyParallel syncs all the destinations at once
+	DestinationRolloutStrategyParallel DestinationRolloutStrategy = "Parallel"
+	// DestinationRolloutStrategySequential syncs the destinations one after the other
+	DestinationRolloutStrategySequential DestinationRolloutStrategy = "Sequential"
+	// DestinationRolloutStrategyBatches syncs the destinations in batches of BatchSize destinations
+	DestinationRolloutStrategyBatches DestinationRolloutStrategy = "Batches"
+)
+
+// DestinationRollout controls the order in which a sync operation is performed on the destinations of an application.
+// The destinations are synced in batches, and the sync of a batch only starts once the sync of the previous batch
+// succeeded and all its destinations are Healthy.
+type DestinationRollout struct {
+	// Strategy is the order in which the destinations are synced: Parallel (default), Sequential or Batches
+	Strategy DestinationRolloutStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=DestinationRolloutStrategy"`
+	// BatchSize is the number of destinations synced at once by the Batches strategy
+	BatchSize int64 `json:"batchSize,omitempty" protobuf:"bytes,2,opt,name=batchSize"`
+}
+
+// Validate checks that the rollout strategy is known and that batches have a size
+func (r *DestinationRollout) Validate() error {
+	if r == nil {
+		return nil
+	}
+	switch r.Strategy {
+	case "", DestinationRolloutStrategyParallel, DestinationRolloutStrategySequential:
+	case DestinationRolloutStrategyBatches:
+		if r.BatchSize <= 0 {
+			return fmt.Errorf("destination rollout batch size must be greater than zero")
+		}
+	default:
+		return fmt.Errorf("unknown destination rollout strategy '%s'", r.Strategy)
+	}
+	return nil
+}
+
+// Batches returns the indexes of the given number of destinations grouped in the batches in which they are synced
+func (r *DestinationRollout) Batches(count int) [][]int {
+	size := count
+	if r != nil {
+		switch r.Strategy {
+		case DestinationRolloutStrategySequential:
+			size = 1
+		case DestinationRolloutStrategyBatches:
+			size = int(r.BatchSize)
+		}
+	}
+	if size <= 0 {
+		size = 1
+	}
+	var batches [][]int
+	for start := 0; start < count; start += size {
+		var batch []int
+		for i := start; i < count && i < start+size; i++ {
+			batch = append(batch, i)
+		}
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
 type ResourceHealthLocation string
 
 var (
@@ -978,6 +1095,65 @@ type ApplicationStatus struct {
 	SourceTypes []ApplicationSourceType `json:"sourceTypes,omitempty" protobuf:"bytes,12,opt,name=sourceTypes"`
 	// ControllerNamespace indicates the namespace in which the application controller is located
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
+	// Rollback contains information about the most recent automated rollback of the application
+	Rollback *RollbackStatus `json:"rollback,omitempty" protobuf:"bytes,14,opt,name=rollback"`
+	// Maintenance contains information about the maintenance mode of the application, during which automated sync is suspended
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty" protobuf:"bytes,15,opt,name=maintenance"`
+	// OperationQueue holds the operations to run once the operation in progress completes, in order
+	OperationQueue []QueuedOperation `json:"operationQueue,omitempty" protobuf:"bytes,16,rep,name=operationQueue"`
+	// OrphanedResources holds the actions pending for the orphaned resources of the application
+	OrphanedResources []OrphanedResourceStatus `json:"orphanedResources,omitempty" protobuf:"bytes,17,rep,name=orphanedResources"`
+	// Destinations holds the sync status, health and resources of each destination of an application with multiple destinations
+	Destinations []ApplicationDestinationStatus `json:"destinations,omitempty" protobuf:"bytes,18,rep,name=destinations"`
+}
+
+// QueuedOperation is an operation waiting for the operation in progress to complete
+type QueuedOperation struct {
+	// ID identifies the queued operation
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Operation is the queued operation
+	Operation Operation `json:"operation" protobuf:"bytes,2,opt,name=operation"`
+	// QueuedAt holds the time the operation was queued
+	QueuedAt metav1.Time `json:"queuedAt" protobuf:"bytes,3,opt,name=queuedAt"`
+}
+
+// MaintenanceStatus contains information about the maintenance mode of an application
+type MaintenanceStatus struct {
+	// Reason describes why the application was put in maintenance mode
+	Reason string `json:"reason,omitempty" protobuf:"bytes,1,opt,name=reason"`
+	// StartedBy is the user who put the application in maintenance mode
+	StartedBy string `json:"startedBy,omitempty" protobuf:"bytes,2,opt,name=startedBy"`
+	// StartedAt holds the time the maintenance mode was enabled
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,3,opt,name=startedAt"`
+	// ExpiresAt holds the time the maintenance mode ends automatically
+	ExpiresAt metav1.Time `json:"expiresAt" protobuf:"bytes,4,opt,name=expiresAt"`
+}
+
+// IsActive returns true if the maintenance mode is enabled and has not expired at the given time
+func (m *MaintenanceStatus) IsActive(now time.Time) bool {
+	return m != nil && now.Before(m.ExpiresAt.Time)
+}
+
+// RollbackStatus contains information about an automated rollback of an application
+type RollbackStatus struct {
+	// Revision is the revision the application was rolled back from. Automated sync is suspended for this revision.
+	Revision string `json:"revision,omitempty" protobuf:"bytes,1,opt,name=revision"`
+	// Revisions holds the revisions of each source in sources field the application was rolled back from
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,2,opt,name=revisions"`
+	// HistoryID is the ID of the revision history entry the application was rolled back to
+	HistoryID int64 `json:"historyID" protobuf:"bytes,3,opt,name=historyID"`
+	// RolledBackAt holds the time the rollback was initiated
+	RolledBackAt metav1.Time `json:"rolledBackAt" protobuf:"bytes,4,opt,name=rolledBackAt"`
+	// Message describes why the application was rolled back
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// Matches returns true if the given revision(s) are the ones the application was rolled back from
+func (r *RollbackStatus) Matches(revision string, revisions []string, hasMultipleSources bool) bool {
+	if hasMultipleSources {
+		return reflect.DeepEqual(r.Revisions, revisions)
+	}
+	return r.Revision == revision
 }
 
 // GetRevisions will return the current revision associated with the Application.
@@ -1001,6 +1177,10 @@ func (app *Application) BuildComparedToStatus() ComparedTo {
 		Destination:       app.Spec.Destination,
 		IgnoreDifferences: app.Spec.IgnoreDifferences,
 	}
+	if app.Spec.HasMultipleDestinations() {
+		ct.Destination = ApplicationDestination{}
+		ct.Destinations = app.Spec.Destinations
+	}
 	if app.Spec.HasMultipleSources() {
 		ct.Sources = app.Spec.Sources
 	} else {
@@ -1034,6 +1214,11 @@ type Operation struct {
 	Retry RetryStrategy `json:"retry,omitempty" protobuf:"bytes,4,opt,name=retry"`
 }
 
+// IsEquivalent returns true if the given operation performs the same sync as this one, regardless of who initiated it
+func (o *Operation) IsEquivalent(other *Operation) bool {
+	return reflect.DeepEqual(o.Sync, other.Sync) && reflect.DeepEqual(o.Retry, other.Retry)
+}
+
 // DryRun returns true if an operation was requested to be performed in dry run mode
 func (o *Operation) DryRun() bool {
 	if o.Sync != nil {
@@ -1060,6 +1245,17 @@ func (in RevisionHistories) LastRevisionHistory() RevisionHistory {
 	return in[len(in)-1]
 }
 
+// LastHealthyRevisionHistory returns the most recent history item, other than the latest one, which was observed healthy.
+// Returns nil if there is no such history item.
+func (in RevisionHistories) LastHealthyRevisionHistory() *RevisionHistory {
+	for i := len(in) - 2; i >= 0; i-- {
+		if in[i].HealthyAt != nil {
+			return &in[i]
+		}
+	}
+	return nil
+}
+
 // Trunc truncates the list of history items to size n
 func (in RevisionHistories) Trunc(n int) RevisionHistories {
 	i := len(in) - n
@@ -1116,6 +1312,9 @@ type SyncOperation struct {
 	Revisions       []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	ChangeRevisions []string `json:"changeRevisions,omitempty" protobuf:"bytes,12,opt,name=changeRevisions"`
 	ChangeRevision  string   `json:"changeRevision,omitempty" protobuf:"bytes,13,opt,name=changeRevision"`
+	// Timeout is the maximum amount of time each attempt of the sync may run before it is terminated and marked as Failed.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). Defaults to the timeout of the sync policy.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,14,opt,name=timeout"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1123,6 +1322,19 @@ func (o *SyncOperation) IsApplyStrategy() bool {
 	return o.SyncStrategy != nil && o.SyncStrategy.Apply != nil
 }
 
+// GetTimeout returns the maximum duration of each attempt of the sync, falling back to the timeout of the given
+// sync policy. Returns zero if sync attempts never time out.
+func (o *SyncOperation) GetTimeout(policy *SyncPolicy) (time.Duration, error) {
+	timeout := o.Timeout
+	if timeout == "" && policy != nil {
+		timeout = policy.Timeout
+	}
+	if timeout == "" {
+		return 0, nil
+	}
+	return ParseDuration(timeout)
+}
+
 // OperationState contains information about state of a running operation
 type OperationState struct {
 	// Operation is the original requested operation
@@ -1139,6 +1351,39 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// RetryAttempts holds the failures of the most recent attempts of the operation
+	RetryAttempts []RetryAttempt `json:"retryAttempts,omitempty" protobuf:"bytes,9,rep,name=retryAttempts"`
+	// Destinations holds the state of the operation on each destination of an application with multiple destinations
+	Destinations []DestinationOperationState `json:"destinations,omitempty" protobuf:"bytes,10,rep,name=destinations"`
+}
+
+// DestinationOperationState contains information about an operation on one of the destinations of an application
+// with multiple destinations
+type DestinationOperationState struct {
+	// Destination is the destination the operation is performed on
+	Destination ApplicationDestination `json:"destination" protobuf:"bytes,1,opt,name=destination"`
+	// Phase is the current phase of the operation on the destination. Empty until the operation on the destination started.
+	Phase synccommon.OperationPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase"`
+	// Message holds any pertinent messages when attempting to perform operation on the destination (typically errors).
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// SyncResult is the result of a Sync operation on the destination
+	SyncResult *SyncOperationResult `json:"syncResult,omitempty" protobuf:"bytes,4,opt,name=syncResult"`
+	// StartedAt contains time of the start of the operation on the destination
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,5,opt,name=startedAt"`
+	// FinishedAt contains time of the completion of the operation on the destination
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,6,opt,name=finishedAt"`
+}
+
+// RetryAttempt contains information about a failed attempt of an operation
+type RetryAttempt struct {
+	// Attempt is the number of the attempt, starting at 0 for the initial attempt
+	Attempt int64 `json:"attempt" protobuf:"bytes,1,opt,name=attempt"`
+	// Message holds the error the attempt failed with
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// Transient indicates whether the attempt failed due to a transient error
+	Transient bool `json:"transient,omitempty" protobuf:"bytes,3,opt,name=transient"`
+	// FinishedAt contains time of attempt completion
+	FinishedAt metav1.Time `json:"finishedAt" protobuf:"bytes,4,opt,name=finishedAt"`
 }
 
 type Info struct {
@@ -1195,12 +1440,41 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Timeout is the maximum amount of time each attempt of a sync may run before it is terminated and marked as Failed.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). Syncs never time out if not set.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout"`
+	// SyncWaves controls how the resources of each sync wave are applied
+	SyncWaves *SyncWavesStrategy `json:"syncWaves,omitempty" protobuf:"bytes,6,opt,name=syncWaves"`
+	// OperationQueue enables the queuing of the sync requests received while an operation is in progress, which are
+	// otherwise rejected
+	OperationQueue *OperationQueuePolicy `json:"operationQueue,omitempty" protobuf:"bytes,7,opt,name=operationQueue"`
 	// If you add a field here, be sure to update IsZero.
 }
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.Timeout == "" && p.SyncWaves == nil && p.OperationQueue == nil)
+}
+
+// OperationQueuePolicy controls the queuing of the operations requested while an operation is in progress
+type OperationQueuePolicy struct {
+	// MaxLength is the maximum number of queued operations. Requests are rejected while the queue is full.
+	// +kubebuilder:validation:Minimum=1
+	MaxLength int64 `json:"maxLength" protobuf:"varint,1,opt,name=maxLength"`
+}
+
+// SyncWavesStrategy controls how the resources of each sync wave are applied
+type SyncWavesStrategy struct {
+	// MaxConcurrency is the maximum number of resources of a sync wave which are applied and not yet healthy at a time.
+	// All the resources of a wave are applied at once if not set.
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrency int64 `json:"maxConcurrency,omitempty" protobuf:"varint,1,opt,name=maxConcurrency"`
+	// HealthThreshold is the percentage of the resources of a sync wave which must be healthy before the sync proceeds
+	// with the next wave. The remaining resources of the wave are no longer waited for, unless they are degraded.
+	// All the resources of a wave must be healthy if not set.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	HealthThreshold int64 `json:"healthThreshold,omitempty" protobuf:"varint,2,opt,name=healthThreshold"`
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1209,9 +1483,28 @@ type RetryStrategy struct {
 	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
 	// Backoff controls how to backoff on subsequent retries of failed syncs
 	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff,casttype=Backoff"`
+	// Policy controls which failed syncs are retried. One of: Always, Transient (default: Always)
+	Policy RetryPolicy `json:"policy,omitempty" protobuf:"bytes,3,opt,name=policy,casttype=RetryPolicy"`
+}
+
+// RetryPolicy controls which failed syncs are retried
+type RetryPolicy string
+
+const (
+	// RetryPolicyAlways retries every failed sync
+	RetryPolicyAlways RetryPolicy = "Always"
+	// RetryPolicyTransient only retries syncs which failed due to transient errors, such as conflicts, timeouts or
+	// unavailable webhooks, and fails fast on any other error, such as a validation error
+	RetryPolicyTransient RetryPolicy = "Transient"
+)
+
+// ShouldRetry returns whether a failed sync should be retried according to the retry policy
+func (r *RetryStrategy) ShouldRetry(transient bool) bool {
+	return r.Policy != RetryPolicyTransient || transient
 }
 
-func parseStringToDuration(durationString string) (time.Duration, error) {
+// ParseDuration parses a duration set in seconds, if no unit is attached, or as a Go duration
+func ParseDuration(durationString string) (time.Duration, error) {
 	var suspendDuration time.Duration
 	// If no units are attached, treat as seconds
 	if val, err := strconv.Atoi(durationString); err == nil {
@@ -1224,20 +1517,22 @@ func parseStringToDuration(durationString string) (time.Duration, error) {
 	return suspendDuration, nil
 }
 
-// NextRetryAt calculates the earliest time the next retry should be performed on a failing sync
-func (r *RetryStrategy) NextRetryAt(lastAttempt time.Time, retryCounts int64) (time.Time, error) {
+// NextRetryAt calculates the earliest time the next retry should be performed on a failing sync. The jitter of the
+// backoff is derived from the given seed and the retry count, so that the retry time of an attempt is the same on every
+// call.
+func (r *RetryStrategy) NextRetryAt(lastAttempt time.Time, retryCounts int64, jitterSeed string) (time.Time, error) {
 	maxDuration := DefaultSyncRetryMaxDuration
 	duration := DefaultSyncRetryDuration
 	factor := DefaultSyncRetryFactor
 	var err error
 	if r.Backoff != nil {
 		if r.Backoff.Duration != "" {
-			if duration, err = parseStringToDuration(r.Backoff.Duration); err != nil {
+			if duration, err = ParseDuration(r.Backoff.Duration); err != nil {
 				return time.Time{}, err
 			}
 		}
 		if r.Backoff.MaxDuration != "" {
-			if maxDuration, err = parseStringToDuration(r.Backoff.MaxDuration); err != nil {
+			if maxDuration, err = ParseDuration(r.Backoff.MaxDuration); err != nil {
 				return time.Time{}, err
 			}
 		}
@@ -1252,6 +1547,14 @@ func (r *RetryStrategy) NextRetryAt(lastAttempt time.Time, retryCounts int64) (t
 	if maxDuration > 0 {
 		timeToWait = math.Min(float64(maxDuration), timeToWait)
 	}
+	// Jitter randomly reduces timeToWait by up to the given percentage, so that applications failing at the same
+	// time are not all retried at the same time.
+	if r.Backoff != nil && r.Backoff.Jitter != nil && *r.Backoff.Jitter > 0 {
+		jitter := math.Min(float64(*r.Backoff.Jitter), 100) / 100
+		hash := fnv.New64a()
+		_, _ = fmt.Fprintf(hash, "%s/%d", jitterSeed, retryCounts)
+		timeToWait -= timeToWait * jitter * rand.New(rand.NewSource(int64(hash.Sum64()))).Float64()
+	}
 	return lastAttempt.Add(time.Duration(timeToWait)), nil
 }
 
@@ -1263,6 +1566,8 @@ type Backoff struct {
 	Factor *int64 `json:"factor,omitempty" protobuf:"bytes,2,name=factor"`
 	// MaxDuration is the maximum amount of time allowed for the backoff strategy
 	MaxDuration string `json:"maxDuration,omitempty" protobuf:"bytes,3,opt,name=maxDuration"`
+	// Jitter is the maximum percentage (0-100) by which the backoff duration is randomly reduced
+	Jitter *int64 `json:"jitter,omitempty" protobuf:"bytes,4,opt,name=jitter"`
 }
 
 // SyncPolicyAutomated controls the behavior of an automated sync
@@ -1273,6 +1578,35 @@ type SyncPolicyAutomated struct {
 	SelfHeal bool `json:"selfHeal,omitempty" protobuf:"bytes,2,opt,name=selfHeal"`
 	// AllowEmpty allows apps have zero live resources (default: false)
 	AllowEmpty bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
+	// Rollback controls automatic rollback to the last healthy revision if the application health degrades after an automated sync
+	Rollback *AutomatedRollback `json:"rollback,omitempty" protobuf:"bytes,4,opt,name=rollback"`
+}
+
+// AutomatedRollback controls the rollback of an application whose health degrades after an automated sync
+type AutomatedRollback struct {
+	// Window is the amount of time after an automated sync completes during which a health degradation triggers a rollback.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). Defaults to 10m.
+	Window string `json:"window,omitempty" protobuf:"bytes,1,opt,name=window"`
+	// ProgressingTimeout is the maximum amount of time the application may stay Progressing after an automated sync before it is rolled back.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). A Progressing application is never rolled back if not set.
+	ProgressingTimeout string `json:"progressingTimeout,omitempty" protobuf:"bytes,2,opt,name=progressingTimeout"`
+}
+
+// GetWindow returns the duration after an automated sync during which a health degradation triggers a rollback
+func (r *AutomatedRollback) GetWindow() (time.Duration, error) {
+	if r.Window == "" {
+		return DefaultAutomatedRollbackWindow, nil
+	}
+	return ParseDuration(r.Window)
+}
+
+// GetProgressingTimeout returns the maximum duration an application may stay Progressing after an automated sync.
+// Returns zero if rollback of Progressing applications is disabled.
+func (r *AutomatedRollback) GetProgressingTimeout() (time.Duration, error) {
+	if r.ProgressingTimeout == "" {
+		return 0, nil
+	}
+	return ParseDuration(r.ProgressingTimeout)
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -1352,6 +1686,26 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// Waves holds the progress of each wave of the Sync phase
+	Waves []SyncWaveResult `json:"waves,omitempty" protobuf:"bytes,7,rep,name=waves"`
+	// CurrentWavePhase is the sync phase of the wave which was applied last
+	CurrentWavePhase synccommon.SyncPhase `json:"currentWavePhase,omitempty" protobuf:"bytes,8,opt,name=currentWavePhase"`
+	// CurrentWave is the sync wave which was applied last
+	CurrentWave int64 `json:"currentWave,omitempty" protobuf:"varint,9,opt,name=currentWave"`
+	// CurrentWaveStartedAt contains the time at which the wave which was applied last started to be applied
+	CurrentWaveStartedAt *metav1.Time `json:"currentWaveStartedAt,omitempty" protobuf:"bytes,10,opt,name=currentWaveStartedAt"`
+}
+
+// SyncWaveResult holds the progress of the resources of a sync wave
+type SyncWaveResult struct {
+	// Wave is the sync wave
+	Wave int64 `json:"wave" protobuf:"varint,1,opt,name=wave"`
+	// Resources is the number of resources of the wave
+	Resources int64 `json:"resources" protobuf:"varint,2,opt,name=resources"`
+	// Applied is the number of resources of the wave which have been applied
+	Applied int64 `json:"applied" protobuf:"varint,3,opt,name=applied"`
+	// Healthy is the number of resources of the wave which are healthy
+	Healthy int64 `json:"healthy" protobuf:"varint,4,opt,name=healthy"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1377,6 +1731,8 @@ type ResourceResult struct {
 	HookPhase synccommon.OperationPhase `json:"hookPhase,omitempty" protobuf:"bytes,9,opt,name=hookPhase"`
 	// SyncPhase indicates the particular phase of the sync that this result was acquired in
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
+	// Replaced is true if the resource was deleted and recreated because the update of its immutable fields was rejected
+	Replaced bool `json:"replaced,omitempty" protobuf:"varint,11,opt,name=replaced"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1429,6 +1785,11 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// HealthyAt holds the time the application was first observed Synced and Healthy at this revision
+	HealthyAt *metav1.Time `json:"healthyAt,omitempty" protobuf:"bytes,11,opt,name=healthyAt"`
+	// CommittedAt holds the commit date of the deployed git revision, or of the most recent one if the application has
+	// multiple sources
+	CommittedAt *metav1.Time `json:"committedAt,omitempty" protobuf:"bytes,12,opt,name=committedAt"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1480,6 +1841,10 @@ type ApplicationConditionType = string
 const (
 	// ApplicationConditionDeletionError indicates that controller failed to delete application
 	ApplicationConditionDeletionError = "DeletionError"
+	// ApplicationConditionDeletionProgress reports the progress of the cascaded deletion of the resources of the application
+	ApplicationConditionDeletionProgress = "DeletionProgress"
+	// ApplicationConditionPreDeleteError indicates that a PreDelete hook failed, which blocks the deletion of the application
+	ApplicationConditionPreDeleteError = "PreDeleteError"
 	// ApplicationConditionInvalidSpecError indicates that application source is invalid
 	ApplicationConditionInvalidSpecError = "InvalidSpecError"
 	// ApplicationConditionComparisonError indicates controller failed to compare application state
@@ -1496,6 +1861,14 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionRollbackWarning indicates that application was automatically rolled back after its health degraded
+	ApplicationConditionRollbackWarning = "RollbackWarning"
+	// ApplicationConditionPolicyViolationError indicates that a target resource violates a manifest policy of the project with Deny severity
+	ApplicationConditionPolicyViolationError = "PolicyViolationError"
+	// ApplicationConditionPolicyViolationWarning indicates that a target resource violates a manifest policy of the project with Warn severity
+	ApplicationConditionPolicyViolationWarning = "PolicyViolationWarning"
+	// ApplicationConditionMaintenanceWarning indicates that automated sync of the application is suspended by the maintenance mode
+	ApplicationConditionMaintenanceWarning = "MaintenanceWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -1518,6 +1891,8 @@ type ComparedTo struct {
 	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,3,opt,name=sources"`
 	// IgnoreDifferences is a reference to the application's ignored differences used for comparison
 	IgnoreDifferences IgnoreDifferences `json:"ignoreDifferences,omitempty" protobuf:"bytes,4,opt,name=ignoreDifferences"`
+	// Destinations is a reference to the application's multiple destinations used for comparison
+	Destinations ApplicationDestinations `json:"destinations,omitempty" protobuf:"bytes,5,rep,name=destinations"`
 }
 
 // SyncStatus contains information about the currently observed live and desired states of an application
@@ -1539,6 +1914,8 @@ type HealthStatus struct {
 	Status health.HealthStatusCode `json:"status,omitempty" protobuf:"bytes,1,opt,name=status"`
 	// Message is a human-readable informational message describing the health status
 	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// LastTransitionTime is the time the health status of the resource last changed
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
 }
 
 // InfoItem contains arbitrary, human readable information about an application
@@ -1793,6 +2170,185 @@ func (r *ResourceDiff) FullName() string {
 	return fmt.Sprintf("%s/%s/%s/%s", r.Group, r.Kind, r.Namespace, r.Name)
 }
 
+// DriftRecord is an episode during which a live resource, which was in sync with its target state, drifted from it
+type DriftRecord struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind      string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	Name      string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	// Revision is the revision the resource was synced to when the drift was detected
+	Revision string `json:"revision,omitempty" protobuf:"bytes,5,opt,name=revision"`
+	// DetectedAt is the time the drift was detected
+	DetectedAt metav1.Time `json:"detectedAt" protobuf:"bytes,6,opt,name=detectedAt"`
+	// ResolvedAt is the time the resource was found in sync again. Unset while the resource is still drifted
+	ResolvedAt *metav1.Time `json:"resolvedAt,omitempty" protobuf:"bytes,7,opt,name=resolvedAt"`
+	// Fields holds the paths of the live fields which differ from the target state
+	Fields []string `json:"fields,omitempty" protobuf:"bytes,8,rep,name=fields"`
+	// Manager is the field manager which last updated the live resource, e.g. kubectl-edit
+	Manager string `json:"manager,omitempty" protobuf:"bytes,9,opt,name=manager"`
+	// Deleted is true if the drift was caused by the deletion of the live resource
+	Deleted bool `json:"deleted,omitempty" protobuf:"bytes,10,opt,name=deleted"`
+}
+
+// FullName returns full name of the drifted resource in the format "group/kind/namespace/name"
+func (r *DriftRecord) FullName() string {
+	return fmt.Sprintf("%s/%s/%s/%s", r.Group, r.Kind, r.Namespace, r.Name)
+}
+
+// IsResolved returns true if the drifted resource was found in sync again
+func (r *DriftRecord) IsResolved() bool {
+	return r.ResolvedAt != nil
+}
+
+// DORAMetrics summarizes the deployments of an application, or of the applications of a project, which completed
+// within a rolling window
+type DORAMetrics struct {
+	// Window is the duration of the rolling window, e.g. 7d
+	Window string `json:"window" protobuf:"bytes,1,opt,name=window"`
+	// Deployments is the number of syncs which deployed a new revision
+	Deployments int64 `json:"deployments" protobuf:"varint,2,opt,name=deployments"`
+	// LeadTimeSeconds is the mean time between the commit of the deployed revisions and their deployment
+	LeadTimeSeconds *int64 `json:"leadTimeSeconds,omitempty" protobuf:"varint,3,opt,name=leadTimeSeconds"`
+	// FailedDeployments is the number of deployments after which the application did not become healthy
+	FailedDeployments int64 `json:"failedDeployments" protobuf:"varint,4,opt,name=failedDeployments"`
+	// TimeToRestoreSeconds is the mean time between a failed deployment and the application becoming healthy again
+	TimeToRestoreSeconds *int64 `json:"timeToRestoreSeconds,omitempty" protobuf:"varint,5,opt,name=timeToRestoreSeconds"`
+}
+
+// StatusTransitionType is the kind of application status which transitioned
+type StatusTransitionType string
+
+const (
+	// StatusTransitionHealth is a transition of the health status of an application
+	StatusTransitionHealth StatusTransitionType = "Health"
+	// StatusTransitionSync is a transition of the sync status of an application
+	StatusTransitionSync StatusTransitionType = "Sync"
+)
+
+// StatusTransition is a change of the health or sync status of an application, recorded in its status timeline
+type StatusTransition struct {
+	// Type is the kind of status which transitioned, either Health or Sync
+	Type StatusTransitionType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=StatusTransitionType"`
+	// From is the status before the transition, empty for the first status of the application
+	From string `json:"from,omitempty" protobuf:"bytes,2,opt,name=from"`
+	// To is the status after the transition
+	To string `json:"to" protobuf:"bytes,3,opt,name=to"`
+	// Reason describes the resources which caused the transition
+	Reason string `json:"reason,omitempty" protobuf:"bytes,4,opt,name=reason"`
+	// Revision is the revision the application was compared against at the time of the transition
+	Revision string `json:"revision,omitempty" protobuf:"bytes,5,opt,name=revision"`
+	// TransitionedAt is the time the transition was detected
+	TransitionedAt metav1.Time `json:"transitionedAt" protobuf:"bytes,6,opt,name=transitionedAt"`
+}
+
+// SyncPlanAction is the action a sync operation would perform on a resource
+type SyncPlanAction string
+
+const (
+	// SyncPlanActionCreate means that the resource does not exist and would be created
+	SyncPlanActionCreate SyncPlanAction = "create"
+	// SyncPlanActionUpdate means that the live resource differs from its target state and would be updated
+	SyncPlanActionUpdate SyncPlanAction = "update"
+	// SyncPlanActionReplace means that the live resource differs from its target state and would be replaced
+	SyncPlanActionReplace SyncPlanAction = "replace"
+	// SyncPlanActionPrune means that the live resource is no longer part of the target state and would be deleted
+	SyncPlanActionPrune SyncPlanAction = "prune"
+	// SyncPlanActionHook means that the resource is a hook, which would be created during its sync phase
+	SyncPlanActionHook SyncPlanAction = "hook"
+	// SyncPlanActionNone means that the live resource matches its target state
+	SyncPlanActionNone SyncPlanAction = "none"
+)
+
+// SyncPlan is the predicted outcome of a sync operation, computed without changing the live resources
+type SyncPlan struct {
+	// Revision is the revision which would be synced
+	Revision string `json:"revision,omitempty" protobuf:"bytes,1,opt,name=revision"`
+	// Revisions are the revisions which would be synced, for applications with multiple sources
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,2,rep,name=revisions"`
+	// Resources are the resources of the application, in the order in which they would be synced
+	Resources []SyncPlanResource `json:"resources,omitempty" protobuf:"bytes,3,rep,name=resources"`
+	// Failures are the policy and permission failures which would prevent the whole sync operation
+	Failures []string `json:"failures,omitempty" protobuf:"bytes,4,rep,name=failures"`
+}
+
+// IsPermitted returns true if neither the sync operation nor any of its resources would fail because of a policy or a
+// permission
+func (p *SyncPlan) IsPermitted() bool {
+	if len(p.Failures) > 0 {
+		return false
+	}
+	for _, res := range p.Resources {
+		if len(res.Failures) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SyncPlanResource is the predicted change of a resource by a sync operation
+type SyncPlanResource struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Version   string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	Kind      string `json:"kind,omitempty" protobuf:"bytes,3,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	Name      string `json:"name,omitempty" protobuf:"bytes,5,opt,name=name"`
+	// Action is the action the sync operation would perform on the resource
+	Action SyncPlanAction `json:"action" protobuf:"bytes,6,opt,name=action,casttype=SyncPlanAction"`
+	// SyncPhase is the phase of the sync operation in which the resource would be synced
+	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,7,opt,name=syncPhase"`
+	// SyncWave is the wave in which the resource would be synced
+	SyncWave int64 `json:"syncWave,omitempty" protobuf:"bytes,8,opt,name=syncWave"`
+	// HookType is the type of the hook. Empty for non-hook resources
+	HookType synccommon.HookType `json:"hookType,omitempty" protobuf:"bytes,9,opt,name=hookType"`
+	// Patch is the JSON patch (RFC 6902) from the live state of the resource to the state predicted by a server-side
+	// apply in dry-run mode
+	Patch string `json:"patch,omitempty" protobuf:"bytes,10,opt,name=patch"`
+	// Message explains why the action would not be performed, e.g. because pruning is disabled
+	Message string `json:"message,omitempty" protobuf:"bytes,11,opt,name=message"`
+	// Failures are the policy and permission failures which would prevent the sync of the resource
+	Failures []string `json:"failures,omitempty" protobuf:"bytes,12,rep,name=failures"`
+}
+
+// FullName returns full name of the resource in the format "group/kind/namespace/name"
+func (r *SyncPlanResource) FullName() string {
+	return fmt.Sprintf("%s/%s/%s/%s", r.Group, r.Kind, r.Namespace, r.Name)
+}
+
+// HookLogs holds the tail of the container logs of a pod of a failed hook, captured before the hook is deleted
+type HookLogs struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind      string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	Name      string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	// HookType is the type of the hook
+	HookType synccommon.HookType `json:"hookType,omitempty" protobuf:"bytes,5,opt,name=hookType"`
+	// SyncPhase is the sync phase the hook was run in
+	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,6,opt,name=syncPhase"`
+	// OperationStartedAt is the start time of the sync operation which ran the hook
+	OperationStartedAt metav1.Time `json:"operationStartedAt" protobuf:"bytes,7,opt,name=operationStartedAt"`
+	// PodName is the name of the pod the logs were captured from
+	PodName string `json:"podName,omitempty" protobuf:"bytes,8,opt,name=podName"`
+	// Containers holds the logs of each container of the pod
+	Containers []ContainerLogs `json:"containers,omitempty" protobuf:"bytes,9,rep,name=containers"`
+	// CapturedAt is the time the logs were captured
+	CapturedAt metav1.Time `json:"capturedAt" protobuf:"bytes,10,opt,name=capturedAt"`
+}
+
+// FullName returns full name of the hook in the format "group/kind/namespace/name"
+func (l *HookLogs) FullName() string {
+	return fmt.Sprintf("%s/%s/%s/%s", l.Group, l.Kind, l.Namespace, l.Name)
+}
+
+// ContainerLogs holds the tail of the logs of a container
+type ContainerLogs struct {
+	// Container is the name of the container
+	Container string `json:"container" protobuf:"bytes,1,opt,name=container"`
+	// Logs holds the last lines of the logs of the container, with the credentials redacted
+	Logs string `json:"logs,omitempty" protobuf:"bytes,2,opt,name=logs"`
+	// Truncated is true if the logs were cut to the maximum captured size
+	Truncated bool `json:"truncated,omitempty" protobuf:"varint,3,opt,name=truncated"`
+}
+
 // ConnectionStatus represents the status indicator for a connection to a remote resource
 type ConnectionStatus = string
 
@@ -2026,6 +2582,9 @@ type OverrideIgnoreDiff struct {
 type rawResourceOverride struct {
 	HealthLua             string           `json:"health.lua,omitempty"`
 	UseOpenLibs           bool             `json:"health.lua.useOpenLibs,omitempty"`
+	HealthCEL             string           `json:"health.cel,omitempty"`
+	HealthKStatus         bool             `json:"health.kstatus,omitempty"`
+	ProgressingTimeout    string           `json:"health.progressingTimeout,omitempty"`
 	Actions               string           `json:"actions,omitempty"`
 	IgnoreDifferences     string           `json:"ignoreDifferences,omitempty"`
 	IgnoreResourceUpdates string           `json:"ignoreResourceUpdates,omitempty"`
@@ -2035,8 +2594,16 @@ type rawResourceOverride struct {
 // ResourceOverride holds configuration to customize resource diffing and health assessment
 // TODO: describe the members of this type
 type ResourceOverride struct {
-	HealthLua             string             `protobuf:"bytes,1,opt,name=healthLua"`
-	UseOpenLibs           bool               `protobuf:"bytes,5,opt,name=useOpenLibs"`
+	HealthLua   string `protobuf:"bytes,1,opt,name=healthLua"`
+	UseOpenLibs bool   `protobuf:"bytes,5,opt,name=useOpenLibs"`
+	// HealthCEL is a CEL expression assessing the health of the resource, used when HealthLua is not set
+	HealthCEL string `protobuf:"bytes,7,opt,name=healthCEL"`
+	// HealthKStatus enables the generic assessment of the health of the resource from its standard status conditions,
+	// used when neither HealthLua nor HealthCEL is set
+	HealthKStatus bool `protobuf:"bytes,8,opt,name=healthKStatus"`
+	// ProgressingTimeout is the maximum amount of time the resource may remain Progressing, after which it is
+	// reported Degraded
+	ProgressingTimeout    string             `protobuf:"bytes,9,opt,name=progressingTimeout"`
 	Actions               string             `protobuf:"bytes,3,opt,name=actions"`
 	IgnoreDifferences     OverrideIgnoreDiff `protobuf:"bytes,2,opt,name=ignoreDifferences"`
 	IgnoreResourceUpdates OverrideIgnoreDiff `protobuf:"bytes,6,opt,name=ignoreResourceUpdates"`
@@ -2052,6 +2619,9 @@ func (s *ResourceOverride) UnmarshalJSON(data []byte) error {
 	s.KnownTypeFields = raw.KnownTypeFields
 	s.HealthLua = raw.HealthLua
 	s.UseOpenLibs = raw.UseOpenLibs
+	s.HealthCEL = raw.HealthCEL
+	s.HealthKStatus = raw.HealthKStatus
+	s.ProgressingTimeout = raw.ProgressingTimeout
 	s.Actions = raw.Actions
 	err := yaml.Unmarshal([]byte(raw.IgnoreDifferences), &s.IgnoreDifferences)
 	if err != nil {
@@ -2074,10 +2644,18 @@ func (s ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), s.KnownTypeFields}
+	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.HealthCEL, s.HealthKStatus, s.ProgressingTimeout, s.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), s.KnownTypeFields}
 	return json.Marshal(raw)
 }
 
+// GetProgressingTimeout returns the maximum amount of time the resource may remain Progressing. Returns zero if not set.
+func (o *ResourceOverride) GetProgressingTimeout() (time.Duration, error) {
+	if o.ProgressingTimeout == "" {
+		return 0, nil
+	}
+	return ParseDuration(o.ProgressingTimeout)
+}
+
 // TODO: describe this method
 func (o *ResourceOverride) GetActions() (ResourceActions, error) {
 	var actions ResourceActions
@@ -2244,6 +2822,98 @@ type OrphanedResourcesMonitorSettings struct {
 	Warn *bool `json:"warn,omitempty" protobuf:"bytes,1,name=warn"`
 	// Ignore contains a list of resources that are to be excluded from orphaned resources monitoring
 	Ignore []OrphanedResourceKey `json:"ignore,omitempty" protobuf:"bytes,2,opt,name=ignore"`
+	// Actions are the actions applied to the orphaned resources. The first action matching a resource applies to it.
+	Actions []OrphanedResourceAction `json:"actions,omitempty" protobuf:"bytes,3,rep,name=actions"`
+}
+
+// OrphanedResourceActionType is the type of an action applied to orphaned resources
+type OrphanedResourceActionType string
+
+const (
+	// OrphanedResourceActionDelete deletes the orphaned resources
+	OrphanedResourceActionDelete OrphanedResourceActionType = "Delete"
+	// OrphanedResourceActionAdopt adds the tracking metadata of the application to the orphaned resources
+	OrphanedResourceActionAdopt OrphanedResourceActionType = "Adopt"
+)
+
+// defaultOrphanedResourceDeleteGracePeriod is the grace period of the deletion of orphaned resources when not specified
+const defaultOrphanedResourceDeleteGracePeriod = 24 * time.Hour
+
+// OrphanedResourceAction is an action applied to the orphaned resources matching its group, kind and name globs
+type OrphanedResourceAction struct {
+	// Action is the action applied to the matching orphaned resources
+	// +kubebuilder:validation:Enum=Delete;Adopt
+	Action OrphanedResourceActionType `json:"action" protobuf:"bytes,1,opt,name=action,casttype=OrphanedResourceActionType"`
+	// Group is a glob matching the group of the orphaned resources
+	Group string `json:"group,omitempty" protobuf:"bytes,2,opt,name=group"`
+	// Kind is a glob matching the kind of the orphaned resources. Matches all the kinds if empty.
+	Kind string `json:"kind,omitempty" protobuf:"bytes,3,opt,name=kind"`
+	// Name is a glob matching the name of the orphaned resources. Matches all the names if empty.
+	Name string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	// GracePeriod is the amount of time a resource must remain orphaned before the action is applied to it, during
+	// which the pending action is reported in the application status. Defaults to 24h for Delete, and to 0 for Adopt.
+	GracePeriod string `json:"gracePeriod,omitempty" protobuf:"bytes,5,opt,name=gracePeriod"`
+	// DryRun only reports the action in the application status, without applying it
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,6,opt,name=dryRun"`
+}
+
+// Matches returns true if the action applies to the orphaned resource of the given group, kind and name
+func (a *OrphanedResourceAction) Matches(group, kind, name string) bool {
+	return (a.Kind == "" || glob.Match(a.Kind, kind)) && glob.Match(a.Group, group) && (a.Name == "" || glob.Match(a.Name, name))
+}
+
+// GetGracePeriod returns the amount of time a resource must remain orphaned before the action is applied to it
+func (a *OrphanedResourceAction) GetGracePeriod() (time.Duration, error) {
+	if a.GracePeriod == "" {
+		if a.Action == OrphanedResourceActionDelete {
+			return defaultOrphanedResourceDeleteGracePeriod, nil
+		}
+		return 0, nil
+	}
+	return ParseDuration(a.GracePeriod)
+}
+
+// GetAction returns the first action which applies to the orphaned resource of the given group, kind and name, if any
+func (s *OrphanedResourcesMonitorSettings) GetAction(group, kind, name string) *OrphanedResourceAction {
+	if s == nil {
+		return nil
+	}
+	for i := range s.Actions {
+		if s.Actions[i].Matches(group, kind, name) {
+			return &s.Actions[i]
+		}
+	}
+	return nil
+}
+
+// ApplicationDestinationStatus holds the state of one of the destinations of an application with multiple destinations
+type ApplicationDestinationStatus struct {
+	// Destination is the destination the state is about
+	Destination ApplicationDestination `json:"destination" protobuf:"bytes,1,opt,name=destination"`
+	// Status is the sync state of the destination
+	Status SyncStatusCode `json:"status,omitempty" protobuf:"bytes,2,opt,name=status,casttype=SyncStatusCode"`
+	// Health is the health of the destination
+	Health HealthStatus `json:"health,omitempty" protobuf:"bytes,3,opt,name=health"`
+	// Resources is a list of the resources of the application on the destination
+	Resources []ResourceStatus `json:"resources,omitempty" protobuf:"bytes,4,rep,name=resources"`
+}
+
+// OrphanedResourceStatus is an action pending for an orphaned resource of an application
+type OrphanedResourceStatus struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind      string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// Action is the action pending for the resource
+	Action OrphanedResourceActionType `json:"action" protobuf:"bytes,5,opt,name=action,casttype=OrphanedResourceActionType"`
+	// OrphanedSince is the time the resource was first found orphaned
+	OrphanedSince metav1.Time `json:"orphanedSince" protobuf:"bytes,6,opt,name=orphanedSince"`
+	// ScheduledAt is the time the action is applied, unless it is a dry run
+	ScheduledAt metav1.Time `json:"scheduledAt" protobuf:"bytes,7,opt,name=scheduledAt"`
+	// DryRun indicates that the action is only reported
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,8,opt,name=dryRun"`
+	// Message holds the error of the last attempt to apply the action, if any
+	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
 }
 
 // OrphanedResourceKey is a reference to a resource to be ignored from
@@ -2292,6 +2962,65 @@ type AppProjectSpec struct {
 	SourceNamespaces []string `json:"sourceNamespaces,omitempty" protobuf:"bytes,12,opt,name=sourceNamespaces"`
 	// PermitOnlyProjectScopedClusters determines whether destinations can only reference clusters which are project-scoped
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
+	// ManifestPolicies are the policies the target manifests of the applications in this project are checked against
+	ManifestPolicies []ManifestPolicy `json:"manifestPolicies,omitempty" protobuf:"bytes,14,rep,name=manifestPolicies"`
+	// DestinationServiceAccounts maps destinations to the service accounts impersonated when syncing applications to them
+	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,15,rep,name=destinationServiceAccounts"`
+}
+
+// ApplicationDestinationServiceAccount holds the service account impersonated by Argo CD when modifying the resources
+// of the applications deployed to a destination
+type ApplicationDestinationServiceAccount struct {
+	// Server is the URL of the destination cluster, which may contain wildcards
+	Server string `json:"server" protobuf:"bytes,1,opt,name=server"`
+	// Namespace is the destination namespace, which may contain wildcards
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// DefaultServiceAccount is the name of the service account to impersonate. It is looked up in the destination
+	// namespace of the application unless it is qualified with a namespace, as in <namespace>:<name>.
+	DefaultServiceAccount string `json:"defaultServiceAccount" protobuf:"bytes,3,opt,name=defaultServiceAccount"`
+}
+
+// ManifestPolicySeverity defines the effect of a manifest policy violation
+type ManifestPolicySeverity string
+
+const (
+	// ManifestPolicySeverityDeny prevents the application from being synced while a resource violates the policy
+	ManifestPolicySeverityDeny ManifestPolicySeverity = "Deny"
+	// ManifestPolicySeverityWarn only reports the violations of the policy
+	ManifestPolicySeverityWarn ManifestPolicySeverity = "Warn"
+)
+
+// ManifestPolicy is a policy written as a CEL expression, which is evaluated against each rendered target
+// manifest of an application before it is synced
+type ManifestPolicy struct {
+	// Name is the name of the policy, which must be unique within the project
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Description is an optional description of the policy
+	Description string `json:"description,omitempty" protobuf:"bytes,2,opt,name=description"`
+	// Kinds restricts the policy to the resources of the given group and kinds, which may contain wildcards.
+	// The policy applies to all resources if no kinds are specified.
+	Kinds []metav1.GroupKind `json:"kinds,omitempty" protobuf:"bytes,3,rep,name=kinds"`
+	// Expression is a CEL expression evaluated against the manifest, available as the `object` variable.
+	// It must return true if the manifest complies with the policy.
+	Expression string `json:"expression" protobuf:"bytes,4,opt,name=expression"`
+	// Message is the message reported for a resource violating the policy
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// Severity is the effect of a violation of the policy: Deny (default) prevents the application from being synced, Warn only reports the violation
+	// +kubebuilder:validation:Enum=Deny;Warn
+	Severity ManifestPolicySeverity `json:"severity,omitempty" protobuf:"bytes,6,opt,name=severity,casttype=ManifestPolicySeverity"`
+}
+
+// GetSeverity returns the severity of the policy, which defaults to Deny
+func (p *ManifestPolicy) GetSeverity() ManifestPolicySeverity {
+	if p.Severity == "" {
+		return ManifestPolicySeverityDeny
+	}
+	return p.Severity
+}
+
+// Matches returns whether the policy applies to resources of the given group and kind
+func (p *ManifestPolicy) Matches(gk metav1.GroupKind) bool {
+	return len(p.Kinds) == 0 || isResourceInList(gk, p.Kinds)
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2750,6 +3479,18 @@ func (app *Application) UnSetPostDeleteFinalizer(stage ...string) {
 	setFinalizer(&app.ObjectMeta, strings.Join(append([]string{PostDeleteFinalizerName}, stage...), "/"), false)
 }
 
+func (app *Application) HasPreDeleteFinalizer(stage ...string) bool {
+	return getFinalizerIndex(app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/")) > -1
+}
+
+func (app *Application) SetPreDeleteFinalizer(stage ...string) {
+	setFinalizer(&app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/"), true)
+}
+
+func (app *Application) UnSetPreDeleteFinalizer(stage ...string) {
+	setFinalizer(&app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/"), false)
+}
+
 // SetCascadedDeletion will enable cascaded deletion by setting the propagation policy finalizer
 func (app *Application) SetCascadedDeletion(finalizer string) {
 	setFinalizer(&app.ObjectMeta, finalizer, true)
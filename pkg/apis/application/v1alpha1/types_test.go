@@ -748,6 +748,93 @@ func TestAppProject_ValidateGroupName(t *testing.T) {
 	}
 }
 
+func TestAppProject_ValidateManifestPolicies(t *testing.T) {
+	p := newTestProject()
+	p.Spec.ManifestPolicies = []ManifestPolicy{{Name: "policy", Expression: "true"}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.ManifestPolicies = []ManifestPolicy{{Name: "policy", Expression: "true"}, {Name: "policy", Expression: "true"}}
+	require.ErrorContains(t, p.ValidateProject(), "already exists")
+
+	p.Spec.ManifestPolicies = []ManifestPolicy{{Expression: "true"}}
+	require.ErrorContains(t, p.ValidateProject(), "name is required")
+
+	p.Spec.ManifestPolicies = []ManifestPolicy{{Name: "policy"}}
+	require.ErrorContains(t, p.ValidateProject(), "requires an expression")
+
+	p.Spec.ManifestPolicies = []ManifestPolicy{{Name: "policy", Expression: "true", Severity: "Block"}}
+	require.ErrorContains(t, p.ValidateProject(), "invalid severity")
+}
+
+func TestAppProject_ValidateOrphanedResourcesActions(t *testing.T) {
+	p := newTestProject()
+	p.Spec.OrphanedResources = &OrphanedResourcesMonitorSettings{Actions: []OrphanedResourceAction{{Action: OrphanedResourceActionDelete, Kind: "ConfigMap", GracePeriod: "1h"}}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.OrphanedResources.Actions = []OrphanedResourceAction{{Action: "Prune"}}
+	require.ErrorContains(t, p.ValidateProject(), "is invalid")
+
+	p.Spec.OrphanedResources.Actions = []OrphanedResourceAction{{Action: OrphanedResourceActionAdopt, GracePeriod: "later"}}
+	require.ErrorContains(t, p.ValidateProject(), "invalid grace period")
+}
+
+func TestAppProject_ValidateDestinationServiceAccounts(t *testing.T) {
+	p := newTestProject()
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "*", DefaultServiceAccount: "deployer"},
+		{Server: "*", Namespace: "team-*", DefaultServiceAccount: "argocd:team-deployer"},
+	}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.DestinationServiceAccounts = append(p.Spec.DestinationServiceAccounts, ApplicationDestinationServiceAccount{Server: "*", Namespace: "team-*", DefaultServiceAccount: "other"})
+	require.ErrorContains(t, p.ValidateProject(), "already exists")
+
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Namespace: "*", DefaultServiceAccount: "deployer"}}
+	require.ErrorContains(t, p.ValidateProject(), "server is required")
+
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", Namespace: "!kube-system", DefaultServiceAccount: "deployer"}}
+	require.ErrorContains(t, p.ValidateProject(), "cannot be negated")
+
+	for _, serviceAccount := range []string{"", " deployer", "ns:", "a:b:c", "deploy*"} {
+		p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", Namespace: "*", DefaultServiceAccount: serviceAccount}}
+		require.ErrorContains(t, p.ValidateProject(), "is invalid", serviceAccount)
+	}
+}
+
+func TestAppProject_GetDestinationServiceAccount(t *testing.T) {
+	p := newTestProject()
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "kube-*", DefaultServiceAccount: "argocd:admin-deployer"},
+		{Server: "https://kubernetes.default.svc", Namespace: "*", DefaultServiceAccount: "deployer"},
+		{Server: "https://*.example.com", Namespace: "", DefaultServiceAccount: "cluster-deployer"},
+	}
+
+	serviceAccount, err := p.GetDestinationServiceAccount(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "kube-system"}, "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:argocd:admin-deployer", serviceAccount)
+
+	serviceAccount, err = p.GetDestinationServiceAccount(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"}, "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:guestbook:deployer", serviceAccount)
+
+	serviceAccount, err = p.GetDestinationServiceAccount(ApplicationDestination{Server: "https://prod.example.com"}, "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:argocd:cluster-deployer", serviceAccount)
+
+	_, err = p.GetDestinationServiceAccount(ApplicationDestination{Server: "https://prod.example.com", Namespace: "guestbook"}, "argocd")
+	require.ErrorContains(t, err, "no service account matches")
+}
+
+func TestManifestPolicy_Matches(t *testing.T) {
+	policy := ManifestPolicy{}
+	assert.True(t, policy.Matches(metav1.GroupKind{Group: "apps", Kind: "Deployment"}))
+	assert.Equal(t, ManifestPolicySeverityDeny, policy.GetSeverity())
+
+	policy.Kinds = []metav1.GroupKind{{Group: "apps", Kind: "*"}}
+	assert.True(t, policy.Matches(metav1.GroupKind{Group: "apps", Kind: "Deployment"}))
+	assert.False(t, policy.Matches(metav1.GroupKind{Group: "", Kind: "Pod"}))
+}
+
 func TestAppProject_ValidateSyncWindowList(t *testing.T) {
 	t.Run("WorkingSyncWindow", func(t *testing.T) {
 		p := newTestProjectWithSyncWindows()
@@ -2837,6 +2924,21 @@ func TestSyncPolicy_IsZero(t *testing.T) {
 	assert.False(t, (&SyncPolicy{Automated: &SyncPolicyAutomated{}}).IsZero())
 	assert.False(t, (&SyncPolicy{SyncOptions: SyncOptions{""}}).IsZero())
 	assert.False(t, (&SyncPolicy{Retry: &RetryStrategy{}}).IsZero())
+	assert.False(t, (&SyncPolicy{Timeout: "30m"}).IsZero())
+}
+
+func TestSyncOperation_GetTimeout(t *testing.T) {
+	timeout, err := (&SyncOperation{}).GetTimeout(nil)
+	require.NoError(t, err)
+	assert.Zero(t, timeout)
+	timeout, err = (&SyncOperation{}).GetTimeout(&SyncPolicy{Timeout: "30m"})
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, timeout)
+	timeout, err = (&SyncOperation{Timeout: "120"}).GetTimeout(&SyncPolicy{Timeout: "30m"})
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, timeout)
+	_, err = (&SyncOperation{Timeout: "invalid"}).GetTimeout(nil)
+	require.Error(t, err)
 }
 
 func TestSyncOptions_HasOption(t *testing.T) {
@@ -2866,6 +2968,44 @@ func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Equal(t, RevisionHistories{{Revision: "my-revision"}}, RevisionHistories{{}, {}, {Revision: "my-revision"}}.Trunc(1))
 }
 
+func TestRevisionHistories_LastHealthyRevisionHistory(t *testing.T) {
+	healthyAt := metav1.Now()
+	assert.Nil(t, RevisionHistories{}.LastHealthyRevisionHistory())
+	// the latest element is never a rollback candidate
+	assert.Nil(t, RevisionHistories{{ID: 1, HealthyAt: &healthyAt}}.LastHealthyRevisionHistory())
+	assert.Nil(t, RevisionHistories{{ID: 1}, {ID: 2}}.LastHealthyRevisionHistory())
+	history := RevisionHistories{{ID: 1, HealthyAt: &healthyAt}, {ID: 2, HealthyAt: &healthyAt}, {ID: 3}, {ID: 4}}.LastHealthyRevisionHistory()
+	require.NotNil(t, history)
+	assert.Equal(t, int64(2), history.ID)
+}
+
+func TestAutomatedRollback_GetWindow(t *testing.T) {
+	window, err := (&AutomatedRollback{}).GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultAutomatedRollbackWindow, window)
+	window, err = (&AutomatedRollback{Window: "2m"}).GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, window)
+	_, err = (&AutomatedRollback{Window: "invalid"}).GetWindow()
+	require.Error(t, err)
+
+	timeout, err := (&AutomatedRollback{}).GetProgressingTimeout()
+	require.NoError(t, err)
+	assert.Zero(t, timeout)
+	timeout, err = (&AutomatedRollback{ProgressingTimeout: "30"}).GetProgressingTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, timeout)
+}
+
+func TestMaintenanceStatus_IsActive(t *testing.T) {
+	now := time.Now()
+	var maintenance *MaintenanceStatus
+	assert.False(t, maintenance.IsActive(now))
+	maintenance = &MaintenanceStatus{StartedAt: metav1.NewTime(now.Add(-time.Hour)), ExpiresAt: metav1.NewTime(now.Add(time.Hour))}
+	assert.True(t, maintenance.IsActive(now))
+	assert.False(t, maintenance.IsActive(now.Add(time.Hour)))
+}
+
 func TestApplicationSpec_GetRevisionHistoryLimit(t *testing.T) {
 	// default
 	assert.Equal(t, 10, ApplicationSpec{}.GetRevisionHistoryLimit())
@@ -2964,7 +3104,7 @@ func TestRetryStrategy_NextRetryAtDefaultBackoff(t *testing.T) {
 	}
 
 	for i, expected := range expectedTimes {
-		retryAt, err := retry.NextRetryAt(now, int64(i))
+		retryAt, err := retry.NextRetryAt(now, int64(i), "app-uid")
 		require.NoError(t, err)
 		assert.Equal(t, expected.Format(time.RFC850), retryAt.Format(time.RFC850))
 	}
@@ -2988,12 +3128,64 @@ func TestRetryStrategy_NextRetryAtCustomBackoff(t *testing.T) {
 	}
 
 	for i, expected := range expectedTimes {
-		retryAt, err := retry.NextRetryAt(now, int64(i))
+		retryAt, err := retry.NextRetryAt(now, int64(i), "app-uid")
 		require.NoError(t, err)
 		assert.Equal(t, expected.Format(time.RFC850), retryAt.Format(time.RFC850))
 	}
 }
 
+func TestRetryStrategy_NextRetryAtJitter(t *testing.T) {
+	retry := RetryStrategy{
+		Backoff: &Backoff{
+			Duration: "10s",
+			Factor:   ptr.To(int64(1)),
+			Jitter:   ptr.To(int64(50)),
+		},
+	}
+	now := time.Now()
+	for i := 0; i < 20; i++ {
+		retryAt, err := retry.NextRetryAt(now, int64(i), "app-uid")
+		require.NoError(t, err)
+		assert.False(t, retryAt.Before(now.Add(5*time.Second)))
+		assert.False(t, retryAt.After(now.Add(10*time.Second)))
+	}
+}
+
+func TestRetryStrategy_NextRetryAtJitterIsStable(t *testing.T) {
+	retry := RetryStrategy{
+		Backoff: &Backoff{
+			Duration: "1m",
+			Factor:   ptr.To(int64(1)),
+			Jitter:   ptr.To(int64(50)),
+		},
+	}
+	now := time.Now()
+	retryAt, err := retry.NextRetryAt(now, 1, "app-uid")
+	require.NoError(t, err)
+	// the retry time of an attempt does not change between the reconciliations of the application
+	for i := 0; i < 20; i++ {
+		again, err := retry.NextRetryAt(now, 1, "app-uid")
+		require.NoError(t, err)
+		assert.Equal(t, retryAt, again)
+	}
+
+	// the retries of different applications are spread
+	retryTimes := map[time.Time]bool{}
+	for i := 0; i < 20; i++ {
+		retryAt, err := retry.NextRetryAt(now, 1, fmt.Sprintf("app-uid-%d", i))
+		require.NoError(t, err)
+		retryTimes[retryAt] = true
+	}
+	assert.Greater(t, len(retryTimes), 1)
+}
+
+func TestRetryStrategy_ShouldRetry(t *testing.T) {
+	assert.True(t, (&RetryStrategy{}).ShouldRetry(false))
+	assert.True(t, (&RetryStrategy{Policy: RetryPolicyAlways}).ShouldRetry(false))
+	assert.True(t, (&RetryStrategy{Policy: RetryPolicyTransient}).ShouldRetry(true))
+	assert.False(t, (&RetryStrategy{Policy: RetryPolicyTransient}).ShouldRetry(false))
+}
+
 func TestSourceAllowsConcurrentProcessing_KustomizeParams(t *testing.T) {
 	t.Run("Has NameSuffix", func(t *testing.T) {
 		src := ApplicationSource{Path: ".", Kustomize: &ApplicationSourceKustomize{
@@ -3090,6 +3282,39 @@ func TestOrphanedResourcesMonitorSettings_IsWarn(t *testing.T) {
 	assert.True(t, settings.IsWarn())
 }
 
+func TestOrphanedResourcesMonitorSettings_GetAction(t *testing.T) {
+	var nilSettings *OrphanedResourcesMonitorSettings
+	assert.Nil(t, nilSettings.GetAction("apps", "Deployment", "web"))
+
+	settings := OrphanedResourcesMonitorSettings{Actions: []OrphanedResourceAction{
+		{Action: OrphanedResourceActionAdopt, Group: "apps", Kind: "Deployment", Name: "web-*"},
+		{Action: OrphanedResourceActionDelete, Group: "*"},
+	}}
+	assert.Equal(t, OrphanedResourceActionAdopt, settings.GetAction("apps", "Deployment", "web-1").Action)
+	assert.Equal(t, OrphanedResourceActionDelete, settings.GetAction("apps", "Deployment", "db").Action)
+	assert.Equal(t, OrphanedResourceActionDelete, settings.GetAction("", "ConfigMap", "web-1").Action)
+
+	settings.Actions = settings.Actions[:1]
+	assert.Nil(t, settings.GetAction("", "Deployment", "web-1"))
+}
+
+func TestOrphanedResourceAction_GetGracePeriod(t *testing.T) {
+	gracePeriod, err := (&OrphanedResourceAction{Action: OrphanedResourceActionDelete}).GetGracePeriod()
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, gracePeriod)
+
+	gracePeriod, err = (&OrphanedResourceAction{Action: OrphanedResourceActionAdopt}).GetGracePeriod()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), gracePeriod)
+
+	gracePeriod, err = (&OrphanedResourceAction{Action: OrphanedResourceActionDelete, GracePeriod: "30m"}).GetGracePeriod()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, gracePeriod)
+
+	_, err = (&OrphanedResourceAction{Action: OrphanedResourceActionDelete, GracePeriod: "soon"}).GetGracePeriod()
+	require.Error(t, err)
+}
+
 func Test_isValidPolicyObject(t *testing.T) {
 	policyTests := []struct {
 		name    string
@@ -3742,3 +3967,41 @@ func TestApplicationSpec_GetSourcePtrByIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestApplicationSpec_GetDestinations(t *testing.T) {
+	spec := ApplicationSpec{Destination: ApplicationDestination{Server: "https://cluster-1", Namespace: "default"}}
+	assert.False(t, spec.HasMultipleDestinations())
+	assert.Equal(t, ApplicationDestinations{{Server: "https://cluster-1", Namespace: "default"}}, spec.GetDestinations())
+
+	spec.Destinations = ApplicationDestinations{{Server: "https://cluster-2"}, {Server: "https://cluster-3"}}
+	assert.True(t, spec.HasMultipleDestinations())
+	assert.Equal(t, spec.Destinations, spec.GetDestinations())
+}
+
+func TestDestinationRollout_Batches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rollout  *DestinationRollout
+		expected [][]int
+	}{
+		{name: "Default", rollout: nil, expected: [][]int{{0, 1, 2, 3, 4}}},
+		{name: "Parallel", rollout: &DestinationRollout{Strategy: DestinationRolloutStrategyParallel}, expected: [][]int{{0, 1, 2, 3, 4}}},
+		{name: "Sequential", rollout: &DestinationRollout{Strategy: DestinationRolloutStrategySequential}, expected: [][]int{{0}, {1}, {2}, {3}, {4}}},
+		{name: "Batches", rollout: &DestinationRollout{Strategy: DestinationRolloutStrategyBatches, BatchSize: 2}, expected: [][]int{{0, 1}, {2, 3}, {4}}},
+		{name: "BatchesLargerThanDestinations", rollout: &DestinationRollout{Strategy: DestinationRolloutStrategyBatches, BatchSize: 10}, expected: [][]int{{0, 1, 2, 3, 4}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.rollout.Batches(5))
+		})
+	}
+}
+
+func TestDestinationRollout_Validate(t *testing.T) {
+	var rollout *DestinationRollout
+	require.NoError(t, rollout.Validate())
+	require.NoError(t, (&DestinationRollout{Strategy: DestinationRolloutStrategySequential}).Validate())
+	require.NoError(t, (&DestinationRollout{Strategy: DestinationRolloutStrategyBatches, BatchSize: 3}).Validate())
+	require.EqualError(t, (&DestinationRollout{Strategy: DestinationRolloutStrategyBatches}).Validate(), "destination rollout batch size must be greater than zero")
+	require.EqualError(t, (&DestinationRollout{Strategy: "Canary"}).Validate(), "unknown destination rollout strategy 'Canary'")
+}
@@ -156,6 +156,18 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ManifestPolicies != nil {
+		in, out := &in.ManifestPolicies, &out.ManifestPolicies
+		*out = make([]ManifestPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DestinationServiceAccounts != nil {
+		in, out := &in.DestinationServiceAccounts, &out.DestinationServiceAccounts
+		*out = make([]ApplicationDestinationServiceAccount, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -261,6 +273,67 @@ func (in *ApplicationDestination) DeepCopy() *ApplicationDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDestinationServiceAccount) DeepCopyInto(out *ApplicationDestinationServiceAccount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDestinationServiceAccount.
+func (in *ApplicationDestinationServiceAccount) DeepCopy() *ApplicationDestinationServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDestinationServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDestinationStatus) DeepCopyInto(out *ApplicationDestinationStatus) {
+	*out = *in
+	out.Destination = in.Destination
+	in.Health.DeepCopyInto(&out.Health)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDestinationStatus.
+func (in *ApplicationDestinationStatus) DeepCopy() *ApplicationDestinationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDestinationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ApplicationDestinations) DeepCopyInto(out *ApplicationDestinations) {
+	{
+		in := &in
+		*out = make(ApplicationDestinations, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDestinations.
+func (in ApplicationDestinations) DeepCopy() ApplicationDestinations {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDestinations)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
 	*out = *in
@@ -1308,6 +1381,16 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make(ApplicationDestinations, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationRollout != nil {
+		in, out := &in.DestinationRollout, &out.DestinationRollout
+		*out = new(DestinationRollout)
+		**out = **in
+	}
 	return
 }
 
@@ -1332,7 +1415,7 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		}
 	}
 	in.Sync.DeepCopyInto(&out.Sync)
-	out.Health = in.Health
+	in.Health.DeepCopyInto(&out.Health)
 	if in.History != nil {
 		in, out := &in.History, &out.History
 		*out = make(RevisionHistories, len(*in))
@@ -1366,6 +1449,37 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		*out = make([]ApplicationSourceType, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OperationQueue != nil {
+		in, out := &in.OperationQueue, &out.OperationQueue
+		*out = make([]QueuedOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrphanedResources != nil {
+		in, out := &in.OrphanedResources, &out.OrphanedResources
+		*out = make([]OrphanedResourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]ApplicationDestinationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1459,6 +1573,22 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomatedRollback) DeepCopyInto(out *AutomatedRollback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomatedRollback.
+func (in *AutomatedRollback) DeepCopy() *AutomatedRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomatedRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -1467,6 +1597,11 @@ func (in *Backoff) DeepCopyInto(out *Backoff) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -1754,6 +1889,11 @@ func (in *ComparedTo) DeepCopyInto(out *ComparedTo) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make(ApplicationDestinations, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1825,6 +1965,120 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerLogs) DeepCopyInto(out *ContainerLogs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerLogs.
+func (in *ContainerLogs) DeepCopy() *ContainerLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DORAMetrics) DeepCopyInto(out *DORAMetrics) {
+	*out = *in
+	if in.LeadTimeSeconds != nil {
+		in, out := &in.LeadTimeSeconds, &out.LeadTimeSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TimeToRestoreSeconds != nil {
+		in, out := &in.TimeToRestoreSeconds, &out.TimeToRestoreSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DORAMetrics.
+func (in *DORAMetrics) DeepCopy() *DORAMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(DORAMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationOperationState) DeepCopyInto(out *DestinationOperationState) {
+	*out = *in
+	out.Destination = in.Destination
+	if in.SyncResult != nil {
+		in, out := &in.SyncResult, &out.SyncResult
+		*out = new(SyncOperationResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationOperationState.
+func (in *DestinationOperationState) DeepCopy() *DestinationOperationState {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationOperationState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationRollout) DeepCopyInto(out *DestinationRollout) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationRollout.
+func (in *DestinationRollout) DeepCopy() *DestinationRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftRecord) DeepCopyInto(out *DriftRecord) {
+	*out = *in
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	if in.ResolvedAt != nil {
+		in, out := &in.ResolvedAt, &out.ResolvedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftRecord.
+func (in *DriftRecord) DeepCopy() *DriftRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DuckTypeGenerator) DeepCopyInto(out *DuckTypeGenerator) {
 	*out = *in
@@ -2053,6 +2307,10 @@ func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2119,6 +2377,29 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookLogs) DeepCopyInto(out *HookLogs) {
+	*out = *in
+	in.OperationStartedAt.DeepCopyInto(&out.OperationStartedAt)
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerLogs, len(*in))
+		copy(*out, *in)
+	}
+	in.CapturedAt.DeepCopyInto(&out.CapturedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookLogs.
+func (in *HookLogs) DeepCopy() *HookLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(HookLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -2477,6 +2758,24 @@ func (in *ListGenerator) DeepCopy() *ListGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceStatus) DeepCopyInto(out *MaintenanceStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceStatus.
+func (in *MaintenanceStatus) DeepCopy() *MaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedNamespaceMetadata) DeepCopyInto(out *ManagedNamespaceMetadata) {
 	*out = *in
@@ -2507,6 +2806,27 @@ func (in *ManagedNamespaceMetadata) DeepCopy() *ManagedNamespaceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestPolicy) DeepCopyInto(out *ManifestPolicy) {
+	*out = *in
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]v1.GroupKind, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestPolicy.
+func (in *ManifestPolicy) DeepCopy() *ManifestPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MatrixGenerator) DeepCopyInto(out *MatrixGenerator) {
 	*out = *in
@@ -2661,6 +2981,22 @@ func (in *OperationInitiator) DeepCopy() *OperationInitiator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationQueuePolicy) DeepCopyInto(out *OperationQueuePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationQueuePolicy.
+func (in *OperationQueuePolicy) DeepCopy() *OperationQueuePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationQueuePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationState) DeepCopyInto(out *OperationState) {
 	*out = *in
@@ -2675,6 +3011,20 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.RetryAttempts != nil {
+		in, out := &in.RetryAttempts, &out.RetryAttempts
+		*out = make([]RetryAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]DestinationOperationState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2732,6 +3082,22 @@ func (in *OptionalMap) DeepCopy() *OptionalMap {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResourceAction) DeepCopyInto(out *OrphanedResourceAction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResourceAction.
+func (in *OrphanedResourceAction) DeepCopy() *OrphanedResourceAction {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedResourceAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrphanedResourceKey) DeepCopyInto(out *OrphanedResourceKey) {
 	*out = *in
@@ -2748,6 +3114,24 @@ func (in *OrphanedResourceKey) DeepCopy() *OrphanedResourceKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResourceStatus) DeepCopyInto(out *OrphanedResourceStatus) {
+	*out = *in
+	in.OrphanedSince.DeepCopyInto(&out.OrphanedSince)
+	in.ScheduledAt.DeepCopyInto(&out.ScheduledAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResourceStatus.
+func (in *OrphanedResourceStatus) DeepCopy() *OrphanedResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrphanedResourcesMonitorSettings) DeepCopyInto(out *OrphanedResourcesMonitorSettings) {
 	*out = *in
@@ -2761,6 +3145,11 @@ func (in *OrphanedResourcesMonitorSettings) DeepCopyInto(out *OrphanedResourcesM
 		*out = make([]OrphanedResourceKey, len(*in))
 		copy(*out, *in)
 	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]OrphanedResourceAction, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3159,6 +3548,24 @@ func (in *PullRequestGeneratorGithub) DeepCopy() *PullRequestGeneratorGithub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuedOperation) DeepCopyInto(out *QueuedOperation) {
+	*out = *in
+	in.Operation.DeepCopyInto(&out.Operation)
+	in.QueuedAt.DeepCopyInto(&out.QueuedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuedOperation.
+func (in *QueuedOperation) DeepCopy() *QueuedOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuedOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RefTarget) DeepCopyInto(out *RefTarget) {
 	*out = *in
@@ -3555,7 +3962,7 @@ func (in *ResourceNode) DeepCopyInto(out *ResourceNode) {
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(HealthStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.CreatedAt != nil {
 		in, out := &in.CreatedAt, &out.CreatedAt
@@ -3675,7 +4082,7 @@ func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(HealthStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -3690,6 +4097,23 @@ func (in *ResourceStatus) DeepCopy() *ResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryAttempt) DeepCopyInto(out *RetryAttempt) {
+	*out = *in
+	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryAttempt.
+func (in *RetryAttempt) DeepCopy() *RetryAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 	*out = *in
@@ -3755,6 +4179,14 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 		copy(*out, *in)
 	}
 	out.InitiatedBy = in.InitiatedBy
+	if in.HealthyAt != nil {
+		in, out := &in.HealthyAt, &out.HealthyAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CommittedAt != nil {
+		in, out := &in.CommittedAt, &out.CommittedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3790,6 +4222,28 @@ func (in *RevisionMetadata) DeepCopy() *RevisionMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackStatus) DeepCopyInto(out *RollbackStatus) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.RolledBackAt.DeepCopyInto(&out.RolledBackAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackStatus.
+func (in *RollbackStatus) DeepCopy() *RollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SCMProviderGenerator) DeepCopyInto(out *SCMProviderGenerator) {
 	*out = *in
@@ -4092,6 +4546,23 @@ func (in *SignatureKey) DeepCopy() *SignatureKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusTransition) DeepCopyInto(out *StatusTransition) {
+	*out = *in
+	in.TransitionedAt.DeepCopyInto(&out.TransitionedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusTransition.
+func (in *StatusTransition) DeepCopy() *StatusTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -4198,6 +4669,15 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]SyncWaveResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.CurrentWaveStartedAt != nil {
+		in, out := &in.CurrentWaveStartedAt, &out.CurrentWaveStartedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -4231,13 +4711,67 @@ func (in SyncOptions) DeepCopy() SyncOptions {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPlan) DeepCopyInto(out *SyncPlan) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]SyncPlanResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPlan.
+func (in *SyncPlan) DeepCopy() *SyncPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPlanResource) DeepCopyInto(out *SyncPlanResource) {
+	*out = *in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPlanResource.
+func (in *SyncPlanResource) DeepCopy() *SyncPlanResource {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPlanResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 	*out = *in
 	if in.Automated != nil {
 		in, out := &in.Automated, &out.Automated
 		*out = new(SyncPolicyAutomated)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.SyncOptions != nil {
 		in, out := &in.SyncOptions, &out.SyncOptions
@@ -4254,6 +4788,16 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SyncWaves != nil {
+		in, out := &in.SyncWaves, &out.SyncWaves
+		*out = new(SyncWavesStrategy)
+		**out = **in
+	}
+	if in.OperationQueue != nil {
+		in, out := &in.OperationQueue, &out.OperationQueue
+		*out = new(OperationQueuePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -4270,6 +4814,11 @@ func (in *SyncPolicy) DeepCopy() *SyncPolicy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(AutomatedRollback)
+		**out = **in
+	}
 	return
 }
 
@@ -4364,6 +4913,38 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWaveResult) DeepCopyInto(out *SyncWaveResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWaveResult.
+func (in *SyncWaveResult) DeepCopy() *SyncWaveResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWaveResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWavesStrategy) DeepCopyInto(out *SyncWavesStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWavesStrategy.
+func (in *SyncWavesStrategy) DeepCopy() *SyncWavesStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWavesStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 	*out = *in
@@ -1897,11 +1897,15 @@ func mkTempParameters(source string) string {
 }
 
 // Simple wrapper run a test with a temporary copy of the testdata, because
-// the test would modify the data when run.
+// the test would modify the data when run. The copy is removed even if the
+// test fails.
 func runWithTempTestdata(t *testing.T, path string, runner func(t *testing.T, path string)) {
+	t.Helper()
 	tempDir := mkTempParameters("./testdata/app-parameters")
+	t.Cleanup(func() {
+		os.RemoveAll(tempDir)
+	})
 	runner(t, filepath.Join(tempDir, "app-parameters", path))
-	os.RemoveAll(tempDir)
 }
 
 func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
@@ -453,9 +453,71 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, security.NamespaceNotPermittedError(a.Namespace)
 	}
 
+	sources := make([]appv1.ApplicationSource, 0)
+	if a.Spec.HasMultipleSources() {
+		appSpec := a.Spec.DeepCopy()
+		numOfSources := int64(len(a.Spec.GetSources()))
+		for i, pos := range q.SourcePositions {
+			if pos <= 0 || pos > numOfSources {
+				return nil, fmt.Errorf("source position is out of range")
+			}
+			appSpec.Sources[pos-1].TargetRevision = q.Revisions[i]
+		}
+		sources = appSpec.GetSources()
+	} else {
+		source := a.Spec.GetSource()
+		if q.GetRevision() != "" {
+			source.TargetRevision = q.GetRevision()
+		}
+		sources = append(sources, source)
+	}
+
+	manifestInfos, err := s.generateManifests(ctx, a, proj, sources)
+	if err != nil {
+		return nil, err
+	}
 	manifestInfosAppVersionsIdx := -1 // defines index of spec source to take app appVersion
+	for sIdx, source := range sources {
+		if source.Ref == "" {
+			manifestInfosAppVersionsIdx = sIdx
+			break
+		}
+	}
+
+	manifests := &apiclient.ManifestResponse{}
+	for _, manifestInfo := range manifestInfos {
+		for i, manifest := range manifestInfo.Manifests {
+			obj := &unstructured.Unstructured{}
+			err = json.Unmarshal([]byte(manifest.CompiledManifest), obj)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			}
+			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+				obj, _, err = diff.HideSecretData(obj, nil)
+				if err != nil {
+					return nil, fmt.Errorf("error hiding secret data: %w", err)
+				}
+				data, err := json.Marshal(obj)
+				if err != nil {
+					return nil, fmt.Errorf("error marshaling manifest: %w", err)
+				}
+				manifestInfo.Manifests[i].CompiledManifest = string(data)
+			}
+		}
+		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+	}
+	if manifestInfosAppVersionsIdx >= 0 && manifestInfos[manifestInfosAppVersionsIdx] != nil {
+		manifests.ApplicationVersions = manifestInfos[manifestInfosAppVersionsIdx].ApplicationVersions
+	}
+
+	return manifests, nil
+}
+
+// generateManifests generates the manifests of the given sources of an application, and returns the manifests of
+// each source
+func (s *Server) generateManifests(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, sources []appv1.ApplicationSource) ([]*apiclient.ManifestResponse, error) {
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
-	err = s.queryRepoServer(ctx, proj, func(
+	err := s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
@@ -478,32 +540,13 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("error getting API resources: %w", err)
 		}
 
-		sources := make([]appv1.ApplicationSource, 0)
-		appSpec := a.Spec.DeepCopy()
-		if a.Spec.HasMultipleSources() {
-			numOfSources := int64(len(a.Spec.GetSources()))
-			for i, pos := range q.SourcePositions {
-				if pos <= 0 || pos > numOfSources {
-					return fmt.Errorf("source position is out of range")
-				}
-				appSpec.Sources[pos-1].TargetRevision = q.Revisions[i]
-			}
-			sources = appSpec.GetSources()
-		} else {
-			source := a.Spec.GetSource()
-			if q.GetRevision() != "" {
-				source.TargetRevision = q.GetRevision()
-			}
-			sources = append(sources, source)
-		}
-
 		// Store the map of all sources having ref field into a map for applications with sources field
-		refSources, err := argo.GetRefSources(context.Background(), sources, appSpec.Project, s.db.GetRepository, []string{}, false)
+		refSources, err := argo.GetRefSources(context.Background(), sources, a.Spec.Project, s.db.GetRepository, []string{}, false)
 		if err != nil {
 			return fmt.Errorf("failed to get ref sources: %w", err)
 		}
 
-		for sIdx, source := range sources {
+		for _, source := range sources {
 			repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
 			if err != nil {
 				return fmt.Errorf("error getting repository: %w", err)
@@ -544,43 +587,13 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				return fmt.Errorf("error generating manifests: %w", err)
 			}
 			manifestInfos = append(manifestInfos, manifestInfo)
-			if source.Ref == "" && manifestInfosAppVersionsIdx < 0 {
-				manifestInfosAppVersionsIdx = sIdx
-			}
 		}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
-
-	manifests := &apiclient.ManifestResponse{}
-	for _, manifestInfo := range manifestInfos {
-		for i, manifest := range manifestInfo.Manifests {
-			obj := &unstructured.Unstructured{}
-			err = json.Unmarshal([]byte(manifest.CompiledManifest), obj)
-			if err != nil {
-				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
-			}
-			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-				obj, _, err = diff.HideSecretData(obj, nil)
-				if err != nil {
-					return nil, fmt.Errorf("error hiding secret data: %w", err)
-				}
-				data, err := json.Marshal(obj)
-				if err != nil {
-					return nil, fmt.Errorf("error marshaling manifest: %w", err)
-				}
-				manifestInfo.Manifests[i].CompiledManifest = string(data)
-			}
-		}
-		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
-	}
-	if manifestInfosAppVersionsIdx >= 0 && manifestInfos[manifestInfosAppVersionsIdx] != nil {
-		manifests.ApplicationVersions = manifestInfos[manifestInfosAppVersionsIdx].ApplicationVersions
-	}
-
-	return manifests, nil
+	return manifestInfos, nil
 }
 
 func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_GetManifestsWithFilesServer) error {
@@ -1259,7 +1272,13 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 		}
 	}
 
-	if err := argo.ValidateDestination(ctx, &app.Spec.Destination, s.db); err != nil {
+	if app.Spec.HasMultipleDestinations() {
+		for i := range app.Spec.Destinations {
+			if err := argo.ValidateDestination(ctx, &app.Spec.Destinations[i], s.db); err != nil {
+				return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
+			}
+		}
+	} else if err := argo.ValidateDestination(ctx, &app.Spec.Destination, s.db); err != nil {
 		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
 	}
 
@@ -1301,6 +1320,28 @@ func (s *Server) getApplicationClusterConfig(ctx context.Context, a *appv1.Appli
 	return config, err
 }
 
+// impersonateDestinationServiceAccount makes config modify the resources of the application on behalf of the service
+// account of its destination, if impersonation is enabled
+func (s *Server) impersonateDestinationServiceAccount(ctx context.Context, a *appv1.Application, config *rest.Config) error {
+	impersonationEnabled, err := s.settingsMgr.IsImpersonationEnabled()
+	if err != nil {
+		return fmt.Errorf("error getting impersonation settings: %w", err)
+	}
+	if !impersonationEnabled {
+		return nil
+	}
+	proj, err := s.getAppProject(ctx, a, log.WithField("application", a.Name))
+	if err != nil {
+		return err
+	}
+	serviceAccount, err := proj.GetDestinationServiceAccount(a.Spec.Destination, a.Namespace)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "error finding the service account to impersonate: %v", err)
+	}
+	config.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+	return nil
+}
+
 // getCachedAppState loads the cached state and trigger app refresh if cache is missing
 func (s *Server) getCachedAppState(ctx context.Context, a *appv1.Application, getFromCache func() error) error {
 	err := getFromCache()
@@ -1416,6 +1457,9 @@ func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationRe
 	if err != nil {
 		return nil, err
 	}
+	if err := s.impersonateDestinationServiceAccount(ctx, a, config); err != nil {
+		return nil, err
+	}
 
 	manifest, err := s.kubectl.PatchResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace, types.PatchType(q.GetPatchType()), []byte(q.GetPatch()))
 	if err != nil {
@@ -1459,6 +1503,9 @@ func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationR
 	if err != nil {
 		return nil, err
 	}
+	if err := s.impersonateDestinationServiceAccount(ctx, a, config); err != nil {
+		return nil, err
+	}
 	var deleteOption metav1.DeleteOptions
 	if q.GetOrphan() {
 		propagationPolicy := metav1.DeletePropagationOrphan
@@ -1664,6 +1711,109 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 	return res, nil
 }
 
+// ListDrift returns the drift history of the application resources matching the query, oldest first
+func (s *Server) ListDrift(ctx context.Context, q *application.ResourcesQuery) (*application.DriftHistoryResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return nil, err
+	}
+
+	var history []appv1.DriftRecord
+	// a cache miss means that no resource of the application drifted recently
+	if err := s.cache.GetAppDriftHistory(a.InstanceName(s.ns), &history); err != nil && !errors.Is(err, servercache.ErrCacheMiss) {
+		return nil, fmt.Errorf("error getting cached app drift history: %w", err)
+	}
+	res := &application.DriftHistoryResponse{}
+	for i := range history {
+		record := history[i]
+		if isMatchingResource(q, kube.ResourceKey{Name: record.Name, Namespace: record.Namespace, Kind: record.Kind, Group: record.Group}) {
+			res.Items = append(res.Items, &record)
+		}
+	}
+	return res, nil
+}
+
+// ListHookLogs returns the logs captured from the pods of the failed hooks of the last sync operation of the application
+func (s *Server) ListHookLogs(ctx context.Context, q *application.ResourcesQuery) (*application.HookLogsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return nil, err
+	}
+
+	// the logs are subject to the same RBAC as the pod logs
+	serverRBACLogEnforceEnable, err := s.settingsMgr.GetServerRBACLogEnforceEnable()
+	if err != nil {
+		return nil, fmt.Errorf("error getting RBAC log enforce enable: %w", err)
+	}
+	if serverRBACLogEnforceEnable {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceLogs, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+
+	var logs []appv1.HookLogs
+	// a cache miss means that no hook of the application failed recently
+	if err := s.cache.GetAppHookLogs(a.InstanceName(s.ns), &logs); err != nil && !errors.Is(err, servercache.ErrCacheMiss) {
+		return nil, fmt.Errorf("error getting cached app hook logs: %w", err)
+	}
+	res := &application.HookLogsResponse{}
+	for i := range logs {
+		hookLogs := logs[i]
+		if isMatchingResource(q, kube.ResourceKey{Name: hookLogs.Name, Namespace: hookLogs.Namespace, Kind: hookLogs.Kind, Group: hookLogs.Group}) {
+			res.Items = append(res.Items, &hookLogs)
+		}
+	}
+	return res, nil
+}
+
+// GetStatusTimeline returns the transitions of the health and sync status of the application, oldest first
+func (s *Server) GetStatusTimeline(ctx context.Context, q *application.ApplicationQuery) (*application.StatusTimelineResponse, error) {
+	project := ""
+	projects := getProjectsFromApplicationQuery(*q)
+	if len(projects) == 1 {
+		project = projects[0]
+	} else if len(projects) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "multiple projects specified - the timeline endpoint accepts either zero or one project")
+	}
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, project, q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var timeline []appv1.StatusTransition
+	// a cache miss means that the status of the application did not change recently
+	if err := s.cache.GetAppStatusTimeline(a.InstanceName(s.ns), &timeline); err != nil && !errors.Is(err, servercache.ErrCacheMiss) {
+		return nil, fmt.Errorf("error getting cached app status timeline: %w", err)
+	}
+	res := &application.StatusTimelineResponse{}
+	for i := range timeline {
+		res.Items = append(res.Items, &timeline[i])
+	}
+	return res, nil
+}
+
+// GetDORAMetrics returns the DORA metrics of the application over rolling windows, computed from its revision history
+func (s *Server) GetDORAMetrics(ctx context.Context, q *application.ApplicationQuery) (*application.DORAMetricsResponse, error) {
+	project := ""
+	projects := getProjectsFromApplicationQuery(*q)
+	if len(projects) == 1 {
+		project = projects[0]
+	} else if len(projects) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "multiple projects specified - the dora endpoint accepts either zero or one project")
+	}
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, project, q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &application.DORAMetricsResponse{}
+	for _, metrics := range argo.GetDORAMetrics([]*appv1.Application{a}, time.Now()) {
+		metrics := metrics
+		res.Items = append(res.Items, &metrics)
+	}
+	return res, nil
+}
+
 func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
 	if q.PodName != nil {
 		podKind := "Pod"
@@ -1939,6 +2089,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if syncReq.SyncOptions != nil {
 		syncOptions = syncReq.SyncOptions.Items
 	}
+	if _, err := (&appv1.SyncOperation{Timeout: syncReq.GetTimeout()}).GetTimeout(nil); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sync timeout '%s': %v", syncReq.GetTimeout(), err)
+	}
 
 	// We cannot use local manifests if we're only allowed to sync to signed commits
 	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
@@ -1964,6 +2117,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 			Manifests:    syncReq.Manifests,
 			Sources:      a.Spec.Sources,
 			Revisions:    sourceRevisions,
+			Timeout:      syncReq.GetTimeout(),
 		},
 		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 		Info:        syncReq.Infos,
@@ -1975,7 +2129,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	appName := syncReq.GetName()
 	appNs := s.appNamespaceOrDefault(syncReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
+	a, queued, err := argo.SetOrQueueAppOperation(appIf, appName, &op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
@@ -1992,6 +2146,10 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if syncReq.Manifests != nil {
 		reason = fmt.Sprintf("initiated %ssync locally", partial)
 	}
+	if queued != nil {
+		s.logAppEvent(a, ctx, argo.EventReasonOperationQueued, fmt.Sprintf("queued %s (%s)", strings.TrimPrefix(reason, "initiated "), queued.ID))
+		return a, nil
+	}
 	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, reason)
 	return a, nil
 }
@@ -2309,6 +2467,115 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
 }
 
+// GetOperationQueue returns the operations queued while an operation is in progress, in the order they will run
+func (s *Server) GetOperationQueue(ctx context.Context, q *application.ApplicationQuery) (*application.OperationQueueResponse, error) {
+	project := ""
+	projects := getProjectsFromApplicationQuery(*q)
+	if len(projects) == 1 {
+		project = projects[0]
+	} else if len(projects) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "multiple projects specified - the operation queue endpoint accepts either zero or one project")
+	}
+	// the queue changes with each sync request, so it is read from the api server rather than the informer
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionGet, project, s.appNamespaceOrDefault(q.GetAppNamespace()), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+	res := &application.OperationQueueResponse{}
+	for i := range a.Status.OperationQueue {
+		res.Items = append(res.Items, &a.Status.OperationQueue[i])
+	}
+	return res, nil
+}
+
+// CancelQueuedOperation removes an operation from the queue of an application
+func (s *Server) CancelQueuedOperation(ctx context.Context, q *application.ApplicationQueuedOperationRequest) (*appv1.Application, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionSync, q.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+	updated, err := argo.RemoveQueuedAppOperation(s.appclientset.ArgoprojV1alpha1().Applications(appNs), a.Name, q.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("error removing queued operation: %w", err)
+	}
+	s.waitSync(updated)
+	s.logAppEvent(updated, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("cancelled queued operation %s", q.GetId()))
+	return updated, nil
+}
+
+// defaultMaintenanceDuration is the duration of the maintenance mode when the request does not specify one
+const defaultMaintenanceDuration = 24 * time.Hour
+
+// EnableMaintenance puts an application in maintenance mode, suspending automated sync and self-heal until it expires
+func (s *Server) EnableMaintenance(ctx context.Context, q *application.ApplicationMaintenanceRequest) (*appv1.Application, error) {
+	duration := defaultMaintenanceDuration
+	if q.GetDuration() != "" {
+		var err error
+		duration, err = time.ParseDuration(q.GetDuration())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid maintenance duration %q: %v", q.GetDuration(), err)
+		}
+		if duration <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "maintenance duration must be positive")
+		}
+	}
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	now := metav1.Now()
+	maintenance := &appv1.MaintenanceStatus{
+		Reason:    q.GetReason(),
+		StartedBy: user,
+		StartedAt: now,
+		ExpiresAt: metav1.NewTime(now.Add(duration)),
+	}
+	action := fmt.Sprintf("enabled maintenance mode until %s", maintenance.ExpiresAt.UTC().Format(time.RFC3339))
+	if maintenance.Reason != "" {
+		action = fmt.Sprintf("%s: %s", action, maintenance.Reason)
+	}
+	return s.setMaintenance(ctx, q, maintenance, argo.EventReasonMaintenanceEnabled, action)
+}
+
+// DisableMaintenance ends the maintenance mode of an application
+func (s *Server) DisableMaintenance(ctx context.Context, q *application.ApplicationMaintenanceRequest) (*appv1.Application, error) {
+	return s.setMaintenance(ctx, q, nil, argo.EventReasonMaintenanceDisabled, "disabled maintenance mode")
+}
+
+func (s *Server) setMaintenance(ctx context.Context, q *application.ApplicationMaintenanceRequest, maintenance *appv1.MaintenanceStatus, reason string, action string) (*appv1.Application, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionUpdate, q.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < 10; i++ {
+		if maintenance == nil && a.Status.Maintenance == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "application %s is not in maintenance mode", appName)
+		}
+		a.Status.Maintenance = maintenance
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.waitSync(updated)
+			s.logAppEvent(a, ctx, reason, action)
+			return updated, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to update maintenance mode of app %q due to update conflict. retrying again...", appName)
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to update maintenance mode of app. Too many conflicts")
+}
+
 func (s *Server) logAppEvent(a *appv1.Application, ctx context.Context, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason}
 	user := session.Username(ctx)
@@ -2453,6 +2720,12 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		return nil, err
 	}
 
+	// the live object was read with the credentials of Argo CD, but the resources are modified on behalf of the
+	// service account of the destination
+	if err := s.impersonateDestinationServiceAccount(ctx, a, config); err != nil {
+		return nil, err
+	}
+
 	// First, make sure all the returned resources are permitted, for each operation.
 	// Also perform create with dry-runs for all create-operation resources.
 	// This is performed separately to reduce the risk of only some of the resources being successfully created later.
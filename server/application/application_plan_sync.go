@@ -0,0 +1,425 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"gomodules.xyz/jsonpatch/v2"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+
+	argocommon "github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
+	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
+	"github.com/argoproj/argo-cd/v2/util/manifestpolicy"
+	"github.com/argoproj/argo-cd/v2/util/security"
+)
+
+// syncPhaseOrder is the order in which the sync phases are run
+var syncPhaseOrder = map[common.SyncPhase]int{
+	common.SyncPhasePreSync:  -1,
+	common.SyncPhaseSync:     0,
+	common.SyncPhasePostSync: 1,
+	common.SyncPhaseSyncFail: 2,
+}
+
+// PlanSync returns the changes a sync of an application would perform, without changing the live resources. The
+// target resources are generated for the requested revisions, and the changes of the existing resources are predicted
+// by a server-side apply in dry-run mode.
+func (s *Server) PlanSync(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*appv1.SyncPlan, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, syncReq.GetProject(), syncReq.GetAppNamespace(), syncReq.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+
+	plan := &appv1.SyncPlan{Failures: s.getSyncFailures(ctx, a, proj, syncReq)}
+
+	// like for a dry-run, a revision can be planned even if auto-sync is set to another one
+	resolveReq := *syncReq
+	resolveReq.DryRun = ptr.To(true)
+	plan.Revision, _, plan.Revisions, _, err = s.resolveSourceRevisions(ctx, a, &resolveReq)
+	if err != nil {
+		return nil, err
+	}
+
+	targetObjs, err := s.getPlanTargetObjs(ctx, a, proj, syncReq, plan.Revision, plan.Revisions)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	// the dry runs of the plan are only permitted if the sync would be
+	if err := s.impersonateDestinationServiceAccount(ctx, a, config); err != nil {
+		return nil, err
+	}
+	apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+	if err != nil {
+		return nil, fmt.Errorf("error getting API resources: %w", err)
+	}
+	infoProvider := apiResourceInfoProvider(apiResources)
+	for _, obj := range targetObjs {
+		if !kube.IsNamespacedOrUnknown(infoProvider, obj.GroupVersionKind().GroupKind()) {
+			obj.SetNamespace("")
+		} else if obj.GetNamespace() == "" {
+			obj.SetNamespace(a.Spec.Destination.Namespace)
+		}
+	}
+
+	var syncResources []appv1.SyncOperationResource
+	for _, r := range syncReq.GetResources() {
+		if r != nil {
+			syncResources = append(syncResources, *r)
+		}
+	}
+	isSynced := func(obj *unstructured.Unstructured) bool {
+		return len(syncResources) == 0 || argo.ContainsSyncResource(obj.GetName(), obj.GetNamespace(), obj.GroupVersionKind(), syncResources)
+	}
+
+	var hookObjs, syncedObjs, liveObjs []*unstructured.Unstructured
+	targetKeys := map[kube.ResourceKey]bool{}
+	for _, obj := range targetObjs {
+		targetKeys[kube.GetResourceKey(obj)] = true
+		if !isSynced(obj) {
+			continue
+		}
+		if hook.IsHook(obj) {
+			hookObjs = append(hookObjs, obj)
+			continue
+		}
+		if hook.Skip(obj) {
+			continue
+		}
+		live, err := s.kubectl.GetResource(ctx, config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace())
+		if err != nil && !apierr.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting live resource %s: %w", kube.GetResourceKey(obj), err)
+		}
+		syncedObjs = append(syncedObjs, obj)
+		liveObjs = append(liveObjs, live)
+	}
+
+	var managedResources []*appv1.ResourceDiff
+	err = s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &managedResources)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting cached app managed resources: %w", err)
+	}
+	var pruneObjs []*unstructured.Unstructured
+	for _, res := range managedResources {
+		if res.Hook || res.LiveState == "" || res.LiveState == "null" {
+			continue
+		}
+		live, err := appv1.UnmarshalToUnstructured(res.LiveState)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling live state of %s: %w", res.FullName(), err)
+		}
+		if live != nil && !targetKeys[kube.GetResourceKey(live)] && isSynced(live) {
+			pruneObjs = append(pruneObjs, live)
+		}
+	}
+
+	diffConfig, cleanup, err := s.getPlanDiffConfig(a, config)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	var syncOptions appv1.SyncOptions
+	if a.Spec.SyncPolicy != nil {
+		syncOptions = a.Spec.SyncPolicy.SyncOptions
+	}
+	if syncReq.SyncOptions != nil {
+		syncOptions = syncReq.SyncOptions.Items
+	}
+	plan.Resources, err = planResources(syncedObjs, liveObjs, hookObjs, pruneObjs, syncReq.GetPrune(), syncOptions.HasOption(common.SyncOptionReplace), diffConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	failures := resourcePolicyFailures(proj, targetObjs)
+	for i := range plan.Resources {
+		res := &plan.Resources[i]
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(schema.GroupVersionKind{Group: res.Group, Version: res.Version, Kind: res.Kind})
+		obj.SetNamespace(res.Namespace)
+		obj.SetName(res.Name)
+		if err := s.verifyResourcePermitted(ctx, a, proj, obj); err != nil {
+			res.Failures = append(res.Failures, err.Error())
+		}
+		res.Failures = append(res.Failures, failures[kube.GetResourceKey(obj)]...)
+	}
+	return plan, nil
+}
+
+// getSyncFailures returns the reasons why a sync of the application would be rejected, which the Sync API returns as
+// errors
+func (s *Server) getSyncFailures(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, syncReq *application.ApplicationSyncRequest) []string {
+	var failures []string
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, a.RBACName(s.ns)); err != nil {
+		failures = append(failures, status.Convert(err).Message())
+	}
+	if syncReq.Manifests != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionOverride, a.RBACName(s.ns)); err != nil {
+			failures = append(failures, status.Convert(err).Message())
+		}
+		if len(proj.Spec.SignatureKeys) > 0 {
+			failures = append(failures, "cannot use local sync when signature keys are required")
+		}
+	}
+	if !proj.Spec.SyncWindows.Matches(a).CanSync(true) {
+		failures = append(failures, "cannot sync: blocked by sync window")
+	}
+	if a.DeletionTimestamp != nil {
+		failures = append(failures, "application is deleting")
+	}
+	return failures
+}
+
+// getPlanTargetObjs returns the target resources of a sync plan, either from the local manifests of the request or
+// generated from the given revisions
+func (s *Server) getPlanTargetObjs(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, syncReq *application.ApplicationSyncRequest, revision string, revisions []string) ([]*unstructured.Unstructured, error) {
+	var manifests []string
+	if syncReq.Manifests != nil {
+		manifests = syncReq.Manifests
+	} else {
+		var sources []appv1.ApplicationSource
+		if a.Spec.HasMultipleSources() {
+			sources = a.Spec.DeepCopy().GetSources()
+			for i := range sources {
+				sources[i].TargetRevision = revisions[i]
+			}
+		} else {
+			source := a.Spec.GetSource()
+			source.TargetRevision = revision
+			sources = append(sources, source)
+		}
+		manifestInfos, err := s.generateManifests(ctx, a, proj, sources)
+		if err != nil {
+			return nil, err
+		}
+		for _, manifestInfo := range manifestInfos {
+			for _, manifest := range manifestInfo.Manifests {
+				manifests = append(manifests, manifest.CompiledManifest)
+			}
+		}
+	}
+	targetObjs := make([]*unstructured.Unstructured, 0, len(manifests))
+	for _, manifest := range manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+		}
+		if obj != nil {
+			targetObjs = append(targetObjs, obj)
+		}
+	}
+	return targetObjs, nil
+}
+
+// getPlanDiffConfig returns the configuration of the server-side diff predicting the changes of the live resources.
+// Unlike the diff of the application controller, the predicted state includes the changes of the mutating webhooks,
+// since they would be applied by the sync.
+func (s *Server) getPlanDiffConfig(a *appv1.Application, config *rest.Config) (argodiff.DiffConfig, func(), error) {
+	appLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting app instance label key from settings: %w", err)
+	}
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+	compareOptions, err := s.settingsMgr.GetResourceCompareOptions()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting resource compare options: %w", err)
+	}
+	resourceOps, cleanup, err := s.kubectl.ManageResources(config, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating resource operations: %w", err)
+	}
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(a.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, normalizers.IgnoreNormalizerOpts{}).
+		WithTracking(appLabelKey, string(argo.GetTrackingMethod(s.settingsMgr))).
+		WithNoCache().
+		WithManager(argocommon.ArgoCDSSAManager).
+		WithServerSideDiff(true).
+		WithServerSideDryRunner(diff.NewK8sServerSideDryRunner(resourceOps)).
+		WithIgnoreMutationWebhook(false).
+		Build()
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("error building diff config: %w", err)
+	}
+	return diffConfig, cleanup, nil
+}
+
+// planResources returns the planned changes of the synced resources, whose live states are at the same index in
+// liveObjs, of the hooks and of the resources to prune, in the order in which they would be synced
+func planResources(targetObjs, liveObjs, hookObjs, pruneObjs []*unstructured.Unstructured, prune, replace bool, diffConfig argodiff.DiffConfig) ([]appv1.SyncPlanResource, error) {
+	var resources []appv1.SyncPlanResource
+
+	diffResults, err := argodiff.StateDiffs(liveObjs, targetObjs, diffConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error diffing live and target resources: %w", err)
+	}
+	for i, obj := range targetObjs {
+		res := newSyncPlanResource(obj, common.SyncPhaseSync)
+		diffResult := diffResults.Diffs[i]
+		switch {
+		case liveObjs[i] == nil:
+			res.Action = appv1.SyncPlanActionCreate
+		case !diffResult.Modified:
+			res.Action = appv1.SyncPlanActionNone
+		case replace || resourceutil.HasAnnotationOption(obj, common.AnnotationSyncOptions, common.SyncOptionReplace):
+			res.Action = appv1.SyncPlanActionReplace
+		default:
+			res.Action = appv1.SyncPlanActionUpdate
+		}
+		if res.Action != appv1.SyncPlanActionNone {
+			res.Patch, err = predictedPatch(obj, diffResult)
+			if err != nil {
+				return nil, fmt.Errorf("error computing the patch of %s: %w", res.FullName(), err)
+			}
+		}
+		resources = append(resources, res)
+	}
+
+	for _, obj := range hookObjs {
+		for _, hookType := range hook.Types(obj) {
+			phase := common.SyncPhase(hookType)
+			if _, ok := syncPhaseOrder[phase]; !ok {
+				continue
+			}
+			res := newSyncPlanResource(obj, phase)
+			res.Action = appv1.SyncPlanActionHook
+			res.HookType = hookType
+			resources = append(resources, res)
+		}
+	}
+
+	for _, obj := range pruneObjs {
+		res := newSyncPlanResource(obj, common.SyncPhaseSync)
+		res.Action = appv1.SyncPlanActionPrune
+		switch {
+		case resourceutil.HasAnnotationOption(obj, common.AnnotationSyncOptions, common.SyncOptionDisablePrune):
+			res.Message = "ignored (no prune)"
+		case !prune:
+			res.Message = "ignored (requires pruning)"
+		}
+		resources = append(resources, res)
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		if d := syncPhaseOrder[a.SyncPhase] - syncPhaseOrder[b.SyncPhase]; d != 0 {
+			return d < 0
+		}
+		if a.SyncWave != b.SyncWave {
+			return a.SyncWave < b.SyncWave
+		}
+		return a.FullName() < b.FullName()
+	})
+	return resources, nil
+}
+
+func newSyncPlanResource(obj *unstructured.Unstructured, phase common.SyncPhase) appv1.SyncPlanResource {
+	gvk := obj.GroupVersionKind()
+	name := obj.GetName()
+	if name == "" {
+		name = obj.GetGenerateName()
+	}
+	return appv1.SyncPlanResource{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      name,
+		SyncPhase: phase,
+		SyncWave:  int64(syncwaves.Wave(obj)),
+	}
+}
+
+// predictedPatch returns the JSON patch from the normalized live state to the predicted live state of a resource. The
+// data of secrets is masked.
+func predictedPatch(obj *unstructured.Unstructured, diffResult diff.DiffResult) (string, error) {
+	live := []byte("{}")
+	if string(diffResult.NormalizedLive) != "null" {
+		live = diffResult.NormalizedLive
+	}
+	predicted := diffResult.PredictedLive
+	if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+		var liveObj, predictedObj *unstructured.Unstructured
+		if err := json.Unmarshal(live, &liveObj); err != nil {
+			return "", err
+		}
+		if err := json.Unmarshal(predicted, &predictedObj); err != nil {
+			return "", err
+		}
+		predictedObj, liveObj, err := diff.HideSecretData(predictedObj, liveObj)
+		if err != nil {
+			return "", err
+		}
+		if live, err = json.Marshal(liveObj); err != nil {
+			return "", err
+		}
+		if predicted, err = json.Marshal(predictedObj); err != nil {
+			return "", err
+		}
+	}
+	operations, err := jsonpatch.CreatePatch(live, predicted)
+	if err != nil {
+		return "", err
+	}
+	sort.Sort(jsonpatch.ByPath(operations))
+	patch, err := json.Marshal(operations)
+	if err != nil {
+		return "", err
+	}
+	return string(patch), nil
+}
+
+// resourcePolicyFailures returns the violations of the manifest policies of the project which would prevent the sync
+// of each target resource
+func resourcePolicyFailures(proj *appv1.AppProject, targetObjs []*unstructured.Unstructured) map[kube.ResourceKey][]string {
+	failures := map[kube.ResourceKey][]string{}
+	for _, violation := range manifestpolicy.Evaluate(proj.Spec.ManifestPolicies, targetObjs) {
+		if violation.Severity == appv1.ManifestPolicySeverityDeny {
+			failures[violation.Resource] = append(failures[violation.Resource], violation.String())
+		}
+	}
+	return failures
+}
+
+// apiResourceInfoProvider tells whether resources are namespaced from the API resources of a cluster
+type apiResourceInfoProvider []kube.APIResourceInfo
+
+func (p apiResourceInfoProvider) IsNamespaced(gk schema.GroupKind) (bool, error) {
+	for _, res := range p {
+		if res.GroupKind == gk {
+			return res.Meta.Namespaced, nil
+		}
+	}
+	return false, fmt.Errorf("resource %s is not found in the API resources of the cluster", gk)
+}
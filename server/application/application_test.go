@@ -55,6 +55,8 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
+	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v2/util/assets"
 	"github.com/argoproj/argo-cd/v2/util/cache"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
@@ -948,6 +950,61 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.Equal(t, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", err.Error(), "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("ListDrift", func(t *testing.T) {
+		_, err := appServer.ListDrift(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		require.NoError(t, err)
+		_, err = appServer.ListDrift(noRoleCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ListDrift(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ListDrift(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist"), Project: ptr.To("test")})
+		assert.Equal(t, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", err.Error(), "when the request specifies a project, we can return the standard k8s error message")
+	})
+
+	t.Run("ListHookLogs", func(t *testing.T) {
+		_, err := appServer.ListHookLogs(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		require.NoError(t, err)
+		_, err = appServer.ListHookLogs(noRoleCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ListHookLogs(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ListHookLogs(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist"), Project: ptr.To("test")})
+		assert.Equal(t, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", err.Error(), "when the request specifies a project, we can return the standard k8s error message")
+	})
+
+	t.Run("GetStatusTimeline", func(t *testing.T) {
+		_, err := appServer.GetStatusTimeline(adminCtx, &application.ApplicationQuery{Name: ptr.To("test")})
+		require.NoError(t, err)
+		_, err = appServer.GetStatusTimeline(noRoleCtx, &application.ApplicationQuery{Name: ptr.To("test")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetStatusTimeline(adminCtx, &application.ApplicationQuery{Name: ptr.To("doest-not-exist")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetStatusTimeline(adminCtx, &application.ApplicationQuery{Name: ptr.To("doest-not-exist"), Project: []string{"test"}})
+		assert.Equal(t, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", err.Error(), "when the request specifies a project, we can return the standard k8s error message")
+	})
+
+	t.Run("GetDORAMetrics", func(t *testing.T) {
+		_, err := appServer.GetDORAMetrics(adminCtx, &application.ApplicationQuery{Name: ptr.To("test")})
+		require.NoError(t, err)
+		_, err = appServer.GetDORAMetrics(noRoleCtx, &application.ApplicationQuery{Name: ptr.To("test")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetDORAMetrics(adminCtx, &application.ApplicationQuery{Name: ptr.To("doest-not-exist")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetDORAMetrics(adminCtx, &application.ApplicationQuery{Name: ptr.To("doest-not-exist"), Project: []string{"test"}})
+		assert.Equal(t, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", err.Error(), "when the request specifies a project, we can return the standard k8s error message")
+	})
+
+	t.Run("GetOperationQueue", func(t *testing.T) {
+		_, err := appServer.GetOperationQueue(adminCtx, &application.ApplicationQuery{Name: ptr.To("test")})
+		require.NoError(t, err)
+		_, err = appServer.GetOperationQueue(noRoleCtx, &application.ApplicationQuery{Name: ptr.To("test")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetOperationQueue(adminCtx, &application.ApplicationQuery{Name: ptr.To("doest-not-exist")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetOperationQueue(adminCtx, &application.ApplicationQuery{Name: ptr.To("doest-not-exist"), Project: []string{"test"}})
+		assert.Equal(t, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", err.Error(), "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("Sync", func(t *testing.T) {
 		_, err := appServer.Sync(adminCtx, &application.ApplicationSyncRequest{Name: ptr.To("test")})
 		require.NoError(t, err)
@@ -1762,6 +1819,46 @@ p, test-user, applications, update/fake.io/PodTest/*, default/test-app, deny
 	})
 }
 
+func TestImpersonateDestinationServiceAccount(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	proj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "impersonated", Namespace: "default"},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:                []string{"*"},
+			Destinations:               []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			DestinationServiceAccounts: []appsv1.ApplicationDestinationServiceAccount{{Server: "*", Namespace: "guestbook", DefaultServiceAccount: "deployer"}},
+		},
+	}
+	testApp := newTestApp()
+	testApp.Spec.Project = proj.Name
+	testApp.Spec.Destination.Namespace = "guestbook"
+
+	t.Run("Disabled", func(t *testing.T) {
+		appServer := newTestAppServerWithEnforcerConfigure(f, t, map[string]string{}, proj, testApp)
+		config := &rest.Config{}
+		require.NoError(t, appServer.impersonateDestinationServiceAccount(context.Background(), testApp, config))
+		assert.Empty(t, config.Impersonate.UserName)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		appServer := newTestAppServerWithEnforcerConfigure(f, t, map[string]string{"application.sync.impersonation.enabled": "true"}, proj, testApp)
+		config := &rest.Config{}
+		require.NoError(t, appServer.impersonateDestinationServiceAccount(context.Background(), testApp, config))
+		assert.Equal(t, "system:serviceaccount:guestbook:deployer", config.Impersonate.UserName)
+	})
+
+	t.Run("NoMatchingServiceAccount", func(t *testing.T) {
+		appServer := newTestAppServerWithEnforcerConfigure(f, t, map[string]string{"application.sync.impersonation.enabled": "true"}, proj, testApp)
+		otherApp := testApp.DeepCopy()
+		otherApp.Spec.Destination.Namespace = "other"
+		err := appServer.impersonateDestinationServiceAccount(context.Background(), otherApp, &rest.Config{})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
 func TestSyncAndTerminate(t *testing.T) {
 	ctx := context.Background()
 	appServer := newTestAppServer(t)
@@ -1802,6 +1899,81 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, synccommon.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestMaintenance(t *testing.T) {
+	ctx := context.Background()
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	t.Run("InvalidDuration", func(t *testing.T) {
+		_, err := appServer.EnableMaintenance(ctx, &application.ApplicationMaintenanceRequest{Name: &testApp.Name, Duration: ptr.To("-1h")})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DisableWhenNotInMaintenance", func(t *testing.T) {
+		_, err := appServer.DisableMaintenance(ctx, &application.ApplicationMaintenanceRequest{Name: &testApp.Name})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("EnableAndDisable", func(t *testing.T) {
+		before := time.Now()
+		app, err := appServer.EnableMaintenance(ctx, &application.ApplicationMaintenanceRequest{Name: &testApp.Name, Reason: ptr.To("database migration"), Duration: ptr.To("2h")})
+		require.NoError(t, err)
+		require.NotNil(t, app.Status.Maintenance)
+		assert.Equal(t, "database migration", app.Status.Maintenance.Reason)
+		assert.True(t, app.Status.Maintenance.IsActive(before.Add(time.Hour)))
+		assert.False(t, app.Status.Maintenance.IsActive(before.Add(3*time.Hour)))
+
+		events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		require.NotEmpty(t, events.Items)
+		event := events.Items[len(events.Items)-1]
+		assert.Equal(t, argo.EventReasonMaintenanceEnabled, event.Reason)
+		assert.Contains(t, event.Message, "database migration")
+
+		app, err = appServer.DisableMaintenance(ctx, &application.ApplicationMaintenanceRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Nil(t, app.Status.Maintenance)
+	})
+}
+
+func TestOperationQueue(t *testing.T) {
+	ctx := context.Background()
+	testApp := newTestApp()
+	testApp.Spec.SyncPolicy = &appsv1.SyncPolicy{OperationQueue: &appsv1.OperationQueuePolicy{MaxLength: 1}}
+	testApp.Operation = &appsv1.Operation{Sync: &appsv1.SyncOperation{Revision: "aaa"}}
+	appServer := newTestAppServer(t, testApp)
+
+	app, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, "aaa", app.Operation.Sync.Revision)
+	require.Len(t, app.Status.OperationQueue, 1)
+	id := app.Status.OperationQueue[0].ID
+
+	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.NotEmpty(t, events.Items)
+	assert.Equal(t, argo.EventReasonOperationQueued, events.Items[len(events.Items)-1].Reason)
+
+	// an equivalent request is not queued twice
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Len(t, app.Status.OperationQueue, 1)
+
+	queue, err := appServer.GetOperationQueue(ctx, &application.ApplicationQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, queue.Items, 1)
+	assert.Equal(t, id, queue.Items[0].ID)
+
+	app, err = appServer.CancelQueuedOperation(ctx, &application.ApplicationQueuedOperationRequest{Name: &testApp.Name, Id: &id})
+	require.NoError(t, err)
+	assert.Empty(t, app.Status.OperationQueue)
+
+	_, err = appServer.CancelQueuedOperation(ctx, &application.ApplicationQueuedOperationRequest{Name: &testApp.Name, Id: &id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
 func TestSyncHelm(t *testing.T) {
 	ctx := context.Background()
 	appServer := newTestAppServer(t)
@@ -2401,6 +2573,229 @@ func TestInferResourcesStatusHealth(t *testing.T) {
 	assert.Nil(t, testApp.Status.Resources[1].Health)
 }
 
+func TestListDrift(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+
+	// no drift recorded
+	res, err := appServer.ListDrift(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	assert.Empty(t, res.Items)
+
+	resolvedAt := metav1.Now()
+	require.NoError(t, appStateCache.SetAppDriftHistory(testApp.InstanceName(appServer.ns), []appsv1.DriftRecord{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Fields: []string{"spec.replicas"}, Manager: "kubectl-edit", ResolvedAt: &resolvedAt},
+		{Kind: "ConfigMap", Namespace: "default", Name: "guestbook", Deleted: true},
+	}))
+
+	res, err = appServer.ListDrift(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	assert.Len(t, res.Items, 2)
+
+	res, err = appServer.ListDrift(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name, Kind: ptr.To("Deployment")})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, []string{"spec.replicas"}, res.Items[0].Fields)
+	assert.Equal(t, "kubectl-edit", res.Items[0].Manager)
+}
+
+func TestPlanSync(t *testing.T) {
+	policyProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy-proj", Namespace: testNamespace},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			ManifestPolicies: []appsv1.ManifestPolicy{{
+				Name:       "labelled",
+				Kinds:      []metav1.GroupKind{{Kind: "ConfigMap"}},
+				Expression: "has(object.metadata.labels) && 'team' in object.metadata.labels",
+				Message:    "ConfigMaps must have a team label",
+			}},
+		},
+	}
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.Spec.Project = policyProj.Name
+	})
+	orphan := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"orphan","namespace":"` + test.FakeDestNamespace + `"}}`
+	manifests := []string{
+		`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"},"data":{"foo":"bar"}}`,
+		`{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"migrate","annotations":{"argocd.argoproj.io/hook":"PreSync"}}}`,
+	}
+
+	newAppServer := func(t *testing.T, role string) *Server {
+		t.Helper()
+		appServer := newTestAppServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole(role)
+		}, t, map[string]string{}, testApp, policyProj)
+		appStateCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute)
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+		require.NoError(t, appStateCache.SetAppManagedResources(testApp.InstanceName(appServer.ns), []*appsv1.ResourceDiff{
+			{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "orphan", TargetState: "null", LiveState: orphan},
+		}))
+		return appServer
+	}
+
+	t.Run("Resources", func(t *testing.T) {
+		appServer := newAppServer(t, "role:admin")
+		plan, err := appServer.PlanSync(context.Background(), &application.ApplicationSyncRequest{Name: &testApp.Name, Manifests: manifests})
+		require.NoError(t, err)
+
+		assert.Empty(t, plan.Failures)
+		require.Len(t, plan.Resources, 3)
+
+		assert.Equal(t, "migrate", plan.Resources[0].Name)
+		assert.Equal(t, appsv1.SyncPlanActionHook, plan.Resources[0].Action)
+		assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhasePreSync), plan.Resources[0].SyncPhase)
+		assert.Equal(t, synccommon.HookTypePreSync, plan.Resources[0].HookType)
+
+		assert.Equal(t, "config", plan.Resources[1].Name)
+		assert.Equal(t, test.FakeDestNamespace, plan.Resources[1].Namespace)
+		assert.Equal(t, appsv1.SyncPlanActionCreate, plan.Resources[1].Action)
+		assert.Contains(t, plan.Resources[1].Patch, `{"op":"add","path":"/data","value":{"foo":"bar"}}`)
+		assert.Equal(t, []string{"/ConfigMap " + test.FakeDestNamespace + "/config violates policy 'labelled': ConfigMaps must have a team label"}, plan.Resources[1].Failures)
+
+		assert.Equal(t, "orphan", plan.Resources[2].Name)
+		assert.Equal(t, appsv1.SyncPlanActionPrune, plan.Resources[2].Action)
+		assert.Equal(t, "ignored (requires pruning)", plan.Resources[2].Message)
+		assert.False(t, plan.IsPermitted())
+	})
+
+	t.Run("SelectedResources", func(t *testing.T) {
+		appServer := newAppServer(t, "role:admin")
+		plan, err := appServer.PlanSync(context.Background(), &application.ApplicationSyncRequest{
+			Name:      &testApp.Name,
+			Manifests: manifests,
+			Prune:     ptr.To(true),
+			Resources: []*appsv1.SyncOperationResource{{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "orphan"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, plan.Resources, 1)
+		assert.Equal(t, appsv1.SyncPlanActionPrune, plan.Resources[0].Action)
+		assert.Empty(t, plan.Resources[0].Message)
+		assert.True(t, plan.IsPermitted())
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		appServer := newAppServer(t, "role:readonly")
+		plan, err := appServer.PlanSync(context.Background(), &application.ApplicationSyncRequest{Name: &testApp.Name, Manifests: manifests})
+		require.NoError(t, err)
+		require.Len(t, plan.Failures, 2)
+		assert.Contains(t, plan.Failures[0], "permission denied: applications, sync")
+		assert.Contains(t, plan.Failures[1], "permission denied: applications, override")
+	})
+
+	t.Run("Impersonation", func(t *testing.T) {
+		// the project has no service account for the destination of the application
+		appServer := newTestAppServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:admin")
+		}, t, map[string]string{"application.sync.impersonation.enabled": "true"}, testApp, policyProj)
+		_, err := appServer.PlanSync(context.Background(), &application.ApplicationSyncRequest{Name: &testApp.Name, Manifests: manifests})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+// fakeServerSideDryRunner returns the predicted live state of the resources by name
+type fakeServerSideDryRunner map[string]string
+
+func (r fakeServerSideDryRunner) Run(_ context.Context, obj *unstructured.Unstructured, _ string) (string, error) {
+	return r[obj.GetName()], nil
+}
+
+func TestPlanResources(t *testing.T) {
+	newObj := func(manifest string) *unstructured.Unstructured {
+		obj, err := appsv1.UnmarshalToUnstructured(manifest)
+		require.NoError(t, err)
+		return obj
+	}
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(nil, nil, false, normalizers.IgnoreNormalizerOpts{}).
+		WithTracking(common.LabelKeyAppInstance, string(argo.TrackingMethodLabel)).
+		WithNoCache().
+		WithServerSideDiff(true).
+		WithServerSideDryRunner(fakeServerSideDryRunner{
+			"changed":   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"changed","namespace":"default"},"data":{"foo":"baz"}}`,
+			"unchanged": `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unchanged","namespace":"default"},"data":{"foo":"bar"}}`,
+			"secret":    `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret","namespace":"default"},"data":{"password":"bmV3"}}`,
+		}).
+		WithIgnoreMutationWebhook(false).
+		Build()
+	require.NoError(t, err)
+
+	targets := []*unstructured.Unstructured{
+		newObj(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"changed","namespace":"default","annotations":{"argocd.argoproj.io/sync-wave":"1"}},"data":{"foo":"baz"}}`),
+		newObj(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unchanged","namespace":"default"},"data":{"foo":"bar"}}`),
+		newObj(`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret","namespace":"default","annotations":{"argocd.argoproj.io/sync-options":"Replace=true"}},"data":{"password":"bmV3"}}`),
+	}
+	lives := []*unstructured.Unstructured{
+		newObj(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"changed","namespace":"default"},"data":{"foo":"bar"}}`),
+		newObj(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unchanged","namespace":"default"},"data":{"foo":"bar"}}`),
+		newObj(`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret","namespace":"default"},"data":{"password":"b2xk"}}`),
+	}
+	hooks := []*unstructured.Unstructured{
+		newObj(`{"apiVersion":"batch/v1","kind":"Job","metadata":{"generateName":"notify-","namespace":"default","annotations":{"argocd.argoproj.io/hook":"PostSync,PostDelete"}}}`),
+	}
+	prunes := []*unstructured.Unstructured{
+		newObj(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"kept","namespace":"default","annotations":{"argocd.argoproj.io/sync-options":"Prune=false"}}}`),
+	}
+
+	resources, err := planResources(targets, lives, hooks, prunes, true, false, diffConfig)
+	require.NoError(t, err)
+	require.Len(t, resources, 5)
+
+	assert.Equal(t, "kept", resources[0].Name)
+	assert.Equal(t, appsv1.SyncPlanActionPrune, resources[0].Action)
+	assert.Equal(t, "ignored (no prune)", resources[0].Message)
+
+	assert.Equal(t, "unchanged", resources[1].Name)
+	assert.Equal(t, appsv1.SyncPlanActionNone, resources[1].Action)
+	assert.Empty(t, resources[1].Patch)
+
+	assert.Equal(t, "secret", resources[2].Name)
+	assert.Equal(t, appsv1.SyncPlanActionReplace, resources[2].Action)
+	assert.Contains(t, resources[2].Patch, `"path":"/data/password"`)
+	assert.NotContains(t, resources[2].Patch, "bmV3")
+
+	assert.Equal(t, "changed", resources[3].Name)
+	assert.Equal(t, int64(1), resources[3].SyncWave)
+	assert.Equal(t, appsv1.SyncPlanActionUpdate, resources[3].Action)
+	assert.Equal(t, `[{"op":"replace","path":"/data/foo","value":"baz"}]`, resources[3].Patch)
+
+	assert.Equal(t, "notify-", resources[4].Name)
+	assert.Equal(t, appsv1.SyncPlanActionHook, resources[4].Action)
+	assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhasePostSync), resources[4].SyncPhase)
+}
+
+func TestListHookLogs(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+
+	// no hook failed
+	res, err := appServer.ListHookLogs(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	assert.Empty(t, res.Items)
+
+	require.NoError(t, appStateCache.SetAppHookLogs(testApp.InstanceName(appServer.ns), []appsv1.HookLogs{
+		{Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate", PodName: "migrate-abcde", Containers: []appsv1.ContainerLogs{{Container: "migrate", Logs: "migration failed"}}},
+		{Kind: "Pod", Namespace: "default", Name: "smoke-test", PodName: "smoke-test", Containers: []appsv1.ContainerLogs{{Container: "main", Logs: "connection refused"}}},
+	}))
+
+	res, err = appServer.ListHookLogs(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	assert.Len(t, res.Items, 2)
+
+	res, err = appServer.ListHookLogs(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name, Kind: ptr.To("Job")})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, "migrate-abcde", res.Items[0].PodName)
+	assert.Equal(t, "migration failed", res.Items[0].Containers[0].Logs)
+}
+
 func TestRunNewStyleResourceAction(t *testing.T) {
 	cacheClient := cacheutil.NewCache(cacheutil.NewInMemoryCache(1 * time.Hour))
 
@@ -65,6 +65,18 @@ func (c *Cache) GetAppManagedResources(appName string, res *[]*appv1.ResourceDif
 	return c.cache.GetAppManagedResources(appName, res)
 }
 
+func (c *Cache) GetAppDriftHistory(appName string, res *[]appv1.DriftRecord) error {
+	return c.cache.GetAppDriftHistory(appName, res)
+}
+
+func (c *Cache) GetAppHookLogs(appName string, res *[]appv1.HookLogs) error {
+	return c.cache.GetAppHookLogs(appName, res)
+}
+
+func (c *Cache) GetAppStatusTimeline(appName string, res *[]appv1.StatusTransition) error {
+	return c.cache.GetAppStatusTimeline(appName, res)
+}
+
 func (c *Cache) SetRepoConnectionState(repo string, project string, state *appv1.ConnectionState) error {
 	return c.cache.SetItem(repoConnectionStateKey(repo, project), &state, c.connectionStatusCacheExpiration, state == nil)
 }
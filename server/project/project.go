@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/sync"
@@ -31,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+	"github.com/argoproj/argo-cd/v2/util/manifestpolicy"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/session"
 	"github.com/argoproj/argo-cd/v2/util/settings"
@@ -76,6 +78,10 @@ func validateProject(proj *v1alpha1.AppProject) error {
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "policy syntax error: %s", err.Error())
 	}
+	err = manifestpolicy.Validate(proj.Spec.ManifestPolicies)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 	return nil
 }
 
@@ -535,6 +541,33 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 	return res, nil
 }
 
+// GetDORAMetrics returns the DORA metrics of the applications of the project over rolling windows
+func (s *Server) GetDORAMetrics(ctx context.Context, q *project.ProjectQuery) (*application.DORAMetricsResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	apps := make([]*v1alpha1.Application, len(appsList.Items))
+	for i := range appsList.Items {
+		apps[i] = &appsList.Items[i]
+	}
+	apps = argo.FilterByProjectsP(apps, []string{proj.Name})
+
+	res := &application.DORAMetricsResponse{}
+	for _, metrics := range argo.GetDORAMetrics(apps, time.Now()) {
+		metrics := metrics
+		res.Items = append(res.Items, &metrics)
+	}
+	return res, nil
+}
+
 func (s *Server) NormalizeProjs() error {
 	projList, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
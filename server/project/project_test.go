@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/util/db"
 
@@ -686,6 +687,27 @@ p, role:admin, projects, update, *, allow`)
 		assert.Nil(t, res)
 	})
 
+	t.Run("TestGetDORAMetrics", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		deployedAt := v1.NewTime(time.Now().Add(-24 * time.Hour))
+		appWithHistory := existingApp.DeepCopy()
+		appWithHistory.Status.History = v1alpha1.RevisionHistories{{Revision: "a", DeployedAt: deployedAt, HealthyAt: &deployedAt}}
+		otherApp := appWithHistory.DeepCopy()
+		otherApp.Name = "other"
+		otherApp.Spec.Project = "other"
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, appWithHistory, otherApp), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB)
+		res, err := projectServer.GetDORAMetrics(ctx, &project.ProjectQuery{Name: existingProj.Name})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, "7d", res.Items[0].Window)
+		assert.Equal(t, int64(1), res.Items[0].Deployments)
+		assert.Equal(t, int64(0), res.Items[0].FailedDeployments)
+
+		_, err = projectServer.GetDORAMetrics(ctx, &project.ProjectQuery{Name: "incorrect"})
+		assert.Contains(t, err.Error(), "not found")
+	})
+
 	t.Run("TestGetSyncWindowsStateDenied", func(t *testing.T) {
 		enforcer = newEnforcer(kubeclientset)
 		_ = enforcer.SetBuiltinPolicy(`p, *, *, *, *, deny`)
@@ -424,6 +424,15 @@ func SetResourceOverridesSplitKeys(overrides map[string]v1alpha1.ResourceOverrid
 				cm.Data[getResourceOverrideSplitKey(k, "health")] = v.HealthLua
 			}
 			cm.Data[getResourceOverrideSplitKey(k, "useOpenLibs")] = strconv.FormatBool(v.UseOpenLibs)
+			if v.HealthCEL != "" {
+				cm.Data[getResourceOverrideSplitKey(k, "healthCEL")] = v.HealthCEL
+			}
+			if v.HealthKStatus {
+				cm.Data[getResourceOverrideSplitKey(k, "healthKStatus")] = strconv.FormatBool(v.HealthKStatus)
+			}
+			if v.ProgressingTimeout != "" {
+				cm.Data[getResourceOverrideSplitKey(k, "progressingTimeout")] = v.ProgressingTimeout
+			}
 			if v.Actions != "" {
 				cm.Data[getResourceOverrideSplitKey(k, "actions")] = v.Actions
 			}
@@ -29,6 +29,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/rand"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -321,7 +322,8 @@ func ValidateRepo(
 		return nil, fmt.Errorf("error getting permitted repo creds: %w", err)
 	}
 
-	cluster, err := db.GetCluster(context.Background(), spec.Destination.Server)
+	// the manifests of an application with multiple destinations are verified against its first destination
+	cluster, err := db.GetCluster(context.Background(), spec.GetDestinations()[0].Server)
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
@@ -424,7 +426,7 @@ func validateRepo(ctx context.Context,
 		helmOptions,
 		app.Name,
 		&app.ObjectMeta,
-		app.Spec.Destination,
+		app.Spec.GetDestinations()[0],
 		proj,
 		sources,
 		repoClient,
@@ -573,8 +575,51 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		}
 	}
 
+	if spec.HasMultipleDestinations() {
+		if err := spec.DestinationRollout.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: err.Error(),
+			})
+		}
+		for i := range spec.Destinations {
+			destConditions, err := validateDestinationPermissions(ctx, &spec.Destinations[i], proj, spec.Project, db)
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, destConditions...)
+		}
+		// the resources of an application are tracked by application name only, so destinations sharing a cluster would
+		// compare and prune the resources of each other
+		servers := make(map[string]int)
+		for i, dest := range spec.Destinations {
+			if dest.Server == "" {
+				continue
+			}
+			if j, ok := servers[dest.Server]; ok {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("application destinations with namespaces '%s' and '%s' are deployed to the same cluster '%s'", spec.Destinations[j].Namespace, dest.Namespace, dest.Server),
+				})
+				continue
+			}
+			servers[dest.Server] = i
+		}
+		return conditions, nil
+	}
+	destConditions, err := validateDestinationPermissions(ctx, &spec.Destination, proj, spec.Project, db)
+	if err != nil {
+		return nil, err
+	}
+	return append(conditions, destConditions...), nil
+}
+
+// validateDestinationPermissions ensures that the cluster of the given destination has been added to Argo CD and that
+// the destination namespace/cluster are permitted in app project
+func validateDestinationPermissions(ctx context.Context, dest *argoappv1.ApplicationDestination, proj *argoappv1.AppProject, project string, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
+	conditions := make([]argoappv1.ApplicationCondition, 0)
 	// ValidateDestination will resolve the destination's server address from its name for us, if possible
-	if err := ValidateDestination(ctx, &spec.Destination, db); err != nil {
+	if err := ValidateDestination(ctx, dest, db); err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: err.Error(),
@@ -582,8 +627,8 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		return conditions, nil
 	}
 
-	if spec.Destination.Server != "" {
-		permitted, err := proj.IsDestinationPermitted(spec.Destination, func(project string) ([]*argoappv1.Cluster, error) {
+	if dest.Server != "" {
+		permitted, err := proj.IsDestinationPermitted(*dest, func(project string) ([]*argoappv1.Cluster, error) {
 			return db.GetProjectClusters(ctx, project)
 		})
 		if err != nil {
@@ -592,22 +637,22 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		if !permitted {
 			conditions = append(conditions, argoappv1.ApplicationCondition{
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: fmt.Sprintf("application destination server '%s' and namespace '%s' do not match any of the allowed destinations in project '%s'", spec.Destination.Server, spec.Destination.Namespace, spec.Project),
+				Message: fmt.Sprintf("application destination server '%s' and namespace '%s' do not match any of the allowed destinations in project '%s'", dest.Server, dest.Namespace, project),
 			})
 		}
 		// Ensure the k8s cluster the app is referencing, is configured in Argo CD
-		_, err = db.GetCluster(ctx, spec.Destination.Server)
+		_, err = db.GetCluster(ctx, dest.Server)
 		if err != nil {
 			if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
 				conditions = append(conditions, argoappv1.ApplicationCondition{
 					Type:    argoappv1.ApplicationConditionInvalidSpecError,
-					Message: fmt.Sprintf("cluster '%s' has not been configured", spec.Destination.Server),
+					Message: fmt.Sprintf("cluster '%s' has not been configured", dest.Server),
 				})
 			} else {
 				return nil, fmt.Errorf("error getting cluster: %w", err)
 			}
 		}
-	} else if spec.Destination.Server == "" {
+	} else if dest.Server == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: errDestinationMissing})
 	}
 	return conditions, nil
@@ -821,6 +866,84 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 	}
 }
 
+// SetOrQueueAppOperation updates an application with the specified operation, retrying conflict errors. If another
+// operation is in progress and the sync policy of the application enables the operation queue, the operation is queued
+// instead, unless an equivalent operation is already queued. The queued operation is returned in that case.
+func SetOrQueueAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *argoappv1.Operation) (*argoappv1.Application, *argoappv1.QueuedOperation, error) {
+	if op.Sync == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "Operation unspecified")
+	}
+	for {
+		a, err := appIf.Get(context.Background(), appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting application %q: %w", appName, err)
+		}
+		var queued *argoappv1.QueuedOperation
+		if a.Operation == nil {
+			a.Operation = op
+			a.Status.OperationState = nil
+		} else {
+			if a.Spec.SyncPolicy == nil || a.Spec.SyncPolicy.OperationQueue == nil {
+				return nil, nil, ErrAnotherOperationInProgress
+			}
+			for i := range a.Status.OperationQueue {
+				if a.Status.OperationQueue[i].Operation.IsEquivalent(op) {
+					return a, &a.Status.OperationQueue[i], nil
+				}
+			}
+			if int64(len(a.Status.OperationQueue)) >= a.Spec.SyncPolicy.OperationQueue.MaxLength {
+				return nil, nil, status.Errorf(codes.ResourceExhausted, "the operation queue of application %q is full", appName)
+			}
+			id, err := rand.String(8)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error generating queued operation id: %w", err)
+			}
+			a.Status.OperationQueue = append(a.Status.OperationQueue, argoappv1.QueuedOperation{ID: id, Operation: *op, QueuedAt: metav1.Now()})
+			queued = &a.Status.OperationQueue[len(a.Status.OperationQueue)-1]
+		}
+		a, err = appIf.Update(context.Background(), a, metav1.UpdateOptions{})
+		if err == nil {
+			if queued != nil {
+				queued = &a.Status.OperationQueue[len(a.Status.OperationQueue)-1]
+			}
+			return a, queued, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, nil, fmt.Errorf("error updating application %q: %w", appName, err)
+		}
+		log.Warnf("Failed to set operation for app '%s' due to update conflict. Retrying again...", appName)
+	}
+}
+
+// RemoveQueuedAppOperation removes the queued operation with the given ID from an application, retrying conflict errors
+func RemoveQueuedAppOperation(appIf v1alpha1.ApplicationInterface, appName string, id string) (*argoappv1.Application, error) {
+	for {
+		a, err := appIf.Get(context.Background(), appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application %q: %w", appName, err)
+		}
+		index := -1
+		for i := range a.Status.OperationQueue {
+			if a.Status.OperationQueue[i].ID == id {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, status.Errorf(codes.NotFound, "queued operation %q not found", id)
+		}
+		a.Status.OperationQueue = append(a.Status.OperationQueue[:index], a.Status.OperationQueue[index+1:]...)
+		a, err = appIf.Update(context.Background(), a, metav1.UpdateOptions{})
+		if err == nil {
+			return a, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application %q: %w", appName, err)
+		}
+		log.Warnf("Failed to remove queued operation of app '%s' due to update conflict. Retrying again...", appName)
+	}
+}
+
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
 func ContainsSyncResource(name string, namespace string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
 	for _, r := range rr {
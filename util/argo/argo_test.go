@@ -850,6 +850,85 @@ func TestValidatePermissions(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, conditions)
 	})
+
+	t.Run("Each destination is validated", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Path:           "",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destinations: argoappv1.ApplicationDestinations{
+				{Name: "does-exist", Namespace: "default"},
+				{Server: "https://127.0.0.2:6443", Namespace: "not-permitted"},
+			},
+			DestinationRollout: &argoappv1.DestinationRollout{Strategy: argoappv1.DestinationRolloutStrategyBatches},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "default",
+					},
+				},
+				SourceRepos: []string{"http://some/where"},
+			},
+		}
+		db := &dbmocks.ArgoDB{}
+		cluster := argoappv1.Cluster{
+			Name:   "does-exist",
+			Server: "https://127.0.0.1:6443",
+		}
+		db.On("GetClusterServersByName", context.Background(), "does-exist").Return([]string{"https://127.0.0.1:6443"}, nil)
+		db.On("GetCluster", context.Background(), "https://127.0.0.1:6443").Return(&cluster, nil)
+		db.On("GetCluster", context.Background(), "https://127.0.0.2:6443").Return(&argoappv1.Cluster{Server: "https://127.0.0.2:6443"}, nil)
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 2)
+		assert.Equal(t, "destination rollout batch size must be greater than zero", conditions[0].Message)
+		assert.Contains(t, conditions[1].Message, "namespace 'not-permitted' do not match any of the allowed destinations")
+		assert.Equal(t, "https://127.0.0.1:6443", spec.Destinations[0].Server)
+	})
+
+	t.Run("Destinations on the same cluster are rejected", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Path:           "",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destinations: argoappv1.ApplicationDestinations{
+				{Name: "does-exist", Namespace: "default"},
+				{Server: "https://127.0.0.1:6443", Namespace: "other"},
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"http://some/where"},
+			},
+		}
+		db := &dbmocks.ArgoDB{}
+		cluster := argoappv1.Cluster{
+			Name:   "does-exist",
+			Server: "https://127.0.0.1:6443",
+		}
+		db.On("GetClusterServersByName", context.Background(), "does-exist").Return([]string{"https://127.0.0.1:6443"}, nil)
+		db.On("GetCluster", context.Background(), "https://127.0.0.1:6443").Return(&cluster, nil)
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Equal(t, "application destinations with namespaces 'default' and 'other' are deployed to the same cluster 'https://127.0.0.1:6443'", conditions[0].Message)
+	})
 }
 
 func TestSetAppOperations(t *testing.T) {
@@ -903,6 +982,80 @@ func TestSetAppOperations(t *testing.T) {
 	})
 }
 
+func TestSetOrQueueAppOperation(t *testing.T) {
+	newApp := func(queue *argoappv1.OperationQueuePolicy, queued ...argoappv1.QueuedOperation) *argoappv1.Application {
+		return &argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "someapp", Namespace: "default"},
+			Spec:       argoappv1.ApplicationSpec{SyncPolicy: &argoappv1.SyncPolicy{OperationQueue: queue}},
+			Operation:  &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}},
+			Status:     argoappv1.ApplicationStatus{OperationQueue: queued},
+		}
+	}
+
+	t.Run("No operation in progress", func(t *testing.T) {
+		a := newApp(&argoappv1.OperationQueuePolicy{MaxLength: 1})
+		a.Operation = nil
+		appIf := appclientset.NewSimpleClientset(a).ArgoprojV1alpha1().Applications("default")
+		app, queued, err := SetOrQueueAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}})
+		require.NoError(t, err)
+		assert.Nil(t, queued)
+		assert.Equal(t, "bbb", app.Operation.Sync.Revision)
+	})
+
+	t.Run("Queue disabled", func(t *testing.T) {
+		appIf := appclientset.NewSimpleClientset(newApp(nil)).ArgoprojV1alpha1().Applications("default")
+		_, _, err := SetOrQueueAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}})
+		require.ErrorIs(t, err, ErrAnotherOperationInProgress)
+	})
+
+	t.Run("Queued", func(t *testing.T) {
+		appIf := appclientset.NewSimpleClientset(newApp(&argoappv1.OperationQueuePolicy{MaxLength: 1})).ArgoprojV1alpha1().Applications("default")
+		app, queued, err := SetOrQueueAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}})
+		require.NoError(t, err)
+		require.NotNil(t, queued)
+		assert.NotEmpty(t, queued.ID)
+		assert.Equal(t, "aaa", app.Operation.Sync.Revision)
+		require.Len(t, app.Status.OperationQueue, 1)
+		assert.Equal(t, "bbb", app.Status.OperationQueue[0].Operation.Sync.Revision)
+	})
+
+	t.Run("Equivalent operation already queued", func(t *testing.T) {
+		existing := argoappv1.QueuedOperation{ID: "abc", Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}, InitiatedBy: argoappv1.OperationInitiator{Username: "alice"}}}
+		appIf := appclientset.NewSimpleClientset(newApp(&argoappv1.OperationQueuePolicy{MaxLength: 1}, existing)).ArgoprojV1alpha1().Applications("default")
+		app, queued, err := SetOrQueueAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}, InitiatedBy: argoappv1.OperationInitiator{Username: "bob"}})
+		require.NoError(t, err)
+		require.NotNil(t, queued)
+		assert.Equal(t, "abc", queued.ID)
+		assert.Len(t, app.Status.OperationQueue, 1)
+	})
+
+	t.Run("Queue full", func(t *testing.T) {
+		existing := argoappv1.QueuedOperation{ID: "abc", Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}}}
+		appIf := appclientset.NewSimpleClientset(newApp(&argoappv1.OperationQueuePolicy{MaxLength: 1}, existing)).ArgoprojV1alpha1().Applications("default")
+		_, _, err := SetOrQueueAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "ccc"}})
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+func TestRemoveQueuedAppOperation(t *testing.T) {
+	a := &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "someapp", Namespace: "default"},
+		Status: argoappv1.ApplicationStatus{OperationQueue: []argoappv1.QueuedOperation{
+			{ID: "abc", Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}}},
+			{ID: "def", Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}}},
+		}},
+	}
+	appIf := appclientset.NewSimpleClientset(a).ArgoprojV1alpha1().Applications("default")
+	app, err := RemoveQueuedAppOperation(appIf, "someapp", "abc")
+	require.NoError(t, err)
+	require.Len(t, app.Status.OperationQueue, 1)
+	assert.Equal(t, "def", app.Status.OperationQueue[0].ID)
+
+	_, err = RemoveQueuedAppOperation(appIf, "someapp", "abc")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
 func TestValidateDestination(t *testing.T) {
 	t.Run("Validate destination with server url", func(t *testing.T) {
 		dest := argoappv1.ApplicationDestination{
@@ -36,13 +36,20 @@ type ObjectRef struct {
 }
 
 const (
-	EventReasonStatusRefreshed    = "StatusRefreshed"
-	EventReasonResourceCreated    = "ResourceCreated"
-	EventReasonResourceUpdated    = "ResourceUpdated"
-	EventReasonResourceDeleted    = "ResourceDeleted"
-	EventReasonResourceActionRan  = "ResourceActionRan"
-	EventReasonOperationStarted   = "OperationStarted"
-	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonStatusRefreshed               = "StatusRefreshed"
+	EventReasonResourceCreated               = "ResourceCreated"
+	EventReasonResourceUpdated               = "ResourceUpdated"
+	EventReasonResourceDeleted               = "ResourceDeleted"
+	EventReasonResourceActionRan             = "ResourceActionRan"
+	EventReasonOperationStarted              = "OperationStarted"
+	EventReasonOperationQueued               = "OperationQueued"
+	EventReasonOperationCompleted            = "OperationCompleted"
+	EventReasonMaintenanceEnabled            = "MaintenanceEnabled"
+	EventReasonMaintenanceDisabled           = "MaintenanceDisabled"
+	EventReasonMaintenanceExpired            = "MaintenanceExpired"
+	EventReasonOrphanedResourceActionPending = "OrphanedResourceActionPending"
+	EventReasonOrphanedResourceDeleted       = "OrphanedResourceDeleted"
+	EventReasonOrphanedResourceAdopted       = "OrphanedResourceAdopted"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string, eventLabels map[string]string) {
@@ -0,0 +1,159 @@
+package argo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// DORAWindows are the rolling windows over which DORA metrics are computed
+var DORAWindows = []time.Duration{7 * 24 * time.Hour, 30 * 24 * time.Hour}
+
+// DORAStats accumulates the deployments of applications which completed within a rolling window. The deployments are
+// read from the revision history of the applications, so the window is effectively bounded by their revision history
+// limit.
+type DORAStats struct {
+	Window            time.Duration
+	Deployments       int64
+	FailedDeployments int64
+	leadTime          time.Duration
+	leadTimeSamples   int64
+	restoreTime       time.Duration
+	restoreSamples    int64
+}
+
+// NewDORAStats returns empty DORA stats over the given rolling window
+func NewDORAStats(window time.Duration) *DORAStats {
+	return &DORAStats{Window: window}
+}
+
+// deployment is a sequence of consecutive syncs of an application to the same revision
+type deployment struct {
+	deployedAt  time.Time
+	committedAt *time.Time
+	healthyAt   *time.Time
+}
+
+// getDeployments groups the revision history of the application into deployments
+func getDeployments(app *argoappv1.Application) []deployment {
+	var deployments []deployment
+	var lastRevision string
+	for i, history := range app.Status.History {
+		revision := history.Revision
+		if len(history.Revisions) > 0 {
+			revision = strings.Join(history.Revisions, ",")
+		}
+		if i == 0 || revision != lastRevision {
+			d := deployment{deployedAt: history.DeployedAt.Time}
+			if history.CommittedAt != nil {
+				d.committedAt = &history.CommittedAt.Time
+			}
+			deployments = append(deployments, d)
+		}
+		if d := &deployments[len(deployments)-1]; d.healthyAt == nil && history.HealthyAt != nil {
+			d.healthyAt = &history.HealthyAt.Time
+		}
+		lastRevision = revision
+	}
+	return deployments
+}
+
+// AddApplication accumulates the deployments of the given application which completed within the window ending now.
+// A deployment failed if the application was not observed healthy at its revision before the next deployment, or if
+// the application is degraded and it is the latest deployment. The time to restore of a failed deployment is measured
+// until a later deployment was observed healthy.
+func (s *DORAStats) AddApplication(app *argoappv1.Application, now time.Time) {
+	deployments := getDeployments(app)
+	since := now.Add(-s.Window)
+	for i, d := range deployments {
+		if d.deployedAt.Before(since) || d.deployedAt.After(now) {
+			continue
+		}
+		s.Deployments++
+		if d.committedAt != nil && !d.committedAt.After(d.deployedAt) {
+			s.leadTime += d.deployedAt.Sub(*d.committedAt)
+			s.leadTimeSamples++
+		}
+		if d.healthyAt != nil {
+			continue
+		}
+		latest := i == len(deployments)-1
+		if latest && app.Status.Health.Status != health.HealthStatusDegraded {
+			continue
+		}
+		s.FailedDeployments++
+		for _, next := range deployments[i+1:] {
+			if next.healthyAt != nil {
+				s.restoreTime += next.healthyAt.Sub(d.deployedAt)
+				s.restoreSamples++
+				break
+			}
+		}
+	}
+}
+
+// LeadTime returns the mean time between the commit of the deployed revisions and their deployment, and false if the
+// commit date of none of the deployments is known
+func (s *DORAStats) LeadTime() (time.Duration, bool) {
+	if s.leadTimeSamples == 0 {
+		return 0, false
+	}
+	return s.leadTime / time.Duration(s.leadTimeSamples), true
+}
+
+// TimeToRestore returns the mean time between a failed deployment and the application becoming healthy again, and
+// false if none of the failed deployments was restored
+func (s *DORAStats) TimeToRestore() (time.Duration, bool) {
+	if s.restoreSamples == 0 {
+		return 0, false
+	}
+	return s.restoreTime / time.Duration(s.restoreSamples), true
+}
+
+// ChangeFailureRate returns the ratio of failed deployments, or 0 if there were no deployments
+func (s *DORAStats) ChangeFailureRate() float64 {
+	if s.Deployments == 0 {
+		return 0
+	}
+	return float64(s.FailedDeployments) / float64(s.Deployments)
+}
+
+// Metrics returns the accumulated stats as API DORA metrics
+func (s *DORAStats) Metrics() argoappv1.DORAMetrics {
+	metrics := argoappv1.DORAMetrics{
+		Window:            FormatDORAWindow(s.Window),
+		Deployments:       s.Deployments,
+		FailedDeployments: s.FailedDeployments,
+	}
+	if leadTime, ok := s.LeadTime(); ok {
+		seconds := int64(leadTime.Seconds())
+		metrics.LeadTimeSeconds = &seconds
+	}
+	if restoreTime, ok := s.TimeToRestore(); ok {
+		seconds := int64(restoreTime.Seconds())
+		metrics.TimeToRestoreSeconds = &seconds
+	}
+	return metrics
+}
+
+// FormatDORAWindow formats the given window in days, e.g. 7d
+func FormatDORAWindow(window time.Duration) string {
+	return fmt.Sprintf("%dd", int64(window/(24*time.Hour)))
+}
+
+// GetDORAMetrics returns the DORA metrics of the given applications over each of the DORA windows
+func GetDORAMetrics(apps []*argoappv1.Application, now time.Time) []argoappv1.DORAMetrics {
+	metrics := make([]argoappv1.DORAMetrics, 0, len(DORAWindows))
+	for _, window := range DORAWindows {
+		stats := NewDORAStats(window)
+		for _, app := range apps {
+			stats.AddApplication(app, now)
+		}
+		metrics = append(metrics, stats.Metrics())
+	}
+	return metrics
+}
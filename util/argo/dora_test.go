@@ -0,0 +1,150 @@
+package argo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+var doraNow = time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+func doraTime(ago time.Duration) *metav1.Time {
+	t := metav1.NewTime(doraNow.Add(-ago))
+	return &t
+}
+
+func doraHistory(revision string, deployedAgo time.Duration, committedAgo time.Duration, healthyAgo time.Duration) argoappv1.RevisionHistory {
+	history := argoappv1.RevisionHistory{Revision: revision, DeployedAt: *doraTime(deployedAgo)}
+	if committedAgo > 0 {
+		history.CommittedAt = doraTime(committedAgo)
+	}
+	if healthyAgo > 0 {
+		history.HealthyAt = doraTime(healthyAgo)
+	}
+	return history
+}
+
+func doraApp(healthStatus health.HealthStatusCode, history ...argoappv1.RevisionHistory) *argoappv1.Application {
+	return &argoappv1.Application{Status: argoappv1.ApplicationStatus{
+		History: history,
+		Health:  argoappv1.HealthStatus{Status: healthStatus},
+	}}
+}
+
+func TestDORAStats_AddApplication(t *testing.T) {
+	day := 24 * time.Hour
+
+	t.Run("Empty", func(t *testing.T) {
+		stats := NewDORAStats(7 * day)
+		stats.AddApplication(doraApp(health.HealthStatusHealthy), doraNow)
+		assert.Equal(t, argoappv1.DORAMetrics{Window: "7d"}, stats.Metrics())
+		assert.Zero(t, stats.ChangeFailureRate())
+	})
+
+	t.Run("SuccessfulDeployments", func(t *testing.T) {
+		stats := NewDORAStats(7 * day)
+		stats.AddApplication(doraApp(health.HealthStatusHealthy,
+			doraHistory("a", 10*day, 11*day, 10*day-time.Minute),
+			doraHistory("b", 3*day, 3*day+2*time.Hour, 3*day-time.Minute),
+			doraHistory("c", day, day+4*time.Hour, day-time.Minute),
+		), doraNow)
+		assert.Equal(t, int64(2), stats.Deployments)
+		assert.Equal(t, int64(0), stats.FailedDeployments)
+		leadTime, ok := stats.LeadTime()
+		assert.True(t, ok)
+		assert.Equal(t, 3*time.Hour, leadTime)
+		_, ok = stats.TimeToRestore()
+		assert.False(t, ok)
+	})
+
+	t.Run("RedeploymentOfSameRevision", func(t *testing.T) {
+		stats := NewDORAStats(7 * day)
+		stats.AddApplication(doraApp(health.HealthStatusHealthy,
+			doraHistory("a", 3*day, 0, 0),
+			doraHistory("a", 2*day, 0, 2*day-time.Minute),
+		), doraNow)
+		assert.Equal(t, int64(1), stats.Deployments)
+		assert.Equal(t, int64(0), stats.FailedDeployments)
+	})
+
+	t.Run("FailedDeploymentRestored", func(t *testing.T) {
+		stats := NewDORAStats(7 * day)
+		stats.AddApplication(doraApp(health.HealthStatusHealthy,
+			doraHistory("a", 5*day, 0, 5*day-time.Minute),
+			doraHistory("b", 4*day, 0, 0),
+			doraHistory("c", 4*day-time.Hour, 0, 0),
+			doraHistory("d", 4*day-2*time.Hour, 0, 4*day-3*time.Hour),
+		), doraNow)
+		assert.Equal(t, int64(4), stats.Deployments)
+		assert.Equal(t, int64(2), stats.FailedDeployments)
+		assert.InDelta(t, 0.5, stats.ChangeFailureRate(), 0.0001)
+		restoreTime, ok := stats.TimeToRestore()
+		assert.True(t, ok)
+		// b was restored after 3h, c after 2h
+		assert.Equal(t, 150*time.Minute, restoreTime)
+		_, ok = stats.LeadTime()
+		assert.False(t, ok)
+	})
+
+	t.Run("LatestDeploymentDegraded", func(t *testing.T) {
+		stats := NewDORAStats(7 * day)
+		stats.AddApplication(doraApp(health.HealthStatusDegraded,
+			doraHistory("a", 2*day, 0, 2*day-time.Minute),
+			doraHistory("b", day, 0, 0),
+		), doraNow)
+		assert.Equal(t, int64(2), stats.Deployments)
+		assert.Equal(t, int64(1), stats.FailedDeployments)
+		_, ok := stats.TimeToRestore()
+		assert.False(t, ok)
+	})
+
+	t.Run("LatestDeploymentProgressing", func(t *testing.T) {
+		stats := NewDORAStats(7 * day)
+		stats.AddApplication(doraApp(health.HealthStatusProgressing,
+			doraHistory("a", day, 0, 0),
+		), doraNow)
+		assert.Equal(t, int64(1), stats.Deployments)
+		assert.Equal(t, int64(0), stats.FailedDeployments)
+	})
+
+	t.Run("CommittedAfterDeployment", func(t *testing.T) {
+		stats := NewDORAStats(7 * day)
+		history := doraHistory("a", day, 0, day-time.Minute)
+		history.CommittedAt = doraTime(day - time.Hour)
+		stats.AddApplication(doraApp(health.HealthStatusHealthy, history), doraNow)
+		_, ok := stats.LeadTime()
+		assert.False(t, ok)
+	})
+
+	t.Run("MultipleApplications", func(t *testing.T) {
+		stats := NewDORAStats(7 * day)
+		stats.AddApplication(doraApp(health.HealthStatusHealthy, doraHistory("a", day, day+time.Hour, day-time.Minute)), doraNow)
+		stats.AddApplication(doraApp(health.HealthStatusDegraded, doraHistory("a", day, day+3*time.Hour, 0)), doraNow)
+		metrics := stats.Metrics()
+		assert.Equal(t, int64(2), metrics.Deployments)
+		assert.Equal(t, int64(1), metrics.FailedDeployments)
+		assert.Equal(t, int64(2*3600), *metrics.LeadTimeSeconds)
+		assert.Nil(t, metrics.TimeToRestoreSeconds)
+	})
+}
+
+func TestGetDORAMetrics(t *testing.T) {
+	day := 24 * time.Hour
+	app := doraApp(health.HealthStatusHealthy,
+		doraHistory("a", 20*day, 20*day+time.Hour, 0),
+		doraHistory("b", 10*day, 10*day+time.Hour, 10*day-2*time.Hour),
+		doraHistory("c", 2*day, 2*day+time.Hour, 2*day-time.Minute),
+	)
+	metrics := GetDORAMetrics([]*argoappv1.Application{app}, doraNow)
+	hour := int64(3600)
+	restore := int64((10*24 + 2) * 3600)
+	assert.Equal(t, []argoappv1.DORAMetrics{
+		{Window: "7d", Deployments: 1, LeadTimeSeconds: &hour},
+		{Window: "30d", Deployments: 3, LeadTimeSeconds: &hour, FailedDeployments: 1, TimeToRestoreSeconds: &restore},
+	}, metrics)
+}
@@ -17,6 +17,12 @@ var ErrCacheMiss = cacheutil.ErrCacheMiss
 
 const (
 	clusterInfoCacheExpiration = 10 * time.Minute
+	// driftHistoryCacheExpiration is longer than the app state expiration since drift history is not recomputed
+	driftHistoryCacheExpiration = 7 * 24 * time.Hour
+	// hookLogsCacheExpiration is longer than the app state expiration since the logs of deleted hooks cannot be captured again
+	hookLogsCacheExpiration = 7 * 24 * time.Hour
+	// statusTimelineCacheExpiration is longer than the app state expiration since status transitions are not recomputed
+	statusTimelineCacheExpiration = 30 * 24 * time.Hour
 )
 
 type Cache struct {
@@ -104,3 +110,39 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+func appDriftHistoryKey(appName string) string {
+	return fmt.Sprintf("app|drift-history|%s", appName)
+}
+
+func (c *Cache) GetAppDriftHistory(appName string, res *[]appv1.DriftRecord) error {
+	return c.GetItem(appDriftHistoryKey(appName), &res)
+}
+
+func (c *Cache) SetAppDriftHistory(appName string, history []appv1.DriftRecord) error {
+	return c.SetItem(appDriftHistoryKey(appName), history, driftHistoryCacheExpiration, history == nil)
+}
+
+func appHookLogsKey(appName string) string {
+	return fmt.Sprintf("app|hook-logs|%s", appName)
+}
+
+func (c *Cache) GetAppHookLogs(appName string, res *[]appv1.HookLogs) error {
+	return c.GetItem(appHookLogsKey(appName), &res)
+}
+
+func (c *Cache) SetAppHookLogs(appName string, logs []appv1.HookLogs) error {
+	return c.SetItem(appHookLogsKey(appName), logs, hookLogsCacheExpiration, logs == nil)
+}
+
+func appStatusTimelineKey(appName string) string {
+	return fmt.Sprintf("app|status-timeline|%s", appName)
+}
+
+func (c *Cache) GetAppStatusTimeline(appName string, res *[]appv1.StatusTransition) error {
+	return c.GetItem(appStatusTimelineKey(appName), &res)
+}
+
+func (c *Cache) SetAppStatusTimeline(appName string, timeline []appv1.StatusTransition) error {
+	return c.SetItem(appStatusTimelineKey(appName), timeline, statusTimelineCacheExpiration, timeline == nil)
+}
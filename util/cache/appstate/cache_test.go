@@ -41,6 +41,60 @@ func TestCache_GetAppManagedResources(t *testing.T) {
 	assert.Equal(t, &[]*ResourceDiff{{Name: "my-name"}}, value)
 }
 
+func TestCache_GetAppDriftHistory(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &[]DriftRecord{}
+	err := cache.GetAppDriftHistory("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppDriftHistory("my-appname", []DriftRecord{{Name: "my-name", Fields: []string{"spec.replicas"}}})
+	require.NoError(t, err)
+	// cache miss
+	err = cache.GetAppDriftHistory("other-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.GetAppDriftHistory("my-appname", value)
+	require.NoError(t, err)
+	assert.Equal(t, &[]DriftRecord{{Name: "my-name", Fields: []string{"spec.replicas"}}}, value)
+}
+
+func TestCache_GetAppHookLogs(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &[]HookLogs{}
+	err := cache.GetAppHookLogs("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppHookLogs("my-appname", []HookLogs{{Name: "my-name", Containers: []ContainerLogs{{Container: "main", Logs: "error"}}}})
+	require.NoError(t, err)
+	// cache miss
+	err = cache.GetAppHookLogs("other-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.GetAppHookLogs("my-appname", value)
+	require.NoError(t, err)
+	assert.Equal(t, &[]HookLogs{{Name: "my-name", Containers: []ContainerLogs{{Container: "main", Logs: "error"}}}}, value)
+}
+
+func TestCache_GetAppStatusTimeline(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &[]StatusTransition{}
+	err := cache.GetAppStatusTimeline("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppStatusTimeline("my-appname", []StatusTransition{{Type: StatusTransitionHealth, From: "Healthy", To: "Degraded"}})
+	require.NoError(t, err)
+	// cache miss
+	err = cache.GetAppStatusTimeline("other-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.GetAppStatusTimeline("my-appname", value)
+	require.NoError(t, err)
+	assert.Equal(t, &[]StatusTransition{{Type: StatusTransitionHealth, From: "Healthy", To: "Degraded"}}, value)
+}
+
 func TestCache_GetAppResourcesTree(t *testing.T) {
 	cache := newFixtures().Cache
 	// cache miss
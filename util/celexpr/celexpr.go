@@ -0,0 +1,72 @@
+package celexpr
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// CostLimit bounds the cost of a single evaluation of an expression
+	CostLimit = 1000000
+	// programsCacheSize is the maximum number of compiled programs cached by a compiler
+	programsCacheSize = 1000
+)
+
+var (
+	env     *cel.Env
+	envErr  error
+	envOnce sync.Once
+)
+
+// getEnv returns the CEL environment of the expressions: the evaluated resource is available as `object`
+func getEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Variable("object", cel.DynType),
+			ext.Strings(),
+			ext.Sets(),
+		)
+	})
+	return env, envErr
+}
+
+// Compiler compiles CEL expressions evaluated against a resource, and caches the most recently used programs
+type Compiler struct {
+	check    func(ast *cel.Ast) error
+	programs *lru.Cache
+}
+
+// NewCompiler returns a compiler which rejects the expressions for which the given check, if any, returns an error
+func NewCompiler(check func(ast *cel.Ast) error) *Compiler {
+	return &Compiler{check: check, programs: lru.New(programsCacheSize)}
+}
+
+// Compile returns the program of the given expression
+func (c *Compiler) Compile(expression string) (cel.Program, error) {
+	if prg, ok := c.programs.Get(expression); ok {
+		return prg.(cel.Program), nil
+	}
+	env, err := getEnv()
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if c.check != nil {
+		if err := c.check(ast); err != nil {
+			return nil, err
+		}
+	}
+	prg, err := env.Program(ast, cel.CostLimit(CostLimit))
+	if err != nil {
+		return nil, err
+	}
+	c.programs.Add(expression, prg)
+	return prg, nil
+}
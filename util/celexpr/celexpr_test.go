@@ -0,0 +1,49 @@
+package celexpr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompiler_Compile(t *testing.T) {
+	compiler := NewCompiler(nil)
+	prg, err := compiler.Compile("object.spec.replicas > 1")
+	require.NoError(t, err)
+	out, _, err := prg.Eval(map[string]interface{}{"object": map[string]interface{}{"spec": map[string]interface{}{"replicas": 2}}})
+	require.NoError(t, err)
+	assert.Equal(t, true, out.Value())
+
+	_, err = compiler.Compile("object.spec.replicas >")
+	assert.Error(t, err)
+}
+
+func TestCompiler_Check(t *testing.T) {
+	compiler := NewCompiler(func(ast *cel.Ast) error {
+		if !ast.OutputType().IsExactType(cel.BoolType) {
+			return errors.New("expression must return a bool")
+		}
+		return nil
+	})
+	_, err := compiler.Compile("'Healthy'")
+	assert.EqualError(t, err, "expression must return a bool")
+	_, err = compiler.Compile("true")
+	assert.NoError(t, err)
+
+	// the programs compiled by another compiler are not checked against this compiler
+	_, err = NewCompiler(nil).Compile("'Healthy'")
+	assert.NoError(t, err)
+}
+
+func TestCompiler_CacheIsBounded(t *testing.T) {
+	compiler := NewCompiler(nil)
+	for i := 0; i < programsCacheSize+10; i++ {
+		_, err := compiler.Compile(fmt.Sprintf("object.spec.replicas > %d", i))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, programsCacheSize, compiler.programs.Len())
+}
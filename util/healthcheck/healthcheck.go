@@ -0,0 +1,86 @@
+package healthcheck
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/google/cel-go/common/types/ref"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/util/celexpr"
+)
+
+const invalidHealthStatus = "CEL expression returned an invalid health status"
+
+var (
+	compiler = celexpr.NewCompiler(nil)
+	mapType  = reflect.TypeOf(map[string]interface{}{})
+)
+
+// Validate returns an error if the given health expression cannot be compiled
+func Validate(expression string) error {
+	_, err := compiler.Compile(expression)
+	return err
+}
+
+// EvaluateCEL assesses the health of a resource using a CEL expression. The resource is available to the expression
+// as `object`, and the expression returns either a health status code (e.g. 'Healthy'), or a map with the `status`
+// and the optional `message` keys.
+func EvaluateCEL(obj *unstructured.Unstructured, expression string) (*health.HealthStatus, error) {
+	prg, err := compiler.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	out, _, err := prg.Eval(map[string]interface{}{"object": obj.Object})
+	if err != nil {
+		return nil, err
+	}
+	healthStatus, err := toHealthStatus(out)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidHealthStatusCode(healthStatus.Status) {
+		return &health.HealthStatus{
+			Status:  health.HealthStatusUnknown,
+			Message: invalidHealthStatus,
+		}, nil
+	}
+	return healthStatus, nil
+}
+
+func toHealthStatus(out ref.Val) (*health.HealthStatus, error) {
+	switch value := out.Value().(type) {
+	case string:
+		return &health.HealthStatus{Status: health.HealthStatusCode(value)}, nil
+	default:
+		result, err := out.ConvertToNative(mapType)
+		if err != nil {
+			return nil, fmt.Errorf("expect a string or a map output from CEL expression, not %s", out.Type().TypeName())
+		}
+		healthStatus := &health.HealthStatus{}
+		for k, v := range result.(map[string]interface{}) {
+			str, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("expect a string value for key '%s' in the output of CEL expression, not %T", k, v)
+			}
+			switch k {
+			case "status":
+				healthStatus.Status = health.HealthStatusCode(str)
+			case "message":
+				healthStatus.Message = str
+			default:
+				return nil, fmt.Errorf("unexpected key '%s' in the output of CEL expression", k)
+			}
+		}
+		return healthStatus, nil
+	}
+}
+
+func isValidHealthStatusCode(statusCode health.HealthStatusCode) bool {
+	switch statusCode {
+	case health.HealthStatusUnknown, health.HealthStatusProgressing, health.HealthStatusSuspended, health.HealthStatusHealthy, health.HealthStatusDegraded, health.HealthStatusMissing:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package healthcheck
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func newObj(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+	obj := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &obj))
+	return &unstructured.Unstructured{Object: obj}
+}
+
+const readyCertificate = `
+apiVersion: example.com/v1
+kind: Certificate
+metadata:
+  name: my-cert
+  generation: 1
+status:
+  observedGeneration: 1
+  conditions:
+  - type: Ready
+    status: "True"
+    reason: Issued
+    message: Certificate is up to date
+`
+
+func TestEvaluateCEL(t *testing.T) {
+	obj := newObj(t, readyCertificate)
+
+	t.Run("StatusCode", func(t *testing.T) {
+		status, err := EvaluateCEL(obj, `'Healthy'`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy}, status)
+	})
+
+	t.Run("StatusAndMessage", func(t *testing.T) {
+		status, err := EvaluateCEL(obj, `object.status.conditions.exists(c, c.type == 'Ready' && c.status == 'True') ?
+  {'status': 'Healthy', 'message': object.status.conditions[0].message} :
+  {'status': 'Degraded'}`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "Certificate is up to date"}, status)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		status, err := EvaluateCEL(obj, `{'status': 'Running'}`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusUnknown, Message: invalidHealthStatus}, status)
+	})
+
+	t.Run("InvalidOutputType", func(t *testing.T) {
+		_, err := EvaluateCEL(obj, `true`)
+		require.ErrorContains(t, err, "expect a string or a map output")
+	})
+
+	t.Run("UnexpectedKey", func(t *testing.T) {
+		_, err := EvaluateCEL(obj, `{'status': 'Healthy', 'reason': 'Issued'}`)
+		require.ErrorContains(t, err, "unexpected key 'reason'")
+	})
+
+	t.Run("InvalidExpression", func(t *testing.T) {
+		_, err := EvaluateCEL(obj, `object.status.`)
+		require.Error(t, err)
+		require.Error(t, Validate(`object.status.`))
+		require.NoError(t, Validate(`'Healthy'`))
+	})
+
+	t.Run("MissingField", func(t *testing.T) {
+		_, err := EvaluateCEL(obj, `object.spec.replicas > 1 ? 'Healthy' : 'Degraded'`)
+		require.Error(t, err)
+	})
+}
+
+func TestGetConditionsHealth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		manifest string
+		expected *health.HealthStatus
+	}{{
+		name:     "Ready",
+		manifest: readyCertificate,
+		expected: &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "Certificate is up to date"},
+	}, {
+		name: "NoStatus",
+		manifest: `
+apiVersion: example.com/v1
+kind: Certificate
+metadata:
+  name: my-cert
+`,
+		expected: &health.HealthStatus{Status: health.HealthStatusHealthy},
+	}, {
+		name: "GenerationNotObserved",
+		manifest: `
+apiVersion: example.com/v1
+kind: Certificate
+metadata:
+  name: my-cert
+  generation: 2
+status:
+  observedGeneration: 1
+  conditions:
+  - type: Ready
+    status: "True"
+`,
+		expected: &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for generation 2 to be observed"},
+	}, {
+		name: "NotReady",
+		manifest: `
+apiVersion: example.com/v1
+kind: Certificate
+metadata:
+  name: my-cert
+status:
+  conditions:
+  - type: Ready
+    status: "False"
+    reason: Pending
+`,
+		expected: &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "Pending"},
+	}, {
+		name: "Reconciling",
+		manifest: `
+apiVersion: example.com/v1
+kind: Certificate
+metadata:
+  name: my-cert
+status:
+  conditions:
+  - type: Reconciling
+    status: "True"
+    message: Issuing certificate
+  - type: Ready
+    status: "True"
+`,
+		expected: &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "Issuing certificate"},
+	}, {
+		name: "Stalled",
+		manifest: `
+apiVersion: example.com/v1
+kind: Certificate
+metadata:
+  name: my-cert
+status:
+  conditions:
+  - type: Stalled
+    status: "True"
+    reason: IssuerNotFound
+    message: Issuer letsencrypt not found
+  - type: Ready
+    status: "False"
+`,
+		expected: &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "Issuer letsencrypt not found"},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, err := GetConditionsHealth(newObj(t, tc.manifest))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
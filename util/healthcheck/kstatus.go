@@ -0,0 +1,79 @@
+package healthcheck
+
+import (
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// GetConditionsHealth assesses the health of a resource from its standard status conditions, following the
+// conventions of kstatus (https://github.com/kubernetes-sigs/cli-utils/tree/master/pkg/kstatus):
+//   - the resource is Progressing while its latest generation has not been observed by its controller
+//   - a `Reconciling` condition with a True status means that the resource is Progressing
+//   - a `Stalled` condition with a True status means that the resource is Degraded
+//   - a `Ready` condition means that the resource is Healthy if its status is True, and Progressing otherwise
+//   - a resource without any of these conditions is Healthy
+func GetConditionsHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	generation, _ := nestedInt64(obj, "metadata", "generation")
+	if observedGeneration, found := nestedInt64(obj, "status", "observedGeneration"); found && observedGeneration < generation {
+		return &health.HealthStatus{
+			Status:  health.HealthStatusProgressing,
+			Message: fmt.Sprintf("Waiting for generation %d to be observed", generation),
+		}, nil
+	}
+
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status.conditions: %w", err)
+	}
+	byType := map[string]map[string]interface{}{}
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if conditionType, ok := condition["type"].(string); ok {
+			byType[conditionType] = condition
+		}
+	}
+
+	if condition, ok := byType["Reconciling"]; ok && condition["status"] == "True" {
+		return &health.HealthStatus{Status: health.HealthStatusProgressing, Message: conditionMessage(condition)}, nil
+	}
+	if condition, ok := byType["Stalled"]; ok && condition["status"] == "True" {
+		return &health.HealthStatus{Status: health.HealthStatusDegraded, Message: conditionMessage(condition)}, nil
+	}
+	if condition, ok := byType["Ready"]; ok {
+		if condition["status"] == "True" {
+			return &health.HealthStatus{Status: health.HealthStatusHealthy, Message: conditionMessage(condition)}, nil
+		}
+		return &health.HealthStatus{Status: health.HealthStatusProgressing, Message: conditionMessage(condition)}, nil
+	}
+	return &health.HealthStatus{Status: health.HealthStatusHealthy}, nil
+}
+
+// conditionMessage returns the message of a condition, or its reason if it has no message
+func conditionMessage(condition map[string]interface{}) string {
+	if message, ok := condition["message"].(string); ok && message != "" {
+		return message
+	}
+	reason, _ := condition["reason"].(string)
+	return reason
+}
+
+// nestedInt64 returns the integer value of a field, which is decoded as a float64 if the object was unmarshalled from
+// JSON
+func nestedInt64(obj *unstructured.Unstructured, fields ...string) (int64, bool) {
+	value, found, err := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	if err != nil || !found {
+		return 0, false
+	}
+	switch value := value.(type) {
+	case int64:
+		return value, true
+	case float64:
+		return int64(value), true
+	}
+	return 0, false
+}
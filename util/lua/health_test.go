@@ -63,3 +63,29 @@ func TestLuaHealthScript(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestCELHealthScript(t *testing.T) {
+	err := filepath.Walk("../../resource_customizations", func(path string, f os.FileInfo, err error) error {
+		if !strings.Contains(path, "health.cel") {
+			return nil
+		}
+		errors.CheckError(err)
+		dir := filepath.Dir(path)
+		yamlBytes, err := os.ReadFile(dir + "/health_test.yaml")
+		errors.CheckError(err)
+		var resourceTest TestStructure
+		err = yaml.Unmarshal(yamlBytes, &resourceTest)
+		errors.CheckError(err)
+		for i := range resourceTest.Tests {
+			test := resourceTest.Tests[i]
+			t.Run(test.InputPath, func(t *testing.T) {
+				obj := getObj(filepath.Join(dir, test.InputPath))
+				result, err := ResourceHealthOverrides{}.GetResourceHealth(obj)
+				errors.CheckError(err)
+				assert.Equal(t, &test.HealthStatus, result)
+			})
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+}
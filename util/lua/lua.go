@@ -9,6 +9,8 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
@@ -20,12 +22,14 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/resource_customizations"
 	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/healthcheck"
 )
 
 const (
 	incorrectReturnType       = "expect %s output from Lua script, not %s"
 	invalidHealthStatus       = "Lua returned an invalid health status"
 	healthScriptFile          = "health.lua"
+	healthCELFile             = "health.cel"
 	actionScriptFile          = "action.lua"
 	actionDiscoveryScriptFile = "discovery.lua"
 )
@@ -36,12 +40,24 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 	luaVM := VM{
 		ResourceOverrides: overrides,
 	}
+	// a CEL expression or the generic conditions check configured in the resource overrides take precedence over the
+	// built-in scripts
+	if override, ok := luaVM.getHealthOverride(obj); ok && override.HealthLua == "" {
+		if override.HealthCEL != "" {
+			return healthcheck.EvaluateCEL(obj, override.HealthCEL)
+		}
+		return healthcheck.GetConditionsHealth(obj)
+	}
 	script, useOpenLibs, err := luaVM.GetHealthScript(obj)
 	if err != nil {
 		return nil, err
 	}
 	if script == "" {
-		return nil, nil
+		expression, err := luaVM.GetHealthCEL(obj)
+		if err != nil || expression == "" {
+			return nil, err
+		}
+		return healthcheck.EvaluateCEL(obj, expression)
 	}
 	// enable/disable the usage of lua standard library
 	luaVM.UseOpenLibs = useOpenLibs
@@ -52,6 +68,24 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 	return result, nil
 }
 
+// HasHealthCheck returns true if a health check is configured in the resource overrides or built in for the given gvk
+func (overrides ResourceHealthOverrides) HasHealthCheck(gvk schema.GroupVersionKind) (bool, error) {
+	luaVM := VM{
+		ResourceOverrides: overrides,
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if _, ok := luaVM.getHealthOverride(obj); ok {
+		return true, nil
+	}
+	script, _, err := luaVM.GetHealthScript(obj)
+	if err != nil || script != "" {
+		return script != "", err
+	}
+	expression, err := luaVM.GetHealthCEL(obj)
+	return expression != "", err
+}
+
 // VM Defines a struct that implements the luaVM
 type VM struct {
 	ResourceOverrides map[string]appv1.ResourceOverride
@@ -156,6 +190,66 @@ func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, bool, erro
 	return builtInScript, true, err
 }
 
+// getHealthOverride returns the resource override of the given resource which configures a health check, matching
+// the gvk as is first and then the wildcard entries
+func (vm VM) getHealthOverride(obj *unstructured.Unstructured) (appv1.ResourceOverride, bool) {
+	hasHealthCheck := func(override appv1.ResourceOverride) bool {
+		return override.HealthLua != "" || override.HealthCEL != "" || override.HealthKStatus
+	}
+	if override, ok := vm.ResourceOverrides[GetConfigMapKey(obj.GroupVersionKind())]; ok && hasHealthCheck(override) {
+		return override, true
+	}
+	if wildcardKey := GetWildcardConfigMapKey(vm, obj.GroupVersionKind()); wildcardKey != "" {
+		if override, ok := vm.ResourceOverrides[wildcardKey]; ok && hasHealthCheck(override) {
+			return override, true
+		}
+	}
+	return appv1.ResourceOverride{}, false
+}
+
+// GetProgressingTimeout returns the maximum amount of time a resource of the given gvk may remain Progressing,
+// matching the gvk as is first and then the most specific wildcard entry. Returns zero if not configured.
+func (overrides ResourceHealthOverrides) GetProgressingTimeout(gvk schema.GroupVersionKind) (time.Duration, error) {
+	key := GetConfigMapKey(gvk)
+	override, ok := overrides[key]
+	if !ok || override.ProgressingTimeout == "" {
+		wildcardKey := ""
+		for _, k := range overrides.sortedKeys() {
+			if overrides[k].ProgressingTimeout != "" && glob.Match(k, key) && (wildcardKey == "" || specificity(k) > specificity(wildcardKey)) {
+				wildcardKey = k
+			}
+		}
+		if wildcardKey == "" {
+			return 0, nil
+		}
+		override = overrides[wildcardKey]
+	}
+	timeout, err := override.GetProgressingTimeout()
+	if err != nil {
+		return 0, fmt.Errorf("invalid progressing timeout %q for %s: %w", override.ProgressingTimeout, key, err)
+	}
+	return timeout, nil
+}
+
+func (overrides ResourceHealthOverrides) sortedKeys() []string {
+	keys := make([]string, 0, len(overrides))
+	for k := range overrides {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// specificity returns the number of characters of a wildcard key which are not wildcards
+func specificity(wildcardKey string) int {
+	return len(wildcardKey) - strings.Count(wildcardKey, "*")
+}
+
+// GetHealthCEL returns the built-in CEL health expression of the resource, if any
+func (vm VM) GetHealthCEL(obj *unstructured.Unstructured) (string, error) {
+	return vm.getPredefinedLuaScripts(GetConfigMapKey(obj.GroupVersionKind()), healthCELFile)
+}
+
 func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string) ([]ImpactedResource, error) {
 	l, err := vm.runLua(obj, script)
 	if err != nil {
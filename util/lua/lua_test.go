@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	lua "github.com/yuin/gopher-lua"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/yaml"
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -101,6 +103,73 @@ func TestExecuteWildcardHealthStatusFunction(t *testing.T) {
 	assert.Equal(t, expectedHealthStatus, status)
 }
 
+func TestGetProgressingTimeout(t *testing.T) {
+	overrides := ResourceHealthOverrides{
+		"apps/Deployment":       {ProgressingTimeout: "10m"},
+		"*.crossplane.io/*":     {ProgressingTimeout: "30m"},
+		"*/*":                   {ProgressingTimeout: "1h"},
+		"*.aws.crossplane.io/*": {ProgressingTimeout: "20m"},
+		"example.com/Seconds":   {ProgressingTimeout: "90"},
+		"example.com/Invalid":   {ProgressingTimeout: "soon"},
+	}
+
+	timeout, err := overrides.GetProgressingTimeout(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, timeout)
+
+	// the most specific wildcard entry is used
+	for i := 0; i < 10; i++ {
+		timeout, err = overrides.GetProgressingTimeout(schema.GroupVersionKind{Group: "ec2.aws.crossplane.io", Version: "v1alpha1", Kind: "Instance"})
+		require.NoError(t, err)
+		assert.Equal(t, 20*time.Minute, timeout)
+	}
+
+	timeout, err = overrides.GetProgressingTimeout(schema.GroupVersionKind{Group: "gcp.crossplane.io", Version: "v1alpha1", Kind: "Instance"})
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, timeout)
+
+	timeout, err = overrides.GetProgressingTimeout(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, timeout)
+
+	// timeouts without unit are in seconds
+	timeout, err = overrides.GetProgressingTimeout(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Seconds"})
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	timeout, err = ResourceHealthOverrides{}.GetProgressingTimeout(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"})
+	require.NoError(t, err)
+	assert.Zero(t, timeout)
+
+	_, err = overrides.GetProgressingTimeout(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Invalid"})
+	require.Error(t, err)
+}
+
+func TestHasHealthCheck(t *testing.T) {
+	overrides := ResourceHealthOverrides{
+		"example.com/Lua":        {HealthLua: "return {}"},
+		"*.crossplane.io/*":      {HealthKStatus: true},
+		"example.com/NoHealth":   {ProgressingTimeout: "10m"},
+		"example.com/Conditions": {HealthCEL: "{'status': 'Healthy'}"},
+	}
+	for _, tc := range []struct {
+		gvk      schema.GroupVersionKind
+		expected bool
+	}{
+		{schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Lua"}, true},
+		{schema.GroupVersionKind{Group: "ec2.aws.crossplane.io", Version: "v1alpha1", Kind: "Instance"}, true},
+		{schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Conditions"}, true},
+		{schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "NoHealth"}, false},
+		{schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}, true},
+		{schema.GroupVersionKind{Group: "source.toolkit.fluxcd.io", Version: "v1", Kind: "GitRepository"}, true},
+		{schema.GroupVersionKind{Group: "coordination.k8s.io", Version: "v1", Kind: "Lease"}, false},
+	} {
+		hasHealthCheck, err := overrides.HasHealthCheck(tc.gvk)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, hasHealthCheck, tc.gvk.String())
+	}
+}
+
 const osLuaScript = `os.getenv("HOME")`
 
 func TestFailExternalLibCall(t *testing.T) {
@@ -794,4 +863,53 @@ return hs`
 		require.NoError(t, err)
 		assert.Nil(t, status)
 	})
+
+	t.Run("Get resource health for CEL override", func(t *testing.T) {
+		testObj := StrToUnstructured(testSA)
+		overrides := ResourceHealthOverrides{
+			"ServiceAccount": appv1.ResourceOverride{
+				HealthCEL: `{'status': 'Degraded', 'message': object.metadata.name}`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "test"}, status)
+	})
+
+	t.Run("Lua override takes precedence over CEL override", func(t *testing.T) {
+		testObj := StrToUnstructured(testSA)
+		overrides := ResourceHealthOverrides{
+			"ServiceAccount": appv1.ResourceOverride{
+				HealthLua: healthWildcardOverrideScript,
+				HealthCEL: `'Degraded'`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy}, status)
+	})
+
+	t.Run("CEL override takes precedence over built-in Lua script", func(t *testing.T) {
+		testObj := StrToUnstructured(objJSON)
+		overrides := ResourceHealthOverrides{
+			"argoproj.io/Rollout": appv1.ResourceOverride{
+				HealthCEL: `'Suspended'`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusSuspended}, status)
+	})
+
+	t.Run("Get resource health for kstatus override", func(t *testing.T) {
+		testObj := StrToUnstructured(ec2AWSCrossplaneObjJson)
+		overrides := ResourceHealthOverrides{
+			"*.aws.crossplane.io/*": appv1.ResourceOverride{
+				HealthKStatus: true,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy}, status)
+	})
 }
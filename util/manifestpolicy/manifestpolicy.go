@@ -0,0 +1,98 @@
+package manifestpolicy
+
+import (
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/celexpr"
+)
+
+// compiler compiles the policy expressions, which must evaluate to a bool
+var compiler = celexpr.NewCompiler(func(ast *cel.Ast) error {
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return fmt.Errorf("expression must return a bool, got %s", ast.OutputType())
+	}
+	return nil
+})
+
+// Violation is a violation of a manifest policy by a target resource
+type Violation struct {
+	// Policy is the name of the violated policy
+	Policy string
+	// Severity is the severity of the violated policy
+	Severity v1alpha1.ManifestPolicySeverity
+	// Resource is the key of the resource violating the policy
+	Resource kube.ResourceKey
+	// Message is the message of the violated policy, or the error which occurred while evaluating it
+	Message string
+}
+
+func (v Violation) String() string {
+	name := v.Resource.Name
+	if v.Resource.Namespace != "" {
+		name = v.Resource.Namespace + "/" + name
+	}
+	return fmt.Sprintf("%s/%s %s violates policy '%s': %s", v.Resource.Group, v.Resource.Kind, name, v.Policy, v.Message)
+}
+
+// Validate returns an error if the expression of any of the given policies is invalid
+func Validate(policies []v1alpha1.ManifestPolicy) error {
+	for _, policy := range policies {
+		if _, err := compiler.Compile(policy.Expression); err != nil {
+			return fmt.Errorf("manifest policy '%s' has an invalid expression: %w", policy.Name, err)
+		}
+	}
+	return nil
+}
+
+// evaluate returns whether the given object complies with the policy
+func evaluate(prg cel.Program, obj *unstructured.Unstructured) (bool, error) {
+	out, _, err := prg.Eval(map[string]interface{}{"object": obj.Object})
+	if err != nil {
+		return false, err
+	}
+	compliant, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %v instead of a bool", out.Value())
+	}
+	return compliant, nil
+}
+
+// Evaluate evaluates the given policies against each of the given objects and returns the violations. Policies are
+// enforced strictly: a policy which cannot be compiled or evaluated against an object is reported as violated.
+func Evaluate(policies []v1alpha1.ManifestPolicy, objs []*unstructured.Unstructured) []Violation {
+	var violations []Violation
+	for i := range policies {
+		policy := policies[i]
+		prg, compileErr := compiler.Compile(policy.Expression)
+		for _, obj := range objs {
+			if obj == nil || !policy.Matches(metav1.GroupKind{Group: obj.GroupVersionKind().Group, Kind: obj.GetKind()}) {
+				continue
+			}
+			violation := Violation{Policy: policy.Name, Severity: policy.GetSeverity(), Resource: kube.GetResourceKey(obj)}
+			if compileErr != nil {
+				violation.Message = fmt.Sprintf("invalid expression: %v", compileErr)
+				violations = append(violations, violation)
+				continue
+			}
+			compliant, err := evaluate(prg, obj)
+			switch {
+			case err != nil:
+				violation.Message = fmt.Sprintf("failed to evaluate expression: %v", err)
+			case compliant:
+				continue
+			case policy.Message != "":
+				violation.Message = policy.Message
+			default:
+				violation.Message = fmt.Sprintf("expression '%s' is false", policy.Expression)
+			}
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
@@ -0,0 +1,104 @@
+package manifestpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const deploymentManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+  labels:
+    team: frontend
+spec:
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:latest
+`
+
+const configMapManifest = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: default
+`
+
+func unmarshal(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+	var obj unstructured.Unstructured
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &obj))
+	return &obj
+}
+
+func TestEvaluate(t *testing.T) {
+	objs := []*unstructured.Unstructured{unmarshal(t, deploymentManifest), unmarshal(t, configMapManifest)}
+
+	t.Run("Compliant", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name:       "team-label",
+			Kinds:      []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}},
+			Expression: "has(object.metadata.labels) && 'team' in object.metadata.labels",
+		}}, objs)
+		assert.Empty(t, violations)
+	})
+
+	t.Run("Violated", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name:       "no-latest",
+			Kinds:      []metav1.GroupKind{{Group: "apps", Kind: "*"}},
+			Expression: "object.spec.template.spec.containers.all(c, !c.image.endsWith(':latest'))",
+			Message:    "images must not use the latest tag",
+		}}, objs)
+		require.Len(t, violations, 1)
+		assert.Equal(t, "no-latest", violations[0].Policy)
+		assert.Equal(t, v1alpha1.ManifestPolicySeverityDeny, violations[0].Severity)
+		assert.Equal(t, "guestbook", violations[0].Resource.Name)
+		assert.Equal(t, "apps/Deployment default/guestbook violates policy 'no-latest': images must not use the latest tag", violations[0].String())
+	})
+
+	t.Run("AllKinds", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name:       "team-label",
+			Expression: "has(object.metadata.labels) && 'team' in object.metadata.labels",
+			Severity:   v1alpha1.ManifestPolicySeverityWarn,
+		}}, objs)
+		require.Len(t, violations, 1)
+		assert.Equal(t, "ConfigMap", violations[0].Resource.Kind)
+		assert.Equal(t, v1alpha1.ManifestPolicySeverityWarn, violations[0].Severity)
+		assert.Contains(t, violations[0].Message, "is false")
+	})
+
+	t.Run("EvaluationError", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name:       "missing-field",
+			Kinds:      []metav1.GroupKind{{Kind: "ConfigMap"}},
+			Expression: "object.data.key == 'value'",
+		}}, objs)
+		require.Len(t, violations, 1)
+		assert.Contains(t, violations[0].Message, "failed to evaluate expression")
+	})
+
+	t.Run("InvalidExpression", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{Name: "invalid", Expression: "object.metadata.name +"}}, objs)
+		assert.Len(t, violations, 2)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate([]v1alpha1.ManifestPolicy{{Name: "valid", Expression: "object.kind != 'Pod'"}}))
+	require.Error(t, Validate([]v1alpha1.ManifestPolicy{{Name: "syntax", Expression: "object.kind !="}}))
+	require.Error(t, Validate([]v1alpha1.ManifestPolicy{{Name: "not-bool", Expression: "'value'"}}))
+}
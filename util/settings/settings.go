@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	v1 "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	v1listers "k8s.io/client-go/listers/core/v1"
@@ -522,8 +523,20 @@ const (
 	RespectRBACValueNormal = "normal"
 	// kustomizeSetNamespaceEnabledKey is the key to configure if kustomize set namespace should be executed
 	kustomizeSetNamespaceEnabledKey = "kustomize.setNamespace.enabled"
+	// impersonationEnabledKey is the key to configure whether application syncs impersonate the service accounts of the project destinations
+	impersonationEnabledKey = "application.sync.impersonation.enabled"
+	// replaceOnImmutableFieldKindsKey is the key to configure the kinds of resources which may be recreated by the ReplaceOnImmutableField sync option
+	replaceOnImmutableFieldKindsKey = "application.sync.replaceOnImmutableField.kinds"
 )
 
+// defaultReplaceOnImmutableFieldKinds are the kinds of resources which may be recreated by the ReplaceOnImmutableField
+// sync option if not configured otherwise
+var defaultReplaceOnImmutableFieldKinds = []schema.GroupKind{
+	{Group: "batch", Kind: "Job"},
+	{Group: "", Kind: "Service"},
+	{Group: "apps", Kind: "StatefulSet"},
+}
+
 const (
 	// default max webhook payload size is 1GB
 	defaultMaxWebhookPayloadSize = int64(1) * 1024 * 1024 * 1024
@@ -917,6 +930,51 @@ func (mgr *SettingsManager) GetIsIgnoreResourceUpdatesEnabled() (bool, error) {
 	return strconv.ParseBool(argoCDCM.Data[resourceIgnoreResourceUpdatesEnabledKey])
 }
 
+// IsImpersonationEnabled returns whether application syncs and resource modifications impersonate the
+// service accounts configured in the destinations of the application projects
+func (mgr *SettingsManager) IsImpersonationEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error retrieving config map: %w", err)
+	}
+
+	if argoCDCM.Data[impersonationEnabledKey] == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(argoCDCM.Data[impersonationEnabledKey])
+}
+
+// GetReplaceOnImmutableFieldKinds returns the kinds of resources which may be deleted and recreated by the
+// ReplaceOnImmutableField sync option. The kinds are configured as a comma-separated list of <group>/<kind>, where the
+// group of the core API is empty.
+func (mgr *SettingsManager) GetReplaceOnImmutableFieldKinds() ([]schema.GroupKind, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config map: %w", err)
+	}
+	value, ok := argoCDCM.Data[replaceOnImmutableFieldKindsKey]
+	if !ok {
+		return defaultReplaceOnImmutableFieldKinds, nil
+	}
+	kinds := make([]schema.GroupKind, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		group, kind, found := strings.Cut(item, "/")
+		if !found {
+			group, kind = "", item
+		}
+		if kind == "" {
+			return nil, fmt.Errorf("invalid kind %q in %s: kinds must be formatted as <group>/<kind>", item, replaceOnImmutableFieldKindsKey)
+		}
+		kinds = append(kinds, schema.GroupKind{Group: group, Kind: kind})
+	}
+	return kinds, nil
+}
+
 // GetResourceOverrides loads Resource Overrides from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.ResourceOverride, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -1024,6 +1082,19 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 				return err
 			}
 			overrideVal.UseOpenLibs = useOpenLibs
+		case "healthCEL":
+			overrideVal.HealthCEL = v
+		case "healthKStatus":
+			healthKStatus, err := strconv.ParseBool(v)
+			if err != nil {
+				return err
+			}
+			overrideVal.HealthKStatus = healthKStatus
+		case "progressingTimeout":
+			overrideVal.ProgressingTimeout = v
+			if _, err := overrideVal.GetProgressingTimeout(); err != nil {
+				return fmt.Errorf("invalid progressing timeout for %s: %w", overrideKey, err)
+			}
 		case "actions":
 			overrideVal.Actions = v
 		case "ignoreDifferences":
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
@@ -201,6 +202,46 @@ func TestGetIsIgnoreResourceUpdatesEnabledDefaultFalse(t *testing.T) {
 	assert.False(t, ignoreResourceUpdatesEnabled)
 }
 
+func TestIsImpersonationEnabled(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"application.sync.impersonation.enabled": "true",
+	})
+	impersonationEnabled, err := settingsManager.IsImpersonationEnabled()
+	require.NoError(t, err)
+	assert.True(t, impersonationEnabled)
+}
+
+func TestIsImpersonationEnabledDefaultFalse(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	impersonationEnabled, err := settingsManager.IsImpersonationEnabled()
+	require.NoError(t, err)
+	assert.False(t, impersonationEnabled)
+}
+
+func TestGetReplaceOnImmutableFieldKinds(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		kinds, err := settingsManager.GetReplaceOnImmutableFieldKinds()
+		require.NoError(t, err)
+		assert.Equal(t, defaultReplaceOnImmutableFieldKinds, kinds)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"application.sync.replaceOnImmutableField.kinds": "batch/Job, PersistentVolumeClaim,",
+		})
+		kinds, err := settingsManager.GetReplaceOnImmutableFieldKinds()
+		require.NoError(t, err)
+		assert.Equal(t, []schema.GroupKind{{Group: "batch", Kind: "Job"}, {Kind: "PersistentVolumeClaim"}}, kinds)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"application.sync.replaceOnImmutableField.kinds": "batch/",
+		})
+		_, err := settingsManager.GetReplaceOnImmutableFieldKinds()
+		require.Error(t, err)
+	})
+}
+
 func TestGetServerRBACLogEnforceEnableKey(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{
 		"server.rbac.log.enforce.enable": "true",
@@ -331,6 +372,91 @@ func TestGetResourceOverridesHealthWithWildcard(t *testing.T) {
 	})
 }
 
+func TestGetResourceOverridesHealthCELAndKStatus(t *testing.T) {
+	t.Run("ResourceCustomizations", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.customizations": `
+    example.com/Foo:
+      health.cel: "'Healthy'"
+    example.com/Bar:
+      health.kstatus: true`,
+		})
+
+		overrides, err := settingsManager.GetResourceOverrides()
+		require.NoError(t, err)
+		assert.Equal(t, "'Healthy'", overrides["example.com/Foo"].HealthCEL)
+		assert.False(t, overrides["example.com/Foo"].HealthKStatus)
+		assert.True(t, overrides["example.com/Bar"].HealthKStatus)
+	})
+
+	t.Run("SplitKeys", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.customizations.healthCEL.example.com_Foo":     "'Healthy'",
+			"resource.customizations.healthKStatus.example.com_Bar": "true",
+		})
+
+		overrides, err := settingsManager.GetResourceOverrides()
+		require.NoError(t, err)
+		assert.Equal(t, "'Healthy'", overrides["example.com/Foo"].HealthCEL)
+		assert.True(t, overrides["example.com/Bar"].HealthKStatus)
+	})
+
+	t.Run("InvalidKStatusValue", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.customizations.healthKStatus.example.com_Bar": "maybe",
+		})
+
+		_, err := settingsManager.GetResourceOverrides()
+		require.Error(t, err)
+	})
+}
+
+func TestGetResourceOverridesProgressingTimeout(t *testing.T) {
+	t.Run("ResourceCustomizations", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.customizations": `
+    example.com/Foo:
+      health.progressingTimeout: 10m`,
+		})
+
+		overrides, err := settingsManager.GetResourceOverrides()
+		require.NoError(t, err)
+		assert.Equal(t, "10m", overrides["example.com/Foo"].ProgressingTimeout)
+	})
+
+	t.Run("SplitKeys", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.customizations.progressingTimeout.apps_Deployment": "15m",
+		})
+
+		overrides, err := settingsManager.GetResourceOverrides()
+		require.NoError(t, err)
+		assert.Equal(t, "15m", overrides["apps/Deployment"].ProgressingTimeout)
+	})
+
+	t.Run("SplitKeysWithoutUnit", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.customizations.progressingTimeout.apps_Deployment": "90",
+		})
+
+		overrides, err := settingsManager.GetResourceOverrides()
+		require.NoError(t, err)
+		override := overrides["apps/Deployment"]
+		timeout, err := override.GetProgressingTimeout()
+		require.NoError(t, err)
+		assert.Equal(t, 90*time.Second, timeout)
+	})
+
+	t.Run("InvalidValue", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.customizations.progressingTimeout.apps_Deployment": "soon",
+		})
+
+		_, err := settingsManager.GetResourceOverrides()
+		require.Error(t, err)
+	})
+}
+
 func TestSettingsManager_GetResourceOverrides_with_empty_string(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{
 		resourceCustomizationsKey: "",